@@ -2,12 +2,14 @@ package acceptance
 
 import (
 	"bytes"
+	"context"
 	"os"
 	"strings"
 
 	"github.com/fatih/color"
 	"github.com/genevieve/leftovers/app"
 	"github.com/genevieve/leftovers/aws"
+	"github.com/genevieve/leftovers/common"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -17,9 +19,10 @@ var _ = Describe("AWS", func() {
 	var (
 		acc AWSAcceptance
 
-		stdout  *bytes.Buffer
-		filter  string
-		deleter aws.Leftovers
+		stdout        *bytes.Buffer
+		filter        string
+		deleter       aws.Leftovers
+		stopRecording func()
 	)
 
 	BeforeEach(func() {
@@ -34,13 +37,27 @@ var _ = Describe("AWS", func() {
 		stdout = bytes.NewBuffer([]byte{})
 		logger := app.NewLogger(stdout, os.Stdin, noConfirm)
 
+		var transport common.WrapTransport
+		transport, stopRecording = Record(acc.AccessKeyId, acc.SecretAccessKey, acc.SessionToken)
+
 		var err error
-		deleter, err = aws.NewLeftovers(logger, acc.AccessKeyId, acc.SecretAccessKey, acc.SessionToken, acc.Region)
+		authArgs := aws.AuthArgs{
+			AccessKeyID:     acc.AccessKeyId,
+			SecretAccessKey: acc.SecretAccessKey,
+			SessionToken:    acc.SessionToken,
+		}
+		deleter, err = aws.NewLeftovers(logger, authArgs, acc.Region, aws.Endpoints{}, transport, common.Backup{}, aws.DeleteArgs{})
 		Expect(err).NotTo(HaveOccurred())
 
 		color.NoColor = true
 	})
 
+	AfterEach(func() {
+		if stopRecording != nil {
+			stopRecording()
+		}
+	})
+
 	Describe("Dry run", func() {
 		BeforeEach(func() {
 			filter = "leftovers-dry-run"
@@ -48,7 +65,7 @@ var _ = Describe("AWS", func() {
 		})
 
 		AfterEach(func() {
-			err := deleter.Delete(filter)
+			err := deleter.Delete(context.Background(), filter)
 			Expect(err).NotTo(HaveOccurred())
 		})
 
@@ -76,7 +93,7 @@ var _ = Describe("AWS", func() {
 		})
 
 		It("deletes resources with the filter", func() {
-			err := deleter.Delete(filter)
+			err := deleter.Delete(context.Background(), filter)
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(stdout.String()).To(ContainSubstring("[EC2 Key Pair: leftovers-acceptance] Deleting..."))
@@ -91,7 +108,7 @@ var _ = Describe("AWS", func() {
 		})
 
 		It("deletes the key pair resources with the filter", func() {
-			err := deleter.DeleteType(filter, "ec2-key-pair")
+			err := deleter.DeleteType(context.Background(), filter, "ec2-key-pair")
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(stdout.String()).To(ContainSubstring("[EC2 Key Pair: lftvrs-acceptance-delete-type] Deleting..."))
@@ -2,6 +2,7 @@ package acceptance
 
 import (
 	"bytes"
+	"context"
 	"os"
 	"strings"
 
@@ -34,7 +35,7 @@ var _ = Describe("Azure", func() {
 		logger := app.NewLogger(stdout, os.Stdin, noConfirm)
 
 		var err error
-		deleter, err = azure.NewLeftovers(logger, acc.ClientId, acc.ClientSecret, acc.SubscriptionId, acc.TenantId)
+		deleter, err = azure.NewLeftovers(logger, acc.ClientId, acc.ClientSecret, acc.SubscriptionId, acc.TenantId, "", false)
 		Expect(err).NotTo(HaveOccurred())
 
 		color.NoColor = true
@@ -47,7 +48,7 @@ var _ = Describe("Azure", func() {
 		})
 
 		AfterEach(func() {
-			err := deleter.Delete(filter)
+			err := deleter.Delete(context.Background(), filter)
 			Expect(err).NotTo(HaveOccurred())
 		})
 
@@ -74,7 +75,7 @@ var _ = Describe("Azure", func() {
 		})
 
 		It("deletes resources with the filter", func() {
-			err := deleter.Delete(filter)
+			err := deleter.Delete(context.Background(), filter)
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(stdout.String()).To(ContainSubstring("[Resource Group: leftovers-acceptance] Deleting..."))
@@ -63,6 +63,6 @@ func (g GCPAcceptance) InsertDisk(name string) {
 
 	waiter := compute.NewOperationWaiter(operation, service, g.ProjectId, g.Logger)
 
-	err = waiter.Wait()
+	err = waiter.Wait(context.Background())
 	Expect(err).NotTo(HaveOccurred())
 }
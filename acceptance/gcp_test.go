@@ -2,11 +2,13 @@ package acceptance
 
 import (
 	"bytes"
+	"context"
 	"os"
 	"strings"
 
 	"github.com/fatih/color"
 	"github.com/genevieve/leftovers/app"
+	"github.com/genevieve/leftovers/common"
 	"github.com/genevieve/leftovers/gcp"
 
 	. "github.com/onsi/ginkgo"
@@ -15,10 +17,11 @@ import (
 
 var _ = Describe("GCP", func() {
 	var (
-		acc     GCPAcceptance
-		stdout  *bytes.Buffer
-		filter  string
-		deleter gcp.Leftovers
+		acc           GCPAcceptance
+		stdout        *bytes.Buffer
+		filter        string
+		deleter       gcp.Leftovers
+		stopRecording func()
 	)
 
 	BeforeEach(func() {
@@ -33,13 +36,22 @@ var _ = Describe("GCP", func() {
 		stdout = bytes.NewBuffer([]byte{})
 		logger := app.NewLogger(stdout, os.Stdin, noConfirm)
 
+		var transport common.WrapTransport
+		transport, stopRecording = Record()
+
 		var err error
-		deleter, err = gcp.NewLeftovers(logger, acc.KeyPath)
+		deleter, err = gcp.NewLeftovers(logger, acc.KeyPath, gcp.Endpoints{}, transport, common.Backup{})
 		Expect(err).NotTo(HaveOccurred())
 
 		color.NoColor = true
 	})
 
+	AfterEach(func() {
+		if stopRecording != nil {
+			stopRecording()
+		}
+	})
+
 	Describe("Dry run", func() {
 		BeforeEach(func() {
 			filter = "leftovers-dry-run"
@@ -47,7 +59,7 @@ var _ = Describe("GCP", func() {
 		})
 
 		AfterEach(func() {
-			err := deleter.Delete(filter)
+			err := deleter.Delete(context.Background(), filter)
 			Expect(err).NotTo(HaveOccurred())
 		})
 
@@ -75,7 +87,7 @@ var _ = Describe("GCP", func() {
 		})
 
 		It("deletes resources with the filter", func() {
-			err := deleter.Delete(filter)
+			err := deleter.Delete(context.Background(), filter)
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(stdout.String()).To(ContainSubstring("[Disk: leftovers-acceptance] Deleting..."))
@@ -90,7 +102,7 @@ var _ = Describe("GCP", func() {
 		})
 
 		It("deletes resources with the filter", func() {
-			err := deleter.DeleteType(filter, "disk")
+			err := deleter.DeleteType(context.Background(), filter, "disk")
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(stdout.String()).To(ContainSubstring("[Disk: lftvrs-acceptance-delete-type] Deleting..."))
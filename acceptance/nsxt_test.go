@@ -2,11 +2,13 @@ package acceptance
 
 import (
 	"bytes"
+	"context"
 	"os"
 	"strings"
 
 	"github.com/fatih/color"
 	"github.com/genevieve/leftovers/app"
+	"github.com/genevieve/leftovers/common"
 	"github.com/genevieve/leftovers/nsxt"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -16,8 +18,9 @@ var _ = Describe("NSX-T", func() {
 	var (
 		acc NSXTAcceptance
 
-		stdout  *bytes.Buffer
-		deleter nsxt.Leftovers
+		stdout        *bytes.Buffer
+		deleter       nsxt.Leftovers
+		stopRecording func()
 	)
 
 	BeforeEach(func() {
@@ -32,13 +35,22 @@ var _ = Describe("NSX-T", func() {
 		stdout = bytes.NewBuffer([]byte{})
 		logger := app.NewLogger(stdout, os.Stdin, noConfirm)
 
+		var transport common.WrapTransport
+		transport, stopRecording = Record(acc.Password)
+
 		var err error
-		deleter, err = nsxt.NewLeftovers(logger, acc.ManagerHost, acc.User, acc.Password)
+		deleter, err = nsxt.NewLeftovers(logger, acc.ManagerHost, acc.User, acc.Password, "", transport)
 		Expect(err).NotTo(HaveOccurred())
 
 		color.NoColor = true
 	})
 
+	AfterEach(func() {
+		if stopRecording != nil {
+			stopRecording()
+		}
+	})
+
 	Describe("leftovers", func() {
 		BeforeEach(func() {
 			acc.CreateT1Router("leftover-tier1-router")
@@ -54,7 +66,7 @@ var _ = Describe("NSX-T", func() {
 			})
 
 			By("successfully deleting resources", func() {
-				err := deleter.Delete("leftover")
+				err := deleter.Delete(context.Background(), "leftover")
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(stdout.String()).To(ContainSubstring("[Tier 1 Router: leftover-tier1-router] Deleting..."))
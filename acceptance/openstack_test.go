@@ -2,6 +2,7 @@ package acceptance
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"os"
 	"strings"
@@ -43,7 +44,7 @@ var _ = Describe("Openstack", func() {
 			By("failing to create a new Leftovers when openstack can't authenticate")
 			incorrectAuthArgs := openstack.AuthArgs{}
 			var err error
-			leftovers, err = openstack.NewLeftovers(nil, incorrectAuthArgs)
+			leftovers, err = openstack.NewLeftovers(nil, incorrectAuthArgs, nil)
 
 			Expect(leftovers).To(Equal(openstack.Leftovers{}))
 			Expect(err).To(HaveOccurred())
@@ -53,6 +54,8 @@ var _ = Describe("Openstack", func() {
 			noConfirm := true
 			stdout = bytes.NewBuffer([]byte{})
 			logger := app.NewLogger(stdout, os.Stdin, noConfirm)
+			transport, stopRecording := Record(acc.Password)
+			defer stopRecording()
 			leftovers, err = openstack.NewLeftovers(logger, openstack.AuthArgs{
 				AuthURL:    acc.AuthURL,
 				Username:   acc.Username,
@@ -60,7 +63,7 @@ var _ = Describe("Openstack", func() {
 				Domain:     acc.Domain,
 				Region:     acc.Region,
 				TenantName: acc.TenantName,
-			})
+			}, transport)
 			Expect(err).NotTo(HaveOccurred())
 			leftovers.Types()
 
@@ -89,7 +92,7 @@ var _ = Describe("Openstack", func() {
 			Expect(acc.ImageExists(imageID)).To(BeTrue())
 
 			By("passing a filter to DeleteType")
-			err = leftovers.DeleteType("some filter", "Volume")
+			err = leftovers.DeleteType(context.Background(), "some filter", "Volume")
 
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(Equal("cannot delete openstack resources using a filter"))
@@ -102,7 +105,7 @@ var _ = Describe("Openstack", func() {
 			Eventually(func() (bool, error) {
 				return acc.IsSafeToDeleteVolume(volumeID)
 			}, "2s").Should(BeTrue(), "Volume status should have transitioned to a deletable status")
-			err = leftovers.DeleteType("", "Volume")
+			err = leftovers.DeleteType(context.Background(), "", "Volume")
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(stdout.String()).To(ContainSubstring(fmt.Sprintf("[Volume: %s %s] Deleting...", "some volume", volumeID)))
@@ -116,7 +119,7 @@ var _ = Describe("Openstack", func() {
 
 			By("deleting by type 'Compute Instance'")
 			volumeID = acc.CreateVolume("some other volume")
-			err = leftovers.DeleteType("", "Compute Instance")
+			err = leftovers.DeleteType(context.Background(), "", "Compute Instance")
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(stdout.String()).To(ContainSubstring(fmt.Sprintf("[Compute Instance: %s %s] Deleting...", "some instance", instanceID)))
@@ -131,7 +134,7 @@ var _ = Describe("Openstack", func() {
 			By("deleting by type 'Image'", func() {
 				volumeID = acc.CreateVolume("yet another volume")
 				instanceID = acc.CreateComputeInstance("yet another compute instance")
-				err = leftovers.DeleteType("", "Image")
+				err = leftovers.DeleteType(context.Background(), "", "Image")
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(stdout.String()).To(ContainSubstring(fmt.Sprintf("[Image: %s %s] Deleting...", "some image", imageID)))
@@ -149,7 +152,7 @@ var _ = Describe("Openstack", func() {
 			By("passing a filter to Delete")
 			instanceID = acc.CreateComputeInstance("some other instance")
 			imageID = acc.CreateImage("some other image")
-			err = leftovers.Delete("filter")
+			err = leftovers.Delete(context.Background(), "filter")
 
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(Equal("cannot delete openstack resources using a filter"))
@@ -162,7 +165,7 @@ var _ = Describe("Openstack", func() {
 			Eventually(func() (bool, error) {
 				return acc.IsSafeToDeleteVolume(volumeID)
 			}, "10s").Should(BeTrue(), "Volume status should have transitioned to a deletable status")
-			err = leftovers.Delete("")
+			err = leftovers.Delete(context.Background(), "")
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(stdout.String()).To(ContainSubstring(fmt.Sprintf("[Volume: %s %s] Deleting...", "yet another volume", volumeID)))
@@ -0,0 +1,39 @@
+package acceptance
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/genevieve/leftovers/common"
+	"github.com/genevieve/leftovers/recorder"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const LEFTOVERS_CASSETTE_DIR = "LEFTOVERS_CASSETTE_DIR"
+
+var nonWord = regexp.MustCompile(`[^a-z0-9]+`)
+
+// Record returns a transport that records the traffic of the current spec
+// to a cassette in $LEFTOVERS_CASSETTE_DIR, with the provided secrets
+// scrubbed, and a function that saves the cassette. If the variable
+// is not set, nothing is recorded.
+func Record(secrets ...string) (common.WrapTransport, func()) {
+	dir := os.Getenv(LEFTOVERS_CASSETTE_DIR)
+	if dir == "" {
+		return nil, func() {}
+	}
+
+	name := strings.Trim(nonWord.ReplaceAllString(strings.ToLower(CurrentGinkgoTestDescription().FullTestText), "-"), "-")
+
+	rec, err := recorder.New(filepath.Join(dir, name+".json"), recorder.ModeRecord)
+	Expect(err).NotTo(HaveOccurred())
+	rec.Scrub(secrets...)
+
+	return rec.Wrap, func() {
+		Expect(rec.Stop()).To(Succeed())
+	}
+}
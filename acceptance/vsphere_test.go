@@ -2,12 +2,14 @@ package acceptance
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"os"
 	"strings"
 
 	"github.com/fatih/color"
 	"github.com/genevieve/leftovers/app"
+	"github.com/genevieve/leftovers/common"
 	"github.com/genevieve/leftovers/vsphere"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -17,9 +19,10 @@ var _ = Describe("vSphere", func() {
 	var (
 		acc VSphereAcceptance
 
-		stdout  *bytes.Buffer
-		filter  string
-		deleter vsphere.Leftovers
+		stdout        *bytes.Buffer
+		filter        string
+		deleter       vsphere.Leftovers
+		stopRecording func()
 	)
 
 	BeforeEach(func() {
@@ -38,13 +41,22 @@ var _ = Describe("vSphere", func() {
 		stdout = bytes.NewBuffer([]byte{})
 		logger := app.NewLogger(stdout, os.Stdin, noConfirm)
 
+		var transport common.WrapTransport
+		transport, stopRecording = Record(acc.VCenterPassword)
+
 		var err error
-		deleter, err = vsphere.NewLeftovers(logger, acc.VCenterIP, acc.VCenterUser, acc.VCenterPassword, acc.Datacenter)
+		deleter, err = vsphere.NewLeftovers(logger, acc.VCenterIP, acc.VCenterUser, acc.VCenterPassword, acc.Datacenter, transport)
 		Expect(err).NotTo(HaveOccurred())
 
 		color.NoColor = true
 	})
 
+	AfterEach(func() {
+		if stopRecording != nil {
+			stopRecording()
+		}
+	})
+
 	Describe("leftovers", func() {
 		BeforeEach(func() {
 			rootFolder := acc.FindFolder(filter)
@@ -70,7 +82,7 @@ var _ = Describe("vSphere", func() {
 			})
 
 			By("successfully deleting VMs", func() {
-				err := deleter.Delete(filter)
+				err := deleter.Delete(context.Background(), filter)
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(stdout.String()).To(ContainSubstring("[Virtual Machine: leftover-vm] Deleting..."))
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"fmt"
 	"sync"
 
@@ -11,6 +12,7 @@ import (
 
 type logger interface {
 	Println(message string)
+	Errorln(message string)
 }
 
 type AsyncDeleter struct {
@@ -23,13 +25,21 @@ func NewAsyncDeleter(logger logger) AsyncDeleter {
 	}
 }
 
-func (a AsyncDeleter) Run(deletables [][]common.Deletable) error {
+// Run deletes each list of deletables concurrently, one list at a time.
+// When the context is done, it stops waiting for the deletables that support
+// it and does not start deleting the lists that are left.
+func (a AsyncDeleter) Run(ctx context.Context, deletables [][]common.Deletable) error {
 	var (
 		wg     sync.WaitGroup
+		mu     sync.Mutex
 		result *multierror.Error
 	)
 
 	for _, list := range deletables {
+		if ctx.Err() != nil {
+			result = multierror.Append(result, ctx.Err())
+			break
+		}
 
 		for _, d := range list {
 			wg.Add(1)
@@ -39,12 +49,15 @@ func (a AsyncDeleter) Run(deletables [][]common.Deletable) error {
 
 				a.logger.Println(fmt.Sprintf("[%s: %s] Deleting...", d.Type(), d.Name()))
 
-				err := d.Delete()
+				err := common.Delete(ctx, d)
 				if err != nil {
 					err = fmt.Errorf("[%s: %s] %s", d.Type(), d.Name(), color.YellowString(err.Error()))
+
+					mu.Lock()
 					result = multierror.Append(result, err)
+					mu.Unlock()
 
-					a.logger.Println(err.Error())
+					a.logger.Errorln(err.Error())
 				} else {
 					a.logger.Println(fmt.Sprintf("[%s: %s] %s", d.Type(), d.Name(), color.GreenString("Deleted!")))
 				}
@@ -1,18 +1,53 @@
 package app
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
+	"regexp"
 	"strings"
 	"sync"
+	"time"
 )
 
+// Level is the minimum level of the messages that the Logger prints.
+type Level int
+
+const (
+	LevelDebug Level = iota
+	LevelVerbose
+	LevelNormal
+	LevelQuiet
+
+	// LevelError is the level of errors, which are
+	// printed at every level, including LevelQuiet.
+	LevelError
+)
+
+func (l Level) String() string {
+	switch l {
+	case LevelDebug:
+		return "debug"
+	case LevelVerbose:
+		return "verbose"
+	case LevelError:
+		return "error"
+	default:
+		return "info"
+	}
+}
+
+var ansi = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
 type Logger struct {
 	newline   bool
 	writer    io.Writer
 	mutex     *sync.Mutex
 	reader    io.Reader
 	noConfirm bool
+	level     Level
+	json      bool
+	file      io.Writer
 }
 
 // NewLogger returns a new Logger with the provided writer,
@@ -24,9 +59,27 @@ func NewLogger(writer io.Writer, reader io.Reader, noConfirm bool) *Logger {
 		mutex:     &sync.Mutex{},
 		reader:    reader,
 		noConfirm: noConfirm,
+		level:     LevelNormal,
 	}
 }
 
+// SetLevel sets the minimum level of the messages to print.
+func (l *Logger) SetLevel(level Level) {
+	l.level = level
+}
+
+// SetJSON prints each message as a line of JSON with
+// its time and level instead of as plain text.
+func (l *Logger) SetJSON(json bool) {
+	l.json = json
+}
+
+// SetLogFile also writes every message to the provided file, without
+// colors. Messages are written to the file even with LevelQuiet.
+func (l *Logger) SetLogFile(file io.Writer) {
+	l.file = file
+}
+
 // clear is not threadsafe.
 func (l *Logger) clear() {
 	if l.newline {
@@ -39,23 +92,74 @@ func (l *Logger) clear() {
 
 // Printf handles arguments in the manner of fmt.Fprintf.
 func (l *Logger) Printf(message string, a ...interface{}) {
-	l.mutex.Lock()
-	defer l.mutex.Unlock()
-	l.clear()
-	fmt.Fprintf(l.writer, "%s", fmt.Sprintf(message, a...))
+	l.write(LevelNormal, fmt.Sprintf(message, a...))
 }
 
 // Println handles the argument in the manner of fmt.Fprintln.
 func (l *Logger) Println(message string) {
+	l.write(LevelNormal, fmt.Sprintln(message))
+}
+
+// Errorln prints an error, like a resource failing to be listed or
+// deleted, in the manner of fmt.Fprintln. Errors are printed at every level.
+func (l *Logger) Errorln(message string) {
+	l.write(LevelError, fmt.Sprintln(message))
+}
+
+// Verbosef prints progress, like waiting for a resource
+// to be deleted, when the level is LevelVerbose or lower.
+func (l *Logger) Verbosef(message string, a ...interface{}) {
+	l.write(LevelVerbose, fmt.Sprintf(message, a...))
+}
+
+// Debugf prints details, like API requests, when the level is LevelDebug.
+func (l *Logger) Debugf(message string, a ...interface{}) {
+	l.write(LevelDebug, fmt.Sprintf(message, a...))
+}
+
+func (l *Logger) write(level Level, message string) {
 	l.mutex.Lock()
 	defer l.mutex.Unlock()
+
+	if l.file != nil && (level >= l.level || level >= LevelNormal) {
+		l.file.Write([]byte(l.format(level, ansi.ReplaceAllString(message, ""))))
+	}
+
+	if level < l.level {
+		return
+	}
+
 	l.clear()
-	fmt.Fprintln(l.writer, message)
+	l.writer.Write([]byte(l.format(level, message)))
+}
+
+func (l *Logger) format(level Level, message string) string {
+	if !l.json {
+		return message
+	}
+
+	return record(level.String(), message)
+}
+
+// record returns the message as a line of JSON with its time and level.
+func record(level, message string) string {
+	line, _ := json.Marshal(struct {
+		Time    string `json:"time"`
+		Level   string `json:"level"`
+		Message string `json:"message"`
+	}{
+		Time:    time.Now().UTC().Format(time.RFC3339),
+		Level:   level,
+		Message: strings.TrimSuffix(ansi.ReplaceAllString(message, ""), "\n"),
+	})
+
+	return fmt.Sprintf("%s\n", line)
 }
 
 // PromptWithDetails will block all other goroutines attempting
 // to print the prompt to the logger for a given resource type
-// and resource name, while waiting for user input.
+// and resource name, while waiting for user input. With SetJSON,
+// the prompt is printed as a line of JSON with the prompt level.
 func (l *Logger) PromptWithDetails(resourceType, resourceName string) bool {
 	if l.noConfirm {
 		return true
@@ -64,7 +168,11 @@ func (l *Logger) PromptWithDetails(resourceType, resourceName string) bool {
 	l.mutex.Lock()
 	defer l.mutex.Unlock()
 	l.clear()
-	fmt.Fprintf(l.writer, "[%s: %s] Delete? (y/N): ", resourceType, resourceName)
+	if l.json {
+		fmt.Fprint(l.writer, record("prompt", fmt.Sprintf("[%s: %s] Delete? (y/N)", resourceType, resourceName)))
+	} else {
+		fmt.Fprintf(l.writer, "[%s: %s] Delete? (y/N): ", resourceType, resourceName)
+	}
 	l.newline = true
 
 	var proceed string
@@ -0,0 +1,121 @@
+package app_test
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/genevieve/leftovers/app"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Logger", func() {
+	var (
+		stdout *bytes.Buffer
+		stdin  *bytes.Buffer
+
+		logger *app.Logger
+	)
+
+	BeforeEach(func() {
+		stdout = &bytes.Buffer{}
+		stdin = &bytes.Buffer{}
+
+		logger = app.NewLogger(stdout, stdin, false)
+	})
+
+	log := func() {
+		logger.Println("[EC2 Volume: banana] Deleting...")
+		logger.Verbosef("Waiting %s before next try.\n", "2s")
+		logger.Debugf("--> %s %s\n", "GET", "https://ec2.amazonaws.com/")
+	}
+
+	Describe("levels", func() {
+		It("prints normal messages by default", func() {
+			log()
+			Expect(stdout.String()).To(Equal("[EC2 Volume: banana] Deleting...\n"))
+		})
+
+		It("prints progress when verbose", func() {
+			logger.SetLevel(app.LevelVerbose)
+			log()
+			Expect(stdout.String()).To(Equal("[EC2 Volume: banana] Deleting...\nWaiting 2s before next try.\n"))
+		})
+
+		It("prints api requests when debugging", func() {
+			logger.SetLevel(app.LevelDebug)
+			log()
+			Expect(stdout.String()).To(HaveSuffix("--> GET https://ec2.amazonaws.com/\n"))
+		})
+
+		It("prints nothing but prompts when quiet", func() {
+			logger.SetLevel(app.LevelQuiet)
+			stdin.WriteString("y\n")
+
+			log()
+			Expect(logger.PromptWithDetails("EC2 Volume", "banana")).To(BeTrue())
+			Expect(stdout.String()).To(Equal("[EC2 Volume: banana] Delete? (y/N): "))
+		})
+
+		It("prints errors when quiet", func() {
+			logger.SetLevel(app.LevelQuiet)
+
+			log()
+			logger.Errorln("[EC2 Volume: banana] the-error")
+			Expect(stdout.String()).To(Equal("[EC2 Volume: banana] the-error\n"))
+		})
+	})
+
+	Describe("SetJSON", func() {
+		It("prints each message as a line of json without colors", func() {
+			logger.SetJSON(true)
+			noColor := color.NoColor
+			color.NoColor = false
+			defer func() { color.NoColor = noColor }()
+
+			logger.Printf("[%s: %s] %s\n", "EC2 Volume", "banana", color.GreenString("Deleted!"))
+
+			Expect(stdout.String()).To(MatchRegexp(`^{"time":"[^"]+","level":"info","message":"\[EC2 Volume: banana\] Deleted!"}\n$`))
+		})
+
+		It("prints errors with the error level", func() {
+			logger.SetJSON(true)
+
+			logger.Errorln("[EC2 Volume: banana] the-error")
+
+			Expect(stdout.String()).To(MatchRegexp(`^{"time":"[^"]+","level":"error","message":"\[EC2 Volume: banana\] the-error"}\n$`))
+		})
+
+		It("prints prompts with the prompt level", func() {
+			logger.SetJSON(true)
+			stdin.WriteString("y\n")
+
+			Expect(logger.PromptWithDetails("EC2 Volume", "banana")).To(BeTrue())
+			logger.Printf("[%s: %s] Deleting...", "EC2 Volume", "banana")
+
+			lines := strings.Split(stdout.String(), "\n")
+			Expect(lines).To(HaveLen(3))
+			Expect(lines[0]).To(MatchRegexp(`^{"time":"[^"]+","level":"prompt","message":"\[EC2 Volume: banana\] Delete\? \(y/N\)"}$`))
+			Expect(lines[1]).To(MatchRegexp(`^{"time":"[^"]+","level":"info","message":"\[EC2 Volume: banana\] Deleting..."}$`))
+		})
+	})
+
+	Describe("SetLogFile", func() {
+		It("writes messages to the file without colors, even when quiet", func() {
+			file := &bytes.Buffer{}
+			logger.SetLogFile(file)
+			logger.SetLevel(app.LevelQuiet)
+			noColor := color.NoColor
+			color.NoColor = false
+			defer func() { color.NoColor = noColor }()
+
+			logger.Errorln(color.YellowString("some error"))
+			log()
+
+			Expect(stdout.String()).To(Equal("\x1b[33msome error\x1b[0m\n"))
+			Expect(strings.Split(file.String(), "\n")).To(Equal([]string{"some error", "[EC2 Volume: banana] Deleting...", ""}))
+		})
+	})
+})
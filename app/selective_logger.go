@@ -0,0 +1,44 @@
+package app
+
+type promptLogger interface {
+	Printf(message string, a ...interface{})
+	Println(message string)
+	Errorln(message string)
+	Verbosef(message string, a ...interface{})
+	Debugf(message string, a ...interface{})
+	PromptWithDetails(resourceType, resourceName string) bool
+	NoConfirm()
+}
+
+type selector interface {
+	Contains(resourceType, resourceName string) bool
+}
+
+// SelectiveLogger wraps a logger so that it only prompts to delete resources
+// that the selector contains, or does not contain if exclude is true. All other
+// resources are skipped without a prompt, as if deletion had been declined.
+type SelectiveLogger struct {
+	promptLogger
+	selector selector
+	exclude  bool
+}
+
+// NewSelectiveLogger returns a new SelectiveLogger that wraps the
+// provided logger.
+func NewSelectiveLogger(logger promptLogger, selector selector, exclude bool) *SelectiveLogger {
+	return &SelectiveLogger{
+		promptLogger: logger,
+		selector:     selector,
+		exclude:      exclude,
+	}
+}
+
+// PromptWithDetails returns false for resources that are not selected,
+// and prompts for all others.
+func (l *SelectiveLogger) PromptWithDetails(resourceType, resourceName string) bool {
+	if l.selector.Contains(resourceType, resourceName) == l.exclude {
+		return false
+	}
+
+	return l.promptLogger.PromptWithDetails(resourceType, resourceName)
+}
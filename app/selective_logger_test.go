@@ -0,0 +1,105 @@
+package app_test
+
+import (
+	"bytes"
+
+	"github.com/genevieve/leftovers/app"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// names contains the resources with the provided names, of any type.
+type names map[string]bool
+
+func (n names) Contains(resourceType, resourceName string) bool {
+	return n[resourceName]
+}
+
+var _ = Describe("SelectiveLogger", func() {
+	var (
+		stdout *bytes.Buffer
+		stdin  *bytes.Buffer
+
+		logger   *app.Logger
+		selector names
+	)
+
+	BeforeEach(func() {
+		stdout = &bytes.Buffer{}
+		stdin = &bytes.Buffer{}
+
+		logger = app.NewLogger(stdout, stdin, false)
+		selector = names{"banana": true}
+	})
+
+	Describe("PromptWithDetails", func() {
+		Context("when including the selected resources", func() {
+			var selective *app.SelectiveLogger
+
+			BeforeEach(func() {
+				selective = app.NewSelectiveLogger(logger, selector, false)
+			})
+
+			It("prompts for the selected resources", func() {
+				stdin.WriteString("y\n")
+
+				Expect(selective.PromptWithDetails("EC2 Volume", "banana")).To(BeTrue())
+				Expect(stdout.String()).To(Equal("[EC2 Volume: banana] Delete? (y/N): "))
+			})
+
+			It("returns the answer to the prompt", func() {
+				stdin.WriteString("n\n")
+
+				Expect(selective.PromptWithDetails("EC2 Volume", "banana")).To(BeFalse())
+				Expect(stdout.String()).To(Equal("[EC2 Volume: banana] Delete? (y/N): "))
+			})
+
+			It("skips other resources without a prompt", func() {
+				Expect(selective.PromptWithDetails("EC2 Volume", "kiwi")).To(BeFalse())
+				Expect(selective.PromptWithDetails("EC2 Volume", "banana-kiwi")).To(BeFalse())
+				Expect(stdout.String()).To(BeEmpty())
+			})
+		})
+
+		Context("when excluding the selected resources", func() {
+			var selective *app.SelectiveLogger
+
+			BeforeEach(func() {
+				selective = app.NewSelectiveLogger(logger, selector, true)
+			})
+
+			It("skips the selected resources without a prompt", func() {
+				Expect(selective.PromptWithDetails("EC2 Volume", "banana")).To(BeFalse())
+				Expect(stdout.String()).To(BeEmpty())
+			})
+
+			It("prompts for other resources", func() {
+				stdin.WriteString("y\n")
+
+				Expect(selective.PromptWithDetails("EC2 Volume", "kiwi")).To(BeTrue())
+				Expect(stdout.String()).To(Equal("[EC2 Volume: kiwi] Delete? (y/N): "))
+			})
+		})
+
+		Context("when the wrapped logger does not confirm", func() {
+			It("selects resources without a prompt", func() {
+				logger = app.NewLogger(stdout, stdin, true)
+				selective := app.NewSelectiveLogger(logger, selector, false)
+
+				Expect(selective.PromptWithDetails("EC2 Volume", "banana")).To(BeTrue())
+				Expect(selective.PromptWithDetails("EC2 Volume", "kiwi")).To(BeFalse())
+				Expect(stdout.String()).To(BeEmpty())
+			})
+		})
+	})
+
+	It("passes other messages to the wrapped logger", func() {
+		selective := app.NewSelectiveLogger(logger, selector, false)
+
+		selective.Println("[EC2 Volume: kiwi] Deleting...")
+		selective.Errorln("[EC2 Volume: kiwi] the-error")
+
+		Expect(stdout.String()).To(Equal("[EC2 Volume: kiwi] Deleting...\n[EC2 Volume: kiwi] the-error\n"))
+	})
+})
@@ -0,0 +1,46 @@
+package app
+
+import (
+	"net/http"
+	"time"
+)
+
+type debugLogger interface {
+	Debugf(message string, a ...interface{})
+}
+
+// TracingTransport logs each API request and response
+// at the debug level. Query strings are left out of the
+// logged URLs since they can carry credentials.
+type TracingTransport struct {
+	base   http.RoundTripper
+	logger debugLogger
+}
+
+// NewTracingTransport returns a new TracingTransport that sends
+// requests with the provided base transport.
+func NewTracingTransport(base http.RoundTripper, logger debugLogger) TracingTransport {
+	return TracingTransport{
+		base:   base,
+		logger: logger,
+	}
+}
+
+func (t TracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	url := *req.URL
+	url.RawQuery = ""
+	url.User = nil
+
+	t.logger.Debugf("--> %s %s\n", req.Method, url.String())
+	start := time.Now()
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		t.logger.Debugf("<-- %s %s failed after %s: %s\n", req.Method, url.String(), time.Since(start).Round(time.Millisecond), err)
+		return resp, err
+	}
+
+	t.logger.Debugf("<-- %s %s %d (%s)\n", req.Method, url.String(), resp.StatusCode, time.Since(start).Round(time.Millisecond))
+
+	return resp, nil
+}
@@ -0,0 +1,126 @@
+package acm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awsacm "github.com/aws/aws-sdk-go/service/acm"
+	"github.com/genevieve/leftovers/common"
+)
+
+// releaseTimeout is how long to wait for the load balancers
+// that are being deleted to release a certificate.
+const releaseTimeout = 2 * time.Minute
+
+type Certificate struct {
+	client     certificatesClient
+	logger     logger
+	listeners  listeners
+	arn        *string
+	identifier string
+	rtype      string
+}
+
+func NewCertificate(client certificatesClient, logger logger, listeners listeners, arn, domainName *string, tags []*awsacm.Tag) Certificate {
+	identifier := aws.StringValue(domainName)
+
+	var extra []string
+	for _, t := range tags {
+		extra = append(extra, fmt.Sprintf("%s:%s", *t.Key, aws.StringValue(t.Value)))
+	}
+
+	if len(extra) > 0 {
+		identifier = fmt.Sprintf("%s (%s)", identifier, strings.Join(extra, ", "))
+	}
+
+	return Certificate{
+		client:     client,
+		logger:     logger,
+		listeners:  listeners,
+		arn:        arn,
+		identifier: identifier,
+		rtype:      "ACM Certificate",
+	}
+}
+
+func (c Certificate) Delete() error {
+	return c.DeleteContext(context.Background())
+}
+
+// DeleteContext deletes the certificate. Since a certificate cannot be deleted
+// while it is in use, it returns an error with the resources that use it,
+// unless they are all load balancers that are being deleted, which release
+// it shortly after they are deleted.
+func (c Certificate) DeleteContext(ctx context.Context) error {
+	result, state, err := certificateRefresh(c.client, c.arn)()
+	if err != nil {
+		return fmt.Errorf("Describe: %s", err)
+	}
+
+	if state == "deleted" {
+		return nil
+	}
+
+	if state == "in-use" {
+		users := aws.StringValueSlice(result.(*awsacm.CertificateDetail).InUseBy)
+		for _, u := range users {
+			if !c.listeners.Deleting(u) {
+				return fmt.Errorf("In use by %s", strings.Join(users, ", "))
+			}
+		}
+
+		c.logger.Printf("[%s: %s] In use by %s, waiting for it to be released\n", c.rtype, c.identifier, strings.Join(users, ", "))
+
+		ctx, cancel := context.WithTimeout(ctx, releaseTimeout)
+		defer cancel()
+
+		poller := common.NewPoller(c.logger, certificateRefresh(c.client, c.arn), []string{"in-use"}, []string{"not-in-use", "deleted"})
+
+		_, err = poller.Wait(ctx)
+		if err != nil {
+			return fmt.Errorf("Waiting for certificate to be released by %s: %s", strings.Join(users, ", "), err)
+		}
+	}
+
+	_, err = c.client.DeleteCertificate(&awsacm.DeleteCertificateInput{CertificateArn: c.arn})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == awsacm.ErrCodeResourceNotFoundException {
+			return nil
+		}
+		return fmt.Errorf("Delete: %s", err)
+	}
+
+	return nil
+}
+
+func (c Certificate) Name() string {
+	return c.identifier
+}
+
+func (c Certificate) Type() string {
+	return c.rtype
+}
+
+// certificateRefresh returns whether the certificate is in use
+// by the resources that it is associated with, like listeners.
+func certificateRefresh(client certificatesClient, arn *string) common.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.DescribeCertificate(&awsacm.DescribeCertificateInput{CertificateArn: arn})
+		if err != nil {
+			if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == awsacm.ErrCodeResourceNotFoundException {
+				return arn, "deleted", nil
+			}
+			return nil, "", err
+		}
+
+		if len(resp.Certificate.InUseBy) > 0 {
+			return resp.Certificate, "in-use", nil
+		}
+
+		return resp.Certificate, "not-in-use", nil
+	}
+}
@@ -0,0 +1,149 @@
+package acm_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awsacm "github.com/aws/aws-sdk-go/service/acm"
+	"github.com/genevieve/leftovers/aws/acm"
+	"github.com/genevieve/leftovers/aws/acm/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Certificate", func() {
+	var (
+		certificate acm.Certificate
+		client      *fakes.CertificatesClient
+		logger      *fakes.Logger
+		listeners   *fakes.Listeners
+		arn         *string
+	)
+
+	BeforeEach(func() {
+		client = &fakes.CertificatesClient{}
+		logger = &fakes.Logger{}
+		listeners = &fakes.Listeners{}
+		arn = aws.String("the-arn")
+		tags := []*awsacm.Tag{{Key: aws.String("the-key"), Value: aws.String("the-value")}}
+
+		client.DescribeCertificateCall.Returns.Output = &awsacm.DescribeCertificateOutput{
+			Certificate: &awsacm.CertificateDetail{},
+		}
+
+		certificate = acm.NewCertificate(client, logger, listeners, arn, aws.String("the-domain"), tags)
+	})
+
+	Describe("Delete", func() {
+		It("deletes the certificate", func() {
+			err := certificate.Delete()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DescribeCertificateCall.CallCount).To(Equal(1))
+			Expect(client.DescribeCertificateCall.Receives.Input.CertificateArn).To(Equal(arn))
+
+			Expect(client.DeleteCertificateCall.CallCount).To(Equal(1))
+			Expect(client.DeleteCertificateCall.Receives.Input.CertificateArn).To(Equal(arn))
+		})
+
+		Context("when the certificate is in use", func() {
+			BeforeEach(func() {
+				client.DescribeCertificateCall.ReturnsOnCall = map[int]struct {
+					Output *awsacm.DescribeCertificateOutput
+					Error  error
+				}{
+					0: {Output: &awsacm.DescribeCertificateOutput{
+						Certificate: &awsacm.CertificateDetail{InUseBy: aws.StringSlice([]string{"the-load-balancer-arn"})},
+					}},
+				}
+				listeners.DeletingCall.Returns.Deleting = true
+			})
+
+			It("waits for the load balancers that are being deleted to release it before deleting it", func() {
+				err := certificate.Delete()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(listeners.DeletingCall.Receives.LoadBalancer).To(Equal("the-load-balancer-arn"))
+
+				Expect(logger.PrintfCall.Messages).To(ContainElement("[ACM Certificate: the-domain (the-key:the-value)] In use by the-load-balancer-arn, waiting for it to be released\n"))
+				Expect(client.DescribeCertificateCall.CallCount).To(Equal(2))
+				Expect(client.DeleteCertificateCall.CallCount).To(Equal(1))
+			})
+
+			Context("when the resources that use it are not being deleted", func() {
+				BeforeEach(func() {
+					listeners.DeletingCall.Returns.Deleting = false
+				})
+
+				It("returns an error with the resources that use it without waiting", func() {
+					err := certificate.Delete()
+					Expect(err).To(MatchError("In use by the-load-balancer-arn"))
+
+					Expect(client.DescribeCertificateCall.CallCount).To(Equal(1))
+					Expect(client.DeleteCertificateCall.CallCount).To(Equal(0))
+				})
+			})
+
+			Context("when the client fails while waiting", func() {
+				BeforeEach(func() {
+					client.DescribeCertificateCall.Returns.Error = errors.New("banana")
+				})
+
+				It("returns the error", func() {
+					err := certificate.Delete()
+					Expect(err).To(MatchError("Waiting for certificate to be released by the-load-balancer-arn: banana"))
+
+					Expect(client.DeleteCertificateCall.CallCount).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when the certificate no longer exists", func() {
+			BeforeEach(func() {
+				client.DescribeCertificateCall.Returns.Error = awserr.New("ResourceNotFoundException", "", nil)
+			})
+
+			It("does not delete it", func() {
+				err := certificate.Delete()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(client.DeleteCertificateCall.CallCount).To(Equal(0))
+			})
+		})
+
+		Context("when the client fails to describe the certificate", func() {
+			BeforeEach(func() {
+				client.DescribeCertificateCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := certificate.Delete()
+				Expect(err).To(MatchError("Describe: banana"))
+			})
+		})
+
+		Context("when the client fails to delete the certificate", func() {
+			BeforeEach(func() {
+				client.DeleteCertificateCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := certificate.Delete()
+				Expect(err).To(MatchError("Delete: banana"))
+			})
+		})
+	})
+
+	Describe("Name", func() {
+		It("returns the identifier", func() {
+			Expect(certificate.Name()).To(Equal("the-domain (the-key:the-value)"))
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(certificate.Type()).To(Equal("ACM Certificate"))
+		})
+	})
+})
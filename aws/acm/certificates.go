@@ -0,0 +1,82 @@
+package acm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsacm "github.com/aws/aws-sdk-go/service/acm"
+	"github.com/genevieve/leftovers/common"
+)
+
+type certificatesClient interface {
+	ListCertificatesPages(*awsacm.ListCertificatesInput, func(*awsacm.ListCertificatesOutput, bool) bool) error
+	ListTagsForCertificate(*awsacm.ListTagsForCertificateInput) (*awsacm.ListTagsForCertificateOutput, error)
+	DescribeCertificate(*awsacm.DescribeCertificateInput) (*awsacm.DescribeCertificateOutput, error)
+	DeleteCertificate(*awsacm.DeleteCertificateInput) (*awsacm.DeleteCertificateOutput, error)
+}
+
+type listeners interface {
+	Deleting(loadBalancer string) bool
+}
+
+type Certificates struct {
+	client    certificatesClient
+	logger    logger
+	listeners listeners
+}
+
+// NewCertificates returns the certificates to delete. A certificate that is
+// in use is only waited on if the provided listeners are deleting the load
+// balancers that use it.
+func NewCertificates(client certificatesClient, logger logger, listeners listeners) Certificates {
+	return Certificates{
+		client:    client,
+		logger:    logger,
+		listeners: listeners,
+	}
+}
+
+// List returns the certificates of every key type, since
+// only RSA 2048 certificates are listed by default.
+func (c Certificates) List(filter string) ([]common.Deletable, error) {
+	input := &awsacm.ListCertificatesInput{
+		Includes: &awsacm.Filters{KeyTypes: aws.StringSlice(awsacm.KeyAlgorithm_Values())},
+	}
+
+	var certificates []*awsacm.CertificateSummary
+	err := c.client.ListCertificatesPages(input, func(page *awsacm.ListCertificatesOutput, lastPage bool) bool {
+		certificates = append(certificates, page.CertificateSummaryList...)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("List ACM Certificates: %s", err)
+	}
+
+	var resources []common.Deletable
+	for _, certificate := range certificates {
+		tags, err := c.client.ListTagsForCertificate(&awsacm.ListTagsForCertificateInput{CertificateArn: certificate.CertificateArn})
+		if err != nil {
+			return nil, fmt.Errorf("List Tags For Certificate: %s", err)
+		}
+
+		r := NewCertificate(c.client, c.logger, c.listeners, certificate.CertificateArn, certificate.DomainName, tags.Tags)
+
+		if !strings.Contains(r.Name(), filter) {
+			continue
+		}
+
+		proceed := c.logger.PromptWithDetails(r.Type(), r.Name())
+		if !proceed {
+			continue
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func (c Certificates) Type() string {
+	return "acm-certificate"
+}
@@ -0,0 +1,113 @@
+package acm_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsacm "github.com/aws/aws-sdk-go/service/acm"
+	"github.com/genevieve/leftovers/aws/acm"
+	"github.com/genevieve/leftovers/aws/acm/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Certificates", func() {
+	var (
+		client *fakes.CertificatesClient
+		logger *fakes.Logger
+
+		certificates acm.Certificates
+	)
+
+	BeforeEach(func() {
+		client = &fakes.CertificatesClient{}
+		logger = &fakes.Logger{}
+		logger.PromptWithDetailsCall.Returns.Proceed = true
+
+		certificates = acm.NewCertificates(client, logger, &fakes.Listeners{})
+	})
+
+	Describe("List", func() {
+		var filter string
+
+		BeforeEach(func() {
+			client.ListCertificatesPagesCall.Returns.Pages = []*awsacm.ListCertificatesOutput{{
+				CertificateSummaryList: []*awsacm.CertificateSummary{{
+					CertificateArn: aws.String("the-arn"),
+					DomainName:     aws.String("banana.example.com"),
+				}},
+			}}
+			client.ListTagsForCertificateCall.Returns.Output = &awsacm.ListTagsForCertificateOutput{
+				Tags: []*awsacm.Tag{{Key: aws.String("the-key"), Value: aws.String("the-value")}},
+			}
+			filter = "banana"
+		})
+
+		It("returns a list of certificates to delete", func() {
+			items, err := certificates.List(filter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.ListCertificatesPagesCall.CallCount).To(Equal(1))
+			Expect(client.ListCertificatesPagesCall.Receives.Input.Includes.KeyTypes).To(ContainElement(aws.String("EC_prime256v1")))
+			Expect(client.ListTagsForCertificateCall.Receives.Input.CertificateArn).To(Equal(aws.String("the-arn")))
+
+			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("ACM Certificate"))
+			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("banana.example.com (the-key:the-value)"))
+
+			Expect(items).To(HaveLen(1))
+		})
+
+		Context("when the certificate name does not contain the filter", func() {
+			It("does not return it to the list", func() {
+				items, err := certificates.List("kiwi")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+
+		Context("when the client fails to list certificates", func() {
+			BeforeEach(func() {
+				client.ListCertificatesPagesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := certificates.List(filter)
+				Expect(err).To(MatchError("List ACM Certificates: some error"))
+			})
+		})
+
+		Context("when the client fails to list tags", func() {
+			BeforeEach(func() {
+				client.ListTagsForCertificateCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := certificates.List(filter)
+				Expect(err).To(MatchError("List Tags For Certificate: some error"))
+			})
+		})
+
+		Context("when the user responds no to the prompt", func() {
+			BeforeEach(func() {
+				logger.PromptWithDetailsCall.Returns.Proceed = false
+			})
+
+			It("does not return it to the list", func() {
+				items, err := certificates.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(certificates.Type()).To(Equal("acm-certificate"))
+		})
+	})
+})
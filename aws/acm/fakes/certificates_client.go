@@ -0,0 +1,94 @@
+package fakes
+
+import (
+	awsacm "github.com/aws/aws-sdk-go/service/acm"
+)
+
+type CertificatesClient struct {
+	ListCertificatesPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsacm.ListCertificatesInput
+		}
+		Returns struct {
+			Pages []*awsacm.ListCertificatesOutput
+			Error error
+		}
+	}
+
+	ListTagsForCertificateCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsacm.ListTagsForCertificateInput
+		}
+		Returns struct {
+			Output *awsacm.ListTagsForCertificateOutput
+			Error  error
+		}
+	}
+
+	DescribeCertificateCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsacm.DescribeCertificateInput
+		}
+		Returns struct {
+			Output *awsacm.DescribeCertificateOutput
+			Error  error
+		}
+		ReturnsOnCall map[int]struct {
+			Output *awsacm.DescribeCertificateOutput
+			Error  error
+		}
+	}
+
+	DeleteCertificateCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsacm.DeleteCertificateInput
+		}
+		Returns struct {
+			Output *awsacm.DeleteCertificateOutput
+			Error  error
+		}
+	}
+}
+
+func (c *CertificatesClient) ListCertificatesPages(input *awsacm.ListCertificatesInput, fn func(*awsacm.ListCertificatesOutput, bool) bool) error {
+	c.ListCertificatesPagesCall.CallCount++
+	c.ListCertificatesPagesCall.Receives.Input = input
+
+	pages := c.ListCertificatesPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return c.ListCertificatesPagesCall.Returns.Error
+}
+
+func (c *CertificatesClient) ListTagsForCertificate(input *awsacm.ListTagsForCertificateInput) (*awsacm.ListTagsForCertificateOutput, error) {
+	c.ListTagsForCertificateCall.CallCount++
+	c.ListTagsForCertificateCall.Receives.Input = input
+
+	return c.ListTagsForCertificateCall.Returns.Output, c.ListTagsForCertificateCall.Returns.Error
+}
+
+func (c *CertificatesClient) DescribeCertificate(input *awsacm.DescribeCertificateInput) (*awsacm.DescribeCertificateOutput, error) {
+	c.DescribeCertificateCall.CallCount++
+	c.DescribeCertificateCall.Receives.Input = input
+
+	if ret, ok := c.DescribeCertificateCall.ReturnsOnCall[c.DescribeCertificateCall.CallCount-1]; ok {
+		return ret.Output, ret.Error
+	}
+
+	return c.DescribeCertificateCall.Returns.Output, c.DescribeCertificateCall.Returns.Error
+}
+
+func (c *CertificatesClient) DeleteCertificate(input *awsacm.DeleteCertificateInput) (*awsacm.DeleteCertificateOutput, error) {
+	c.DeleteCertificateCall.CallCount++
+	c.DeleteCertificateCall.Receives.Input = input
+
+	return c.DeleteCertificateCall.Returns.Output, c.DeleteCertificateCall.Returns.Error
+}
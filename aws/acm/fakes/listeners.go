@@ -0,0 +1,20 @@
+package fakes
+
+type Listeners struct {
+	DeletingCall struct {
+		CallCount int
+		Receives  struct {
+			LoadBalancer string
+		}
+		Returns struct {
+			Deleting bool
+		}
+	}
+}
+
+func (l *Listeners) Deleting(loadBalancer string) bool {
+	l.DeletingCall.CallCount++
+	l.DeletingCall.Receives.LoadBalancer = loadBalancer
+
+	return l.DeletingCall.Returns.Deleting
+}
@@ -0,0 +1,6 @@
+package acm
+
+type logger interface {
+	Printf(m string, a ...interface{})
+	PromptWithDetails(resourceType, resourceName string) bool
+}
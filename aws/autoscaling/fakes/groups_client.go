@@ -0,0 +1,86 @@
+package fakes
+
+import (
+	awsautoscaling "github.com/aws/aws-sdk-go/service/autoscaling"
+)
+
+type GroupsClient struct {
+	DescribeAutoScalingGroupsCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsautoscaling.DescribeAutoScalingGroupsInput
+		}
+		Returns struct {
+			Output *awsautoscaling.DescribeAutoScalingGroupsOutput
+			Error  error
+		}
+	}
+
+	DescribeAutoScalingGroupsPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsautoscaling.DescribeAutoScalingGroupsInput
+		}
+		Returns struct {
+			Pages []*awsautoscaling.DescribeAutoScalingGroupsOutput
+			Error error
+		}
+	}
+
+	UpdateAutoScalingGroupCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsautoscaling.UpdateAutoScalingGroupInput
+		}
+		Returns struct {
+			Output *awsautoscaling.UpdateAutoScalingGroupOutput
+			Error  error
+		}
+	}
+
+	DeleteAutoScalingGroupCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsautoscaling.DeleteAutoScalingGroupInput
+		}
+		Returns struct {
+			Output *awsautoscaling.DeleteAutoScalingGroupOutput
+			Error  error
+		}
+	}
+}
+
+func (g *GroupsClient) DescribeAutoScalingGroups(input *awsautoscaling.DescribeAutoScalingGroupsInput) (*awsautoscaling.DescribeAutoScalingGroupsOutput, error) {
+	g.DescribeAutoScalingGroupsCall.CallCount++
+	g.DescribeAutoScalingGroupsCall.Receives.Input = input
+
+	return g.DescribeAutoScalingGroupsCall.Returns.Output, g.DescribeAutoScalingGroupsCall.Returns.Error
+}
+
+func (g *GroupsClient) DescribeAutoScalingGroupsPages(input *awsautoscaling.DescribeAutoScalingGroupsInput, fn func(*awsautoscaling.DescribeAutoScalingGroupsOutput, bool) bool) error {
+	g.DescribeAutoScalingGroupsPagesCall.CallCount++
+	g.DescribeAutoScalingGroupsPagesCall.Receives.Input = input
+
+	pages := g.DescribeAutoScalingGroupsPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return g.DescribeAutoScalingGroupsPagesCall.Returns.Error
+}
+
+func (g *GroupsClient) UpdateAutoScalingGroup(input *awsautoscaling.UpdateAutoScalingGroupInput) (*awsautoscaling.UpdateAutoScalingGroupOutput, error) {
+	g.UpdateAutoScalingGroupCall.CallCount++
+	g.UpdateAutoScalingGroupCall.Receives.Input = input
+
+	return g.UpdateAutoScalingGroupCall.Returns.Output, g.UpdateAutoScalingGroupCall.Returns.Error
+}
+
+func (g *GroupsClient) DeleteAutoScalingGroup(input *awsautoscaling.DeleteAutoScalingGroupInput) (*awsautoscaling.DeleteAutoScalingGroupOutput, error) {
+	g.DeleteAutoScalingGroupCall.CallCount++
+	g.DeleteAutoScalingGroupCall.Receives.Input = input
+
+	return g.DeleteAutoScalingGroupCall.Returns.Output, g.DeleteAutoScalingGroupCall.Returns.Error
+}
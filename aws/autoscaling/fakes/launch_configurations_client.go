@@ -0,0 +1,50 @@
+package fakes
+
+import (
+	awsautoscaling "github.com/aws/aws-sdk-go/service/autoscaling"
+)
+
+type LaunchConfigurationsClient struct {
+	DescribeLaunchConfigurationsPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsautoscaling.DescribeLaunchConfigurationsInput
+		}
+		Returns struct {
+			Pages []*awsautoscaling.DescribeLaunchConfigurationsOutput
+			Error error
+		}
+	}
+
+	DeleteLaunchConfigurationCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsautoscaling.DeleteLaunchConfigurationInput
+		}
+		Returns struct {
+			Output *awsautoscaling.DeleteLaunchConfigurationOutput
+			Error  error
+		}
+	}
+}
+
+func (l *LaunchConfigurationsClient) DescribeLaunchConfigurationsPages(input *awsautoscaling.DescribeLaunchConfigurationsInput, fn func(*awsautoscaling.DescribeLaunchConfigurationsOutput, bool) bool) error {
+	l.DescribeLaunchConfigurationsPagesCall.CallCount++
+	l.DescribeLaunchConfigurationsPagesCall.Receives.Input = input
+
+	pages := l.DescribeLaunchConfigurationsPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return l.DescribeLaunchConfigurationsPagesCall.Returns.Error
+}
+
+func (l *LaunchConfigurationsClient) DeleteLaunchConfiguration(input *awsautoscaling.DeleteLaunchConfigurationInput) (*awsautoscaling.DeleteLaunchConfigurationOutput, error) {
+	l.DeleteLaunchConfigurationCall.CallCount++
+	l.DeleteLaunchConfigurationCall.Receives.Input = input
+
+	return l.DeleteLaunchConfigurationCall.Returns.Output, l.DeleteLaunchConfigurationCall.Returns.Error
+}
@@ -0,0 +1,106 @@
+package autoscaling
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsautoscaling "github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/genevieve/leftovers/common"
+)
+
+// deleteInProgress is the status of a group that is being deleted.
+const deleteInProgress = "Delete in progress"
+
+type Group struct {
+	client     groupsClient
+	logger     logger
+	name       *string
+	identifier string
+	rtype      string
+}
+
+func NewGroup(client groupsClient, logger logger, name *string, tags []*awsautoscaling.TagDescription) Group {
+	identifier := *name
+
+	var extra []string
+	for _, t := range tags {
+		extra = append(extra, fmt.Sprintf("%s:%s", *t.Key, *t.Value))
+	}
+
+	if len(extra) > 0 {
+		identifier = fmt.Sprintf("%s (%s)", *name, strings.Join(extra, ", "))
+	}
+
+	return Group{
+		client:     client,
+		logger:     logger,
+		name:       name,
+		identifier: identifier,
+		rtype:      "AutoScaling Group",
+	}
+}
+
+func (g Group) Delete() error {
+	return g.DeleteContext(context.Background())
+}
+
+// DeleteContext scales the group to zero so that it stops replacing
+// its instances, force deletes it with its instances, and
+// waits for it to be deleted.
+func (g Group) DeleteContext(ctx context.Context) error {
+	_, err := g.client.UpdateAutoScalingGroup(&awsautoscaling.UpdateAutoScalingGroupInput{
+		AutoScalingGroupName: g.name,
+		MinSize:              aws.Int64(0),
+		MaxSize:              aws.Int64(0),
+		DesiredCapacity:      aws.Int64(0),
+	})
+	if err != nil {
+		return fmt.Errorf("Scale to zero: %s", err)
+	}
+
+	_, err = g.client.DeleteAutoScalingGroup(&awsautoscaling.DeleteAutoScalingGroupInput{
+		AutoScalingGroupName: g.name,
+		ForceDelete:          aws.Bool(true),
+	})
+	if err != nil {
+		return fmt.Errorf("Delete: %s", err)
+	}
+
+	refresh := groupRefresh(g.client, g.name)
+
+	poller := common.NewPoller(g.logger, refresh, []string{"deleting"}, []string{"deleted"})
+
+	_, err = poller.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("Waiting for deletion: %s", err)
+	}
+
+	return nil
+}
+
+func (g Group) Name() string {
+	return g.identifier
+}
+
+func (g Group) Type() string {
+	return g.rtype
+}
+
+func groupRefresh(client groupsClient, name *string) common.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.DescribeAutoScalingGroups(&awsautoscaling.DescribeAutoScalingGroupsInput{
+			AutoScalingGroupNames: []*string{name},
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		if len(resp.AutoScalingGroups) == 0 {
+			return name, "deleted", nil
+		}
+
+		return resp.AutoScalingGroups[0], "deleting", nil
+	}
+}
@@ -0,0 +1,101 @@
+package autoscaling_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsautoscaling "github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/genevieve/leftovers/aws/autoscaling"
+	"github.com/genevieve/leftovers/aws/autoscaling/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Group", func() {
+	var (
+		group  autoscaling.Group
+		client *fakes.GroupsClient
+		logger *fakes.Logger
+		name   *string
+	)
+
+	BeforeEach(func() {
+		client = &fakes.GroupsClient{}
+		logger = &fakes.Logger{}
+		name = aws.String("the-name")
+		tags := []*awsautoscaling.TagDescription{{Key: aws.String("the-key"), Value: aws.String("the-value")}}
+
+		group = autoscaling.NewGroup(client, logger, name, tags)
+	})
+
+	Describe("Delete", func() {
+		BeforeEach(func() {
+			client.DescribeAutoScalingGroupsCall.Returns.Output = &awsautoscaling.DescribeAutoScalingGroupsOutput{}
+		})
+
+		It("scales the group to zero, force deletes it and waits for it to be deleted", func() {
+			err := group.Delete()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.UpdateAutoScalingGroupCall.CallCount).To(Equal(1))
+			Expect(client.UpdateAutoScalingGroupCall.Receives.Input.AutoScalingGroupName).To(Equal(name))
+			Expect(client.UpdateAutoScalingGroupCall.Receives.Input.MinSize).To(Equal(aws.Int64(0)))
+			Expect(client.UpdateAutoScalingGroupCall.Receives.Input.MaxSize).To(Equal(aws.Int64(0)))
+			Expect(client.UpdateAutoScalingGroupCall.Receives.Input.DesiredCapacity).To(Equal(aws.Int64(0)))
+
+			Expect(client.DeleteAutoScalingGroupCall.CallCount).To(Equal(1))
+			Expect(client.DeleteAutoScalingGroupCall.Receives.Input.AutoScalingGroupName).To(Equal(name))
+			Expect(client.DeleteAutoScalingGroupCall.Receives.Input.ForceDelete).To(Equal(aws.Bool(true)))
+
+			Expect(client.DescribeAutoScalingGroupsCall.CallCount).To(Equal(1))
+			Expect(client.DescribeAutoScalingGroupsCall.Receives.Input.AutoScalingGroupNames).To(Equal([]*string{name}))
+		})
+
+		Context("when the client fails to scale the group", func() {
+			BeforeEach(func() {
+				client.UpdateAutoScalingGroupCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := group.Delete()
+				Expect(err).To(MatchError("Scale to zero: banana"))
+
+				Expect(client.DeleteAutoScalingGroupCall.CallCount).To(Equal(0))
+			})
+		})
+
+		Context("when the client fails to delete the group", func() {
+			BeforeEach(func() {
+				client.DeleteAutoScalingGroupCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := group.Delete()
+				Expect(err).To(MatchError("Delete: banana"))
+			})
+		})
+
+		Context("when the client fails to describe the group", func() {
+			BeforeEach(func() {
+				client.DescribeAutoScalingGroupsCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := group.Delete()
+				Expect(err).To(MatchError("Waiting for deletion: banana"))
+			})
+		})
+	})
+
+	Describe("Name", func() {
+		It("returns the identifier", func() {
+			Expect(group.Name()).To(Equal("the-name (the-key:the-value)"))
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(group.Type()).To(Equal("AutoScaling Group"))
+		})
+	})
+})
@@ -0,0 +1,70 @@
+package autoscaling
+
+import (
+	"fmt"
+	"strings"
+
+	awsautoscaling "github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/genevieve/leftovers/common"
+)
+
+type groupsClient interface {
+	DescribeAutoScalingGroups(*awsautoscaling.DescribeAutoScalingGroupsInput) (*awsautoscaling.DescribeAutoScalingGroupsOutput, error)
+	DescribeAutoScalingGroupsPages(*awsautoscaling.DescribeAutoScalingGroupsInput, func(*awsautoscaling.DescribeAutoScalingGroupsOutput, bool) bool) error
+	UpdateAutoScalingGroup(*awsautoscaling.UpdateAutoScalingGroupInput) (*awsautoscaling.UpdateAutoScalingGroupOutput, error)
+	DeleteAutoScalingGroup(*awsautoscaling.DeleteAutoScalingGroupInput) (*awsautoscaling.DeleteAutoScalingGroupOutput, error)
+}
+
+type logger interface {
+	Printf(m string, a ...interface{})
+	PromptWithDetails(resourceType, resourceName string) bool
+}
+
+type Groups struct {
+	client groupsClient
+	logger logger
+}
+
+func NewGroups(client groupsClient, logger logger) Groups {
+	return Groups{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (g Groups) List(filter string) ([]common.Deletable, error) {
+	var groups []*awsautoscaling.Group
+	err := g.client.DescribeAutoScalingGroupsPages(&awsautoscaling.DescribeAutoScalingGroupsInput{}, func(page *awsautoscaling.DescribeAutoScalingGroupsOutput, lastPage bool) bool {
+		groups = append(groups, page.AutoScalingGroups...)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Describing AutoScaling Groups: %s", err)
+	}
+
+	var resources []common.Deletable
+	for _, group := range groups {
+		if group.Status != nil && *group.Status == deleteInProgress {
+			continue
+		}
+
+		r := NewGroup(g.client, g.logger, group.AutoScalingGroupName, group.Tags)
+
+		if !strings.Contains(r.Name(), filter) {
+			continue
+		}
+
+		proceed := g.logger.PromptWithDetails(r.Type(), r.Name())
+		if !proceed {
+			continue
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func (g Groups) Type() string {
+	return "autoscaling-group"
+}
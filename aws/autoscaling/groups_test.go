@@ -0,0 +1,128 @@
+package autoscaling_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsautoscaling "github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/genevieve/leftovers/aws/autoscaling"
+	"github.com/genevieve/leftovers/aws/autoscaling/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Groups", func() {
+	var (
+		client *fakes.GroupsClient
+		logger *fakes.Logger
+
+		groups autoscaling.Groups
+	)
+
+	BeforeEach(func() {
+		client = &fakes.GroupsClient{}
+		logger = &fakes.Logger{}
+		logger.PromptWithDetailsCall.Returns.Proceed = true
+
+		groups = autoscaling.NewGroups(client, logger)
+	})
+
+	Describe("List", func() {
+		var filter string
+
+		BeforeEach(func() {
+			client.DescribeAutoScalingGroupsPagesCall.Returns.Pages = []*awsautoscaling.DescribeAutoScalingGroupsOutput{{
+				AutoScalingGroups: []*awsautoscaling.Group{{
+					AutoScalingGroupName: aws.String("banana-group"),
+					Tags: []*awsautoscaling.TagDescription{{
+						Key:   aws.String("env"),
+						Value: aws.String("banana"),
+					}},
+				}},
+			}}
+			filter = "banana"
+		})
+
+		It("returns a list of autoscaling groups to delete", func() {
+			items, err := groups.List(filter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DescribeAutoScalingGroupsPagesCall.CallCount).To(Equal(1))
+
+			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("AutoScaling Group"))
+			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("banana-group (env:banana)"))
+
+			Expect(items).To(HaveLen(1))
+		})
+
+		Context("when there is more than one page of groups", func() {
+			BeforeEach(func() {
+				client.DescribeAutoScalingGroupsPagesCall.Returns.Pages = append(client.DescribeAutoScalingGroupsPagesCall.Returns.Pages, &awsautoscaling.DescribeAutoScalingGroupsOutput{
+					AutoScalingGroups: []*awsautoscaling.Group{{
+						AutoScalingGroupName: aws.String("other-banana-group"),
+					}},
+				})
+			})
+
+			It("returns the groups from every page", func() {
+				items, err := groups.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(2))
+				Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("other-banana-group"))
+
+				Expect(items).To(HaveLen(2))
+			})
+		})
+
+		Context("when the group is already being deleted", func() {
+			BeforeEach(func() {
+				client.DescribeAutoScalingGroupsPagesCall.Returns.Pages[0].AutoScalingGroups[0].Status = aws.String("Delete in progress")
+			})
+
+			It("does not return it to the list", func() {
+				items, err := groups.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+
+		Context("when the group name does not contain the filter", func() {
+			It("does not return it to the list", func() {
+				items, err := groups.List("kiwi")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+
+		Context("when the client fails to describe groups", func() {
+			BeforeEach(func() {
+				client.DescribeAutoScalingGroupsPagesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := groups.List(filter)
+				Expect(err).To(MatchError("Describing AutoScaling Groups: some error"))
+			})
+		})
+
+		Context("when the user responds no to the prompt", func() {
+			BeforeEach(func() {
+				logger.PromptWithDetailsCall.Returns.Proceed = false
+			})
+
+			It("does not return it to the list", func() {
+				items, err := groups.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+	})
+})
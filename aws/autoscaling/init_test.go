@@ -0,0 +1,13 @@
+package autoscaling_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestAutoScaling(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "aws/autoscaling")
+}
@@ -0,0 +1,40 @@
+package autoscaling
+
+import (
+	"fmt"
+
+	awsautoscaling "github.com/aws/aws-sdk-go/service/autoscaling"
+)
+
+type LaunchConfiguration struct {
+	client     launchConfigurationsClient
+	name       *string
+	identifier string
+	rtype      string
+}
+
+func NewLaunchConfiguration(client launchConfigurationsClient, name *string) LaunchConfiguration {
+	return LaunchConfiguration{
+		client:     client,
+		name:       name,
+		identifier: *name,
+		rtype:      "AutoScaling Launch Configuration",
+	}
+}
+
+func (l LaunchConfiguration) Delete() error {
+	_, err := l.client.DeleteLaunchConfiguration(&awsautoscaling.DeleteLaunchConfigurationInput{LaunchConfigurationName: l.name})
+	if err != nil {
+		return fmt.Errorf("Delete: %s", err)
+	}
+
+	return nil
+}
+
+func (l LaunchConfiguration) Name() string {
+	return l.identifier
+}
+
+func (l LaunchConfiguration) Type() string {
+	return l.rtype
+}
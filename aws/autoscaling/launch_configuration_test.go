@@ -0,0 +1,59 @@
+package autoscaling_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/genevieve/leftovers/aws/autoscaling"
+	"github.com/genevieve/leftovers/aws/autoscaling/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LaunchConfiguration", func() {
+	var (
+		launchConfiguration autoscaling.LaunchConfiguration
+		client              *fakes.LaunchConfigurationsClient
+		name                *string
+	)
+
+	BeforeEach(func() {
+		client = &fakes.LaunchConfigurationsClient{}
+		name = aws.String("the-name")
+
+		launchConfiguration = autoscaling.NewLaunchConfiguration(client, name)
+	})
+
+	Describe("Delete", func() {
+		It("deletes the launch configuration", func() {
+			err := launchConfiguration.Delete()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DeleteLaunchConfigurationCall.CallCount).To(Equal(1))
+			Expect(client.DeleteLaunchConfigurationCall.Receives.Input.LaunchConfigurationName).To(Equal(name))
+		})
+
+		Context("when the client fails", func() {
+			BeforeEach(func() {
+				client.DeleteLaunchConfigurationCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := launchConfiguration.Delete()
+				Expect(err).To(MatchError("Delete: banana"))
+			})
+		})
+	})
+
+	Describe("Name", func() {
+		It("returns the identifier", func() {
+			Expect(launchConfiguration.Name()).To(Equal("the-name"))
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(launchConfiguration.Type()).To(Equal("AutoScaling Launch Configuration"))
+		})
+	})
+})
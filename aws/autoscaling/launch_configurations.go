@@ -0,0 +1,59 @@
+package autoscaling
+
+import (
+	"fmt"
+	"strings"
+
+	awsautoscaling "github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/genevieve/leftovers/common"
+)
+
+type launchConfigurationsClient interface {
+	DescribeLaunchConfigurationsPages(*awsautoscaling.DescribeLaunchConfigurationsInput, func(*awsautoscaling.DescribeLaunchConfigurationsOutput, bool) bool) error
+	DeleteLaunchConfiguration(*awsautoscaling.DeleteLaunchConfigurationInput) (*awsautoscaling.DeleteLaunchConfigurationOutput, error)
+}
+
+type LaunchConfigurations struct {
+	client launchConfigurationsClient
+	logger logger
+}
+
+func NewLaunchConfigurations(client launchConfigurationsClient, logger logger) LaunchConfigurations {
+	return LaunchConfigurations{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (l LaunchConfigurations) List(filter string) ([]common.Deletable, error) {
+	var configurations []*awsautoscaling.LaunchConfiguration
+	err := l.client.DescribeLaunchConfigurationsPages(&awsautoscaling.DescribeLaunchConfigurationsInput{}, func(page *awsautoscaling.DescribeLaunchConfigurationsOutput, lastPage bool) bool {
+		configurations = append(configurations, page.LaunchConfigurations...)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Describing AutoScaling Launch Configurations: %s", err)
+	}
+
+	var resources []common.Deletable
+	for _, configuration := range configurations {
+		r := NewLaunchConfiguration(l.client, configuration.LaunchConfigurationName)
+
+		if !strings.Contains(r.Name(), filter) {
+			continue
+		}
+
+		proceed := l.logger.PromptWithDetails(r.Type(), r.Name())
+		if !proceed {
+			continue
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func (l LaunchConfigurations) Type() string {
+	return "launch-configuration"
+}
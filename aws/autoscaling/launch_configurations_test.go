@@ -0,0 +1,94 @@
+package autoscaling_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsautoscaling "github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/genevieve/leftovers/aws/autoscaling"
+	"github.com/genevieve/leftovers/aws/autoscaling/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LaunchConfigurations", func() {
+	var (
+		client *fakes.LaunchConfigurationsClient
+		logger *fakes.Logger
+
+		launchConfigurations autoscaling.LaunchConfigurations
+	)
+
+	BeforeEach(func() {
+		client = &fakes.LaunchConfigurationsClient{}
+		logger = &fakes.Logger{}
+		logger.PromptWithDetailsCall.Returns.Proceed = true
+
+		launchConfigurations = autoscaling.NewLaunchConfigurations(client, logger)
+	})
+
+	Describe("List", func() {
+		var filter string
+
+		BeforeEach(func() {
+			client.DescribeLaunchConfigurationsPagesCall.Returns.Pages = []*awsautoscaling.DescribeLaunchConfigurationsOutput{{
+				LaunchConfigurations: []*awsautoscaling.LaunchConfiguration{{
+					LaunchConfigurationName: aws.String("banana-config"),
+				}},
+			}, {
+				LaunchConfigurations: []*awsautoscaling.LaunchConfiguration{{
+					LaunchConfigurationName: aws.String("other-banana-config"),
+				}},
+			}}
+			filter = "banana"
+		})
+
+		It("returns a list of launch configurations from every page to delete", func() {
+			items, err := launchConfigurations.List(filter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DescribeLaunchConfigurationsPagesCall.CallCount).To(Equal(1))
+
+			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(2))
+			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("AutoScaling Launch Configuration"))
+			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("other-banana-config"))
+
+			Expect(items).To(HaveLen(2))
+		})
+
+		Context("when the launch configuration name does not contain the filter", func() {
+			It("does not return it to the list", func() {
+				items, err := launchConfigurations.List("kiwi")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+
+		Context("when the client fails to describe launch configurations", func() {
+			BeforeEach(func() {
+				client.DescribeLaunchConfigurationsPagesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := launchConfigurations.List(filter)
+				Expect(err).To(MatchError("Describing AutoScaling Launch Configurations: some error"))
+			})
+		})
+
+		Context("when the user responds no to the prompt", func() {
+			BeforeEach(func() {
+				logger.PromptWithDetailsCall.Returns.Proceed = false
+			})
+
+			It("does not return it to the list", func() {
+				items, err := launchConfigurations.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(2))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,98 @@
+package fakes
+
+import (
+	awscloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+)
+
+type StacksClient struct {
+	DescribeStacksCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awscloudformation.DescribeStacksInput
+		}
+		Returns struct {
+			Output *awscloudformation.DescribeStacksOutput
+			Error  error
+		}
+		Stub func(*awscloudformation.DescribeStacksInput) (*awscloudformation.DescribeStacksOutput, error)
+	}
+
+	DescribeStacksPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awscloudformation.DescribeStacksInput
+		}
+		Returns struct {
+			Pages []*awscloudformation.DescribeStacksOutput
+			Error error
+		}
+	}
+
+	ListStackResourcesPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awscloudformation.ListStackResourcesInput
+		}
+		Returns struct {
+			Pages []*awscloudformation.ListStackResourcesOutput
+			Error error
+		}
+	}
+
+	DeleteStackCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awscloudformation.DeleteStackInput
+		}
+		Returns struct {
+			Output *awscloudformation.DeleteStackOutput
+			Error  error
+		}
+	}
+}
+
+func (s *StacksClient) DescribeStacks(input *awscloudformation.DescribeStacksInput) (*awscloudformation.DescribeStacksOutput, error) {
+	s.DescribeStacksCall.CallCount++
+	s.DescribeStacksCall.Receives.Input = input
+
+	if s.DescribeStacksCall.Stub != nil {
+		return s.DescribeStacksCall.Stub(input)
+	}
+
+	return s.DescribeStacksCall.Returns.Output, s.DescribeStacksCall.Returns.Error
+}
+
+func (s *StacksClient) DescribeStacksPages(input *awscloudformation.DescribeStacksInput, fn func(*awscloudformation.DescribeStacksOutput, bool) bool) error {
+	s.DescribeStacksPagesCall.CallCount++
+	s.DescribeStacksPagesCall.Receives.Input = input
+
+	pages := s.DescribeStacksPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return s.DescribeStacksPagesCall.Returns.Error
+}
+
+func (s *StacksClient) ListStackResourcesPages(input *awscloudformation.ListStackResourcesInput, fn func(*awscloudformation.ListStackResourcesOutput, bool) bool) error {
+	s.ListStackResourcesPagesCall.CallCount++
+	s.ListStackResourcesPagesCall.Receives.Input = input
+
+	pages := s.ListStackResourcesPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return s.ListStackResourcesPagesCall.Returns.Error
+}
+
+func (s *StacksClient) DeleteStack(input *awscloudformation.DeleteStackInput) (*awscloudformation.DeleteStackOutput, error) {
+	s.DeleteStackCall.CallCount++
+	s.DeleteStackCall.Receives.Input = input
+
+	return s.DeleteStackCall.Returns.Output, s.DeleteStackCall.Returns.Error
+}
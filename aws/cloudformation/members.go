@@ -0,0 +1,52 @@
+package cloudformation
+
+import (
+	"fmt"
+	"strings"
+)
+
+const stackNameTag = "aws:cloudformation:stack-name"
+
+// Members are the resources that belong to the stacks that are being
+// deleted, by physical id and by the aws:cloudformation:stack-name tag.
+// Those resources are deleted with their stack, so they are not
+// prompted for with the resources that are deleted individually.
+type Members struct {
+	ids    map[string]bool
+	stacks []string
+}
+
+func NewMembers() *Members {
+	return &Members{ids: map[string]bool{}}
+}
+
+func (m *Members) reset() {
+	m.ids = map[string]bool{}
+	m.stacks = nil
+}
+
+func (m *Members) addStack(name string) {
+	m.stacks = append(m.stacks, name)
+}
+
+func (m *Members) add(id string) {
+	m.ids[id] = true
+}
+
+// Owns returns true if the provided name is the name of a resource
+// that belongs to a stack that is being deleted.
+func (m *Members) Owns(name string) bool {
+	id := strings.SplitN(name, " ", 2)[0]
+	if m.ids[id] {
+		return true
+	}
+
+	for _, stack := range m.stacks {
+		tag := fmt.Sprintf("%s:%s", stackNameTag, stack)
+		if strings.Contains(name, tag+",") || strings.Contains(name, tag+")") {
+			return true
+		}
+	}
+
+	return false
+}
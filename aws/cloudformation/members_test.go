@@ -0,0 +1,53 @@
+package cloudformation_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	awscloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/genevieve/leftovers/aws/cloudformation"
+	"github.com/genevieve/leftovers/aws/cloudformation/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Members", func() {
+	var members *cloudformation.Members
+
+	BeforeEach(func() {
+		client := &fakes.StacksClient{}
+		logger := &fakes.Logger{}
+		logger.PromptWithDetailsCall.Returns.Proceed = true
+
+		client.DescribeStacksPagesCall.Returns.Pages = []*awscloudformation.DescribeStacksOutput{{
+			Stacks: []*awscloudformation.Stack{{
+				StackId:     aws.String("the-stack-id"),
+				StackName:   aws.String("the-stack"),
+				StackStatus: aws.String("CREATE_COMPLETE"),
+			}},
+		}}
+		client.ListStackResourcesPagesCall.Returns.Pages = []*awscloudformation.ListStackResourcesOutput{{
+			StackResourceSummaries: []*awscloudformation.StackResourceSummary{{
+				PhysicalResourceId: aws.String("i-12345"),
+				ResourceType:       aws.String("AWS::EC2::Instance"),
+			}},
+		}}
+
+		members = cloudformation.NewMembers()
+
+		_, err := cloudformation.NewStacks(client, logger, members).List("")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("Owns", func() {
+		It("returns true for the resources of the stacks being deleted", func() {
+			Expect(members.Owns("i-12345")).To(BeTrue())
+			Expect(members.Owns("i-12345 (aws:cloudformation:stack-name:the-stack)")).To(BeTrue())
+			Expect(members.Owns("i-67890")).To(BeFalse())
+		})
+
+		It("returns true for the resources tagged with the name of the stacks being deleted", func() {
+			Expect(members.Owns("sg-12345 (aws:cloudformation:stack-name:the-stack, Name:web)")).To(BeTrue())
+			Expect(members.Owns("sg-12345 (aws:cloudformation:stack-name:the-stack)")).To(BeTrue())
+			Expect(members.Owns("sg-12345 (aws:cloudformation:stack-name:the-stack-2)")).To(BeFalse())
+		})
+	})
+})
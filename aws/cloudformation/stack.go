@@ -0,0 +1,142 @@
+package cloudformation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	awscloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/genevieve/leftovers/common"
+)
+
+type Stack struct {
+	client     stacksClient
+	logger     logger
+	id         *string
+	name       string
+	status     string
+	identifier string
+	rtype      string
+}
+
+func NewStack(client stacksClient, logger logger, id, name *string, status string, tags []*awscloudformation.Tag) Stack {
+	identifier := *name
+
+	var extra []string
+	for _, t := range tags {
+		extra = append(extra, fmt.Sprintf("%s:%s", *t.Key, *t.Value))
+	}
+
+	if len(extra) > 0 {
+		identifier = fmt.Sprintf("%s (%s)", *name, strings.Join(extra, ", "))
+	}
+
+	return Stack{
+		client:     client,
+		logger:     logger,
+		id:         id,
+		name:       *name,
+		status:     status,
+		identifier: identifier,
+		rtype:      "CloudFormation Stack",
+	}
+}
+
+func (s Stack) Delete() error {
+	return s.DeleteContext(context.Background())
+}
+
+// DeleteContext deletes the stack with its nested stacks and waits for it to be
+// deleted. If the stack fails to delete, it is deleted again retaining the
+// resources that failed to delete, which are printed.
+func (s Stack) DeleteContext(ctx context.Context) error {
+	_, err := s.client.DeleteStack(&awscloudformation.DeleteStackInput{StackName: s.id})
+	if err != nil {
+		return fmt.Errorf("Delete: %s", err)
+	}
+
+	pending := []string{awscloudformation.StackStatusDeleteInProgress, s.status}
+	target := []string{awscloudformation.StackStatusDeleteComplete, awscloudformation.StackStatusDeleteFailed}
+
+	status, err := s.wait(ctx, pending, target)
+	if err != nil {
+		return fmt.Errorf("Waiting for deletion: %s", err)
+	}
+
+	if status != awscloudformation.StackStatusDeleteFailed {
+		return nil
+	}
+
+	var retain []*string
+	err = s.client.ListStackResourcesPages(&awscloudformation.ListStackResourcesInput{StackName: s.id}, func(page *awscloudformation.ListStackResourcesOutput, lastPage bool) bool {
+		for _, r := range page.StackResourceSummaries {
+			if *r.ResourceStatus == awscloudformation.ResourceStatusDeleteFailed {
+				retain = append(retain, r.LogicalResourceId)
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("List resources that failed to delete: %s", err)
+	}
+
+	var logicalIds []string
+	for _, r := range retain {
+		logicalIds = append(logicalIds, *r)
+	}
+	s.logger.Printf("[%s: %s] Retaining resources that failed to delete: %s\n", s.rtype, s.name, strings.Join(logicalIds, ", "))
+
+	_, err = s.client.DeleteStack(&awscloudformation.DeleteStackInput{StackName: s.id, RetainResources: retain})
+	if err != nil {
+		return fmt.Errorf("Delete retaining %s: %s", strings.Join(logicalIds, ", "), err)
+	}
+
+	// The stack reports DELETE_FAILED until the retry starts,
+	// so only DELETE_COMPLETE ends the second wait.
+	pending = []string{awscloudformation.StackStatusDeleteInProgress, awscloudformation.StackStatusDeleteFailed}
+	target = []string{awscloudformation.StackStatusDeleteComplete}
+
+	_, err = s.wait(ctx, pending, target)
+	if err != nil {
+		return fmt.Errorf("Waiting for deletion retaining %s: %s", strings.Join(logicalIds, ", "), err)
+	}
+
+	return nil
+}
+
+func (s Stack) Name() string {
+	return s.identifier
+}
+
+func (s Stack) Type() string {
+	return s.rtype
+}
+
+// wait waits for the stack to move from the pending statuses
+// to one of the target statuses and returns the status it reached.
+func (s Stack) wait(ctx context.Context, pending, target []string) (string, error) {
+	poller := common.NewPoller(s.logger, stackRefresh(s.client, s.id), pending, target)
+
+	result, err := poller.Wait(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return *result.(*awscloudformation.Stack).StackStatus, nil
+}
+
+func stackRefresh(client stacksClient, id *string) common.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.DescribeStacks(&awscloudformation.DescribeStacksInput{StackName: id})
+		if err != nil {
+			return nil, "", err
+		}
+
+		if len(resp.Stacks) == 0 {
+			return nil, "", nil
+		}
+
+		stack := resp.Stacks[0]
+		return stack, *stack.StackStatus, nil
+	}
+}
@@ -0,0 +1,174 @@
+package cloudformation_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awscloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/genevieve/leftovers/aws/cloudformation"
+	"github.com/genevieve/leftovers/aws/cloudformation/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Stack", func() {
+	var (
+		client *fakes.StacksClient
+		logger *fakes.Logger
+		id     *string
+		name   *string
+
+		stack cloudformation.Stack
+	)
+
+	BeforeEach(func() {
+		client = &fakes.StacksClient{}
+		logger = &fakes.Logger{}
+		id = aws.String("the-stack-id")
+		name = aws.String("the-stack")
+
+		client.DescribeStacksCall.Returns.Output = &awscloudformation.DescribeStacksOutput{
+			Stacks: []*awscloudformation.Stack{{StackStatus: aws.String("DELETE_COMPLETE")}},
+		}
+
+		stack = cloudformation.NewStack(client, logger, id, name, "CREATE_COMPLETE", []*awscloudformation.Tag{})
+	})
+
+	Describe("Delete", func() {
+		It("deletes the stack and waits for it to be deleted", func() {
+			err := stack.Delete()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DeleteStackCall.CallCount).To(Equal(1))
+			Expect(client.DeleteStackCall.Receives.Input.StackName).To(Equal(id))
+
+			Expect(client.DescribeStacksCall.CallCount).To(Equal(1))
+			Expect(client.DescribeStacksCall.Receives.Input.StackName).To(Equal(id))
+		})
+
+		Context("when the client fails", func() {
+			BeforeEach(func() {
+				client.DeleteStackCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := stack.Delete()
+				Expect(err).To(MatchError("Delete: banana"))
+			})
+		})
+
+		Context("when the client fails to describe the stack", func() {
+			BeforeEach(func() {
+				client.DescribeStacksCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := stack.Delete()
+				Expect(err).To(MatchError("Waiting for deletion: banana"))
+			})
+		})
+
+		Context("when the stack fails to delete", func() {
+			var statuses []string
+
+			BeforeEach(func() {
+				statuses = []string{"DELETE_FAILED", "DELETE_COMPLETE"}
+				client.DescribeStacksCall.Stub = func(*awscloudformation.DescribeStacksInput) (*awscloudformation.DescribeStacksOutput, error) {
+					status := statuses[0]
+					if len(statuses) > 1 {
+						statuses = statuses[1:]
+					}
+					return &awscloudformation.DescribeStacksOutput{
+						Stacks: []*awscloudformation.Stack{{StackStatus: aws.String(status)}},
+					}, nil
+				}
+				client.ListStackResourcesPagesCall.Returns.Pages = []*awscloudformation.ListStackResourcesOutput{{
+					StackResourceSummaries: []*awscloudformation.StackResourceSummary{
+						{LogicalResourceId: aws.String("Bucket"), ResourceStatus: aws.String("DELETE_FAILED")},
+						{LogicalResourceId: aws.String("Instance"), ResourceStatus: aws.String("DELETE_COMPLETE")},
+					},
+				}}
+			})
+
+			It("deletes it again retaining the resources that failed to delete", func() {
+				err := stack.Delete()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PrintfCall.Messages).To(ContainElement("[CloudFormation Stack: the-stack] Retaining resources that failed to delete: Bucket\n"))
+
+				Expect(client.DeleteStackCall.CallCount).To(Equal(2))
+				Expect(client.DeleteStackCall.Receives.Input.StackName).To(Equal(id))
+				Expect(client.DeleteStackCall.Receives.Input.RetainResources).To(Equal([]*string{aws.String("Bucket")}))
+
+				Expect(client.DescribeStacksCall.CallCount).To(Equal(2))
+			})
+
+			Context("when the stack still reports that it failed to delete after the retry", func() {
+				BeforeEach(func() {
+					statuses = []string{"DELETE_FAILED", "DELETE_FAILED", "DELETE_IN_PROGRESS", "DELETE_COMPLETE"}
+				})
+
+				It("keeps waiting until it is deleted", func() {
+					err := stack.Delete()
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(client.DeleteStackCall.CallCount).To(Equal(2))
+					Expect(client.DescribeStacksCall.CallCount).To(Equal(4))
+				})
+			})
+
+			Context("when the client fails to describe the stack after the retry", func() {
+				BeforeEach(func() {
+					statuses = []string{"DELETE_FAILED"}
+					stub := client.DescribeStacksCall.Stub
+					client.DescribeStacksCall.Stub = func(input *awscloudformation.DescribeStacksInput) (*awscloudformation.DescribeStacksOutput, error) {
+						if client.DescribeStacksCall.CallCount > 1 {
+							return nil, errors.New("banana")
+						}
+						return stub(input)
+					}
+				})
+
+				It("returns the error", func() {
+					err := stack.Delete()
+					Expect(err).To(MatchError("Waiting for deletion retaining Bucket: banana"))
+				})
+			})
+
+			Context("when the client fails to list the stack resources", func() {
+				BeforeEach(func() {
+					client.ListStackResourcesPagesCall.Returns.Error = errors.New("banana")
+				})
+
+				It("returns the error", func() {
+					err := stack.Delete()
+					Expect(err).To(MatchError("List resources that failed to delete: banana"))
+				})
+			})
+		})
+	})
+
+	Describe("Name", func() {
+		It("returns the identifier", func() {
+			Expect(stack.Name()).To(Equal("the-stack"))
+		})
+
+		Context("when the stack has tags", func() {
+			BeforeEach(func() {
+				stack = cloudformation.NewStack(client, logger, id, name, "CREATE_COMPLETE", []*awscloudformation.Tag{
+					{Key: aws.String("env"), Value: aws.String("banana")},
+				})
+			})
+
+			It("uses them in the identifier", func() {
+				Expect(stack.Name()).To(Equal("the-stack (env:banana)"))
+			})
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(stack.Type()).To(Equal("CloudFormation Stack"))
+		})
+	})
+})
@@ -0,0 +1,121 @@
+package cloudformation
+
+import (
+	"fmt"
+	"strings"
+
+	awscloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/genevieve/leftovers/common"
+)
+
+type stacksClient interface {
+	DescribeStacks(*awscloudformation.DescribeStacksInput) (*awscloudformation.DescribeStacksOutput, error)
+	DescribeStacksPages(*awscloudformation.DescribeStacksInput, func(*awscloudformation.DescribeStacksOutput, bool) bool) error
+	ListStackResourcesPages(*awscloudformation.ListStackResourcesInput, func(*awscloudformation.ListStackResourcesOutput, bool) bool) error
+	DeleteStack(*awscloudformation.DeleteStackInput) (*awscloudformation.DeleteStackOutput, error)
+}
+
+type logger interface {
+	Printf(m string, a ...interface{})
+	PromptWithDetails(resourceType, resourceName string) bool
+}
+
+type Stacks struct {
+	client  stacksClient
+	logger  logger
+	members *Members
+}
+
+// NewStacks returns the root stacks to delete, which delete their nested
+// stacks with them. The resources of the stacks that are selected are added
+// to the provided members.
+func NewStacks(client stacksClient, logger logger, members *Members) Stacks {
+	return Stacks{
+		client:  client,
+		logger:  logger,
+		members: members,
+	}
+}
+
+func (s Stacks) List(filter string) ([]common.Deletable, error) {
+	s.members.reset()
+
+	var stacks []*awscloudformation.Stack
+	err := s.client.DescribeStacksPages(&awscloudformation.DescribeStacksInput{}, func(page *awscloudformation.DescribeStacksOutput, lastPage bool) bool {
+		stacks = append(stacks, page.Stacks...)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Describe CloudFormation Stacks: %s", err)
+	}
+
+	var resources []common.Deletable
+	for _, stack := range stacks {
+		if stack.ParentId != nil {
+			continue
+		}
+
+		status := *stack.StackStatus
+		if status == awscloudformation.StackStatusDeleteComplete || status == awscloudformation.StackStatusDeleteInProgress {
+			continue
+		}
+
+		r := NewStack(s.client, s.logger, stack.StackId, stack.StackName, status, stack.Tags)
+
+		if !strings.Contains(r.Name(), filter) {
+			continue
+		}
+
+		proceed := s.logger.PromptWithDetails(r.Type(), r.Name())
+		if !proceed {
+			continue
+		}
+
+		s.members.addStack(*stack.StackName)
+
+		err = s.addMembers(stack.StackId)
+		if err != nil {
+			return nil, fmt.Errorf("List resources of CloudFormation Stack %s: %s", *stack.StackName, err)
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func (s Stacks) Type() string {
+	return "cloudformation-stack"
+}
+
+// addMembers adds the resources of the stack,
+// and of its nested stacks, to the members.
+func (s Stacks) addMembers(stack *string) error {
+	var nested []*string
+	err := s.client.ListStackResourcesPages(&awscloudformation.ListStackResourcesInput{StackName: stack}, func(page *awscloudformation.ListStackResourcesOutput, lastPage bool) bool {
+		for _, r := range page.StackResourceSummaries {
+			if r.PhysicalResourceId == nil {
+				continue
+			}
+
+			if *r.ResourceType == "AWS::CloudFormation::Stack" && !s.members.ids[*r.PhysicalResourceId] {
+				nested = append(nested, r.PhysicalResourceId)
+			}
+
+			s.members.add(*r.PhysicalResourceId)
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, n := range nested {
+		err = s.addMembers(n)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,194 @@
+package cloudformation_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awscloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/genevieve/leftovers/aws/cloudformation"
+	"github.com/genevieve/leftovers/aws/cloudformation/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Stacks", func() {
+	var (
+		client  *fakes.StacksClient
+		logger  *fakes.Logger
+		members *cloudformation.Members
+
+		stacks cloudformation.Stacks
+	)
+
+	BeforeEach(func() {
+		client = &fakes.StacksClient{}
+		logger = &fakes.Logger{}
+		logger.PromptWithDetailsCall.Returns.Proceed = true
+		members = cloudformation.NewMembers()
+
+		stacks = cloudformation.NewStacks(client, logger, members)
+	})
+
+	Describe("List", func() {
+		var filter string
+
+		BeforeEach(func() {
+			client.DescribeStacksPagesCall.Returns.Pages = []*awscloudformation.DescribeStacksOutput{{
+				Stacks: []*awscloudformation.Stack{{
+					StackId:     aws.String("the-stack-id"),
+					StackName:   aws.String("the-stack"),
+					StackStatus: aws.String("CREATE_COMPLETE"),
+					Tags:        []*awscloudformation.Tag{{Key: aws.String("env"), Value: aws.String("banana")}},
+				}},
+			}}
+			client.ListStackResourcesPagesCall.Returns.Pages = []*awscloudformation.ListStackResourcesOutput{{
+				StackResourceSummaries: []*awscloudformation.StackResourceSummary{{
+					PhysicalResourceId: aws.String("i-12345"),
+					ResourceType:       aws.String("AWS::EC2::Instance"),
+				}},
+			}}
+			filter = "banana"
+		})
+
+		It("returns a list of stacks whose name or tags contain the filter", func() {
+			items, err := stacks.List(filter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DescribeStacksPagesCall.CallCount).To(Equal(1))
+
+			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("CloudFormation Stack"))
+			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("the-stack (env:banana)"))
+
+			Expect(items).To(HaveLen(1))
+		})
+
+		It("adds the resources of the stack to the members", func() {
+			_, err := stacks.List(filter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.ListStackResourcesPagesCall.Receives.Input.StackName).To(Equal(aws.String("the-stack-id")))
+			Expect(members.Owns("i-12345")).To(BeTrue())
+		})
+
+		Context("when the stack has nested stacks", func() {
+			BeforeEach(func() {
+				client.DescribeStacksPagesCall.Returns.Pages[0].Stacks = append(client.DescribeStacksPagesCall.Returns.Pages[0].Stacks, &awscloudformation.Stack{
+					StackId:     aws.String("the-nested-stack-id"),
+					StackName:   aws.String("the-nested-stack-banana"),
+					StackStatus: aws.String("CREATE_COMPLETE"),
+					ParentId:    aws.String("the-stack-id"),
+				})
+				client.ListStackResourcesPagesCall.Returns.Pages[0].StackResourceSummaries = append(client.ListStackResourcesPagesCall.Returns.Pages[0].StackResourceSummaries, &awscloudformation.StackResourceSummary{
+					PhysicalResourceId: aws.String("the-nested-stack-id"),
+					ResourceType:       aws.String("AWS::CloudFormation::Stack"),
+				})
+			})
+
+			It("returns only the root stack and adds the resources of the nested stacks to the members", func() {
+				items, err := stacks.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(client.ListStackResourcesPagesCall.CallCount).To(Equal(2))
+				Expect(client.ListStackResourcesPagesCall.Receives.Input.StackName).To(Equal(aws.String("the-nested-stack-id")))
+				Expect(members.Owns("the-nested-stack-id")).To(BeTrue())
+
+				Expect(items).To(HaveLen(1))
+			})
+		})
+
+		Context("when there is more than one page of stacks", func() {
+			BeforeEach(func() {
+				client.DescribeStacksPagesCall.Returns.Pages = append(client.DescribeStacksPagesCall.Returns.Pages, &awscloudformation.DescribeStacksOutput{
+					Stacks: []*awscloudformation.Stack{{
+						StackId:     aws.String("the-other-stack-id"),
+						StackName:   aws.String("the-other-stack-banana"),
+						StackStatus: aws.String("ROLLBACK_COMPLETE"),
+					}},
+				})
+			})
+
+			It("returns the stacks from every page", func() {
+				items, err := stacks.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("the-other-stack-banana"))
+				Expect(items).To(HaveLen(2))
+			})
+		})
+
+		Context("when the stack is already being deleted", func() {
+			BeforeEach(func() {
+				client.DescribeStacksPagesCall.Returns.Pages[0].Stacks[0].StackStatus = aws.String("DELETE_IN_PROGRESS")
+			})
+
+			It("does not return it to the list", func() {
+				items, err := stacks.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+
+		Context("when neither the name nor the tags contain the filter", func() {
+			It("does not return it to the list or add its resources to the members", func() {
+				items, err := stacks.List("mango")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
+				Expect(members.Owns("i-12345")).To(BeFalse())
+				Expect(items).To(HaveLen(0))
+			})
+		})
+
+		Context("when the members were added by a previous list", func() {
+			It("forgets them", func() {
+				_, err := stacks.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = stacks.List("mango")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(members.Owns("i-12345")).To(BeFalse())
+			})
+		})
+
+		Context("when the client fails to describe stacks", func() {
+			BeforeEach(func() {
+				client.DescribeStacksPagesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := stacks.List(filter)
+				Expect(err).To(MatchError("Describe CloudFormation Stacks: some error"))
+			})
+		})
+
+		Context("when the client fails to list stack resources", func() {
+			BeforeEach(func() {
+				client.ListStackResourcesPagesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := stacks.List(filter)
+				Expect(err).To(MatchError("List resources of CloudFormation Stack the-stack: some error"))
+			})
+		})
+
+		Context("when the user responds no to the prompt", func() {
+			BeforeEach(func() {
+				logger.PromptWithDetailsCall.Returns.Proceed = false
+			})
+
+			It("does not return it to the list or add its resources to the members", func() {
+				items, err := stacks.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+				Expect(members.Owns("i-12345")).To(BeFalse())
+				Expect(items).To(HaveLen(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,52 @@
+package cloudwatch
+
+import (
+	"fmt"
+	"strings"
+
+	awscloudwatch "github.com/aws/aws-sdk-go/service/cloudwatch"
+)
+
+type Alarm struct {
+	client     alarmsClient
+	name       *string
+	identifier string
+	rtype      string
+}
+
+func NewAlarm(client alarmsClient, name *string, tags []*awscloudwatch.Tag) Alarm {
+	identifier := *name
+
+	var extra []string
+	for _, t := range tags {
+		extra = append(extra, fmt.Sprintf("%s:%s", *t.Key, *t.Value))
+	}
+
+	if len(extra) > 0 {
+		identifier = fmt.Sprintf("%s (%s)", *name, strings.Join(extra, ", "))
+	}
+
+	return Alarm{
+		client:     client,
+		name:       name,
+		identifier: identifier,
+		rtype:      "CloudWatch Alarm",
+	}
+}
+
+func (a Alarm) Delete() error {
+	_, err := a.client.DeleteAlarms(&awscloudwatch.DeleteAlarmsInput{AlarmNames: []*string{a.name}})
+	if err != nil {
+		return fmt.Errorf("Delete: %s", err)
+	}
+
+	return nil
+}
+
+func (a Alarm) Name() string {
+	return a.identifier
+}
+
+func (a Alarm) Type() string {
+	return a.rtype
+}
@@ -0,0 +1,61 @@
+package cloudwatch_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awscloudwatch "github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/genevieve/leftovers/aws/cloudwatch"
+	"github.com/genevieve/leftovers/aws/cloudwatch/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Alarm", func() {
+	var (
+		alarm  cloudwatch.Alarm
+		client *fakes.AlarmsClient
+		name   *string
+	)
+
+	BeforeEach(func() {
+		client = &fakes.AlarmsClient{}
+		name = aws.String("the-alarm")
+		tags := []*awscloudwatch.Tag{{Key: aws.String("the-key"), Value: aws.String("the-value")}}
+
+		alarm = cloudwatch.NewAlarm(client, name, tags)
+	})
+
+	Describe("Delete", func() {
+		It("deletes the alarm", func() {
+			err := alarm.Delete()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DeleteAlarmsCall.CallCount).To(Equal(1))
+			Expect(client.DeleteAlarmsCall.Receives.Input.AlarmNames).To(Equal([]*string{name}))
+		})
+
+		Context("when the client fails", func() {
+			BeforeEach(func() {
+				client.DeleteAlarmsCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := alarm.Delete()
+				Expect(err).To(MatchError("Delete: banana"))
+			})
+		})
+	})
+
+	Describe("Name", func() {
+		It("returns the identifier", func() {
+			Expect(alarm.Name()).To(Equal("the-alarm (the-key:the-value)"))
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(alarm.Type()).To(Equal("CloudWatch Alarm"))
+		})
+	})
+})
@@ -0,0 +1,91 @@
+package cloudwatch
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awscloudwatch "github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/genevieve/leftovers/common"
+)
+
+type alarmsClient interface {
+	DescribeAlarmsPages(*awscloudwatch.DescribeAlarmsInput, func(*awscloudwatch.DescribeAlarmsOutput, bool) bool) error
+	ListTagsForResource(*awscloudwatch.ListTagsForResourceInput) (*awscloudwatch.ListTagsForResourceOutput, error)
+	DeleteAlarms(*awscloudwatch.DeleteAlarmsInput) (*awscloudwatch.DeleteAlarmsOutput, error)
+}
+
+type Alarms struct {
+	client    alarmsClient
+	logger    logger
+	alarmType string
+	rtype     string
+}
+
+// NewCompositeAlarms returns the composite alarms, which are deleted
+// before the metric alarms since an alarm that is used in the rule of
+// a composite alarm cannot be deleted.
+func NewCompositeAlarms(client alarmsClient, logger logger) Alarms {
+	return Alarms{
+		client:    client,
+		logger:    logger,
+		alarmType: awscloudwatch.AlarmTypeCompositeAlarm,
+		rtype:     "cloudwatch-composite-alarm",
+	}
+}
+
+func NewMetricAlarms(client alarmsClient, logger logger) Alarms {
+	return Alarms{
+		client:    client,
+		logger:    logger,
+		alarmType: awscloudwatch.AlarmTypeMetricAlarm,
+		rtype:     "cloudwatch-metric-alarm",
+	}
+}
+
+func (a Alarms) List(filter string) ([]common.Deletable, error) {
+	type alarm struct{ name, arn *string }
+
+	var alarms []alarm
+	err := a.client.DescribeAlarmsPages(&awscloudwatch.DescribeAlarmsInput{
+		AlarmTypes: []*string{aws.String(a.alarmType)},
+	}, func(page *awscloudwatch.DescribeAlarmsOutput, lastPage bool) bool {
+		for _, c := range page.CompositeAlarms {
+			alarms = append(alarms, alarm{name: c.AlarmName, arn: c.AlarmArn})
+		}
+		for _, m := range page.MetricAlarms {
+			alarms = append(alarms, alarm{name: m.AlarmName, arn: m.AlarmArn})
+		}
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Describe CloudWatch Alarms: %s", err)
+	}
+
+	var resources []common.Deletable
+	for _, alarm := range alarms {
+		tags, err := a.client.ListTagsForResource(&awscloudwatch.ListTagsForResourceInput{ResourceARN: alarm.arn})
+		if err != nil {
+			return nil, fmt.Errorf("List tags for CloudWatch Alarm %s: %s", *alarm.name, err)
+		}
+
+		r := NewAlarm(a.client, alarm.name, tags.Tags)
+
+		if !strings.Contains(r.Name(), filter) {
+			continue
+		}
+
+		proceed := a.logger.PromptWithDetails(r.Type(), r.Name())
+		if !proceed {
+			continue
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func (a Alarms) Type() string {
+	return a.rtype
+}
@@ -0,0 +1,146 @@
+package cloudwatch_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awscloudwatch "github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/genevieve/leftovers/aws/cloudwatch"
+	"github.com/genevieve/leftovers/aws/cloudwatch/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Alarms", func() {
+	var (
+		client *fakes.AlarmsClient
+		logger *fakes.Logger
+
+		alarms cloudwatch.Alarms
+	)
+
+	BeforeEach(func() {
+		client = &fakes.AlarmsClient{}
+		logger = &fakes.Logger{}
+		logger.PromptWithDetailsCall.Returns.Proceed = true
+
+		alarms = cloudwatch.NewMetricAlarms(client, logger)
+	})
+
+	Describe("List", func() {
+		var filter string
+
+		BeforeEach(func() {
+			client.DescribeAlarmsPagesCall.Returns.Pages = []*awscloudwatch.DescribeAlarmsOutput{{
+				MetricAlarms: []*awscloudwatch.MetricAlarm{{
+					AlarmName: aws.String("the-metric-alarm"),
+					AlarmArn:  aws.String("the-metric-alarm-arn"),
+				}},
+			}, {
+				MetricAlarms: []*awscloudwatch.MetricAlarm{{
+					AlarmName: aws.String("the-other-metric-alarm"),
+					AlarmArn:  aws.String("the-other-metric-alarm-arn"),
+				}},
+			}}
+			client.ListTagsForResourceCall.Returns.Output = &awscloudwatch.ListTagsForResourceOutput{
+				Tags: []*awscloudwatch.Tag{{Key: aws.String("env"), Value: aws.String("banana")}},
+			}
+			filter = "banana"
+		})
+
+		It("returns the metric alarms whose name or tags contain the filter", func() {
+			items, err := alarms.List(filter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DescribeAlarmsPagesCall.CallCount).To(Equal(1))
+			Expect(client.DescribeAlarmsPagesCall.Receives.Input.AlarmTypes).To(Equal([]*string{aws.String("MetricAlarm")}))
+
+			Expect(client.ListTagsForResourceCall.CallCount).To(Equal(2))
+			Expect(client.ListTagsForResourceCall.Receives.Input.ResourceARN).To(Equal(aws.String("the-other-metric-alarm-arn")))
+
+			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(2))
+			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("CloudWatch Alarm"))
+			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("the-other-metric-alarm (env:banana)"))
+
+			Expect(items).To(HaveLen(2))
+		})
+
+		Context("when the alarms are composite alarms", func() {
+			BeforeEach(func() {
+				alarms = cloudwatch.NewCompositeAlarms(client, logger)
+
+				client.DescribeAlarmsPagesCall.Returns.Pages = []*awscloudwatch.DescribeAlarmsOutput{{
+					CompositeAlarms: []*awscloudwatch.CompositeAlarm{{
+						AlarmName: aws.String("the-composite-alarm"),
+						AlarmArn:  aws.String("the-composite-alarm-arn"),
+					}},
+				}}
+			})
+
+			It("returns the composite alarms", func() {
+				items, err := alarms.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(client.DescribeAlarmsPagesCall.Receives.Input.AlarmTypes).To(Equal([]*string{aws.String("CompositeAlarm")}))
+
+				Expect(client.ListTagsForResourceCall.Receives.Input.ResourceARN).To(Equal(aws.String("the-composite-alarm-arn")))
+				Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("the-composite-alarm (env:banana)"))
+
+				Expect(items).To(HaveLen(1))
+			})
+		})
+
+		Context("when neither the name nor the tags contain the filter", func() {
+			It("does not return it to the list", func() {
+				items, err := alarms.List("mango")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+
+		Context("when the client fails to describe alarms", func() {
+			BeforeEach(func() {
+				client.DescribeAlarmsPagesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := alarms.List(filter)
+				Expect(err).To(MatchError("Describe CloudWatch Alarms: some error"))
+			})
+		})
+
+		Context("when the client fails to list tags", func() {
+			BeforeEach(func() {
+				client.ListTagsForResourceCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := alarms.List(filter)
+				Expect(err).To(MatchError("List tags for CloudWatch Alarm the-metric-alarm: some error"))
+			})
+		})
+
+		Context("when the user responds no to the prompt", func() {
+			BeforeEach(func() {
+				logger.PromptWithDetailsCall.Returns.Proceed = false
+			})
+
+			It("does not return it to the list", func() {
+				items, err := alarms.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(2))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(alarms.Type()).To(Equal("cloudwatch-metric-alarm"))
+			Expect(cloudwatch.NewCompositeAlarms(client, logger).Type()).To(Equal("cloudwatch-composite-alarm"))
+		})
+	})
+})
@@ -0,0 +1,52 @@
+package cloudwatch
+
+import (
+	"fmt"
+	"strings"
+
+	awscloudwatch "github.com/aws/aws-sdk-go/service/cloudwatch"
+)
+
+type Dashboard struct {
+	client     dashboardsClient
+	name       *string
+	identifier string
+	rtype      string
+}
+
+func NewDashboard(client dashboardsClient, name *string, tags []*awscloudwatch.Tag) Dashboard {
+	identifier := *name
+
+	var extra []string
+	for _, t := range tags {
+		extra = append(extra, fmt.Sprintf("%s:%s", *t.Key, *t.Value))
+	}
+
+	if len(extra) > 0 {
+		identifier = fmt.Sprintf("%s (%s)", *name, strings.Join(extra, ", "))
+	}
+
+	return Dashboard{
+		client:     client,
+		name:       name,
+		identifier: identifier,
+		rtype:      "CloudWatch Dashboard",
+	}
+}
+
+func (d Dashboard) Delete() error {
+	_, err := d.client.DeleteDashboards(&awscloudwatch.DeleteDashboardsInput{DashboardNames: []*string{d.name}})
+	if err != nil {
+		return fmt.Errorf("Delete: %s", err)
+	}
+
+	return nil
+}
+
+func (d Dashboard) Name() string {
+	return d.identifier
+}
+
+func (d Dashboard) Type() string {
+	return d.rtype
+}
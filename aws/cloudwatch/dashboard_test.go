@@ -0,0 +1,61 @@
+package cloudwatch_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awscloudwatch "github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/genevieve/leftovers/aws/cloudwatch"
+	"github.com/genevieve/leftovers/aws/cloudwatch/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Dashboard", func() {
+	var (
+		dashboard cloudwatch.Dashboard
+		client    *fakes.DashboardsClient
+		name      *string
+	)
+
+	BeforeEach(func() {
+		client = &fakes.DashboardsClient{}
+		name = aws.String("the-dashboard")
+		tags := []*awscloudwatch.Tag{{Key: aws.String("the-key"), Value: aws.String("the-value")}}
+
+		dashboard = cloudwatch.NewDashboard(client, name, tags)
+	})
+
+	Describe("Delete", func() {
+		It("deletes the dashboard", func() {
+			err := dashboard.Delete()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DeleteDashboardsCall.CallCount).To(Equal(1))
+			Expect(client.DeleteDashboardsCall.Receives.Input.DashboardNames).To(Equal([]*string{name}))
+		})
+
+		Context("when the client fails", func() {
+			BeforeEach(func() {
+				client.DeleteDashboardsCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := dashboard.Delete()
+				Expect(err).To(MatchError("Delete: banana"))
+			})
+		})
+	})
+
+	Describe("Name", func() {
+		It("returns the identifier", func() {
+			Expect(dashboard.Name()).To(Equal("the-dashboard (the-key:the-value)"))
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(dashboard.Type()).To(Equal("CloudWatch Dashboard"))
+		})
+	})
+})
@@ -0,0 +1,65 @@
+package cloudwatch
+
+import (
+	"fmt"
+	"strings"
+
+	awscloudwatch "github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/genevieve/leftovers/common"
+)
+
+type dashboardsClient interface {
+	ListDashboardsPages(*awscloudwatch.ListDashboardsInput, func(*awscloudwatch.ListDashboardsOutput, bool) bool) error
+	ListTagsForResource(*awscloudwatch.ListTagsForResourceInput) (*awscloudwatch.ListTagsForResourceOutput, error)
+	DeleteDashboards(*awscloudwatch.DeleteDashboardsInput) (*awscloudwatch.DeleteDashboardsOutput, error)
+}
+
+type Dashboards struct {
+	client dashboardsClient
+	logger logger
+}
+
+func NewDashboards(client dashboardsClient, logger logger) Dashboards {
+	return Dashboards{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (d Dashboards) List(filter string) ([]common.Deletable, error) {
+	var dashboards []*awscloudwatch.DashboardEntry
+	err := d.client.ListDashboardsPages(&awscloudwatch.ListDashboardsInput{}, func(page *awscloudwatch.ListDashboardsOutput, lastPage bool) bool {
+		dashboards = append(dashboards, page.DashboardEntries...)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("List CloudWatch Dashboards: %s", err)
+	}
+
+	var resources []common.Deletable
+	for _, dashboard := range dashboards {
+		tags, err := d.client.ListTagsForResource(&awscloudwatch.ListTagsForResourceInput{ResourceARN: dashboard.DashboardArn})
+		if err != nil {
+			return nil, fmt.Errorf("List tags for CloudWatch Dashboard %s: %s", *dashboard.DashboardName, err)
+		}
+
+		r := NewDashboard(d.client, dashboard.DashboardName, tags.Tags)
+
+		if !strings.Contains(r.Name(), filter) {
+			continue
+		}
+
+		proceed := d.logger.PromptWithDetails(r.Type(), r.Name())
+		if !proceed {
+			continue
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func (d Dashboards) Type() string {
+	return "cloudwatch-dashboard"
+}
@@ -0,0 +1,110 @@
+package cloudwatch_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awscloudwatch "github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/genevieve/leftovers/aws/cloudwatch"
+	"github.com/genevieve/leftovers/aws/cloudwatch/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Dashboards", func() {
+	var (
+		client *fakes.DashboardsClient
+		logger *fakes.Logger
+
+		dashboards cloudwatch.Dashboards
+	)
+
+	BeforeEach(func() {
+		client = &fakes.DashboardsClient{}
+		logger = &fakes.Logger{}
+		logger.PromptWithDetailsCall.Returns.Proceed = true
+
+		dashboards = cloudwatch.NewDashboards(client, logger)
+	})
+
+	Describe("List", func() {
+		var filter string
+
+		BeforeEach(func() {
+			client.ListDashboardsPagesCall.Returns.Pages = []*awscloudwatch.ListDashboardsOutput{{
+				DashboardEntries: []*awscloudwatch.DashboardEntry{{
+					DashboardName: aws.String("the-banana-dashboard"),
+					DashboardArn:  aws.String("the-dashboard-arn"),
+				}},
+			}}
+			client.ListTagsForResourceCall.Returns.Output = &awscloudwatch.ListTagsForResourceOutput{}
+			filter = "banana"
+		})
+
+		It("returns the dashboards whose name or tags contain the filter", func() {
+			items, err := dashboards.List(filter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.ListDashboardsPagesCall.CallCount).To(Equal(1))
+			Expect(client.ListTagsForResourceCall.Receives.Input.ResourceARN).To(Equal(aws.String("the-dashboard-arn")))
+
+			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("CloudWatch Dashboard"))
+			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("the-banana-dashboard"))
+
+			Expect(items).To(HaveLen(1))
+		})
+
+		Context("when neither the name nor the tags contain the filter", func() {
+			It("does not return it to the list", func() {
+				items, err := dashboards.List("mango")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+
+		Context("when the client fails to list dashboards", func() {
+			BeforeEach(func() {
+				client.ListDashboardsPagesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := dashboards.List(filter)
+				Expect(err).To(MatchError("List CloudWatch Dashboards: some error"))
+			})
+		})
+
+		Context("when the client fails to list tags", func() {
+			BeforeEach(func() {
+				client.ListTagsForResourceCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := dashboards.List(filter)
+				Expect(err).To(MatchError("List tags for CloudWatch Dashboard the-banana-dashboard: some error"))
+			})
+		})
+
+		Context("when the user responds no to the prompt", func() {
+			BeforeEach(func() {
+				logger.PromptWithDetailsCall.Returns.Proceed = false
+			})
+
+			It("does not return it to the list", func() {
+				items, err := dashboards.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(dashboards.Type()).To(Equal("cloudwatch-dashboard"))
+		})
+	})
+})
@@ -0,0 +1,68 @@
+package fakes
+
+import (
+	awscloudwatch "github.com/aws/aws-sdk-go/service/cloudwatch"
+)
+
+type AlarmsClient struct {
+	DescribeAlarmsPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awscloudwatch.DescribeAlarmsInput
+		}
+		Returns struct {
+			Pages []*awscloudwatch.DescribeAlarmsOutput
+			Error error
+		}
+	}
+
+	ListTagsForResourceCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awscloudwatch.ListTagsForResourceInput
+		}
+		Returns struct {
+			Output *awscloudwatch.ListTagsForResourceOutput
+			Error  error
+		}
+	}
+
+	DeleteAlarmsCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awscloudwatch.DeleteAlarmsInput
+		}
+		Returns struct {
+			Output *awscloudwatch.DeleteAlarmsOutput
+			Error  error
+		}
+	}
+}
+
+func (a *AlarmsClient) DescribeAlarmsPages(input *awscloudwatch.DescribeAlarmsInput, fn func(*awscloudwatch.DescribeAlarmsOutput, bool) bool) error {
+	a.DescribeAlarmsPagesCall.CallCount++
+	a.DescribeAlarmsPagesCall.Receives.Input = input
+
+	pages := a.DescribeAlarmsPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return a.DescribeAlarmsPagesCall.Returns.Error
+}
+
+func (a *AlarmsClient) ListTagsForResource(input *awscloudwatch.ListTagsForResourceInput) (*awscloudwatch.ListTagsForResourceOutput, error) {
+	a.ListTagsForResourceCall.CallCount++
+	a.ListTagsForResourceCall.Receives.Input = input
+
+	return a.ListTagsForResourceCall.Returns.Output, a.ListTagsForResourceCall.Returns.Error
+}
+
+func (a *AlarmsClient) DeleteAlarms(input *awscloudwatch.DeleteAlarmsInput) (*awscloudwatch.DeleteAlarmsOutput, error) {
+	a.DeleteAlarmsCall.CallCount++
+	a.DeleteAlarmsCall.Receives.Input = input
+
+	return a.DeleteAlarmsCall.Returns.Output, a.DeleteAlarmsCall.Returns.Error
+}
@@ -0,0 +1,68 @@
+package fakes
+
+import (
+	awscloudwatch "github.com/aws/aws-sdk-go/service/cloudwatch"
+)
+
+type DashboardsClient struct {
+	ListDashboardsPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awscloudwatch.ListDashboardsInput
+		}
+		Returns struct {
+			Pages []*awscloudwatch.ListDashboardsOutput
+			Error error
+		}
+	}
+
+	ListTagsForResourceCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awscloudwatch.ListTagsForResourceInput
+		}
+		Returns struct {
+			Output *awscloudwatch.ListTagsForResourceOutput
+			Error  error
+		}
+	}
+
+	DeleteDashboardsCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awscloudwatch.DeleteDashboardsInput
+		}
+		Returns struct {
+			Output *awscloudwatch.DeleteDashboardsOutput
+			Error  error
+		}
+	}
+}
+
+func (d *DashboardsClient) ListDashboardsPages(input *awscloudwatch.ListDashboardsInput, fn func(*awscloudwatch.ListDashboardsOutput, bool) bool) error {
+	d.ListDashboardsPagesCall.CallCount++
+	d.ListDashboardsPagesCall.Receives.Input = input
+
+	pages := d.ListDashboardsPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return d.ListDashboardsPagesCall.Returns.Error
+}
+
+func (d *DashboardsClient) ListTagsForResource(input *awscloudwatch.ListTagsForResourceInput) (*awscloudwatch.ListTagsForResourceOutput, error) {
+	d.ListTagsForResourceCall.CallCount++
+	d.ListTagsForResourceCall.Receives.Input = input
+
+	return d.ListTagsForResourceCall.Returns.Output, d.ListTagsForResourceCall.Returns.Error
+}
+
+func (d *DashboardsClient) DeleteDashboards(input *awscloudwatch.DeleteDashboardsInput) (*awscloudwatch.DeleteDashboardsOutput, error) {
+	d.DeleteDashboardsCall.CallCount++
+	d.DeleteDashboardsCall.Receives.Input = input
+
+	return d.DeleteDashboardsCall.Returns.Output, d.DeleteDashboardsCall.Returns.Error
+}
@@ -0,0 +1,68 @@
+package fakes
+
+import (
+	awscloudwatchlogs "github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+)
+
+type LogGroupsClient struct {
+	DescribeLogGroupsPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awscloudwatchlogs.DescribeLogGroupsInput
+		}
+		Returns struct {
+			Pages []*awscloudwatchlogs.DescribeLogGroupsOutput
+			Error error
+		}
+	}
+
+	ListTagsLogGroupCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awscloudwatchlogs.ListTagsLogGroupInput
+		}
+		Returns struct {
+			Output *awscloudwatchlogs.ListTagsLogGroupOutput
+			Error  error
+		}
+	}
+
+	DeleteLogGroupCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awscloudwatchlogs.DeleteLogGroupInput
+		}
+		Returns struct {
+			Output *awscloudwatchlogs.DeleteLogGroupOutput
+			Error  error
+		}
+	}
+}
+
+func (l *LogGroupsClient) DescribeLogGroupsPages(input *awscloudwatchlogs.DescribeLogGroupsInput, fn func(*awscloudwatchlogs.DescribeLogGroupsOutput, bool) bool) error {
+	l.DescribeLogGroupsPagesCall.CallCount++
+	l.DescribeLogGroupsPagesCall.Receives.Input = input
+
+	pages := l.DescribeLogGroupsPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return l.DescribeLogGroupsPagesCall.Returns.Error
+}
+
+func (l *LogGroupsClient) ListTagsLogGroup(input *awscloudwatchlogs.ListTagsLogGroupInput) (*awscloudwatchlogs.ListTagsLogGroupOutput, error) {
+	l.ListTagsLogGroupCall.CallCount++
+	l.ListTagsLogGroupCall.Receives.Input = input
+
+	return l.ListTagsLogGroupCall.Returns.Output, l.ListTagsLogGroupCall.Returns.Error
+}
+
+func (l *LogGroupsClient) DeleteLogGroup(input *awscloudwatchlogs.DeleteLogGroupInput) (*awscloudwatchlogs.DeleteLogGroupOutput, error) {
+	l.DeleteLogGroupCall.CallCount++
+	l.DeleteLogGroupCall.Receives.Input = input
+
+	return l.DeleteLogGroupCall.Returns.Output, l.DeleteLogGroupCall.Returns.Error
+}
@@ -0,0 +1,13 @@
+package cloudwatch_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestCloudWatch(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "aws/cloudwatch")
+}
@@ -0,0 +1,60 @@
+package cloudwatch
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	awscloudwatchlogs "github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+)
+
+type LogGroup struct {
+	client     logGroupsClient
+	name       *string
+	identifier string
+	rtype      string
+}
+
+func NewLogGroup(client logGroupsClient, name *string, tags map[string]*string) LogGroup {
+	identifier := *name
+
+	var keys []string
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var extra []string
+	for _, k := range keys {
+		extra = append(extra, fmt.Sprintf("%s:%s", k, *tags[k]))
+	}
+
+	if len(extra) > 0 {
+		identifier = fmt.Sprintf("%s (%s)", *name, strings.Join(extra, ", "))
+	}
+
+	return LogGroup{
+		client:     client,
+		name:       name,
+		identifier: identifier,
+		rtype:      "CloudWatch Log Group",
+	}
+}
+
+// Delete deletes the log group with all of its log streams.
+func (l LogGroup) Delete() error {
+	_, err := l.client.DeleteLogGroup(&awscloudwatchlogs.DeleteLogGroupInput{LogGroupName: l.name})
+	if err != nil {
+		return fmt.Errorf("Delete: %s", err)
+	}
+
+	return nil
+}
+
+func (l LogGroup) Name() string {
+	return l.identifier
+}
+
+func (l LogGroup) Type() string {
+	return l.rtype
+}
@@ -0,0 +1,59 @@
+package cloudwatch_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/genevieve/leftovers/aws/cloudwatch"
+	"github.com/genevieve/leftovers/aws/cloudwatch/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LogGroup", func() {
+	var (
+		logGroup cloudwatch.LogGroup
+		client   *fakes.LogGroupsClient
+		name     *string
+	)
+
+	BeforeEach(func() {
+		client = &fakes.LogGroupsClient{}
+		name = aws.String("the-log-group")
+
+		logGroup = cloudwatch.NewLogGroup(client, name, map[string]*string{"the-key": aws.String("the-value")})
+	})
+
+	Describe("Delete", func() {
+		It("deletes the log group", func() {
+			err := logGroup.Delete()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DeleteLogGroupCall.CallCount).To(Equal(1))
+			Expect(client.DeleteLogGroupCall.Receives.Input.LogGroupName).To(Equal(name))
+		})
+
+		Context("when the client fails", func() {
+			BeforeEach(func() {
+				client.DeleteLogGroupCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := logGroup.Delete()
+				Expect(err).To(MatchError("Delete: banana"))
+			})
+		})
+	})
+
+	Describe("Name", func() {
+		It("returns the identifier", func() {
+			Expect(logGroup.Name()).To(Equal("the-log-group (the-key:the-value)"))
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(logGroup.Type()).To(Equal("CloudWatch Log Group"))
+		})
+	})
+})
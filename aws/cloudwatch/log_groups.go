@@ -0,0 +1,70 @@
+package cloudwatch
+
+import (
+	"fmt"
+	"strings"
+
+	awscloudwatchlogs "github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/genevieve/leftovers/common"
+)
+
+type logGroupsClient interface {
+	DescribeLogGroupsPages(*awscloudwatchlogs.DescribeLogGroupsInput, func(*awscloudwatchlogs.DescribeLogGroupsOutput, bool) bool) error
+	ListTagsLogGroup(*awscloudwatchlogs.ListTagsLogGroupInput) (*awscloudwatchlogs.ListTagsLogGroupOutput, error)
+	DeleteLogGroup(*awscloudwatchlogs.DeleteLogGroupInput) (*awscloudwatchlogs.DeleteLogGroupOutput, error)
+}
+
+type logger interface {
+	Printf(m string, a ...interface{})
+	PromptWithDetails(resourceType, resourceName string) bool
+}
+
+type LogGroups struct {
+	client logGroupsClient
+	logger logger
+}
+
+func NewLogGroups(client logGroupsClient, logger logger) LogGroups {
+	return LogGroups{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (l LogGroups) List(filter string) ([]common.Deletable, error) {
+	var groups []*awscloudwatchlogs.LogGroup
+	err := l.client.DescribeLogGroupsPages(&awscloudwatchlogs.DescribeLogGroupsInput{}, func(page *awscloudwatchlogs.DescribeLogGroupsOutput, lastPage bool) bool {
+		groups = append(groups, page.LogGroups...)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Describe CloudWatch Log Groups: %s", err)
+	}
+
+	var resources []common.Deletable
+	for _, group := range groups {
+		tags, err := l.client.ListTagsLogGroup(&awscloudwatchlogs.ListTagsLogGroupInput{LogGroupName: group.LogGroupName})
+		if err != nil {
+			return nil, fmt.Errorf("List tags for CloudWatch Log Group %s: %s", *group.LogGroupName, err)
+		}
+
+		r := NewLogGroup(l.client, group.LogGroupName, tags.Tags)
+
+		if !strings.Contains(r.Name(), filter) {
+			continue
+		}
+
+		proceed := l.logger.PromptWithDetails(r.Type(), r.Name())
+		if !proceed {
+			continue
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func (l LogGroups) Type() string {
+	return "cloudwatch-log-group"
+}
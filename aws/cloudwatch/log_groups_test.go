@@ -0,0 +1,112 @@
+package cloudwatch_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awscloudwatchlogs "github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/genevieve/leftovers/aws/cloudwatch"
+	"github.com/genevieve/leftovers/aws/cloudwatch/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LogGroups", func() {
+	var (
+		client *fakes.LogGroupsClient
+		logger *fakes.Logger
+
+		logGroups cloudwatch.LogGroups
+	)
+
+	BeforeEach(func() {
+		client = &fakes.LogGroupsClient{}
+		logger = &fakes.Logger{}
+		logger.PromptWithDetailsCall.Returns.Proceed = true
+
+		logGroups = cloudwatch.NewLogGroups(client, logger)
+	})
+
+	Describe("List", func() {
+		var filter string
+
+		BeforeEach(func() {
+			client.DescribeLogGroupsPagesCall.Returns.Pages = []*awscloudwatchlogs.DescribeLogGroupsOutput{{
+				LogGroups: []*awscloudwatchlogs.LogGroup{{LogGroupName: aws.String("/aws/lambda/the-function")}},
+			}, {
+				LogGroups: []*awscloudwatchlogs.LogGroup{{LogGroupName: aws.String("/aws/eks/the-cluster/cluster")}},
+			}}
+			client.ListTagsLogGroupCall.Returns.Output = &awscloudwatchlogs.ListTagsLogGroupOutput{
+				Tags: map[string]*string{"env": aws.String("banana"), "app": aws.String("kiwi")},
+			}
+			filter = "banana"
+		})
+
+		It("returns the log groups from every page whose name or tags contain the filter", func() {
+			items, err := logGroups.List(filter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DescribeLogGroupsPagesCall.CallCount).To(Equal(1))
+			Expect(client.ListTagsLogGroupCall.CallCount).To(Equal(2))
+			Expect(client.ListTagsLogGroupCall.Receives.Input.LogGroupName).To(Equal(aws.String("/aws/eks/the-cluster/cluster")))
+
+			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(2))
+			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("CloudWatch Log Group"))
+			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("/aws/eks/the-cluster/cluster (app:kiwi, env:banana)"))
+
+			Expect(items).To(HaveLen(2))
+		})
+
+		Context("when neither the name nor the tags contain the filter", func() {
+			It("does not return it to the list", func() {
+				items, err := logGroups.List("mango")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+
+		Context("when the client fails to describe log groups", func() {
+			BeforeEach(func() {
+				client.DescribeLogGroupsPagesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := logGroups.List(filter)
+				Expect(err).To(MatchError("Describe CloudWatch Log Groups: some error"))
+			})
+		})
+
+		Context("when the client fails to list tags", func() {
+			BeforeEach(func() {
+				client.ListTagsLogGroupCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := logGroups.List(filter)
+				Expect(err).To(MatchError("List tags for CloudWatch Log Group /aws/lambda/the-function: some error"))
+			})
+		})
+
+		Context("when the user responds no to the prompt", func() {
+			BeforeEach(func() {
+				logger.PromptWithDetailsCall.Returns.Proceed = false
+			})
+
+			It("does not return it to the list", func() {
+				items, err := logGroups.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(2))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(logGroups.Type()).To(Equal("cloudwatch-log-group"))
+		})
+	})
+})
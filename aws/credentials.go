@@ -0,0 +1,95 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	awslib "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/genevieve/leftovers/common"
+)
+
+// defaultRoleSessionName is the session name that
+// roles are assumed with if none is provided.
+const defaultRoleSessionName = "leftovers"
+
+// AuthArgs are the credentials that requests are signed with.
+// If no access key is provided, credentials are found the way the AWS CLI
+// finds them: in the environment, in the shared config and credentials
+// files for the profile, including SSO and credential processes, or from
+// the role of the instance or container. If a role is provided, it is
+// assumed with those credentials.
+type AuthArgs struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+	Profile         string
+	RoleARN         string
+	ExternalID      string
+	SessionName     string
+}
+
+// newSession returns a session that signs requests with the credentials
+// of the provided AuthArgs and sends them with a transport that the provided
+// transport wraps. It returns an error if no credentials are found.
+func newSession(authArgs AuthArgs, config *awslib.Config, transport common.WrapTransport) (*session.Session, error) {
+	if authArgs.AccessKeyID != "" || authArgs.SecretAccessKey != "" {
+		if authArgs.AccessKeyID == "" {
+			return nil, errors.New("Missing aws access key id.")
+		}
+
+		if authArgs.SecretAccessKey == "" {
+			return nil, errors.New("Missing secret access key.")
+		}
+
+		config = config.Copy().WithCredentials(credentials.NewStaticCredentials(authArgs.AccessKeyID, authArgs.SecretAccessKey, authArgs.SessionToken))
+	}
+
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config:            *config,
+		Profile:           authArgs.Profile,
+		SharedConfigState: session.SharedConfigEnable,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Loading aws config: %s", err)
+	}
+
+	// The transport is wrapped after the session is loaded, which
+	// can set the CA bundle of the environment on the base transport.
+	client := sess.Config.HTTPClient
+	if client.Transport == nil {
+		client.Transport = http.DefaultTransport
+	}
+	client.Transport = transport.Wrap(client.Transport)
+
+	if authArgs.RoleARN != "" {
+		sess = assumeRole(sess, authArgs.RoleARN, authArgs.ExternalID, authArgs.SessionName)
+	}
+
+	if _, err := sess.Config.Credentials.Get(); err != nil {
+		return nil, fmt.Errorf("Missing aws credentials: %s", err)
+	}
+
+	return sess, nil
+}
+
+// assumeRole returns a session that signs requests with the credentials
+// of the provided role, which it assumes with the credentials of the
+// provided session.
+func assumeRole(sess *session.Session, roleARN, externalID, sessionName string) *session.Session {
+	if sessionName == "" {
+		sessionName = defaultRoleSessionName
+	}
+
+	return sess.Copy(&awslib.Config{
+		Credentials: stscreds.NewCredentials(sess, roleARN, func(p *stscreds.AssumeRoleProvider) {
+			p.RoleSessionName = sessionName
+			if externalID != "" {
+				p.ExternalID = awslib.String(externalID)
+			}
+		}),
+	})
+}
@@ -0,0 +1,183 @@
+package aws_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/genevieve/leftovers/app"
+	"github.com/genevieve/leftovers/aws"
+	"github.com/genevieve/leftovers/common"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AuthArgs", func() {
+	var (
+		dir       string
+		server    *httptest.Server
+		requests  []url.Values
+		signedBy  []string
+		endpoints aws.Endpoints
+		env       map[string]string
+	)
+
+	setenv := func(key, value string) {
+		if _, ok := env[key]; !ok {
+			env[key] = os.Getenv(key)
+		}
+		os.Setenv(key, value)
+	}
+
+	newLeftovers := func(authArgs aws.AuthArgs, region string) error {
+		logger := app.NewLogger(ioutil.Discard, os.Stdin, true)
+		_, err := aws.NewLeftovers(logger, authArgs, region, endpoints, nil, common.Backup{}, aws.DeleteArgs{})
+		return err
+	}
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "credentials")
+		Expect(err).NotTo(HaveOccurred())
+
+		requests = nil
+		signedBy = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			r.ParseForm()
+			requests = append(requests, r.Form)
+			signedBy = append(signedBy, r.Header.Get("Authorization"))
+			if r.Form.Get("Action") == "DescribeRegions" {
+				fmt.Fprint(w, `<DescribeRegionsResponse><regionInfo><item><regionName>eu-west-1</regionName></item></regionInfo></DescribeRegionsResponse>`)
+				return
+			}
+			fmt.Fprint(w, `<AssumeRoleResponse><AssumeRoleResult>
+				<Credentials><AccessKeyId>role-key</AccessKeyId><SecretAccessKey>role-secret</SecretAccessKey>
+				<SessionToken>role-token</SessionToken><Expiration>2100-01-01T00:00:00Z</Expiration></Credentials>
+				</AssumeRoleResult></AssumeRoleResponse>`)
+		}))
+		endpoints = aws.Endpoints{Services: map[string]string{"sts": server.URL, "ec2": server.URL}}
+
+		env = map[string]string{}
+		setenv("AWS_ACCESS_KEY_ID", "")
+		setenv("AWS_SECRET_ACCESS_KEY", "")
+		setenv("AWS_PROFILE", "")
+		setenv("AWS_REGION", "")
+		setenv("AWS_EC2_METADATA_DISABLED", "true")
+		setenv("AWS_CONFIG_FILE", filepath.Join(dir, "config"))
+		setenv("AWS_SHARED_CREDENTIALS_FILE", filepath.Join(dir, "credentials"))
+
+		err = ioutil.WriteFile(filepath.Join(dir, "config"), []byte("[profile sandbox]\nregion = eu-west-1\n"), 0600)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = ioutil.WriteFile(filepath.Join(dir, "credentials"), []byte("[sandbox]\naws_access_key_id = profile-key\naws_secret_access_key = profile-secret\n"), 0600)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		for key, value := range env {
+			os.Setenv(key, value)
+		}
+		server.Close()
+		os.RemoveAll(dir)
+	})
+
+	It("finds credentials and the region in the profile", func() {
+		err := newLeftovers(aws.AuthArgs{Profile: "sandbox"}, "")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("assumes the role with the external id and session name", func() {
+		err := newLeftovers(aws.AuthArgs{
+			Profile:     "sandbox",
+			RoleARN:     "arn:aws:iam::123456789012:role/sandbox",
+			ExternalID:  "some-external-id",
+			SessionName: "some-session",
+		}, "us-east-1")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].Get("Action")).To(Equal("AssumeRole"))
+		Expect(requests[0].Get("RoleArn")).To(Equal("arn:aws:iam::123456789012:role/sandbox"))
+		Expect(requests[0].Get("ExternalId")).To(Equal("some-external-id"))
+		Expect(requests[0].Get("RoleSessionName")).To(Equal("some-session"))
+		Expect(signedBy[0]).To(ContainSubstring("Credential=profile-key/"))
+	})
+
+	It("assumes the role with the access key and a default session name", func() {
+		err := newLeftovers(aws.AuthArgs{
+			AccessKeyID:     "some-key",
+			SecretAccessKey: "some-secret",
+			RoleARN:         "arn:aws:iam::123456789012:role/sandbox",
+		}, "us-east-1")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].Get("RoleSessionName")).To(Equal("leftovers"))
+		Expect(requests[0].Get("ExternalId")).To(Equal(""))
+		Expect(signedBy[0]).To(ContainSubstring("Credential=some-key/"))
+	})
+
+	Context("when every region is swept", func() {
+		It("describes the enabled regions in the region of the profile", func() {
+			err := newLeftovers(aws.AuthArgs{Profile: "sandbox"}, "all")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(requests).To(HaveLen(1))
+			Expect(requests[0].Get("Action")).To(Equal("DescribeRegions"))
+			Expect(signedBy[0]).To(ContainSubstring("/eu-west-1/ec2/"))
+		})
+
+		It("describes the enabled regions in the region of the environment", func() {
+			setenv("AWS_REGION", "us-gov-west-1")
+
+			err := newLeftovers(aws.AuthArgs{AccessKeyID: "some-key", SecretAccessKey: "some-secret"}, "all")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(requests).To(HaveLen(1))
+			Expect(signedBy[0]).To(ContainSubstring("/us-gov-west-1/ec2/"))
+		})
+
+		Context("when there is no region in the profile", func() {
+			It("describes the enabled regions in us-east-1", func() {
+				err := newLeftovers(aws.AuthArgs{AccessKeyID: "some-key", SecretAccessKey: "some-secret"}, "all")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(requests).To(HaveLen(1))
+				Expect(signedBy[0]).To(ContainSubstring("/us-east-1/ec2/"))
+			})
+		})
+	})
+
+	Context("when the access key is missing its secret", func() {
+		It("returns an error", func() {
+			err := newLeftovers(aws.AuthArgs{AccessKeyID: "some-key"}, "us-east-1")
+			Expect(err).To(MatchError("Missing secret access key."))
+		})
+	})
+
+	Context("when the profile does not exist", func() {
+		It("returns an error", func() {
+			err := newLeftovers(aws.AuthArgs{Profile: "missing"}, "us-east-1")
+			Expect(err).To(MatchError(ContainSubstring("Missing aws credentials")))
+		})
+	})
+
+	Context("when no credentials are found", func() {
+		It("returns an error", func() {
+			err := newLeftovers(aws.AuthArgs{}, "us-east-1")
+			Expect(err).To(MatchError(ContainSubstring("Missing aws credentials")))
+		})
+	})
+
+	Context("when no region is provided or in the profile", func() {
+		It("returns an error", func() {
+			err := newLeftovers(aws.AuthArgs{AccessKeyID: "some-key", SecretAccessKey: "some-secret"}, "")
+			Expect(err).To(MatchError("Missing region."))
+		})
+	})
+})
@@ -0,0 +1,86 @@
+package fakes
+
+import (
+	awsdynamodb "github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+type TablesClient struct {
+	ListTablesPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsdynamodb.ListTablesInput
+		}
+		Returns struct {
+			Pages []*awsdynamodb.ListTablesOutput
+			Error error
+		}
+	}
+
+	DescribeTableCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsdynamodb.DescribeTableInput
+		}
+		Returns struct {
+			Output *awsdynamodb.DescribeTableOutput
+			Error  error
+		}
+	}
+
+	CreateBackupCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsdynamodb.CreateBackupInput
+		}
+		Returns struct {
+			Output *awsdynamodb.CreateBackupOutput
+			Error  error
+		}
+	}
+
+	DeleteTableCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsdynamodb.DeleteTableInput
+		}
+		Returns struct {
+			Output *awsdynamodb.DeleteTableOutput
+			Error  error
+		}
+	}
+}
+
+func (t *TablesClient) ListTablesPages(input *awsdynamodb.ListTablesInput, fn func(*awsdynamodb.ListTablesOutput, bool) bool) error {
+	t.ListTablesPagesCall.CallCount++
+	t.ListTablesPagesCall.Receives.Input = input
+
+	pages := t.ListTablesPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return t.ListTablesPagesCall.Returns.Error
+}
+
+func (t *TablesClient) DescribeTable(input *awsdynamodb.DescribeTableInput) (*awsdynamodb.DescribeTableOutput, error) {
+	t.DescribeTableCall.CallCount++
+	t.DescribeTableCall.Receives.Input = input
+
+	return t.DescribeTableCall.Returns.Output, t.DescribeTableCall.Returns.Error
+}
+
+func (t *TablesClient) CreateBackup(input *awsdynamodb.CreateBackupInput) (*awsdynamodb.CreateBackupOutput, error) {
+	t.CreateBackupCall.CallCount++
+	t.CreateBackupCall.Receives.Input = input
+
+	return t.CreateBackupCall.Returns.Output, t.CreateBackupCall.Returns.Error
+}
+
+func (t *TablesClient) DeleteTable(input *awsdynamodb.DeleteTableInput) (*awsdynamodb.DeleteTableOutput, error) {
+	t.DeleteTableCall.CallCount++
+	t.DeleteTableCall.Receives.Input = input
+
+	return t.DeleteTableCall.Returns.Output, t.DeleteTableCall.Returns.Error
+}
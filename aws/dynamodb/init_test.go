@@ -0,0 +1,13 @@
+package dynamodb_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestDynamoDB(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "aws/dynamodb")
+}
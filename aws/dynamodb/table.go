@@ -0,0 +1,90 @@
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awsdynamodb "github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/genevieve/leftovers/common"
+)
+
+type Table struct {
+	client     tablesClient
+	logger     logger
+	name       *string
+	identifier string
+	rtype      string
+	backup     bool
+}
+
+func NewTable(client tablesClient, logger logger, name *string, backup bool) Table {
+	return Table{
+		client:     client,
+		logger:     logger,
+		name:       name,
+		identifier: *name,
+		rtype:      "DynamoDB Table",
+		backup:     backup,
+	}
+}
+
+func (t Table) Delete() error {
+	return t.DeleteContext(context.Background())
+}
+
+// DeleteContext deletes the table and waits for it to be deleted. Since DynamoDB
+// does not take final snapshots, a backup is created first if backup is true.
+func (t Table) DeleteContext(ctx context.Context) error {
+	if t.backup {
+		name := common.BackupName(t.identifier, 255)
+
+		_, err := t.client.CreateBackup(&awsdynamodb.CreateBackupInput{
+			TableName:  t.name,
+			BackupName: aws.String(name),
+		})
+		if err != nil {
+			return fmt.Errorf("Create backup: %s", err)
+		}
+
+		t.logger.Printf("[%s: %s] Backup: %s\n", t.rtype, t.identifier, name)
+	}
+
+	_, err := t.client.DeleteTable(&awsdynamodb.DeleteTableInput{TableName: t.name})
+	if err != nil {
+		return fmt.Errorf("Delete: %s", err)
+	}
+
+	poller := common.NewPoller(t.logger, tableRefresh(t.client, t.name), []string{"active", "deleting"}, []string{"deleted"})
+
+	_, err = poller.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("Waiting for deletion: %s", err)
+	}
+
+	return nil
+}
+
+func (t Table) Name() string {
+	return t.identifier
+}
+
+func (t Table) Type() string {
+	return t.rtype
+}
+
+func tableRefresh(client tablesClient, name *string) common.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.DescribeTable(&awsdynamodb.DescribeTableInput{TableName: name})
+		if err != nil {
+			if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == awsdynamodb.ErrCodeResourceNotFoundException {
+				return name, "deleted", nil
+			}
+			return nil, "", err
+		}
+
+		return resp.Table, strings.ToLower(*resp.Table.TableStatus), nil
+	}
+}
@@ -0,0 +1,129 @@
+package dynamodb_test
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awsdynamodb "github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/genevieve/leftovers/aws/dynamodb"
+	"github.com/genevieve/leftovers/aws/dynamodb/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Table", func() {
+	var (
+		table  dynamodb.Table
+		client *fakes.TablesClient
+		logger *fakes.Logger
+		name   *string
+	)
+
+	BeforeEach(func() {
+		client = &fakes.TablesClient{}
+		logger = &fakes.Logger{}
+		name = aws.String("the-table")
+
+		client.DescribeTableCall.Returns.Error = awserr.New("ResourceNotFoundException", "", nil)
+
+		table = dynamodb.NewTable(client, logger, name, false)
+	})
+
+	Describe("Delete", func() {
+		It("deletes the table and waits for it to be deleted", func() {
+			err := table.Delete()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.CreateBackupCall.CallCount).To(Equal(0))
+
+			Expect(client.DeleteTableCall.CallCount).To(Equal(1))
+			Expect(client.DeleteTableCall.Receives.Input.TableName).To(Equal(name))
+
+			Expect(client.DescribeTableCall.CallCount).To(Equal(1))
+			Expect(client.DescribeTableCall.Receives.Input.TableName).To(Equal(name))
+		})
+
+		Context("when backup is enabled", func() {
+			BeforeEach(func() {
+				table = dynamodb.NewTable(client, logger, name, true)
+			})
+
+			It("creates a backup before deleting the table and logs its name", func() {
+				err := table.Delete()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(client.CreateBackupCall.CallCount).To(Equal(1))
+				Expect(client.CreateBackupCall.Receives.Input.TableName).To(Equal(name))
+				Expect(*client.CreateBackupCall.Receives.Input.BackupName).To(HavePrefix("the-table-leftovers-"))
+
+				Expect(logger.PrintfCall.Messages).To(ContainElement(
+					fmt.Sprintf("[DynamoDB Table: the-table] Backup: %s\n", *client.CreateBackupCall.Receives.Input.BackupName),
+				))
+			})
+
+			Context("when the client fails to create the backup", func() {
+				BeforeEach(func() {
+					client.CreateBackupCall.Returns.Error = errors.New("banana")
+				})
+
+				It("does not delete the table", func() {
+					err := table.Delete()
+					Expect(err).To(MatchError("Create backup: banana"))
+
+					Expect(client.DeleteTableCall.CallCount).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when the client fails", func() {
+			BeforeEach(func() {
+				client.DeleteTableCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := table.Delete()
+				Expect(err).To(MatchError("Delete: banana"))
+			})
+		})
+
+		Context("when the client fails while waiting", func() {
+			BeforeEach(func() {
+				client.DescribeTableCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := table.Delete()
+				Expect(err).To(MatchError("Waiting for deletion: banana"))
+			})
+		})
+
+		Context("when the table fails to be deleted", func() {
+			BeforeEach(func() {
+				client.DescribeTableCall.Returns.Error = nil
+				client.DescribeTableCall.Returns.Output = &awsdynamodb.DescribeTableOutput{
+					Table: &awsdynamodb.TableDescription{TableStatus: aws.String("ARCHIVED")},
+				}
+			})
+
+			It("returns the error", func() {
+				err := table.Delete()
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(HavePrefix("Waiting for deletion: "))
+			})
+		})
+	})
+
+	Describe("Name", func() {
+		It("returns the identifier", func() {
+			Expect(table.Name()).To(Equal("the-table"))
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(table.Type()).To(Equal("DynamoDB Table"))
+		})
+	})
+})
@@ -0,0 +1,63 @@
+package dynamodb
+
+import (
+	"fmt"
+	"strings"
+
+	awsdynamodb "github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/genevieve/leftovers/common"
+)
+
+type tablesClient interface {
+	ListTablesPages(*awsdynamodb.ListTablesInput, func(*awsdynamodb.ListTablesOutput, bool) bool) error
+	DescribeTable(*awsdynamodb.DescribeTableInput) (*awsdynamodb.DescribeTableOutput, error)
+	CreateBackup(*awsdynamodb.CreateBackupInput) (*awsdynamodb.CreateBackupOutput, error)
+	DeleteTable(*awsdynamodb.DeleteTableInput) (*awsdynamodb.DeleteTableOutput, error)
+}
+
+type Tables struct {
+	client tablesClient
+	logger logger
+	backup common.Backup
+}
+
+func NewTables(client tablesClient, logger logger, backup common.Backup) Tables {
+	return Tables{
+		client: client,
+		logger: logger,
+		backup: backup,
+	}
+}
+
+func (t Tables) List(filter string) ([]common.Deletable, error) {
+	var names []*string
+	err := t.client.ListTablesPages(&awsdynamodb.ListTablesInput{}, func(page *awsdynamodb.ListTablesOutput, lastPage bool) bool {
+		names = append(names, page.TableNames...)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("List DynamoDB Tables: %s", err)
+	}
+
+	var resources []common.Deletable
+	for _, name := range names {
+		r := NewTable(t.client, t.logger, name, t.backup.Enabled)
+
+		if !strings.Contains(r.Name(), filter) {
+			continue
+		}
+
+		proceed := t.logger.PromptWithDetails(r.Type(), r.Name())
+		if !proceed {
+			continue
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func (t Tables) Type() string {
+	return "dynamodb-table"
+}
@@ -0,0 +1,97 @@
+package dynamodb_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsdynamodb "github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/genevieve/leftovers/aws/dynamodb"
+	"github.com/genevieve/leftovers/aws/dynamodb/fakes"
+	"github.com/genevieve/leftovers/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Tables", func() {
+	var (
+		client *fakes.TablesClient
+		logger *fakes.Logger
+
+		tables dynamodb.Tables
+	)
+
+	BeforeEach(func() {
+		client = &fakes.TablesClient{}
+		logger = &fakes.Logger{}
+		logger.PromptWithDetailsCall.Returns.Proceed = true
+
+		tables = dynamodb.NewTables(client, logger, common.Backup{})
+	})
+
+	Describe("List", func() {
+		var filter string
+
+		BeforeEach(func() {
+			client.ListTablesPagesCall.Returns.Pages = []*awsdynamodb.ListTablesOutput{{
+				TableNames: []*string{aws.String("the-banana-table")},
+			}, {
+				TableNames: []*string{aws.String("the-other-banana-table")},
+			}}
+			filter = "banana"
+		})
+
+		It("returns the tables from every page whose name contains the filter", func() {
+			items, err := tables.List(filter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.ListTablesPagesCall.CallCount).To(Equal(1))
+
+			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(2))
+			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("DynamoDB Table"))
+			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("the-other-banana-table"))
+
+			Expect(items).To(HaveLen(2))
+		})
+
+		Context("when the table name does not contain the filter", func() {
+			It("does not return it to the list", func() {
+				items, err := tables.List("kiwi")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+
+		Context("when the client fails to list tables", func() {
+			BeforeEach(func() {
+				client.ListTablesPagesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := tables.List(filter)
+				Expect(err).To(MatchError("List DynamoDB Tables: some error"))
+			})
+		})
+
+		Context("when the user responds no to the prompt", func() {
+			BeforeEach(func() {
+				logger.PromptWithDetailsCall.Returns.Proceed = false
+			})
+
+			It("does not return it to the list", func() {
+				items, err := tables.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(2))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(tables.Type()).To(Equal("dynamodb-table"))
+		})
+	})
+})
@@ -0,0 +1,65 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+)
+
+type CustomerGateway struct {
+	client     customerGatewaysClient
+	logger     logger
+	id         *string
+	identifier string
+	rtype      string
+}
+
+func NewCustomerGateway(client customerGatewaysClient, logger logger, id *string, tags []*awsec2.Tag) CustomerGateway {
+	identifier := *id
+
+	var extra []string
+	for _, t := range tags {
+		extra = append(extra, fmt.Sprintf("%s:%s", *t.Key, *t.Value))
+	}
+
+	if len(extra) > 0 {
+		identifier = fmt.Sprintf("%s (%s)", *id, strings.Join(extra, ", "))
+	}
+
+	return CustomerGateway{
+		client:     client,
+		logger:     logger,
+		id:         id,
+		identifier: identifier,
+		rtype:      "EC2 Customer Gateway",
+	}
+}
+
+func (c CustomerGateway) Delete() error {
+	return c.DeleteContext(context.Background())
+}
+
+// DeleteContext deletes the vpn connections of the gateway and the gateway.
+func (c CustomerGateway) DeleteContext(ctx context.Context) error {
+	err := deleteVpnConnections(ctx, c.client, c.logger, "customer-gateway-id", *c.id)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.client.DeleteCustomerGateway(&awsec2.DeleteCustomerGatewayInput{CustomerGatewayId: c.id})
+	if err != nil {
+		return fmt.Errorf("Delete: %s", err)
+	}
+
+	return nil
+}
+
+func (c CustomerGateway) Name() string {
+	return c.identifier
+}
+
+func (c CustomerGateway) Type() string {
+	return c.rtype
+}
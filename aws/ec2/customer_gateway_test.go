@@ -0,0 +1,104 @@
+package ec2_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/genevieve/leftovers/aws/ec2"
+	"github.com/genevieve/leftovers/aws/ec2/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CustomerGateway", func() {
+	var (
+		gateway ec2.CustomerGateway
+		client  *fakes.CustomerGatewaysClient
+		logger  *fakes.Logger
+		id      *string
+	)
+
+	BeforeEach(func() {
+		client = &fakes.CustomerGatewaysClient{}
+		logger = &fakes.Logger{}
+		id = aws.String("the-id")
+		tags := []*awsec2.Tag{{Key: aws.String("the-key"), Value: aws.String("the-value")}}
+
+		client.DescribeVpnConnectionsCall.Returns.Output = &awsec2.DescribeVpnConnectionsOutput{}
+
+		gateway = ec2.NewCustomerGateway(client, logger, id, tags)
+	})
+
+	Describe("Delete", func() {
+		It("deletes the gateway", func() {
+			err := gateway.Delete()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DescribeVpnConnectionsCall.Receives.Input.Filters[0].Name).To(Equal(aws.String("customer-gateway-id")))
+			Expect(client.DescribeVpnConnectionsCall.Receives.Input.Filters[0].Values).To(Equal([]*string{id}))
+
+			Expect(client.DeleteCustomerGatewayCall.CallCount).To(Equal(1))
+			Expect(client.DeleteCustomerGatewayCall.Receives.Input.CustomerGatewayId).To(Equal(id))
+		})
+
+		Context("when the gateway has vpn connections that are being deleted", func() {
+			BeforeEach(func() {
+				client.DescribeVpnConnectionsCall.ReturnsOnCall = map[int]struct {
+					Output *awsec2.DescribeVpnConnectionsOutput
+					Error  error
+				}{
+					0: {Output: &awsec2.DescribeVpnConnectionsOutput{
+						VpnConnections: []*awsec2.VpnConnection{{VpnConnectionId: aws.String("the-connection-id"), State: aws.String("deleting")}},
+					}},
+				}
+				client.DescribeVpnConnectionsCall.Returns.Output = &awsec2.DescribeVpnConnectionsOutput{
+					VpnConnections: []*awsec2.VpnConnection{{VpnConnectionId: aws.String("the-connection-id"), State: aws.String("deleted")}},
+				}
+			})
+
+			It("waits for them to be deleted without deleting them again", func() {
+				err := gateway.Delete()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(client.DeleteVpnConnectionCall.CallCount).To(Equal(0))
+				Expect(client.DescribeVpnConnectionsCall.CallCount).To(Equal(2))
+				Expect(client.DeleteCustomerGatewayCall.CallCount).To(Equal(1))
+			})
+		})
+
+		Context("when the client fails to describe vpn connections", func() {
+			BeforeEach(func() {
+				client.DescribeVpnConnectionsCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := gateway.Delete()
+				Expect(err).To(MatchError("Describe EC2 VPN Connections: banana"))
+			})
+		})
+
+		Context("when the client fails", func() {
+			BeforeEach(func() {
+				client.DeleteCustomerGatewayCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := gateway.Delete()
+				Expect(err).To(MatchError("Delete: banana"))
+			})
+		})
+	})
+
+	Describe("Name", func() {
+		It("returns the identifier", func() {
+			Expect(gateway.Name()).To(Equal("the-id (the-key:the-value)"))
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(gateway.Type()).To(Equal("EC2 Customer Gateway"))
+		})
+	})
+})
@@ -0,0 +1,63 @@
+package ec2
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/genevieve/leftovers/common"
+)
+
+type customerGatewaysClient interface {
+	vpnConnectionsClient
+
+	DescribeCustomerGateways(*awsec2.DescribeCustomerGatewaysInput) (*awsec2.DescribeCustomerGatewaysOutput, error)
+	DeleteCustomerGateway(*awsec2.DeleteCustomerGatewayInput) (*awsec2.DeleteCustomerGatewayOutput, error)
+}
+
+type CustomerGateways struct {
+	client customerGatewaysClient
+	logger logger
+}
+
+func NewCustomerGateways(client customerGatewaysClient, logger logger) CustomerGateways {
+	return CustomerGateways{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (c CustomerGateways) List(filter string) ([]common.Deletable, error) {
+	resp, err := c.client.DescribeCustomerGateways(&awsec2.DescribeCustomerGatewaysInput{
+		Filters: []*awsec2.Filter{{
+			Name:   aws.String("state"),
+			Values: []*string{aws.String("pending"), aws.String("available")},
+		}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Describe EC2 Customer Gateways: %s", err)
+	}
+
+	var resources []common.Deletable
+	for _, g := range resp.CustomerGateways {
+		r := NewCustomerGateway(c.client, c.logger, g.CustomerGatewayId, g.Tags)
+
+		if !strings.Contains(r.Name(), filter) {
+			continue
+		}
+
+		proceed := c.logger.PromptWithDetails(r.Type(), r.Name())
+		if !proceed {
+			continue
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func (c CustomerGateways) Type() string {
+	return "ec2-customer-gateway"
+}
@@ -0,0 +1,97 @@
+package ec2_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/genevieve/leftovers/aws/ec2"
+	"github.com/genevieve/leftovers/aws/ec2/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CustomerGateways", func() {
+	var (
+		client *fakes.CustomerGatewaysClient
+		logger *fakes.Logger
+
+		gateways ec2.CustomerGateways
+	)
+
+	BeforeEach(func() {
+		client = &fakes.CustomerGatewaysClient{}
+		logger = &fakes.Logger{}
+		logger.PromptWithDetailsCall.Returns.Proceed = true
+
+		gateways = ec2.NewCustomerGateways(client, logger)
+	})
+
+	Describe("List", func() {
+		var filter string
+
+		BeforeEach(func() {
+			client.DescribeCustomerGatewaysCall.Returns.Output = &awsec2.DescribeCustomerGatewaysOutput{
+				CustomerGateways: []*awsec2.CustomerGateway{{
+					CustomerGatewayId: aws.String("cgw-banana"),
+				}},
+			}
+			filter = "banana"
+		})
+
+		It("returns a list of customer gateways that are not being deleted", func() {
+			items, err := gateways.List(filter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DescribeCustomerGatewaysCall.CallCount).To(Equal(1))
+			Expect(client.DescribeCustomerGatewaysCall.Receives.Input.Filters[0].Name).To(Equal(aws.String("state")))
+
+			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("EC2 Customer Gateway"))
+			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("cgw-banana"))
+
+			Expect(items).To(HaveLen(1))
+		})
+
+		Context("when the gateway name does not contain the filter", func() {
+			It("does not return it in the list", func() {
+				items, err := gateways.List("kiwi")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+
+		Context("when the client fails to describe customer gateways", func() {
+			BeforeEach(func() {
+				client.DescribeCustomerGatewaysCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := gateways.List(filter)
+				Expect(err).To(MatchError("Describe EC2 Customer Gateways: some error"))
+			})
+		})
+
+		Context("when the user responds no to the prompt", func() {
+			BeforeEach(func() {
+				logger.PromptWithDetailsCall.Returns.Proceed = false
+			})
+
+			It("does not return it in the list", func() {
+				items, err := gateways.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(gateways.Type()).To(Equal("ec2-customer-gateway"))
+		})
+	})
+})
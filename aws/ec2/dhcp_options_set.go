@@ -0,0 +1,52 @@
+package ec2
+
+import (
+	"fmt"
+	"strings"
+
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+)
+
+type DhcpOptionsSet struct {
+	client     dhcpOptionsSetsClient
+	id         *string
+	identifier string
+	rtype      string
+}
+
+func NewDhcpOptionsSet(client dhcpOptionsSetsClient, id *string, tags []*awsec2.Tag) DhcpOptionsSet {
+	identifier := *id
+
+	var extra []string
+	for _, t := range tags {
+		extra = append(extra, fmt.Sprintf("%s:%s", *t.Key, *t.Value))
+	}
+
+	if len(extra) > 0 {
+		identifier = fmt.Sprintf("%s (%s)", *id, strings.Join(extra, ", "))
+	}
+
+	return DhcpOptionsSet{
+		client:     client,
+		id:         id,
+		identifier: identifier,
+		rtype:      "EC2 DHCP Options Set",
+	}
+}
+
+func (d DhcpOptionsSet) Delete() error {
+	_, err := d.client.DeleteDhcpOptions(&awsec2.DeleteDhcpOptionsInput{DhcpOptionsId: d.id})
+	if err != nil {
+		return fmt.Errorf("Delete: %s", err)
+	}
+
+	return nil
+}
+
+func (d DhcpOptionsSet) Name() string {
+	return d.identifier
+}
+
+func (d DhcpOptionsSet) Type() string {
+	return d.rtype
+}
@@ -0,0 +1,61 @@
+package ec2_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/genevieve/leftovers/aws/ec2"
+	"github.com/genevieve/leftovers/aws/ec2/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DhcpOptionsSet", func() {
+	var (
+		set    ec2.DhcpOptionsSet
+		client *fakes.DhcpOptionsSetsClient
+		id     *string
+	)
+
+	BeforeEach(func() {
+		client = &fakes.DhcpOptionsSetsClient{}
+		id = aws.String("the-id")
+		tags := []*awsec2.Tag{{Key: aws.String("the-key"), Value: aws.String("the-value")}}
+
+		set = ec2.NewDhcpOptionsSet(client, id, tags)
+	})
+
+	Describe("Delete", func() {
+		It("deletes the dhcp options set", func() {
+			err := set.Delete()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DeleteDhcpOptionsCall.CallCount).To(Equal(1))
+			Expect(client.DeleteDhcpOptionsCall.Receives.Input.DhcpOptionsId).To(Equal(id))
+		})
+
+		Context("when the client fails", func() {
+			BeforeEach(func() {
+				client.DeleteDhcpOptionsCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := set.Delete()
+				Expect(err).To(MatchError("Delete: banana"))
+			})
+		})
+	})
+
+	Describe("Name", func() {
+		It("returns the identifier", func() {
+			Expect(set.Name()).To(Equal("the-id (the-key:the-value)"))
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(set.Type()).To(Equal("EC2 DHCP Options Set"))
+		})
+	})
+})
@@ -0,0 +1,153 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/genevieve/leftovers/common"
+)
+
+type dhcpOptionsSetsClient interface {
+	DescribeDhcpOptionsPages(*awsec2.DescribeDhcpOptionsInput, func(*awsec2.DescribeDhcpOptionsOutput, bool) bool) error
+	DescribeVpcsPages(*awsec2.DescribeVpcsInput, func(*awsec2.DescribeVpcsOutput, bool) bool) error
+	AssociateDhcpOptions(*awsec2.AssociateDhcpOptionsInput) (*awsec2.AssociateDhcpOptionsOutput, error)
+	DeleteDhcpOptions(*awsec2.DeleteDhcpOptionsInput) (*awsec2.DeleteDhcpOptionsOutput, error)
+}
+
+type DhcpOptionsSets struct {
+	client dhcpOptionsSetsClient
+	logger logger
+}
+
+func NewDhcpOptionsSets(client dhcpOptionsSetsClient, logger logger) DhcpOptionsSets {
+	return DhcpOptionsSets{
+		client: client,
+		logger: logger,
+	}
+}
+
+// List returns the dhcp options sets that are not associated with
+// any vpc. The sets of the vpcs that are deleted are deleted with them.
+func (d DhcpOptionsSets) List(filter string) ([]common.Deletable, error) {
+	vpcs, err := d.vpcs(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	inUse := map[string]bool{}
+	for _, v := range vpcs {
+		inUse[*v.DhcpOptionsId] = true
+	}
+
+	var sets []*awsec2.DhcpOptions
+	err = d.client.DescribeDhcpOptionsPages(&awsec2.DescribeDhcpOptionsInput{}, func(page *awsec2.DescribeDhcpOptionsOutput, lastPage bool) bool {
+		sets = append(sets, page.DhcpOptions...)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Describe EC2 DHCP Options Sets: %s", err)
+	}
+
+	var resources []common.Deletable
+	for _, s := range sets {
+		if inUse[*s.DhcpOptionsId] {
+			continue
+		}
+
+		r := NewDhcpOptionsSet(d.client, s.DhcpOptionsId, s.Tags)
+
+		if !strings.Contains(r.Name(), filter) {
+			continue
+		}
+
+		proceed := d.logger.PromptWithDetails(r.Type(), r.Name())
+		if !proceed {
+			continue
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func (d DhcpOptionsSets) Type() string {
+	return "ec2-dhcp-options-set"
+}
+
+// Delete associates the vpc with the default dhcp options, and deletes
+// the set that it was associated with if no other vpc is associated with it.
+func (d DhcpOptionsSets) Delete(ctx context.Context, vpcId string) error {
+	vpcs, err := d.vpcs(&awsec2.Filter{
+		Name:   aws.String("vpc-id"),
+		Values: []*string{aws.String(vpcId)},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, v := range vpcs {
+		id := v.DhcpOptionsId
+		if *id == "default" {
+			continue
+		}
+
+		_, err = d.client.AssociateDhcpOptions(&awsec2.AssociateDhcpOptionsInput{
+			DhcpOptionsId: aws.String("default"),
+			VpcId:         v.VpcId,
+		})
+		if err != nil {
+			return fmt.Errorf("Associate default dhcp options: %s", err)
+		}
+
+		associated, err := d.vpcs(&awsec2.Filter{
+			Name:   aws.String("dhcp-options-id"),
+			Values: []*string{id},
+		})
+		if err != nil {
+			return err
+		}
+
+		others := 0
+		for _, a := range associated {
+			if *a.VpcId != vpcId {
+				others++
+			}
+		}
+
+		if others > 0 {
+			d.logger.Printf("[EC2 VPC: %s] Kept dhcp options set %s, which is used by other vpcs \n", vpcId, *id)
+			continue
+		}
+
+		err = NewDhcpOptionsSet(d.client, id, nil).Delete()
+		if err != nil {
+			return fmt.Errorf("Delete %s: %s", *id, err)
+		}
+
+		d.logger.Printf("[EC2 VPC: %s] Deleted dhcp options set %s \n", vpcId, *id)
+	}
+
+	return nil
+}
+
+func (d DhcpOptionsSets) vpcs(filter *awsec2.Filter) ([]*awsec2.Vpc, error) {
+	input := &awsec2.DescribeVpcsInput{}
+	if filter != nil {
+		input.Filters = []*awsec2.Filter{filter}
+	}
+
+	var vpcs []*awsec2.Vpc
+	err := d.client.DescribeVpcsPages(input, func(page *awsec2.DescribeVpcsOutput, lastPage bool) bool {
+		vpcs = append(vpcs, page.Vpcs...)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Describe EC2 VPCs: %s", err)
+	}
+
+	return vpcs, nil
+}
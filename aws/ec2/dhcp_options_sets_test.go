@@ -0,0 +1,210 @@
+package ec2_test
+
+import (
+	"context"
+
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/genevieve/leftovers/aws/ec2"
+	"github.com/genevieve/leftovers/aws/ec2/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DhcpOptionsSets", func() {
+	var (
+		client *fakes.DhcpOptionsSetsClient
+		logger *fakes.Logger
+
+		sets ec2.DhcpOptionsSets
+	)
+
+	BeforeEach(func() {
+		client = &fakes.DhcpOptionsSetsClient{}
+		logger = &fakes.Logger{}
+		logger.PromptWithDetailsCall.Returns.Proceed = true
+
+		sets = ec2.NewDhcpOptionsSets(client, logger)
+	})
+
+	Describe("List", func() {
+		var filter string
+
+		BeforeEach(func() {
+			client.DescribeVpcsPagesCall.Returns.Pages = []*awsec2.DescribeVpcsOutput{{
+				Vpcs: []*awsec2.Vpc{{DhcpOptionsId: aws.String("dopt-used-banana")}},
+			}}
+			client.DescribeDhcpOptionsPagesCall.Returns.Pages = []*awsec2.DescribeDhcpOptionsOutput{{
+				DhcpOptions: []*awsec2.DhcpOptions{
+					{DhcpOptionsId: aws.String("dopt-banana")},
+					{DhcpOptionsId: aws.String("dopt-used-banana")},
+				},
+			}}
+			filter = "banana"
+		})
+
+		It("returns a list of dhcp options sets that no vpc is associated with", func() {
+			items, err := sets.List(filter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DescribeVpcsPagesCall.CallCount).To(Equal(1))
+			Expect(client.DescribeDhcpOptionsPagesCall.CallCount).To(Equal(1))
+
+			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("EC2 DHCP Options Set"))
+			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("dopt-banana"))
+
+			Expect(items).To(HaveLen(1))
+		})
+
+		Context("when the set name does not contain the filter", func() {
+			It("does not return it in the list", func() {
+				items, err := sets.List("kiwi")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+
+		Context("when the client fails to describe vpcs", func() {
+			BeforeEach(func() {
+				client.DescribeVpcsPagesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := sets.List(filter)
+				Expect(err).To(MatchError("Describe EC2 VPCs: some error"))
+			})
+		})
+
+		Context("when the client fails to describe dhcp options sets", func() {
+			BeforeEach(func() {
+				client.DescribeDhcpOptionsPagesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := sets.List(filter)
+				Expect(err).To(MatchError("Describe EC2 DHCP Options Sets: some error"))
+			})
+		})
+
+		Context("when the user responds no to the prompt", func() {
+			BeforeEach(func() {
+				logger.PromptWithDetailsCall.Returns.Proceed = false
+			})
+
+			It("does not return it in the list", func() {
+				items, err := sets.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+	})
+
+	Describe("Delete", func() {
+		var (
+			vpc    *awsec2.DescribeVpcsOutput
+			others *awsec2.DescribeVpcsOutput
+		)
+
+		BeforeEach(func() {
+			vpc = &awsec2.DescribeVpcsOutput{
+				Vpcs: []*awsec2.Vpc{{VpcId: aws.String("the-vpc-id"), DhcpOptionsId: aws.String("dopt-banana")}},
+			}
+			others = &awsec2.DescribeVpcsOutput{}
+			client.DescribeVpcsPagesCall.Returns.Pages = []*awsec2.DescribeVpcsOutput{vpc, others}
+		})
+
+		It("associates the vpc with the default dhcp options and deletes its set", func() {
+			err := sets.Delete(context.Background(), "the-vpc-id")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.AssociateDhcpOptionsCall.CallCount).To(Equal(1))
+			Expect(client.AssociateDhcpOptionsCall.Receives.Input.DhcpOptionsId).To(Equal(aws.String("default")))
+			Expect(client.AssociateDhcpOptionsCall.Receives.Input.VpcId).To(Equal(aws.String("the-vpc-id")))
+
+			Expect(client.DescribeVpcsPagesCall.CallCount).To(Equal(2))
+			Expect(client.DescribeVpcsPagesCall.Receives.Input.Filters[0].Name).To(Equal(aws.String("dhcp-options-id")))
+			Expect(client.DescribeVpcsPagesCall.Receives.Input.Filters[0].Values).To(Equal([]*string{aws.String("dopt-banana")}))
+
+			Expect(client.DeleteDhcpOptionsCall.CallCount).To(Equal(1))
+			Expect(client.DeleteDhcpOptionsCall.Receives.Input.DhcpOptionsId).To(Equal(aws.String("dopt-banana")))
+
+			Expect(logger.PrintfCall.Messages).To(ContainElement("[EC2 VPC: the-vpc-id] Deleted dhcp options set dopt-banana \n"))
+		})
+
+		Context("when other vpcs are associated with the set", func() {
+			BeforeEach(func() {
+				others.Vpcs = []*awsec2.Vpc{{VpcId: aws.String("the-other-vpc-id"), DhcpOptionsId: aws.String("default")}}
+			})
+
+			It("keeps the set", func() {
+				err := sets.Delete(context.Background(), "the-vpc-id")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(client.AssociateDhcpOptionsCall.CallCount).To(Equal(1))
+				Expect(client.DeleteDhcpOptionsCall.CallCount).To(Equal(0))
+
+				Expect(logger.PrintfCall.Messages).To(ContainElement("[EC2 VPC: the-vpc-id] Kept dhcp options set dopt-banana, which is used by other vpcs \n"))
+			})
+		})
+
+		Context("when the vpc uses the default dhcp options", func() {
+			BeforeEach(func() {
+				vpc.Vpcs[0].DhcpOptionsId = aws.String("default")
+			})
+
+			It("does nothing", func() {
+				err := sets.Delete(context.Background(), "the-vpc-id")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(client.AssociateDhcpOptionsCall.CallCount).To(Equal(0))
+				Expect(client.DeleteDhcpOptionsCall.CallCount).To(Equal(0))
+			})
+		})
+
+		Context("when the client fails to describe the vpc", func() {
+			BeforeEach(func() {
+				client.DescribeVpcsPagesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				err := sets.Delete(context.Background(), "the-vpc-id")
+				Expect(err).To(MatchError("Describe EC2 VPCs: some error"))
+			})
+		})
+
+		Context("when the client fails to associate the default dhcp options", func() {
+			BeforeEach(func() {
+				client.AssociateDhcpOptionsCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				err := sets.Delete(context.Background(), "the-vpc-id")
+				Expect(err).To(MatchError("Associate default dhcp options: some error"))
+			})
+		})
+
+		Context("when the client fails to delete the set", func() {
+			BeforeEach(func() {
+				client.DeleteDhcpOptionsCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				err := sets.Delete(context.Background(), "the-vpc-id")
+				Expect(err).To(MatchError("Delete dopt-banana: Delete: some error"))
+			})
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(sets.Type()).To(Equal("ec2-dhcp-options-set"))
+		})
+	})
+})
@@ -0,0 +1,52 @@
+package ec2
+
+import (
+	"fmt"
+	"strings"
+
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+)
+
+type EgressOnlyInternetGateway struct {
+	client     egressOnlyInternetGatewaysClient
+	id         *string
+	identifier string
+	rtype      string
+}
+
+func NewEgressOnlyInternetGateway(client egressOnlyInternetGatewaysClient, id *string, tags []*awsec2.Tag) EgressOnlyInternetGateway {
+	identifier := *id
+
+	var extra []string
+	for _, t := range tags {
+		extra = append(extra, fmt.Sprintf("%s:%s", *t.Key, *t.Value))
+	}
+
+	if len(extra) > 0 {
+		identifier = fmt.Sprintf("%s (%s)", *id, strings.Join(extra, ", "))
+	}
+
+	return EgressOnlyInternetGateway{
+		client:     client,
+		id:         id,
+		identifier: identifier,
+		rtype:      "EC2 Egress Only Internet Gateway",
+	}
+}
+
+func (e EgressOnlyInternetGateway) Delete() error {
+	_, err := e.client.DeleteEgressOnlyInternetGateway(&awsec2.DeleteEgressOnlyInternetGatewayInput{EgressOnlyInternetGatewayId: e.id})
+	if err != nil {
+		return fmt.Errorf("Delete: %s", err)
+	}
+
+	return nil
+}
+
+func (e EgressOnlyInternetGateway) Name() string {
+	return e.identifier
+}
+
+func (e EgressOnlyInternetGateway) Type() string {
+	return e.rtype
+}
@@ -0,0 +1,61 @@
+package ec2_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/genevieve/leftovers/aws/ec2"
+	"github.com/genevieve/leftovers/aws/ec2/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EgressOnlyInternetGateway", func() {
+	var (
+		gateway ec2.EgressOnlyInternetGateway
+		client  *fakes.EgressOnlyInternetGatewaysClient
+		id      *string
+	)
+
+	BeforeEach(func() {
+		client = &fakes.EgressOnlyInternetGatewaysClient{}
+		id = aws.String("the-id")
+		tags := []*awsec2.Tag{{Key: aws.String("the-key"), Value: aws.String("the-value")}}
+
+		gateway = ec2.NewEgressOnlyInternetGateway(client, id, tags)
+	})
+
+	Describe("Delete", func() {
+		It("deletes the gateway", func() {
+			err := gateway.Delete()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DeleteEgressOnlyInternetGatewayCall.CallCount).To(Equal(1))
+			Expect(client.DeleteEgressOnlyInternetGatewayCall.Receives.Input.EgressOnlyInternetGatewayId).To(Equal(id))
+		})
+
+		Context("when the client fails", func() {
+			BeforeEach(func() {
+				client.DeleteEgressOnlyInternetGatewayCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := gateway.Delete()
+				Expect(err).To(MatchError("Delete: banana"))
+			})
+		})
+	})
+
+	Describe("Name", func() {
+		It("returns the identifier", func() {
+			Expect(gateway.Name()).To(Equal("the-id (the-key:the-value)"))
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(gateway.Type()).To(Equal("EC2 Egress Only Internet Gateway"))
+		})
+	})
+})
@@ -0,0 +1,100 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/genevieve/leftovers/common"
+)
+
+type egressOnlyInternetGatewaysClient interface {
+	DescribeEgressOnlyInternetGatewaysPages(*awsec2.DescribeEgressOnlyInternetGatewaysInput, func(*awsec2.DescribeEgressOnlyInternetGatewaysOutput, bool) bool) error
+	DeleteEgressOnlyInternetGateway(*awsec2.DeleteEgressOnlyInternetGatewayInput) (*awsec2.DeleteEgressOnlyInternetGatewayOutput, error)
+}
+
+type EgressOnlyInternetGateways struct {
+	client egressOnlyInternetGatewaysClient
+	logger logger
+}
+
+func NewEgressOnlyInternetGateways(client egressOnlyInternetGatewaysClient, logger logger) EgressOnlyInternetGateways {
+	return EgressOnlyInternetGateways{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (e EgressOnlyInternetGateways) List(filter string) ([]common.Deletable, error) {
+	gateways, err := e.describe()
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []common.Deletable
+	for _, g := range gateways {
+		r := NewEgressOnlyInternetGateway(e.client, g.EgressOnlyInternetGatewayId, g.Tags)
+
+		if !strings.Contains(r.Name(), filter) {
+			continue
+		}
+
+		proceed := e.logger.PromptWithDetails(r.Type(), r.Name())
+		if !proceed {
+			continue
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func (e EgressOnlyInternetGateways) Type() string {
+	return "ec2-egress-only-internet-gateway"
+}
+
+// Delete deletes the egress-only internet gateways
+// that are attached to the vpc.
+func (e EgressOnlyInternetGateways) Delete(ctx context.Context, vpcId string) error {
+	gateways, err := e.describe()
+	if err != nil {
+		return err
+	}
+
+	for _, g := range gateways {
+		attached := false
+		for _, a := range g.Attachments {
+			if *a.VpcId == vpcId {
+				attached = true
+			}
+		}
+
+		if !attached {
+			continue
+		}
+
+		err = NewEgressOnlyInternetGateway(e.client, g.EgressOnlyInternetGatewayId, g.Tags).Delete()
+		if err != nil {
+			return fmt.Errorf("Delete %s: %s", *g.EgressOnlyInternetGatewayId, err)
+		}
+
+		e.logger.Printf("[EC2 VPC: %s] Deleted egress-only internet gateway %s \n", vpcId, *g.EgressOnlyInternetGatewayId)
+	}
+
+	return nil
+}
+
+func (e EgressOnlyInternetGateways) describe() ([]*awsec2.EgressOnlyInternetGateway, error) {
+	var gateways []*awsec2.EgressOnlyInternetGateway
+	err := e.client.DescribeEgressOnlyInternetGatewaysPages(&awsec2.DescribeEgressOnlyInternetGatewaysInput{}, func(page *awsec2.DescribeEgressOnlyInternetGatewaysOutput, lastPage bool) bool {
+		gateways = append(gateways, page.EgressOnlyInternetGateways...)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Describe EC2 Egress Only Internet Gateways: %s", err)
+	}
+
+	return gateways, nil
+}
@@ -0,0 +1,137 @@
+package ec2_test
+
+import (
+	"context"
+
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/genevieve/leftovers/aws/ec2"
+	"github.com/genevieve/leftovers/aws/ec2/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EgressOnlyInternetGateways", func() {
+	var (
+		client *fakes.EgressOnlyInternetGatewaysClient
+		logger *fakes.Logger
+
+		gateways ec2.EgressOnlyInternetGateways
+	)
+
+	BeforeEach(func() {
+		client = &fakes.EgressOnlyInternetGatewaysClient{}
+		logger = &fakes.Logger{}
+		logger.PromptWithDetailsCall.Returns.Proceed = true
+
+		client.DescribeEgressOnlyInternetGatewaysPagesCall.Returns.Pages = []*awsec2.DescribeEgressOnlyInternetGatewaysOutput{{
+			EgressOnlyInternetGateways: []*awsec2.EgressOnlyInternetGateway{{
+				EgressOnlyInternetGatewayId: aws.String("eigw-banana"),
+				Attachments:                 []*awsec2.InternetGatewayAttachment{{VpcId: aws.String("the-vpc-id")}},
+			}, {
+				EgressOnlyInternetGatewayId: aws.String("eigw-other-banana"),
+				Attachments:                 []*awsec2.InternetGatewayAttachment{{VpcId: aws.String("the-other-vpc-id")}},
+			}},
+		}}
+
+		gateways = ec2.NewEgressOnlyInternetGateways(client, logger)
+	})
+
+	Describe("List", func() {
+		var filter string
+
+		BeforeEach(func() {
+			filter = "banana"
+		})
+
+		It("returns a list of egress-only internet gateways", func() {
+			items, err := gateways.List(filter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DescribeEgressOnlyInternetGatewaysPagesCall.CallCount).To(Equal(1))
+
+			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(2))
+			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("EC2 Egress Only Internet Gateway"))
+			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("eigw-other-banana"))
+
+			Expect(items).To(HaveLen(2))
+		})
+
+		Context("when the gateway name does not contain the filter", func() {
+			It("does not return it in the list", func() {
+				items, err := gateways.List("kiwi")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+
+		Context("when the client fails to describe gateways", func() {
+			BeforeEach(func() {
+				client.DescribeEgressOnlyInternetGatewaysPagesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := gateways.List(filter)
+				Expect(err).To(MatchError("Describe EC2 Egress Only Internet Gateways: some error"))
+			})
+		})
+
+		Context("when the user responds no to the prompt", func() {
+			BeforeEach(func() {
+				logger.PromptWithDetailsCall.Returns.Proceed = false
+			})
+
+			It("does not return it in the list", func() {
+				items, err := gateways.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(2))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+	})
+
+	Describe("Delete", func() {
+		It("deletes the gateways attached to the vpc", func() {
+			err := gateways.Delete(context.Background(), "the-vpc-id")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DeleteEgressOnlyInternetGatewayCall.CallCount).To(Equal(1))
+			Expect(client.DeleteEgressOnlyInternetGatewayCall.Receives.Input.EgressOnlyInternetGatewayId).To(Equal(aws.String("eigw-banana")))
+
+			Expect(logger.PrintfCall.Messages).To(ContainElement("[EC2 VPC: the-vpc-id] Deleted egress-only internet gateway eigw-banana \n"))
+		})
+
+		Context("when the client fails to describe gateways", func() {
+			BeforeEach(func() {
+				client.DescribeEgressOnlyInternetGatewaysPagesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				err := gateways.Delete(context.Background(), "the-vpc-id")
+				Expect(err).To(MatchError("Describe EC2 Egress Only Internet Gateways: some error"))
+			})
+		})
+
+		Context("when the client fails to delete a gateway", func() {
+			BeforeEach(func() {
+				client.DeleteEgressOnlyInternetGatewayCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				err := gateways.Delete(context.Background(), "the-vpc-id")
+				Expect(err).To(MatchError("Delete eigw-banana: Delete: some error"))
+			})
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(gateways.Type()).To(Equal("ec2-egress-only-internet-gateway"))
+		})
+	})
+})
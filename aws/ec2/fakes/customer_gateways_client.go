@@ -0,0 +1,85 @@
+package fakes
+
+import "github.com/aws/aws-sdk-go/service/ec2"
+
+type CustomerGatewaysClient struct {
+	DescribeVpnConnectionsCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.DescribeVpnConnectionsInput
+		}
+		Returns struct {
+			Output *ec2.DescribeVpnConnectionsOutput
+			Error  error
+		}
+		ReturnsOnCall map[int]struct {
+			Output *ec2.DescribeVpnConnectionsOutput
+			Error  error
+		}
+	}
+
+	DeleteVpnConnectionCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.DeleteVpnConnectionInput
+		}
+		Returns struct {
+			Output *ec2.DeleteVpnConnectionOutput
+			Error  error
+		}
+	}
+
+	DescribeCustomerGatewaysCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.DescribeCustomerGatewaysInput
+		}
+		Returns struct {
+			Output *ec2.DescribeCustomerGatewaysOutput
+			Error  error
+		}
+	}
+
+	DeleteCustomerGatewayCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.DeleteCustomerGatewayInput
+		}
+		Returns struct {
+			Output *ec2.DeleteCustomerGatewayOutput
+			Error  error
+		}
+	}
+}
+
+func (c *CustomerGatewaysClient) DescribeVpnConnections(input *ec2.DescribeVpnConnectionsInput) (*ec2.DescribeVpnConnectionsOutput, error) {
+	c.DescribeVpnConnectionsCall.CallCount++
+	c.DescribeVpnConnectionsCall.Receives.Input = input
+
+	if ret, ok := c.DescribeVpnConnectionsCall.ReturnsOnCall[c.DescribeVpnConnectionsCall.CallCount-1]; ok {
+		return ret.Output, ret.Error
+	}
+
+	return c.DescribeVpnConnectionsCall.Returns.Output, c.DescribeVpnConnectionsCall.Returns.Error
+}
+
+func (c *CustomerGatewaysClient) DeleteVpnConnection(input *ec2.DeleteVpnConnectionInput) (*ec2.DeleteVpnConnectionOutput, error) {
+	c.DeleteVpnConnectionCall.CallCount++
+	c.DeleteVpnConnectionCall.Receives.Input = input
+
+	return c.DeleteVpnConnectionCall.Returns.Output, c.DeleteVpnConnectionCall.Returns.Error
+}
+
+func (c *CustomerGatewaysClient) DescribeCustomerGateways(input *ec2.DescribeCustomerGatewaysInput) (*ec2.DescribeCustomerGatewaysOutput, error) {
+	c.DescribeCustomerGatewaysCall.CallCount++
+	c.DescribeCustomerGatewaysCall.Receives.Input = input
+
+	return c.DescribeCustomerGatewaysCall.Returns.Output, c.DescribeCustomerGatewaysCall.Returns.Error
+}
+
+func (c *CustomerGatewaysClient) DeleteCustomerGateway(input *ec2.DeleteCustomerGatewayInput) (*ec2.DeleteCustomerGatewayOutput, error) {
+	c.DeleteCustomerGatewayCall.CallCount++
+	c.DeleteCustomerGatewayCall.Receives.Input = input
+
+	return c.DeleteCustomerGatewayCall.Returns.Output, c.DeleteCustomerGatewayCall.Returns.Error
+}
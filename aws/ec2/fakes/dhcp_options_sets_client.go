@@ -0,0 +1,91 @@
+package fakes
+
+import "github.com/aws/aws-sdk-go/service/ec2"
+
+type DhcpOptionsSetsClient struct {
+	DescribeDhcpOptionsPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.DescribeDhcpOptionsInput
+		}
+		Returns struct {
+			Pages []*ec2.DescribeDhcpOptionsOutput
+			Error error
+		}
+	}
+
+	DescribeVpcsPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.DescribeVpcsInput
+		}
+		Returns struct {
+			Pages []*ec2.DescribeVpcsOutput
+			Error error
+		}
+	}
+
+	AssociateDhcpOptionsCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.AssociateDhcpOptionsInput
+		}
+		Returns struct {
+			Output *ec2.AssociateDhcpOptionsOutput
+			Error  error
+		}
+	}
+
+	DeleteDhcpOptionsCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.DeleteDhcpOptionsInput
+		}
+		Returns struct {
+			Output *ec2.DeleteDhcpOptionsOutput
+			Error  error
+		}
+	}
+}
+
+func (d *DhcpOptionsSetsClient) DescribeDhcpOptionsPages(input *ec2.DescribeDhcpOptionsInput, fn func(*ec2.DescribeDhcpOptionsOutput, bool) bool) error {
+	d.DescribeDhcpOptionsPagesCall.CallCount++
+	d.DescribeDhcpOptionsPagesCall.Receives.Input = input
+
+	pages := d.DescribeDhcpOptionsPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return d.DescribeDhcpOptionsPagesCall.Returns.Error
+}
+
+func (d *DhcpOptionsSetsClient) DescribeVpcsPages(input *ec2.DescribeVpcsInput, fn func(*ec2.DescribeVpcsOutput, bool) bool) error {
+	d.DescribeVpcsPagesCall.CallCount++
+	d.DescribeVpcsPagesCall.Receives.Input = input
+
+	pages := d.DescribeVpcsPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return d.DescribeVpcsPagesCall.Returns.Error
+}
+
+func (d *DhcpOptionsSetsClient) AssociateDhcpOptions(input *ec2.AssociateDhcpOptionsInput) (*ec2.AssociateDhcpOptionsOutput, error) {
+	d.AssociateDhcpOptionsCall.CallCount++
+	d.AssociateDhcpOptionsCall.Receives.Input = input
+
+	return d.AssociateDhcpOptionsCall.Returns.Output, d.AssociateDhcpOptionsCall.Returns.Error
+}
+
+func (d *DhcpOptionsSetsClient) DeleteDhcpOptions(input *ec2.DeleteDhcpOptionsInput) (*ec2.DeleteDhcpOptionsOutput, error) {
+	d.DeleteDhcpOptionsCall.CallCount++
+	d.DeleteDhcpOptionsCall.Receives.Input = input
+
+	return d.DeleteDhcpOptionsCall.Returns.Output, d.DeleteDhcpOptionsCall.Returns.Error
+}
@@ -0,0 +1,48 @@
+package fakes
+
+import "github.com/aws/aws-sdk-go/service/ec2"
+
+type EgressOnlyInternetGatewaysClient struct {
+	DescribeEgressOnlyInternetGatewaysPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.DescribeEgressOnlyInternetGatewaysInput
+		}
+		Returns struct {
+			Pages []*ec2.DescribeEgressOnlyInternetGatewaysOutput
+			Error error
+		}
+	}
+
+	DeleteEgressOnlyInternetGatewayCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.DeleteEgressOnlyInternetGatewayInput
+		}
+		Returns struct {
+			Output *ec2.DeleteEgressOnlyInternetGatewayOutput
+			Error  error
+		}
+	}
+}
+
+func (e *EgressOnlyInternetGatewaysClient) DescribeEgressOnlyInternetGatewaysPages(input *ec2.DescribeEgressOnlyInternetGatewaysInput, fn func(*ec2.DescribeEgressOnlyInternetGatewaysOutput, bool) bool) error {
+	e.DescribeEgressOnlyInternetGatewaysPagesCall.CallCount++
+	e.DescribeEgressOnlyInternetGatewaysPagesCall.Receives.Input = input
+
+	pages := e.DescribeEgressOnlyInternetGatewaysPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return e.DescribeEgressOnlyInternetGatewaysPagesCall.Returns.Error
+}
+
+func (e *EgressOnlyInternetGatewaysClient) DeleteEgressOnlyInternetGateway(input *ec2.DeleteEgressOnlyInternetGatewayInput) (*ec2.DeleteEgressOnlyInternetGatewayOutput, error) {
+	e.DeleteEgressOnlyInternetGatewayCall.CallCount++
+	e.DeleteEgressOnlyInternetGatewayCall.Receives.Input = input
+
+	return e.DeleteEgressOnlyInternetGatewayCall.Returns.Output, e.DeleteEgressOnlyInternetGatewayCall.Returns.Error
+}
@@ -3,14 +3,14 @@ package fakes
 import "github.com/aws/aws-sdk-go/service/ec2"
 
 type ImagesClient struct {
-	DescribeImagesCall struct {
+	DescribeImagesPagesCall struct {
 		CallCount int
 		Receives  struct {
 			Input *ec2.DescribeImagesInput
 		}
 		Returns struct {
-			Output *ec2.DescribeImagesOutput
-			Error  error
+			Pages []*ec2.DescribeImagesOutput
+			Error error
 		}
 	}
 
@@ -26,11 +26,18 @@ type ImagesClient struct {
 	}
 }
 
-func (i *ImagesClient) DescribeImages(input *ec2.DescribeImagesInput) (*ec2.DescribeImagesOutput, error) {
-	i.DescribeImagesCall.CallCount++
-	i.DescribeImagesCall.Receives.Input = input
+func (i *ImagesClient) DescribeImagesPages(input *ec2.DescribeImagesInput, fn func(*ec2.DescribeImagesOutput, bool) bool) error {
+	i.DescribeImagesPagesCall.CallCount++
+	i.DescribeImagesPagesCall.Receives.Input = input
+
+	pages := i.DescribeImagesPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
 
-	return i.DescribeImagesCall.Returns.Output, i.DescribeImagesCall.Returns.Error
+	return i.DescribeImagesPagesCall.Returns.Error
 }
 
 func (i *ImagesClient) DeregisterImage(input *ec2.DeregisterImageInput) (*ec2.DeregisterImageOutput, error) {
@@ -14,6 +14,17 @@ type InstancesClient struct {
 		}
 	}
 
+	DescribeInstancesPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.DescribeInstancesInput
+		}
+		Returns struct {
+			Pages []*ec2.DescribeInstancesOutput
+			Error error
+		}
+	}
+
 	TerminateInstancesCall struct {
 		CallCount int
 		Receives  struct {
@@ -55,6 +66,20 @@ func (i *InstancesClient) DescribeInstances(input *ec2.DescribeInstancesInput) (
 	return i.DescribeInstancesCall.Returns.Output, i.DescribeInstancesCall.Returns.Error
 }
 
+func (i *InstancesClient) DescribeInstancesPages(input *ec2.DescribeInstancesInput, fn func(*ec2.DescribeInstancesOutput, bool) bool) error {
+	i.DescribeInstancesPagesCall.CallCount++
+	i.DescribeInstancesPagesCall.Receives.Input = input
+
+	pages := i.DescribeInstancesPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return i.DescribeInstancesPagesCall.Returns.Error
+}
+
 func (i *InstancesClient) TerminateInstances(input *ec2.TerminateInstancesInput) (*ec2.TerminateInstancesOutput, error) {
 	i.TerminateInstancesCall.CallCount++
 	i.TerminateInstancesCall.Receives.Input = input
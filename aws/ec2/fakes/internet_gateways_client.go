@@ -3,14 +3,14 @@ package fakes
 import "github.com/aws/aws-sdk-go/service/ec2"
 
 type InternetGatewaysClient struct {
-	DescribeInternetGatewaysCall struct {
+	DescribeInternetGatewaysPagesCall struct {
 		CallCount int
 		Receives  struct {
 			Input *ec2.DescribeInternetGatewaysInput
 		}
 		Returns struct {
-			Output *ec2.DescribeInternetGatewaysOutput
-			Error  error
+			Pages []*ec2.DescribeInternetGatewaysOutput
+			Error error
 		}
 	}
 
@@ -37,11 +37,18 @@ type InternetGatewaysClient struct {
 	}
 }
 
-func (i *InternetGatewaysClient) DescribeInternetGateways(input *ec2.DescribeInternetGatewaysInput) (*ec2.DescribeInternetGatewaysOutput, error) {
-	i.DescribeInternetGatewaysCall.CallCount++
-	i.DescribeInternetGatewaysCall.Receives.Input = input
+func (i *InternetGatewaysClient) DescribeInternetGatewaysPages(input *ec2.DescribeInternetGatewaysInput, fn func(*ec2.DescribeInternetGatewaysOutput, bool) bool) error {
+	i.DescribeInternetGatewaysPagesCall.CallCount++
+	i.DescribeInternetGatewaysPagesCall.Receives.Input = input
+
+	pages := i.DescribeInternetGatewaysPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
 
-	return i.DescribeInternetGatewaysCall.Returns.Output, i.DescribeInternetGatewaysCall.Returns.Error
+	return i.DescribeInternetGatewaysPagesCall.Returns.Error
 }
 
 func (i *InternetGatewaysClient) DetachInternetGateway(input *ec2.DetachInternetGatewayInput) (*ec2.DetachInternetGatewayOutput, error) {
@@ -0,0 +1,48 @@
+package fakes
+
+import "github.com/aws/aws-sdk-go/service/ec2"
+
+type LaunchTemplatesClient struct {
+	DescribeLaunchTemplatesPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.DescribeLaunchTemplatesInput
+		}
+		Returns struct {
+			Pages []*ec2.DescribeLaunchTemplatesOutput
+			Error error
+		}
+	}
+
+	DeleteLaunchTemplateCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.DeleteLaunchTemplateInput
+		}
+		Returns struct {
+			Output *ec2.DeleteLaunchTemplateOutput
+			Error  error
+		}
+	}
+}
+
+func (l *LaunchTemplatesClient) DescribeLaunchTemplatesPages(input *ec2.DescribeLaunchTemplatesInput, fn func(*ec2.DescribeLaunchTemplatesOutput, bool) bool) error {
+	l.DescribeLaunchTemplatesPagesCall.CallCount++
+	l.DescribeLaunchTemplatesPagesCall.Receives.Input = input
+
+	pages := l.DescribeLaunchTemplatesPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return l.DescribeLaunchTemplatesPagesCall.Returns.Error
+}
+
+func (l *LaunchTemplatesClient) DeleteLaunchTemplate(input *ec2.DeleteLaunchTemplateInput) (*ec2.DeleteLaunchTemplateOutput, error) {
+	l.DeleteLaunchTemplateCall.CallCount++
+	l.DeleteLaunchTemplateCall.Receives.Input = input
+
+	return l.DeleteLaunchTemplateCall.Returns.Output, l.DeleteLaunchTemplateCall.Returns.Error
+}
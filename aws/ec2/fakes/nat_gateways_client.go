@@ -14,6 +14,17 @@ type NatGatewaysClient struct {
 		}
 	}
 
+	DescribeNatGatewaysPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.DescribeNatGatewaysInput
+		}
+		Returns struct {
+			Pages []*ec2.DescribeNatGatewaysOutput
+			Error error
+		}
+	}
+
 	DeleteNatGatewayCall struct {
 		CallCount int
 		Receives  struct {
@@ -33,6 +44,20 @@ func (e *NatGatewaysClient) DescribeNatGateways(input *ec2.DescribeNatGatewaysIn
 	return e.DescribeNatGatewaysCall.Returns.Output, e.DescribeNatGatewaysCall.Returns.Error
 }
 
+func (e *NatGatewaysClient) DescribeNatGatewaysPages(input *ec2.DescribeNatGatewaysInput, fn func(*ec2.DescribeNatGatewaysOutput, bool) bool) error {
+	e.DescribeNatGatewaysPagesCall.CallCount++
+	e.DescribeNatGatewaysPagesCall.Receives.Input = input
+
+	pages := e.DescribeNatGatewaysPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return e.DescribeNatGatewaysPagesCall.Returns.Error
+}
+
 func (e *NatGatewaysClient) DeleteNatGateway(input *ec2.DeleteNatGatewayInput) (*ec2.DeleteNatGatewayOutput, error) {
 	e.DeleteNatGatewayCall.CallCount++
 	e.DeleteNatGatewayCall.Receives.Input = input
@@ -0,0 +1,66 @@
+package fakes
+
+import "github.com/aws/aws-sdk-go/service/ec2"
+
+type NetworkAclsClient struct {
+	DescribeNetworkAclsPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.DescribeNetworkAclsInput
+		}
+		Returns struct {
+			Pages []*ec2.DescribeNetworkAclsOutput
+			Error error
+		}
+	}
+
+	ReplaceNetworkAclAssociationCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.ReplaceNetworkAclAssociationInput
+		}
+		Returns struct {
+			Output *ec2.ReplaceNetworkAclAssociationOutput
+			Error  error
+		}
+	}
+
+	DeleteNetworkAclCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.DeleteNetworkAclInput
+		}
+		Returns struct {
+			Output *ec2.DeleteNetworkAclOutput
+			Error  error
+		}
+	}
+}
+
+func (n *NetworkAclsClient) DescribeNetworkAclsPages(input *ec2.DescribeNetworkAclsInput, fn func(*ec2.DescribeNetworkAclsOutput, bool) bool) error {
+	n.DescribeNetworkAclsPagesCall.CallCount++
+	n.DescribeNetworkAclsPagesCall.Receives.Input = input
+
+	pages := n.DescribeNetworkAclsPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return n.DescribeNetworkAclsPagesCall.Returns.Error
+}
+
+func (n *NetworkAclsClient) ReplaceNetworkAclAssociation(input *ec2.ReplaceNetworkAclAssociationInput) (*ec2.ReplaceNetworkAclAssociationOutput, error) {
+	n.ReplaceNetworkAclAssociationCall.CallCount++
+	n.ReplaceNetworkAclAssociationCall.Receives.Input = input
+
+	return n.ReplaceNetworkAclAssociationCall.Returns.Output, n.ReplaceNetworkAclAssociationCall.Returns.Error
+}
+
+func (n *NetworkAclsClient) DeleteNetworkAcl(input *ec2.DeleteNetworkAclInput) (*ec2.DeleteNetworkAclOutput, error) {
+	n.DeleteNetworkAclCall.CallCount++
+	n.DeleteNetworkAclCall.Receives.Input = input
+
+	return n.DeleteNetworkAclCall.Returns.Output, n.DeleteNetworkAclCall.Returns.Error
+}
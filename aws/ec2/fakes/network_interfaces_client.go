@@ -3,14 +3,14 @@ package fakes
 import "github.com/aws/aws-sdk-go/service/ec2"
 
 type NetworkInterfaceClient struct {
-	DescribeNetworkInterfacesCall struct {
+	DescribeNetworkInterfacesPagesCall struct {
 		CallCount int
 		Receives  struct {
 			Input *ec2.DescribeNetworkInterfacesInput
 		}
 		Returns struct {
-			Output *ec2.DescribeNetworkInterfacesOutput
-			Error  error
+			Pages []*ec2.DescribeNetworkInterfacesOutput
+			Error error
 		}
 	}
 
@@ -26,11 +26,18 @@ type NetworkInterfaceClient struct {
 	}
 }
 
-func (e *NetworkInterfaceClient) DescribeNetworkInterfaces(input *ec2.DescribeNetworkInterfacesInput) (*ec2.DescribeNetworkInterfacesOutput, error) {
-	e.DescribeNetworkInterfacesCall.CallCount++
-	e.DescribeNetworkInterfacesCall.Receives.Input = input
+func (e *NetworkInterfaceClient) DescribeNetworkInterfacesPages(input *ec2.DescribeNetworkInterfacesInput, fn func(*ec2.DescribeNetworkInterfacesOutput, bool) bool) error {
+	e.DescribeNetworkInterfacesPagesCall.CallCount++
+	e.DescribeNetworkInterfacesPagesCall.Receives.Input = input
+
+	pages := e.DescribeNetworkInterfacesPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
 
-	return e.DescribeNetworkInterfacesCall.Returns.Output, e.DescribeNetworkInterfacesCall.Returns.Error
+	return e.DescribeNetworkInterfacesPagesCall.Returns.Error
 }
 
 func (e *NetworkInterfaceClient) DeleteNetworkInterface(input *ec2.DeleteNetworkInterfaceInput) (*ec2.DeleteNetworkInterfaceOutput, error) {
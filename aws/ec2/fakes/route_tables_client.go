@@ -3,14 +3,14 @@ package fakes
 import "github.com/aws/aws-sdk-go/service/ec2"
 
 type RouteTablesClient struct {
-	DescribeRouteTablesCall struct {
+	DescribeRouteTablesPagesCall struct {
 		CallCount int
 		Receives  struct {
 			Input *ec2.DescribeRouteTablesInput
 		}
 		Returns struct {
-			Output *ec2.DescribeRouteTablesOutput
-			Error  error
+			Pages []*ec2.DescribeRouteTablesOutput
+			Error error
 		}
 	}
 
@@ -37,11 +37,18 @@ type RouteTablesClient struct {
 	}
 }
 
-func (i *RouteTablesClient) DescribeRouteTables(input *ec2.DescribeRouteTablesInput) (*ec2.DescribeRouteTablesOutput, error) {
-	i.DescribeRouteTablesCall.CallCount++
-	i.DescribeRouteTablesCall.Receives.Input = input
+func (i *RouteTablesClient) DescribeRouteTablesPages(input *ec2.DescribeRouteTablesInput, fn func(*ec2.DescribeRouteTablesOutput, bool) bool) error {
+	i.DescribeRouteTablesPagesCall.CallCount++
+	i.DescribeRouteTablesPagesCall.Receives.Input = input
+
+	pages := i.DescribeRouteTablesPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
 
-	return i.DescribeRouteTablesCall.Returns.Output, i.DescribeRouteTablesCall.Returns.Error
+	return i.DescribeRouteTablesPagesCall.Returns.Error
 }
 
 func (i *RouteTablesClient) DisassociateRouteTable(input *ec2.DisassociateRouteTableInput) (*ec2.DisassociateRouteTableOutput, error) {
@@ -3,14 +3,14 @@ package fakes
 import "github.com/aws/aws-sdk-go/service/ec2"
 
 type SecurityGroupsClient struct {
-	DescribeSecurityGroupsCall struct {
+	DescribeSecurityGroupsPagesCall struct {
 		CallCount int
 		Receives  struct {
 			Input *ec2.DescribeSecurityGroupsInput
 		}
 		Returns struct {
-			Output *ec2.DescribeSecurityGroupsOutput
-			Error  error
+			Pages []*ec2.DescribeSecurityGroupsOutput
+			Error error
 		}
 	}
 
@@ -48,11 +48,18 @@ type SecurityGroupsClient struct {
 	}
 }
 
-func (e *SecurityGroupsClient) DescribeSecurityGroups(input *ec2.DescribeSecurityGroupsInput) (*ec2.DescribeSecurityGroupsOutput, error) {
-	e.DescribeSecurityGroupsCall.CallCount++
-	e.DescribeSecurityGroupsCall.Receives.Input = input
+func (e *SecurityGroupsClient) DescribeSecurityGroupsPages(input *ec2.DescribeSecurityGroupsInput, fn func(*ec2.DescribeSecurityGroupsOutput, bool) bool) error {
+	e.DescribeSecurityGroupsPagesCall.CallCount++
+	e.DescribeSecurityGroupsPagesCall.Receives.Input = input
+
+	pages := e.DescribeSecurityGroupsPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
 
-	return e.DescribeSecurityGroupsCall.Returns.Output, e.DescribeSecurityGroupsCall.Returns.Error
+	return e.DescribeSecurityGroupsPagesCall.Returns.Error
 }
 
 func (e *SecurityGroupsClient) RevokeSecurityGroupIngress(input *ec2.RevokeSecurityGroupIngressInput) (*ec2.RevokeSecurityGroupIngressOutput, error) {
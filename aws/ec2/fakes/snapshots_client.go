@@ -3,14 +3,14 @@ package fakes
 import "github.com/aws/aws-sdk-go/service/ec2"
 
 type SnapshotsClient struct {
-	DescribeSnapshotsCall struct {
+	DescribeSnapshotsPagesCall struct {
 		CallCount int
 		Receives  struct {
 			Input *ec2.DescribeSnapshotsInput
 		}
 		Returns struct {
-			Output *ec2.DescribeSnapshotsOutput
-			Error  error
+			Pages []*ec2.DescribeSnapshotsOutput
+			Error error
 		}
 	}
 
@@ -26,11 +26,18 @@ type SnapshotsClient struct {
 	}
 }
 
-func (e *SnapshotsClient) DescribeSnapshots(input *ec2.DescribeSnapshotsInput) (*ec2.DescribeSnapshotsOutput, error) {
-	e.DescribeSnapshotsCall.CallCount++
-	e.DescribeSnapshotsCall.Receives.Input = input
+func (e *SnapshotsClient) DescribeSnapshotsPages(input *ec2.DescribeSnapshotsInput, fn func(*ec2.DescribeSnapshotsOutput, bool) bool) error {
+	e.DescribeSnapshotsPagesCall.CallCount++
+	e.DescribeSnapshotsPagesCall.Receives.Input = input
+
+	pages := e.DescribeSnapshotsPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
 
-	return e.DescribeSnapshotsCall.Returns.Output, e.DescribeSnapshotsCall.Returns.Error
+	return e.DescribeSnapshotsPagesCall.Returns.Error
 }
 
 func (e *SnapshotsClient) DeleteSnapshot(input *ec2.DeleteSnapshotInput) (*ec2.DeleteSnapshotOutput, error) {
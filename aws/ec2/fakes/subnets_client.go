@@ -3,14 +3,14 @@ package fakes
 import "github.com/aws/aws-sdk-go/service/ec2"
 
 type SubnetsClient struct {
-	DescribeSubnetsCall struct {
+	DescribeSubnetsPagesCall struct {
 		CallCount int
 		Receives  struct {
 			Input *ec2.DescribeSubnetsInput
 		}
 		Returns struct {
-			Output *ec2.DescribeSubnetsOutput
-			Error  error
+			Pages []*ec2.DescribeSubnetsOutput
+			Error error
 		}
 	}
 
@@ -26,11 +26,18 @@ type SubnetsClient struct {
 	}
 }
 
-func (i *SubnetsClient) DescribeSubnets(input *ec2.DescribeSubnetsInput) (*ec2.DescribeSubnetsOutput, error) {
-	i.DescribeSubnetsCall.CallCount++
-	i.DescribeSubnetsCall.Receives.Input = input
+func (i *SubnetsClient) DescribeSubnetsPages(input *ec2.DescribeSubnetsInput, fn func(*ec2.DescribeSubnetsOutput, bool) bool) error {
+	i.DescribeSubnetsPagesCall.CallCount++
+	i.DescribeSubnetsPagesCall.Receives.Input = input
+
+	pages := i.DescribeSubnetsPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
 
-	return i.DescribeSubnetsCall.Returns.Output, i.DescribeSubnetsCall.Returns.Error
+	return i.DescribeSubnetsPagesCall.Returns.Error
 }
 
 func (i *SubnetsClient) DeleteSubnet(input *ec2.DeleteSubnetInput) (*ec2.DeleteSubnetOutput, error) {
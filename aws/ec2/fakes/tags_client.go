@@ -5,14 +5,14 @@ import (
 )
 
 type TagsClient struct {
-	DescribeTagsCall struct {
+	DescribeTagsPagesCall struct {
 		CallCount int
 		Receives  struct {
 			Input *ec2.DescribeTagsInput
 		}
 		Returns struct {
-			Output *ec2.DescribeTagsOutput
-			Error  error
+			Pages []*ec2.DescribeTagsOutput
+			Error error
 		}
 	}
 
@@ -28,11 +28,18 @@ type TagsClient struct {
 	}
 }
 
-func (e *TagsClient) DescribeTags(input *ec2.DescribeTagsInput) (*ec2.DescribeTagsOutput, error) {
-	e.DescribeTagsCall.CallCount++
-	e.DescribeTagsCall.Receives.Input = input
+func (e *TagsClient) DescribeTagsPages(input *ec2.DescribeTagsInput, fn func(*ec2.DescribeTagsOutput, bool) bool) error {
+	e.DescribeTagsPagesCall.CallCount++
+	e.DescribeTagsPagesCall.Receives.Input = input
+
+	pages := e.DescribeTagsPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
 
-	return e.DescribeTagsCall.Returns.Output, e.DescribeTagsCall.Returns.Error
+	return e.DescribeTagsPagesCall.Returns.Error
 }
 
 func (e *TagsClient) DeleteTags(input *ec2.DeleteTagsInput) (*ec2.DeleteTagsOutput, error) {
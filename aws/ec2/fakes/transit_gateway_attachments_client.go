@@ -0,0 +1,120 @@
+package fakes
+
+import "github.com/aws/aws-sdk-go/service/ec2"
+
+type TransitGatewayAttachmentsClient struct {
+	DescribeTransitGatewayAttachmentsCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.DescribeTransitGatewayAttachmentsInput
+		}
+		Returns struct {
+			Output *ec2.DescribeTransitGatewayAttachmentsOutput
+			Error  error
+		}
+	}
+
+	DescribeTransitGatewayAttachmentsPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.DescribeTransitGatewayAttachmentsInput
+		}
+		Returns struct {
+			Pages []*ec2.DescribeTransitGatewayAttachmentsOutput
+			Error error
+		}
+	}
+
+	DeleteTransitGatewayVpcAttachmentCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.DeleteTransitGatewayVpcAttachmentInput
+		}
+		Returns struct {
+			Output *ec2.DeleteTransitGatewayVpcAttachmentOutput
+			Error  error
+		}
+	}
+
+	DeleteTransitGatewayPeeringAttachmentCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.DeleteTransitGatewayPeeringAttachmentInput
+		}
+		Returns struct {
+			Output *ec2.DeleteTransitGatewayPeeringAttachmentOutput
+			Error  error
+		}
+	}
+
+	DeleteTransitGatewayConnectCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.DeleteTransitGatewayConnectInput
+		}
+		Returns struct {
+			Output *ec2.DeleteTransitGatewayConnectOutput
+			Error  error
+		}
+	}
+
+	DeleteVpnConnectionCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.DeleteVpnConnectionInput
+		}
+		Returns struct {
+			Output *ec2.DeleteVpnConnectionOutput
+			Error  error
+		}
+	}
+}
+
+func (t *TransitGatewayAttachmentsClient) DescribeTransitGatewayAttachments(input *ec2.DescribeTransitGatewayAttachmentsInput) (*ec2.DescribeTransitGatewayAttachmentsOutput, error) {
+	t.DescribeTransitGatewayAttachmentsCall.CallCount++
+	t.DescribeTransitGatewayAttachmentsCall.Receives.Input = input
+
+	return t.DescribeTransitGatewayAttachmentsCall.Returns.Output, t.DescribeTransitGatewayAttachmentsCall.Returns.Error
+}
+
+func (t *TransitGatewayAttachmentsClient) DescribeTransitGatewayAttachmentsPages(input *ec2.DescribeTransitGatewayAttachmentsInput, fn func(*ec2.DescribeTransitGatewayAttachmentsOutput, bool) bool) error {
+	t.DescribeTransitGatewayAttachmentsPagesCall.CallCount++
+	t.DescribeTransitGatewayAttachmentsPagesCall.Receives.Input = input
+
+	pages := t.DescribeTransitGatewayAttachmentsPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return t.DescribeTransitGatewayAttachmentsPagesCall.Returns.Error
+}
+
+func (t *TransitGatewayAttachmentsClient) DeleteTransitGatewayVpcAttachment(input *ec2.DeleteTransitGatewayVpcAttachmentInput) (*ec2.DeleteTransitGatewayVpcAttachmentOutput, error) {
+	t.DeleteTransitGatewayVpcAttachmentCall.CallCount++
+	t.DeleteTransitGatewayVpcAttachmentCall.Receives.Input = input
+
+	return t.DeleteTransitGatewayVpcAttachmentCall.Returns.Output, t.DeleteTransitGatewayVpcAttachmentCall.Returns.Error
+}
+
+func (t *TransitGatewayAttachmentsClient) DeleteTransitGatewayPeeringAttachment(input *ec2.DeleteTransitGatewayPeeringAttachmentInput) (*ec2.DeleteTransitGatewayPeeringAttachmentOutput, error) {
+	t.DeleteTransitGatewayPeeringAttachmentCall.CallCount++
+	t.DeleteTransitGatewayPeeringAttachmentCall.Receives.Input = input
+
+	return t.DeleteTransitGatewayPeeringAttachmentCall.Returns.Output, t.DeleteTransitGatewayPeeringAttachmentCall.Returns.Error
+}
+
+func (t *TransitGatewayAttachmentsClient) DeleteTransitGatewayConnect(input *ec2.DeleteTransitGatewayConnectInput) (*ec2.DeleteTransitGatewayConnectOutput, error) {
+	t.DeleteTransitGatewayConnectCall.CallCount++
+	t.DeleteTransitGatewayConnectCall.Receives.Input = input
+
+	return t.DeleteTransitGatewayConnectCall.Returns.Output, t.DeleteTransitGatewayConnectCall.Returns.Error
+}
+
+func (t *TransitGatewayAttachmentsClient) DeleteVpnConnection(input *ec2.DeleteVpnConnectionInput) (*ec2.DeleteVpnConnectionOutput, error) {
+	t.DeleteVpnConnectionCall.CallCount++
+	t.DeleteVpnConnectionCall.Receives.Input = input
+
+	return t.DeleteVpnConnectionCall.Returns.Output, t.DeleteVpnConnectionCall.Returns.Error
+}
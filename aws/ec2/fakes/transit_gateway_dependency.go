@@ -0,0 +1,22 @@
+package fakes
+
+import "context"
+
+type TransitGatewayDependency struct {
+	DeleteCall struct {
+		CallCount int
+		Receives  struct {
+			TransitGatewayId string
+		}
+		Returns struct {
+			Error error
+		}
+	}
+}
+
+func (t *TransitGatewayDependency) Delete(ctx context.Context, transitGatewayId string) error {
+	t.DeleteCall.CallCount++
+	t.DeleteCall.Receives.TransitGatewayId = transitGatewayId
+
+	return t.DeleteCall.Returns.Error
+}
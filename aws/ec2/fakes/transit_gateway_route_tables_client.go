@@ -0,0 +1,152 @@
+package fakes
+
+import "github.com/aws/aws-sdk-go/service/ec2"
+
+type TransitGatewayRouteTablesClient struct {
+	DescribeTransitGatewayRouteTablesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.DescribeTransitGatewayRouteTablesInput
+		}
+		Returns struct {
+			Output *ec2.DescribeTransitGatewayRouteTablesOutput
+			Error  error
+		}
+	}
+
+	DescribeTransitGatewayRouteTablesPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.DescribeTransitGatewayRouteTablesInput
+		}
+		Returns struct {
+			Pages []*ec2.DescribeTransitGatewayRouteTablesOutput
+			Error error
+		}
+	}
+
+	GetTransitGatewayRouteTableAssociationsPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.GetTransitGatewayRouteTableAssociationsInput
+		}
+		Returns struct {
+			Pages []*ec2.GetTransitGatewayRouteTableAssociationsOutput
+			Error error
+		}
+	}
+
+	GetTransitGatewayRouteTablePropagationsPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.GetTransitGatewayRouteTablePropagationsInput
+		}
+		Returns struct {
+			Pages []*ec2.GetTransitGatewayRouteTablePropagationsOutput
+			Error error
+		}
+	}
+
+	DisassociateTransitGatewayRouteTableCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.DisassociateTransitGatewayRouteTableInput
+		}
+		Returns struct {
+			Output *ec2.DisassociateTransitGatewayRouteTableOutput
+			Error  error
+		}
+	}
+
+	DisableTransitGatewayRouteTablePropagationCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.DisableTransitGatewayRouteTablePropagationInput
+		}
+		Returns struct {
+			Output *ec2.DisableTransitGatewayRouteTablePropagationOutput
+			Error  error
+		}
+	}
+
+	DeleteTransitGatewayRouteTableCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.DeleteTransitGatewayRouteTableInput
+		}
+		Returns struct {
+			Output *ec2.DeleteTransitGatewayRouteTableOutput
+			Error  error
+		}
+	}
+}
+
+func (t *TransitGatewayRouteTablesClient) DescribeTransitGatewayRouteTables(input *ec2.DescribeTransitGatewayRouteTablesInput) (*ec2.DescribeTransitGatewayRouteTablesOutput, error) {
+	t.DescribeTransitGatewayRouteTablesCall.CallCount++
+	t.DescribeTransitGatewayRouteTablesCall.Receives.Input = input
+
+	return t.DescribeTransitGatewayRouteTablesCall.Returns.Output, t.DescribeTransitGatewayRouteTablesCall.Returns.Error
+}
+
+func (t *TransitGatewayRouteTablesClient) DescribeTransitGatewayRouteTablesPages(input *ec2.DescribeTransitGatewayRouteTablesInput, fn func(*ec2.DescribeTransitGatewayRouteTablesOutput, bool) bool) error {
+	t.DescribeTransitGatewayRouteTablesPagesCall.CallCount++
+	t.DescribeTransitGatewayRouteTablesPagesCall.Receives.Input = input
+
+	pages := t.DescribeTransitGatewayRouteTablesPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return t.DescribeTransitGatewayRouteTablesPagesCall.Returns.Error
+}
+
+func (t *TransitGatewayRouteTablesClient) GetTransitGatewayRouteTableAssociationsPages(input *ec2.GetTransitGatewayRouteTableAssociationsInput, fn func(*ec2.GetTransitGatewayRouteTableAssociationsOutput, bool) bool) error {
+	t.GetTransitGatewayRouteTableAssociationsPagesCall.CallCount++
+	t.GetTransitGatewayRouteTableAssociationsPagesCall.Receives.Input = input
+
+	pages := t.GetTransitGatewayRouteTableAssociationsPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return t.GetTransitGatewayRouteTableAssociationsPagesCall.Returns.Error
+}
+
+func (t *TransitGatewayRouteTablesClient) GetTransitGatewayRouteTablePropagationsPages(input *ec2.GetTransitGatewayRouteTablePropagationsInput, fn func(*ec2.GetTransitGatewayRouteTablePropagationsOutput, bool) bool) error {
+	t.GetTransitGatewayRouteTablePropagationsPagesCall.CallCount++
+	t.GetTransitGatewayRouteTablePropagationsPagesCall.Receives.Input = input
+
+	pages := t.GetTransitGatewayRouteTablePropagationsPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return t.GetTransitGatewayRouteTablePropagationsPagesCall.Returns.Error
+}
+
+func (t *TransitGatewayRouteTablesClient) DisassociateTransitGatewayRouteTable(input *ec2.DisassociateTransitGatewayRouteTableInput) (*ec2.DisassociateTransitGatewayRouteTableOutput, error) {
+	t.DisassociateTransitGatewayRouteTableCall.CallCount++
+	t.DisassociateTransitGatewayRouteTableCall.Receives.Input = input
+
+	return t.DisassociateTransitGatewayRouteTableCall.Returns.Output, t.DisassociateTransitGatewayRouteTableCall.Returns.Error
+}
+
+func (t *TransitGatewayRouteTablesClient) DisableTransitGatewayRouteTablePropagation(input *ec2.DisableTransitGatewayRouteTablePropagationInput) (*ec2.DisableTransitGatewayRouteTablePropagationOutput, error) {
+	t.DisableTransitGatewayRouteTablePropagationCall.CallCount++
+	t.DisableTransitGatewayRouteTablePropagationCall.Receives.Input = input
+
+	return t.DisableTransitGatewayRouteTablePropagationCall.Returns.Output, t.DisableTransitGatewayRouteTablePropagationCall.Returns.Error
+}
+
+func (t *TransitGatewayRouteTablesClient) DeleteTransitGatewayRouteTable(input *ec2.DeleteTransitGatewayRouteTableInput) (*ec2.DeleteTransitGatewayRouteTableOutput, error) {
+	t.DeleteTransitGatewayRouteTableCall.CallCount++
+	t.DeleteTransitGatewayRouteTableCall.Receives.Input = input
+
+	return t.DeleteTransitGatewayRouteTableCall.Returns.Output, t.DeleteTransitGatewayRouteTableCall.Returns.Error
+}
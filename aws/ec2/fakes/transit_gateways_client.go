@@ -0,0 +1,66 @@
+package fakes
+
+import "github.com/aws/aws-sdk-go/service/ec2"
+
+type TransitGatewaysClient struct {
+	DescribeTransitGatewaysCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.DescribeTransitGatewaysInput
+		}
+		Returns struct {
+			Output *ec2.DescribeTransitGatewaysOutput
+			Error  error
+		}
+	}
+
+	DescribeTransitGatewaysPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.DescribeTransitGatewaysInput
+		}
+		Returns struct {
+			Pages []*ec2.DescribeTransitGatewaysOutput
+			Error error
+		}
+	}
+
+	DeleteTransitGatewayCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.DeleteTransitGatewayInput
+		}
+		Returns struct {
+			Output *ec2.DeleteTransitGatewayOutput
+			Error  error
+		}
+	}
+}
+
+func (t *TransitGatewaysClient) DescribeTransitGateways(input *ec2.DescribeTransitGatewaysInput) (*ec2.DescribeTransitGatewaysOutput, error) {
+	t.DescribeTransitGatewaysCall.CallCount++
+	t.DescribeTransitGatewaysCall.Receives.Input = input
+
+	return t.DescribeTransitGatewaysCall.Returns.Output, t.DescribeTransitGatewaysCall.Returns.Error
+}
+
+func (t *TransitGatewaysClient) DescribeTransitGatewaysPages(input *ec2.DescribeTransitGatewaysInput, fn func(*ec2.DescribeTransitGatewaysOutput, bool) bool) error {
+	t.DescribeTransitGatewaysPagesCall.CallCount++
+	t.DescribeTransitGatewaysPagesCall.Receives.Input = input
+
+	pages := t.DescribeTransitGatewaysPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return t.DescribeTransitGatewaysPagesCall.Returns.Error
+}
+
+func (t *TransitGatewaysClient) DeleteTransitGateway(input *ec2.DeleteTransitGatewayInput) (*ec2.DeleteTransitGatewayOutput, error) {
+	t.DeleteTransitGatewayCall.CallCount++
+	t.DeleteTransitGatewayCall.Receives.Input = input
+
+	return t.DeleteTransitGatewayCall.Returns.Output, t.DeleteTransitGatewayCall.Returns.Error
+}
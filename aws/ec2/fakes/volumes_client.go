@@ -3,14 +3,14 @@ package fakes
 import "github.com/aws/aws-sdk-go/service/ec2"
 
 type VolumesClient struct {
-	DescribeVolumesCall struct {
+	DescribeVolumesPagesCall struct {
 		CallCount int
 		Receives  struct {
 			Input *ec2.DescribeVolumesInput
 		}
 		Returns struct {
-			Output *ec2.DescribeVolumesOutput
-			Error  error
+			Pages []*ec2.DescribeVolumesOutput
+			Error error
 		}
 	}
 
@@ -24,13 +24,42 @@ type VolumesClient struct {
 			Error  error
 		}
 	}
+
+	CreateSnapshotCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.CreateSnapshotInput
+		}
+		Returns struct {
+			Output *ec2.Snapshot
+			Error  error
+		}
+	}
+
+	DescribeSnapshotsCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.DescribeSnapshotsInput
+		}
+		Returns struct {
+			Output *ec2.DescribeSnapshotsOutput
+			Error  error
+		}
+	}
 }
 
-func (e *VolumesClient) DescribeVolumes(input *ec2.DescribeVolumesInput) (*ec2.DescribeVolumesOutput, error) {
-	e.DescribeVolumesCall.CallCount++
-	e.DescribeVolumesCall.Receives.Input = input
+func (e *VolumesClient) DescribeVolumesPages(input *ec2.DescribeVolumesInput, fn func(*ec2.DescribeVolumesOutput, bool) bool) error {
+	e.DescribeVolumesPagesCall.CallCount++
+	e.DescribeVolumesPagesCall.Receives.Input = input
+
+	pages := e.DescribeVolumesPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
 
-	return e.DescribeVolumesCall.Returns.Output, e.DescribeVolumesCall.Returns.Error
+	return e.DescribeVolumesPagesCall.Returns.Error
 }
 
 func (e *VolumesClient) DeleteVolume(input *ec2.DeleteVolumeInput) (*ec2.DeleteVolumeOutput, error) {
@@ -39,3 +68,17 @@ func (e *VolumesClient) DeleteVolume(input *ec2.DeleteVolumeInput) (*ec2.DeleteV
 
 	return e.DeleteVolumeCall.Returns.Output, e.DeleteVolumeCall.Returns.Error
 }
+
+func (e *VolumesClient) CreateSnapshot(input *ec2.CreateSnapshotInput) (*ec2.Snapshot, error) {
+	e.CreateSnapshotCall.CallCount++
+	e.CreateSnapshotCall.Receives.Input = input
+
+	return e.CreateSnapshotCall.Returns.Output, e.CreateSnapshotCall.Returns.Error
+}
+
+func (e *VolumesClient) DescribeSnapshots(input *ec2.DescribeSnapshotsInput) (*ec2.DescribeSnapshotsOutput, error) {
+	e.DescribeSnapshotsCall.CallCount++
+	e.DescribeSnapshotsCall.Receives.Input = input
+
+	return e.DescribeSnapshotsCall.Returns.Output, e.DescribeSnapshotsCall.Returns.Error
+}
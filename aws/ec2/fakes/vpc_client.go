@@ -3,14 +3,14 @@ package fakes
 import "github.com/aws/aws-sdk-go/service/ec2"
 
 type VpcClient struct {
-	DescribeVpcsCall struct {
+	DescribeVpcsPagesCall struct {
 		CallCount int
 		Receives  struct {
 			Input *ec2.DescribeVpcsInput
 		}
 		Returns struct {
-			Output *ec2.DescribeVpcsOutput
-			Error  error
+			Pages []*ec2.DescribeVpcsOutput
+			Error error
 		}
 	}
 
@@ -26,11 +26,18 @@ type VpcClient struct {
 	}
 }
 
-func (e *VpcClient) DescribeVpcs(input *ec2.DescribeVpcsInput) (*ec2.DescribeVpcsOutput, error) {
-	e.DescribeVpcsCall.CallCount++
-	e.DescribeVpcsCall.Receives.Input = input
+func (e *VpcClient) DescribeVpcsPages(input *ec2.DescribeVpcsInput, fn func(*ec2.DescribeVpcsOutput, bool) bool) error {
+	e.DescribeVpcsPagesCall.CallCount++
+	e.DescribeVpcsPagesCall.Receives.Input = input
+
+	pages := e.DescribeVpcsPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
 
-	return e.DescribeVpcsCall.Returns.Output, e.DescribeVpcsCall.Returns.Error
+	return e.DescribeVpcsPagesCall.Returns.Error
 }
 
 func (e *VpcClient) DeleteVpc(input *ec2.DeleteVpcInput) (*ec2.DeleteVpcOutput, error) {
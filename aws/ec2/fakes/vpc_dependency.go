@@ -0,0 +1,22 @@
+package fakes
+
+import "context"
+
+type VpcDependency struct {
+	DeleteCall struct {
+		CallCount int
+		Receives  struct {
+			VpcId string
+		}
+		Returns struct {
+			Error error
+		}
+	}
+}
+
+func (v *VpcDependency) Delete(ctx context.Context, vpcId string) error {
+	v.DeleteCall.CallCount++
+	v.DeleteCall.Receives.VpcId = vpcId
+
+	return v.DeleteCall.Returns.Error
+}
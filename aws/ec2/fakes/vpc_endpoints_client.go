@@ -0,0 +1,66 @@
+package fakes
+
+import "github.com/aws/aws-sdk-go/service/ec2"
+
+type VpcEndpointsClient struct {
+	DescribeVpcEndpointsCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.DescribeVpcEndpointsInput
+		}
+		Returns struct {
+			Output *ec2.DescribeVpcEndpointsOutput
+			Error  error
+		}
+	}
+
+	DescribeVpcEndpointsPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.DescribeVpcEndpointsInput
+		}
+		Returns struct {
+			Pages []*ec2.DescribeVpcEndpointsOutput
+			Error error
+		}
+	}
+
+	DeleteVpcEndpointsCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.DeleteVpcEndpointsInput
+		}
+		Returns struct {
+			Output *ec2.DeleteVpcEndpointsOutput
+			Error  error
+		}
+	}
+}
+
+func (v *VpcEndpointsClient) DescribeVpcEndpoints(input *ec2.DescribeVpcEndpointsInput) (*ec2.DescribeVpcEndpointsOutput, error) {
+	v.DescribeVpcEndpointsCall.CallCount++
+	v.DescribeVpcEndpointsCall.Receives.Input = input
+
+	return v.DescribeVpcEndpointsCall.Returns.Output, v.DescribeVpcEndpointsCall.Returns.Error
+}
+
+func (v *VpcEndpointsClient) DescribeVpcEndpointsPages(input *ec2.DescribeVpcEndpointsInput, fn func(*ec2.DescribeVpcEndpointsOutput, bool) bool) error {
+	v.DescribeVpcEndpointsPagesCall.CallCount++
+	v.DescribeVpcEndpointsPagesCall.Receives.Input = input
+
+	pages := v.DescribeVpcEndpointsPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return v.DescribeVpcEndpointsPagesCall.Returns.Error
+}
+
+func (v *VpcEndpointsClient) DeleteVpcEndpoints(input *ec2.DeleteVpcEndpointsInput) (*ec2.DeleteVpcEndpointsOutput, error) {
+	v.DeleteVpcEndpointsCall.CallCount++
+	v.DeleteVpcEndpointsCall.Receives.Input = input
+
+	return v.DeleteVpcEndpointsCall.Returns.Output, v.DeleteVpcEndpointsCall.Returns.Error
+}
@@ -0,0 +1,48 @@
+package fakes
+
+import "github.com/aws/aws-sdk-go/service/ec2"
+
+type VpcPeeringConnectionsClient struct {
+	DescribeVpcPeeringConnectionsPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.DescribeVpcPeeringConnectionsInput
+		}
+		Returns struct {
+			Pages []*ec2.DescribeVpcPeeringConnectionsOutput
+			Error error
+		}
+	}
+
+	DeleteVpcPeeringConnectionCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.DeleteVpcPeeringConnectionInput
+		}
+		Returns struct {
+			Output *ec2.DeleteVpcPeeringConnectionOutput
+			Error  error
+		}
+	}
+}
+
+func (v *VpcPeeringConnectionsClient) DescribeVpcPeeringConnectionsPages(input *ec2.DescribeVpcPeeringConnectionsInput, fn func(*ec2.DescribeVpcPeeringConnectionsOutput, bool) bool) error {
+	v.DescribeVpcPeeringConnectionsPagesCall.CallCount++
+	v.DescribeVpcPeeringConnectionsPagesCall.Receives.Input = input
+
+	pages := v.DescribeVpcPeeringConnectionsPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return v.DescribeVpcPeeringConnectionsPagesCall.Returns.Error
+}
+
+func (v *VpcPeeringConnectionsClient) DeleteVpcPeeringConnection(input *ec2.DeleteVpcPeeringConnectionInput) (*ec2.DeleteVpcPeeringConnectionOutput, error) {
+	v.DeleteVpcPeeringConnectionCall.CallCount++
+	v.DeleteVpcPeeringConnectionCall.Receives.Input = input
+
+	return v.DeleteVpcPeeringConnectionCall.Returns.Output, v.DeleteVpcPeeringConnectionCall.Returns.Error
+}
@@ -0,0 +1,103 @@
+package fakes
+
+import "github.com/aws/aws-sdk-go/service/ec2"
+
+type VpnGatewaysClient struct {
+	DescribeVpnConnectionsCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.DescribeVpnConnectionsInput
+		}
+		Returns struct {
+			Output *ec2.DescribeVpnConnectionsOutput
+			Error  error
+		}
+		ReturnsOnCall map[int]struct {
+			Output *ec2.DescribeVpnConnectionsOutput
+			Error  error
+		}
+	}
+
+	DeleteVpnConnectionCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.DeleteVpnConnectionInput
+		}
+		Returns struct {
+			Output *ec2.DeleteVpnConnectionOutput
+			Error  error
+		}
+	}
+
+	DescribeVpnGatewaysCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.DescribeVpnGatewaysInput
+		}
+		Returns struct {
+			Output *ec2.DescribeVpnGatewaysOutput
+			Error  error
+		}
+	}
+
+	DetachVpnGatewayCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.DetachVpnGatewayInput
+		}
+		Returns struct {
+			Output *ec2.DetachVpnGatewayOutput
+			Error  error
+		}
+	}
+
+	DeleteVpnGatewayCall struct {
+		CallCount int
+		Receives  struct {
+			Input *ec2.DeleteVpnGatewayInput
+		}
+		Returns struct {
+			Output *ec2.DeleteVpnGatewayOutput
+			Error  error
+		}
+	}
+}
+
+func (v *VpnGatewaysClient) DescribeVpnConnections(input *ec2.DescribeVpnConnectionsInput) (*ec2.DescribeVpnConnectionsOutput, error) {
+	v.DescribeVpnConnectionsCall.CallCount++
+	v.DescribeVpnConnectionsCall.Receives.Input = input
+
+	if ret, ok := v.DescribeVpnConnectionsCall.ReturnsOnCall[v.DescribeVpnConnectionsCall.CallCount-1]; ok {
+		return ret.Output, ret.Error
+	}
+
+	return v.DescribeVpnConnectionsCall.Returns.Output, v.DescribeVpnConnectionsCall.Returns.Error
+}
+
+func (v *VpnGatewaysClient) DeleteVpnConnection(input *ec2.DeleteVpnConnectionInput) (*ec2.DeleteVpnConnectionOutput, error) {
+	v.DeleteVpnConnectionCall.CallCount++
+	v.DeleteVpnConnectionCall.Receives.Input = input
+
+	return v.DeleteVpnConnectionCall.Returns.Output, v.DeleteVpnConnectionCall.Returns.Error
+}
+
+func (v *VpnGatewaysClient) DescribeVpnGateways(input *ec2.DescribeVpnGatewaysInput) (*ec2.DescribeVpnGatewaysOutput, error) {
+	v.DescribeVpnGatewaysCall.CallCount++
+	v.DescribeVpnGatewaysCall.Receives.Input = input
+
+	return v.DescribeVpnGatewaysCall.Returns.Output, v.DescribeVpnGatewaysCall.Returns.Error
+}
+
+func (v *VpnGatewaysClient) DetachVpnGateway(input *ec2.DetachVpnGatewayInput) (*ec2.DetachVpnGatewayOutput, error) {
+	v.DetachVpnGatewayCall.CallCount++
+	v.DetachVpnGatewayCall.Receives.Input = input
+
+	return v.DetachVpnGatewayCall.Returns.Output, v.DetachVpnGatewayCall.Returns.Error
+}
+
+func (v *VpnGatewaysClient) DeleteVpnGateway(input *ec2.DeleteVpnGatewayInput) (*ec2.DeleteVpnGatewayOutput, error) {
+	v.DeleteVpnGatewayCall.CallCount++
+	v.DeleteVpnGatewayCall.Receives.Input = input
+
+	return v.DeleteVpnGatewayCall.Returns.Output, v.DeleteVpnGatewayCall.Returns.Error
+}
@@ -10,7 +10,7 @@ import (
 )
 
 type imagesClient interface {
-	DescribeImages(*awsec2.DescribeImagesInput) (*awsec2.DescribeImagesOutput, error)
+	DescribeImagesPages(*awsec2.DescribeImagesInput, func(*awsec2.DescribeImagesOutput, bool) bool) error
 	DeregisterImage(*awsec2.DeregisterImageInput) (*awsec2.DeregisterImageOutput, error)
 }
 
@@ -40,15 +40,19 @@ func (i Images) List(filter string) ([]common.Deletable, error) {
 		return nil, fmt.Errorf("Get caller identity: %s", err)
 	}
 
-	images, err := i.client.DescribeImages(&awsec2.DescribeImagesInput{
+	var images []*awsec2.Image
+	err = i.client.DescribeImagesPages(&awsec2.DescribeImagesInput{
 		Owners: []*string{caller.Account},
+	}, func(page *awsec2.DescribeImagesOutput, lastPage bool) bool {
+		images = append(images, page.Images...)
+		return true
 	})
 	if err != nil {
 		return nil, fmt.Errorf("Describing EC2 Images: %s", err)
 	}
 
 	var resources []common.Deletable
-	for _, image := range images.Images {
+	for _, image := range images {
 		r := NewImage(i.client, image.ImageId, i.resourceTags)
 
 		if !strings.Contains(r.Name(), filter) {
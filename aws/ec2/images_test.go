@@ -34,11 +34,11 @@ var _ = Describe("Images", func() {
 
 	Describe("List", func() {
 		BeforeEach(func() {
-			client.DescribeImagesCall.Returns.Output = &awsec2.DescribeImagesOutput{
+			client.DescribeImagesPagesCall.Returns.Pages = []*awsec2.DescribeImagesOutput{{
 				Images: []*awsec2.Image{{
 					ImageId: aws.String("the-image-id"),
 				}},
-			}
+			}}
 			stsClient.GetCallerIdentityCall.Returns.Output = &awssts.GetCallerIdentityOutput{
 				Account: aws.String("the-account-id"),
 			}
@@ -48,8 +48,8 @@ var _ = Describe("Images", func() {
 			items, err := images.List("")
 			Expect(err).NotTo(HaveOccurred())
 
-			Expect(client.DescribeImagesCall.CallCount).To(Equal(1))
-			Expect(client.DescribeImagesCall.Receives.Input.Owners[0]).To(Equal(aws.String("the-account-id")))
+			Expect(client.DescribeImagesPagesCall.CallCount).To(Equal(1))
+			Expect(client.DescribeImagesPagesCall.Receives.Input.Owners[0]).To(Equal(aws.String("the-account-id")))
 
 			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
 			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("EC2 Image"))
@@ -60,7 +60,7 @@ var _ = Describe("Images", func() {
 
 		Context("when the client fails to list images", func() {
 			BeforeEach(func() {
-				client.DescribeImagesCall.Returns.Error = errors.New("some error")
+				client.DescribeImagesPagesCall.Returns.Error = errors.New("some error")
 			})
 
 			It("returns the error", func() {
@@ -1,13 +1,14 @@
 package ec2
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
-	"github.com/genevieve/leftovers/aws/common"
+	"github.com/genevieve/leftovers/common"
 )
 
 type Instance struct {
@@ -48,11 +49,15 @@ func NewInstance(client instancesClient, logger logger, resourceTags resourceTag
 var pending = []string{"pending", "running", "shutting-down", "stopped", "stopping"}
 var target = []string{"terminated"}
 
-// Delete finds any addresses bound to the instance set for deletion,
+func (i Instance) Delete() error {
+	return i.DeleteContext(context.Background())
+}
+
+// DeleteContext finds any addresses bound to the instance set for deletion,
 // terminates the instance, waits for it to be terminated, deletes
 // any tags that were bound to this instance, and finally releases
 // the addresses.
-func (i Instance) Delete() error {
+func (i Instance) DeleteContext(ctx context.Context) error {
 	addresses, err := i.client.DescribeAddresses(&awsec2.DescribeAddressesInput{
 		Filters: []*awsec2.Filter{{
 			Name:   aws.String("instance-id"),
@@ -76,9 +81,9 @@ func (i Instance) Delete() error {
 	}
 
 	refresh := instanceRefresh(i.client, i.id)
-	state := common.NewState(i.logger, refresh, pending, target)
+	poller := common.NewPoller(i.logger, refresh, pending, target)
 
-	_, err = state.Wait()
+	_, err = poller.Wait(ctx)
 	if err != nil {
 		return fmt.Errorf("Waiting for deletion: %s", err)
 	}
@@ -11,6 +11,7 @@ import (
 
 type instancesClient interface {
 	DescribeInstances(*awsec2.DescribeInstancesInput) (*awsec2.DescribeInstancesOutput, error)
+	DescribeInstancesPages(*awsec2.DescribeInstancesInput, func(*awsec2.DescribeInstancesOutput, bool) bool) error
 	TerminateInstances(*awsec2.TerminateInstancesInput) (*awsec2.TerminateInstancesOutput, error)
 
 	DescribeAddresses(*awsec2.DescribeAddressesInput) (*awsec2.DescribeAddressesOutput, error)
@@ -32,18 +33,22 @@ func NewInstances(client instancesClient, logger logger, resourceTags resourceTa
 }
 
 func (i Instances) List(filter string) ([]common.Deletable, error) {
-	instances, err := i.client.DescribeInstances(&awsec2.DescribeInstancesInput{
+	var reservations []*awsec2.Reservation
+	err := i.client.DescribeInstancesPages(&awsec2.DescribeInstancesInput{
 		Filters: []*awsec2.Filter{{
 			Name:   aws.String("instance-state-name"),
 			Values: []*string{aws.String("pending"), aws.String("running"), aws.String("shutting-down"), aws.String("stopping"), aws.String("stopped")},
 		}},
+	}, func(page *awsec2.DescribeInstancesOutput, lastPage bool) bool {
+		reservations = append(reservations, page.Reservations...)
+		return true
 	})
 	if err != nil {
 		return nil, fmt.Errorf("Describing EC2 Instances: %s", err)
 	}
 
 	var resources []common.Deletable
-	for _, r := range instances.Reservations {
+	for _, r := range reservations {
 		for _, instance := range r.Instances {
 			r := NewInstance(i.client, i.logger, i.resourceTags, instance.InstanceId, instance.KeyName, instance.Tags)
 
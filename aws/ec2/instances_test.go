@@ -33,7 +33,7 @@ var _ = Describe("Instances", func() {
 		var filter string
 
 		BeforeEach(func() {
-			client.DescribeInstancesCall.Returns.Output = &awsec2.DescribeInstancesOutput{
+			client.DescribeInstancesPagesCall.Returns.Pages = []*awsec2.DescribeInstancesOutput{{
 				Reservations: []*awsec2.Reservation{{
 					Instances: []*awsec2.Instance{{
 						State: &awsec2.InstanceState{Name: aws.String("available")},
@@ -45,15 +45,15 @@ var _ = Describe("Instances", func() {
 						KeyName:    aws.String(""),
 					}},
 				}},
-			}
+			}}
 		})
 
 		It("returns a list of ec2 instances to delete", func() {
 			items, err := instances.List(filter)
 			Expect(err).NotTo(HaveOccurred())
 
-			Expect(client.DescribeInstancesCall.CallCount).To(Equal(1))
-			Expect(client.DescribeInstancesCall.Receives.Input.Filters[0].Name).To(Equal(aws.String("instance-state-name")))
+			Expect(client.DescribeInstancesPagesCall.CallCount).To(Equal(1))
+			Expect(client.DescribeInstancesPagesCall.Receives.Input.Filters[0].Name).To(Equal(aws.String("instance-state-name")))
 
 			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
 			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("EC2 Instance"))
@@ -62,12 +62,37 @@ var _ = Describe("Instances", func() {
 			Expect(items).To(HaveLen(1))
 		})
 
+		Context("when there is more than one page of reservations", func() {
+			BeforeEach(func() {
+				client.DescribeInstancesPagesCall.Returns.Pages = append(client.DescribeInstancesPagesCall.Returns.Pages, &awsec2.DescribeInstancesOutput{
+					Reservations: []*awsec2.Reservation{{
+						Instances: []*awsec2.Instance{{
+							State:      &awsec2.InstanceState{Name: aws.String("available")},
+							InstanceId: aws.String("the-other-instance-id"),
+							KeyName:    aws.String(""),
+						}},
+					}},
+				})
+			})
+
+			It("returns the instances from every page", func() {
+				items, err := instances.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(client.DescribeInstancesPagesCall.CallCount).To(Equal(1))
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(2))
+				Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("the-other-instance-id"))
+
+				Expect(items).To(HaveLen(2))
+			})
+		})
+
 		Context("when the instance name does not contain the filter", func() {
 			It("does not try to delete it", func() {
 				items, err := instances.List("kiwi")
 				Expect(err).NotTo(HaveOccurred())
 
-				Expect(client.DescribeInstancesCall.CallCount).To(Equal(1))
+				Expect(client.DescribeInstancesPagesCall.CallCount).To(Equal(1))
 				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
 
 				Expect(items).To(HaveLen(0))
@@ -76,7 +101,7 @@ var _ = Describe("Instances", func() {
 
 		Context("when there is no tag name", func() {
 			BeforeEach(func() {
-				client.DescribeInstancesCall.Returns.Output = &awsec2.DescribeInstancesOutput{
+				client.DescribeInstancesPagesCall.Returns.Pages = []*awsec2.DescribeInstancesOutput{{
 					Reservations: []*awsec2.Reservation{{
 						Instances: []*awsec2.Instance{{
 							State:      &awsec2.InstanceState{Name: aws.String("available")},
@@ -84,7 +109,7 @@ var _ = Describe("Instances", func() {
 							KeyName:    aws.String(""),
 						}},
 					}},
-				}
+				}}
 			})
 
 			It("uses just the instance id in the prompt", func() {
@@ -99,7 +124,7 @@ var _ = Describe("Instances", func() {
 
 		Context("when there is a key name", func() {
 			BeforeEach(func() {
-				client.DescribeInstancesCall.Returns.Output = &awsec2.DescribeInstancesOutput{
+				client.DescribeInstancesPagesCall.Returns.Pages = []*awsec2.DescribeInstancesOutput{{
 					Reservations: []*awsec2.Reservation{{
 						Instances: []*awsec2.Instance{{
 							State:      &awsec2.InstanceState{Name: aws.String("available")},
@@ -107,7 +132,7 @@ var _ = Describe("Instances", func() {
 							KeyName:    aws.String("the-key-pair"),
 						}},
 					}},
-				}
+				}}
 			})
 
 			It("uses it in the prompt", func() {
@@ -122,7 +147,7 @@ var _ = Describe("Instances", func() {
 
 		Context("when the client fails to list instances", func() {
 			BeforeEach(func() {
-				client.DescribeInstancesCall.Returns.Error = errors.New("some error")
+				client.DescribeInstancesPagesCall.Returns.Error = errors.New("some error")
 			})
 
 			It("returns the error", func() {
@@ -8,7 +8,7 @@ import (
 )
 
 type internetGatewaysClient interface {
-	DescribeInternetGateways(*awsec2.DescribeInternetGatewaysInput) (*awsec2.DescribeInternetGatewaysOutput, error)
+	DescribeInternetGatewaysPages(*awsec2.DescribeInternetGatewaysInput, func(*awsec2.DescribeInternetGatewaysOutput, bool) bool) error
 	DetachInternetGateway(*awsec2.DetachInternetGatewayInput) (*awsec2.DetachInternetGatewayOutput, error)
 	DeleteInternetGateway(*awsec2.DeleteInternetGatewayInput) (*awsec2.DeleteInternetGatewayOutput, error)
 }
@@ -30,17 +30,21 @@ func NewInternetGateways(client internetGatewaysClient, logger logger) InternetG
 }
 
 func (n InternetGateways) Delete(vpcId string) error {
-	igws, err := n.client.DescribeInternetGateways(&awsec2.DescribeInternetGatewaysInput{
+	var gateways []*awsec2.InternetGateway
+	err := n.client.DescribeInternetGatewaysPages(&awsec2.DescribeInternetGatewaysInput{
 		Filters: []*awsec2.Filter{{
 			Name:   aws.String("attachment.vpc-id"),
 			Values: []*string{aws.String(vpcId)},
 		}},
+	}, func(page *awsec2.DescribeInternetGatewaysOutput, lastPage bool) bool {
+		gateways = append(gateways, page.InternetGateways...)
+		return true
 	})
 	if err != nil {
 		return fmt.Errorf("Describe EC2 Internet Gateways: %s", err)
 	}
 
-	for _, i := range igws.InternetGateways {
+	for _, i := range gateways {
 		igwId := *i.InternetGatewayId
 
 		_, err = n.client.DetachInternetGateway(&awsec2.DetachInternetGatewayInput{
@@ -28,23 +28,23 @@ var _ = Describe("InternetGateways", func() {
 
 	Describe("Delete", func() {
 		BeforeEach(func() {
-			client.DescribeInternetGatewaysCall.Returns.Output = &awsec2.DescribeInternetGatewaysOutput{
+			client.DescribeInternetGatewaysPagesCall.Returns.Pages = []*awsec2.DescribeInternetGatewaysOutput{{
 				InternetGateways: []*awsec2.InternetGateway{{
 					InternetGatewayId: aws.String("the-gateway-id"),
 					Attachments: []*awsec2.InternetGatewayAttachment{{
 						VpcId: aws.String("the-vpc-id"),
 					}},
 				}},
-			}
+			}}
 		})
 
 		It("detaches and deletes the internet gateways", func() {
 			err := gateways.Delete("the-vpc-id")
 			Expect(err).NotTo(HaveOccurred())
 
-			Expect(client.DescribeInternetGatewaysCall.CallCount).To(Equal(1))
-			Expect(client.DescribeInternetGatewaysCall.Receives.Input.Filters[0].Name).To(Equal(aws.String("attachment.vpc-id")))
-			Expect(client.DescribeInternetGatewaysCall.Receives.Input.Filters[0].Values[0]).To(Equal(aws.String("the-vpc-id")))
+			Expect(client.DescribeInternetGatewaysPagesCall.CallCount).To(Equal(1))
+			Expect(client.DescribeInternetGatewaysPagesCall.Receives.Input.Filters[0].Name).To(Equal(aws.String("attachment.vpc-id")))
+			Expect(client.DescribeInternetGatewaysPagesCall.Receives.Input.Filters[0].Values[0]).To(Equal(aws.String("the-vpc-id")))
 
 			Expect(client.DetachInternetGatewayCall.CallCount).To(Equal(1))
 			Expect(client.DetachInternetGatewayCall.Receives.Input.InternetGatewayId).To(Equal(aws.String("the-gateway-id")))
@@ -61,7 +61,7 @@ var _ = Describe("InternetGateways", func() {
 
 		Context("when the client fails to describe attached internet gateways", func() {
 			BeforeEach(func() {
-				client.DescribeInternetGatewaysCall.Returns.Error = errors.New("some error")
+				client.DescribeInternetGatewaysPagesCall.Returns.Error = errors.New("some error")
 			})
 
 			It("returns the error and does not try deleting them", func() {
@@ -0,0 +1,52 @@
+package ec2
+
+import (
+	"fmt"
+	"strings"
+
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+)
+
+type LaunchTemplate struct {
+	client     launchTemplatesClient
+	id         *string
+	identifier string
+	rtype      string
+}
+
+func NewLaunchTemplate(client launchTemplatesClient, id, name *string, tags []*awsec2.Tag) LaunchTemplate {
+	identifier := *name
+
+	var extra []string
+	for _, t := range tags {
+		extra = append(extra, fmt.Sprintf("%s:%s", *t.Key, *t.Value))
+	}
+
+	if len(extra) > 0 {
+		identifier = fmt.Sprintf("%s (%s)", *name, strings.Join(extra, ", "))
+	}
+
+	return LaunchTemplate{
+		client:     client,
+		id:         id,
+		identifier: identifier,
+		rtype:      "EC2 Launch Template",
+	}
+}
+
+func (l LaunchTemplate) Delete() error {
+	_, err := l.client.DeleteLaunchTemplate(&awsec2.DeleteLaunchTemplateInput{LaunchTemplateId: l.id})
+	if err != nil {
+		return fmt.Errorf("Delete: %s", err)
+	}
+
+	return nil
+}
+
+func (l LaunchTemplate) Name() string {
+	return l.identifier
+}
+
+func (l LaunchTemplate) Type() string {
+	return l.rtype
+}
@@ -0,0 +1,61 @@
+package ec2_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/genevieve/leftovers/aws/ec2"
+	"github.com/genevieve/leftovers/aws/ec2/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LaunchTemplate", func() {
+	var (
+		launchTemplate ec2.LaunchTemplate
+		client         *fakes.LaunchTemplatesClient
+		id             *string
+	)
+
+	BeforeEach(func() {
+		client = &fakes.LaunchTemplatesClient{}
+		id = aws.String("the-id")
+		tags := []*awsec2.Tag{{Key: aws.String("the-key"), Value: aws.String("the-value")}}
+
+		launchTemplate = ec2.NewLaunchTemplate(client, id, aws.String("the-name"), tags)
+	})
+
+	Describe("Delete", func() {
+		It("deletes the launch template", func() {
+			err := launchTemplate.Delete()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DeleteLaunchTemplateCall.CallCount).To(Equal(1))
+			Expect(client.DeleteLaunchTemplateCall.Receives.Input.LaunchTemplateId).To(Equal(id))
+		})
+
+		Context("when the client fails", func() {
+			BeforeEach(func() {
+				client.DeleteLaunchTemplateCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := launchTemplate.Delete()
+				Expect(err).To(MatchError("Delete: banana"))
+			})
+		})
+	})
+
+	Describe("Name", func() {
+		It("returns the identifier", func() {
+			Expect(launchTemplate.Name()).To(Equal("the-name (the-key:the-value)"))
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(launchTemplate.Type()).To(Equal("EC2 Launch Template"))
+		})
+	})
+})
@@ -0,0 +1,59 @@
+package ec2
+
+import (
+	"fmt"
+	"strings"
+
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/genevieve/leftovers/common"
+)
+
+type launchTemplatesClient interface {
+	DescribeLaunchTemplatesPages(*awsec2.DescribeLaunchTemplatesInput, func(*awsec2.DescribeLaunchTemplatesOutput, bool) bool) error
+	DeleteLaunchTemplate(*awsec2.DeleteLaunchTemplateInput) (*awsec2.DeleteLaunchTemplateOutput, error)
+}
+
+type LaunchTemplates struct {
+	client launchTemplatesClient
+	logger logger
+}
+
+func NewLaunchTemplates(client launchTemplatesClient, logger logger) LaunchTemplates {
+	return LaunchTemplates{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (l LaunchTemplates) List(filter string) ([]common.Deletable, error) {
+	var templates []*awsec2.LaunchTemplate
+	err := l.client.DescribeLaunchTemplatesPages(&awsec2.DescribeLaunchTemplatesInput{}, func(page *awsec2.DescribeLaunchTemplatesOutput, lastPage bool) bool {
+		templates = append(templates, page.LaunchTemplates...)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Describing EC2 Launch Templates: %s", err)
+	}
+
+	var resources []common.Deletable
+	for _, t := range templates {
+		r := NewLaunchTemplate(l.client, t.LaunchTemplateId, t.LaunchTemplateName, t.Tags)
+
+		if !strings.Contains(r.Name(), filter) {
+			continue
+		}
+
+		proceed := l.logger.PromptWithDetails(r.Type(), r.Name())
+		if !proceed {
+			continue
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func (l LaunchTemplates) Type() string {
+	return "ec2-launch-template"
+}
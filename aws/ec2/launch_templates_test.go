@@ -0,0 +1,100 @@
+package ec2_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/genevieve/leftovers/aws/ec2"
+	"github.com/genevieve/leftovers/aws/ec2/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LaunchTemplates", func() {
+	var (
+		client *fakes.LaunchTemplatesClient
+		logger *fakes.Logger
+
+		launchTemplates ec2.LaunchTemplates
+	)
+
+	BeforeEach(func() {
+		client = &fakes.LaunchTemplatesClient{}
+		logger = &fakes.Logger{}
+		logger.PromptWithDetailsCall.Returns.Proceed = true
+
+		launchTemplates = ec2.NewLaunchTemplates(client, logger)
+	})
+
+	Describe("List", func() {
+		var filter string
+
+		BeforeEach(func() {
+			client.DescribeLaunchTemplatesPagesCall.Returns.Pages = []*awsec2.DescribeLaunchTemplatesOutput{{
+				LaunchTemplates: []*awsec2.LaunchTemplate{{
+					LaunchTemplateId:   aws.String("lt-1"),
+					LaunchTemplateName: aws.String("banana-template"),
+					Tags: []*awsec2.Tag{{
+						Key:   aws.String("env"),
+						Value: aws.String("banana"),
+					}},
+				}},
+			}, {
+				LaunchTemplates: []*awsec2.LaunchTemplate{{
+					LaunchTemplateId:   aws.String("lt-2"),
+					LaunchTemplateName: aws.String("other-banana-template"),
+				}},
+			}}
+			filter = "banana"
+		})
+
+		It("returns a list of launch templates from every page to delete", func() {
+			items, err := launchTemplates.List(filter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DescribeLaunchTemplatesPagesCall.CallCount).To(Equal(1))
+
+			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(2))
+			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("EC2 Launch Template"))
+			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("other-banana-template"))
+
+			Expect(items).To(HaveLen(2))
+		})
+
+		Context("when the launch template name does not contain the filter", func() {
+			It("does not return it to the list", func() {
+				items, err := launchTemplates.List("kiwi")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+
+		Context("when the client fails to describe launch templates", func() {
+			BeforeEach(func() {
+				client.DescribeLaunchTemplatesPagesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := launchTemplates.List(filter)
+				Expect(err).To(MatchError("Describing EC2 Launch Templates: some error"))
+			})
+		})
+
+		Context("when the user responds no to the prompt", func() {
+			BeforeEach(func() {
+				logger.PromptWithDetailsCall.Returns.Proceed = false
+			})
+
+			It("does not return it to the list", func() {
+				items, err := launchTemplates.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(2))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+	})
+})
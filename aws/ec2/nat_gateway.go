@@ -1,12 +1,13 @@
 package ec2
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
-	"github.com/genevieve/leftovers/aws/common"
+	"github.com/genevieve/leftovers/common"
 )
 
 type NatGateway struct {
@@ -39,6 +40,10 @@ func NewNatGateway(client natGatewaysClient, logger logger, id *string, tags []*
 }
 
 func (n NatGateway) Delete() error {
+	return n.DeleteContext(context.Background())
+}
+
+func (n NatGateway) DeleteContext(ctx context.Context) error {
 	_, err := n.client.DeleteNatGateway(&awsec2.DeleteNatGatewayInput{NatGatewayId: n.id})
 	if err != nil {
 		return fmt.Errorf("Delete: %s", err)
@@ -46,9 +51,9 @@ func (n NatGateway) Delete() error {
 
 	refresh := natGatewayRefresh(n.client, n.id)
 
-	state := common.NewState(n.logger, refresh, []string{"deleting"}, []string{"deleted"})
+	poller := common.NewPoller(n.logger, refresh, []string{"deleting"}, []string{"deleted"})
 
-	_, err = state.Wait()
+	_, err = poller.Wait(ctx)
 	if err != nil {
 		return fmt.Errorf("Waiting for deletion: %s", err)
 	}
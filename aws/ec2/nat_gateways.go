@@ -1,6 +1,7 @@
 package ec2
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -11,6 +12,7 @@ import (
 
 type natGatewaysClient interface {
 	DescribeNatGateways(*awsec2.DescribeNatGatewaysInput) (*awsec2.DescribeNatGatewaysOutput, error)
+	DescribeNatGatewaysPages(*awsec2.DescribeNatGatewaysInput, func(*awsec2.DescribeNatGatewaysOutput, bool) bool) error
 	DeleteNatGateway(*awsec2.DeleteNatGatewayInput) (*awsec2.DeleteNatGatewayOutput, error)
 }
 
@@ -27,18 +29,22 @@ func NewNatGateways(client natGatewaysClient, logger logger) NatGateways {
 }
 
 func (n NatGateways) List(filter string) ([]common.Deletable, error) {
-	natGateways, err := n.client.DescribeNatGateways(&awsec2.DescribeNatGatewaysInput{
+	var natGateways []*awsec2.NatGateway
+	err := n.client.DescribeNatGatewaysPages(&awsec2.DescribeNatGatewaysInput{
 		Filter: []*awsec2.Filter{{
 			Name:   aws.String("state"),
 			Values: []*string{aws.String("pending"), aws.String("available")},
 		}},
+	}, func(page *awsec2.DescribeNatGatewaysOutput, lastPage bool) bool {
+		natGateways = append(natGateways, page.NatGateways...)
+		return true
 	})
 	if err != nil {
 		return nil, fmt.Errorf("Describing EC2 Nat Gateways: %s", err)
 	}
 
 	var resources []common.Deletable
-	for _, g := range natGateways.NatGateways {
+	for _, g := range natGateways {
 		r := NewNatGateway(n.client, n.logger, g.NatGatewayId, g.Tags)
 
 		if !strings.Contains(r.Name(), filter) {
@@ -59,3 +65,34 @@ func (n NatGateways) List(filter string) ([]common.Deletable, error) {
 func (n NatGateways) Type() string {
 	return "ec2-nat-gateway"
 }
+
+// Delete deletes the nat gateways in the vpc and waits for them to be deleted.
+func (n NatGateways) Delete(ctx context.Context, vpcId string) error {
+	var natGateways []*awsec2.NatGateway
+	err := n.client.DescribeNatGatewaysPages(&awsec2.DescribeNatGatewaysInput{
+		Filter: []*awsec2.Filter{{
+			Name:   aws.String("vpc-id"),
+			Values: []*string{aws.String(vpcId)},
+		}, {
+			Name:   aws.String("state"),
+			Values: []*string{aws.String("pending"), aws.String("available")},
+		}},
+	}, func(page *awsec2.DescribeNatGatewaysOutput, lastPage bool) bool {
+		natGateways = append(natGateways, page.NatGateways...)
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("Describing EC2 Nat Gateways: %s", err)
+	}
+
+	for _, g := range natGateways {
+		err = NewNatGateway(n.client, n.logger, g.NatGatewayId, g.Tags).DeleteContext(ctx)
+		if err != nil {
+			return fmt.Errorf("Delete %s: %s", *g.NatGatewayId, err)
+		}
+
+		n.logger.Printf("[EC2 VPC: %s] Deleted nat gateway %s \n", vpcId, *g.NatGatewayId)
+	}
+
+	return nil
+}
@@ -1,6 +1,8 @@
 package ec2_test
 
 import (
+	"context"
+
 	"errors"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -31,11 +33,11 @@ var _ = Describe("NatGateways", func() {
 
 		BeforeEach(func() {
 			logger.PromptWithDetailsCall.Returns.Proceed = true
-			client.DescribeNatGatewaysCall.Returns.Output = &awsec2.DescribeNatGatewaysOutput{
+			client.DescribeNatGatewaysPagesCall.Returns.Pages = []*awsec2.DescribeNatGatewaysOutput{{
 				NatGateways: []*awsec2.NatGateway{{
 					NatGatewayId: aws.String("banana"),
 				}},
-			}
+			}}
 			filter = "ban"
 		})
 
@@ -43,7 +45,7 @@ var _ = Describe("NatGateways", func() {
 			items, err := natGateways.List(filter)
 			Expect(err).NotTo(HaveOccurred())
 
-			Expect(client.DescribeNatGatewaysCall.CallCount).To(Equal(1))
+			Expect(client.DescribeNatGatewaysPagesCall.CallCount).To(Equal(1))
 			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
 			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("EC2 Nat Gateway"))
 			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("banana"))
@@ -53,7 +55,7 @@ var _ = Describe("NatGateways", func() {
 
 		Context("when the client fails to list resources", func() {
 			BeforeEach(func() {
-				client.DescribeNatGatewaysCall.Returns.Error = errors.New("some error")
+				client.DescribeNatGatewaysPagesCall.Returns.Error = errors.New("some error")
 			})
 
 			It("returns the error", func() {
@@ -86,4 +88,52 @@ var _ = Describe("NatGateways", func() {
 			})
 		})
 	})
+
+	Describe("Delete", func() {
+		BeforeEach(func() {
+			client.DescribeNatGatewaysPagesCall.Returns.Pages = []*awsec2.DescribeNatGatewaysOutput{{
+				NatGateways: []*awsec2.NatGateway{{
+					NatGatewayId: aws.String("the-nat-gateway"),
+				}},
+			}}
+			client.DescribeNatGatewaysCall.Returns.Output = &awsec2.DescribeNatGatewaysOutput{
+				NatGateways: []*awsec2.NatGateway{{State: aws.String("deleted")}},
+			}
+		})
+
+		It("deletes the nat gateways in the vpc", func() {
+			err := natGateways.Delete(context.Background(), "the-vpc-id")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DescribeNatGatewaysPagesCall.Receives.Input.Filter[0].Name).To(Equal(aws.String("vpc-id")))
+			Expect(client.DescribeNatGatewaysPagesCall.Receives.Input.Filter[0].Values).To(Equal([]*string{aws.String("the-vpc-id")}))
+
+			Expect(client.DeleteNatGatewayCall.CallCount).To(Equal(1))
+			Expect(client.DeleteNatGatewayCall.Receives.Input.NatGatewayId).To(Equal(aws.String("the-nat-gateway")))
+
+			Expect(logger.PrintfCall.Messages).To(ContainElement("[EC2 VPC: the-vpc-id] Deleted nat gateway the-nat-gateway \n"))
+		})
+
+		Context("when the client fails to describe nat gateways", func() {
+			BeforeEach(func() {
+				client.DescribeNatGatewaysPagesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				err := natGateways.Delete(context.Background(), "the-vpc-id")
+				Expect(err).To(MatchError("Describing EC2 Nat Gateways: some error"))
+			})
+		})
+
+		Context("when the client fails to delete a nat gateway", func() {
+			BeforeEach(func() {
+				client.DeleteNatGatewayCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				err := natGateways.Delete(context.Background(), "the-vpc-id")
+				Expect(err).To(MatchError("Delete the-nat-gateway: Delete: some error"))
+			})
+		})
+	})
 })
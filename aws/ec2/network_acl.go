@@ -0,0 +1,103 @@
+package ec2
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+)
+
+type NetworkAcl struct {
+	client       networkAclsClient
+	id           *string
+	vpcId        *string
+	associations []*awsec2.NetworkAclAssociation
+	identifier   string
+	rtype        string
+}
+
+func NewNetworkAcl(client networkAclsClient, id, vpcId *string, associations []*awsec2.NetworkAclAssociation, tags []*awsec2.Tag) NetworkAcl {
+	identifier := *id
+
+	var extra []string
+	for _, t := range tags {
+		extra = append(extra, fmt.Sprintf("%s:%s", *t.Key, *t.Value))
+	}
+
+	if len(extra) > 0 {
+		identifier = fmt.Sprintf("%s (%s)", *id, strings.Join(extra, ", "))
+	}
+
+	return NetworkAcl{
+		client:       client,
+		id:           id,
+		vpcId:        vpcId,
+		associations: associations,
+		identifier:   identifier,
+		rtype:        "EC2 Network ACL",
+	}
+}
+
+// Delete moves the subnets that are associated with the network acl
+// to the default network acl of the vpc, and deletes the network acl.
+func (n NetworkAcl) Delete() error {
+	if len(n.associations) > 0 {
+		defaultId, err := n.defaultAcl()
+		if err != nil {
+			return fmt.Errorf("Describe default network acl: %s", err)
+		}
+
+		for _, a := range n.associations {
+			_, err = n.client.ReplaceNetworkAclAssociation(&awsec2.ReplaceNetworkAclAssociationInput{
+				AssociationId: a.NetworkAclAssociationId,
+				NetworkAclId:  defaultId,
+			})
+			if err != nil {
+				return fmt.Errorf("Replace association of subnet %s: %s", *a.SubnetId, err)
+			}
+		}
+	}
+
+	_, err := n.client.DeleteNetworkAcl(&awsec2.DeleteNetworkAclInput{NetworkAclId: n.id})
+	if err != nil {
+		return fmt.Errorf("Delete: %s", err)
+	}
+
+	return nil
+}
+
+func (n NetworkAcl) Name() string {
+	return n.identifier
+}
+
+func (n NetworkAcl) Type() string {
+	return n.rtype
+}
+
+func (n NetworkAcl) defaultAcl() (*string, error) {
+	var id *string
+	err := n.client.DescribeNetworkAclsPages(&awsec2.DescribeNetworkAclsInput{
+		Filters: []*awsec2.Filter{{
+			Name:   aws.String("vpc-id"),
+			Values: []*string{n.vpcId},
+		}, {
+			Name:   aws.String("default"),
+			Values: []*string{aws.String("true")},
+		}},
+	}, func(page *awsec2.DescribeNetworkAclsOutput, lastPage bool) bool {
+		for _, a := range page.NetworkAcls {
+			id = a.NetworkAclId
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if id == nil {
+		return nil, fmt.Errorf("VPC %s has no default network acl", *n.vpcId)
+	}
+
+	return id, nil
+}
@@ -0,0 +1,122 @@
+package ec2_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/genevieve/leftovers/aws/ec2"
+	"github.com/genevieve/leftovers/aws/ec2/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NetworkAcl", func() {
+	var (
+		acl          ec2.NetworkAcl
+		client       *fakes.NetworkAclsClient
+		id           *string
+		vpcId        *string
+		associations []*awsec2.NetworkAclAssociation
+		tags         []*awsec2.Tag
+	)
+
+	BeforeEach(func() {
+		client = &fakes.NetworkAclsClient{}
+		id = aws.String("the-id")
+		vpcId = aws.String("the-vpc-id")
+		tags = []*awsec2.Tag{{Key: aws.String("the-key"), Value: aws.String("the-value")}}
+
+		acl = ec2.NewNetworkAcl(client, id, vpcId, associations, tags)
+	})
+
+	Describe("Delete", func() {
+		It("deletes the network acl", func() {
+			err := acl.Delete()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DescribeNetworkAclsPagesCall.CallCount).To(Equal(0))
+			Expect(client.ReplaceNetworkAclAssociationCall.CallCount).To(Equal(0))
+
+			Expect(client.DeleteNetworkAclCall.CallCount).To(Equal(1))
+			Expect(client.DeleteNetworkAclCall.Receives.Input.NetworkAclId).To(Equal(id))
+		})
+
+		Context("when subnets are associated with the network acl", func() {
+			BeforeEach(func() {
+				associations = []*awsec2.NetworkAclAssociation{{
+					NetworkAclAssociationId: aws.String("the-association-id"),
+					SubnetId:                aws.String("the-subnet-id"),
+				}}
+				client.DescribeNetworkAclsPagesCall.Returns.Pages = []*awsec2.DescribeNetworkAclsOutput{{
+					NetworkAcls: []*awsec2.NetworkAcl{{NetworkAclId: aws.String("the-default-id")}},
+				}}
+
+				acl = ec2.NewNetworkAcl(client, id, vpcId, associations, tags)
+			})
+
+			AfterEach(func() {
+				associations = nil
+			})
+
+			It("associates them with the default network acl of the vpc first", func() {
+				err := acl.Delete()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(client.DescribeNetworkAclsPagesCall.Receives.Input.Filters[0].Values).To(Equal([]*string{vpcId}))
+				Expect(client.DescribeNetworkAclsPagesCall.Receives.Input.Filters[1].Name).To(Equal(aws.String("default")))
+
+				Expect(client.ReplaceNetworkAclAssociationCall.CallCount).To(Equal(1))
+				Expect(client.ReplaceNetworkAclAssociationCall.Receives.Input.AssociationId).To(Equal(aws.String("the-association-id")))
+				Expect(client.ReplaceNetworkAclAssociationCall.Receives.Input.NetworkAclId).To(Equal(aws.String("the-default-id")))
+
+				Expect(client.DeleteNetworkAclCall.CallCount).To(Equal(1))
+			})
+
+			Context("when the client fails to describe the default network acl", func() {
+				BeforeEach(func() {
+					client.DescribeNetworkAclsPagesCall.Returns.Error = errors.New("banana")
+				})
+
+				It("returns the error", func() {
+					err := acl.Delete()
+					Expect(err).To(MatchError("Describe default network acl: banana"))
+				})
+			})
+
+			Context("when the client fails to replace an association", func() {
+				BeforeEach(func() {
+					client.ReplaceNetworkAclAssociationCall.Returns.Error = errors.New("banana")
+				})
+
+				It("returns the error", func() {
+					err := acl.Delete()
+					Expect(err).To(MatchError("Replace association of subnet the-subnet-id: banana"))
+				})
+			})
+		})
+
+		Context("when the client fails", func() {
+			BeforeEach(func() {
+				client.DeleteNetworkAclCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := acl.Delete()
+				Expect(err).To(MatchError("Delete: banana"))
+			})
+		})
+	})
+
+	Describe("Name", func() {
+		It("returns the identifier", func() {
+			Expect(acl.Name()).To(Equal("the-id (the-key:the-value)"))
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(acl.Type()).To(Equal("EC2 Network ACL"))
+		})
+	})
+})
@@ -0,0 +1,104 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/genevieve/leftovers/common"
+)
+
+type networkAclsClient interface {
+	DescribeNetworkAclsPages(*awsec2.DescribeNetworkAclsInput, func(*awsec2.DescribeNetworkAclsOutput, bool) bool) error
+	ReplaceNetworkAclAssociation(*awsec2.ReplaceNetworkAclAssociationInput) (*awsec2.ReplaceNetworkAclAssociationOutput, error)
+	DeleteNetworkAcl(*awsec2.DeleteNetworkAclInput) (*awsec2.DeleteNetworkAclOutput, error)
+}
+
+type NetworkAcls struct {
+	client networkAclsClient
+	logger logger
+}
+
+func NewNetworkAcls(client networkAclsClient, logger logger) NetworkAcls {
+	return NetworkAcls{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (n NetworkAcls) List(filter string) ([]common.Deletable, error) {
+	acls, err := n.describe(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []common.Deletable
+	for _, a := range acls {
+		r := NewNetworkAcl(n.client, a.NetworkAclId, a.VpcId, a.Associations, a.Tags)
+
+		if !strings.Contains(r.Name(), filter) {
+			continue
+		}
+
+		proceed := n.logger.PromptWithDetails(r.Type(), r.Name())
+		if !proceed {
+			continue
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func (n NetworkAcls) Type() string {
+	return "ec2-network-acl"
+}
+
+// Delete deletes the network acls in the vpc,
+// except for its default network acl.
+func (n NetworkAcls) Delete(ctx context.Context, vpcId string) error {
+	acls, err := n.describe(&awsec2.Filter{
+		Name:   aws.String("vpc-id"),
+		Values: []*string{aws.String(vpcId)},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, a := range acls {
+		err = NewNetworkAcl(n.client, a.NetworkAclId, a.VpcId, a.Associations, a.Tags).Delete()
+		if err != nil {
+			return fmt.Errorf("Delete %s: %s", *a.NetworkAclId, err)
+		}
+
+		n.logger.Printf("[EC2 VPC: %s] Deleted network acl %s \n", vpcId, *a.NetworkAclId)
+	}
+
+	return nil
+}
+
+// describe returns the network acls that match the filter,
+// if any, and are not the default network acl of their vpc.
+func (n NetworkAcls) describe(filter *awsec2.Filter) ([]*awsec2.NetworkAcl, error) {
+	filters := []*awsec2.Filter{{
+		Name:   aws.String("default"),
+		Values: []*string{aws.String("false")},
+	}}
+	if filter != nil {
+		filters = append(filters, filter)
+	}
+
+	var acls []*awsec2.NetworkAcl
+	err := n.client.DescribeNetworkAclsPages(&awsec2.DescribeNetworkAclsInput{Filters: filters}, func(page *awsec2.DescribeNetworkAclsOutput, lastPage bool) bool {
+		acls = append(acls, page.NetworkAcls...)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Describe EC2 Network ACLs: %s", err)
+	}
+
+	return acls, nil
+}
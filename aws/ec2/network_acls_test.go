@@ -0,0 +1,139 @@
+package ec2_test
+
+import (
+	"context"
+
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/genevieve/leftovers/aws/ec2"
+	"github.com/genevieve/leftovers/aws/ec2/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NetworkAcls", func() {
+	var (
+		client *fakes.NetworkAclsClient
+		logger *fakes.Logger
+
+		acls ec2.NetworkAcls
+	)
+
+	BeforeEach(func() {
+		client = &fakes.NetworkAclsClient{}
+		logger = &fakes.Logger{}
+		logger.PromptWithDetailsCall.Returns.Proceed = true
+
+		client.DescribeNetworkAclsPagesCall.Returns.Pages = []*awsec2.DescribeNetworkAclsOutput{{
+			NetworkAcls: []*awsec2.NetworkAcl{{
+				NetworkAclId: aws.String("acl-banana"),
+				VpcId:        aws.String("the-vpc-id"),
+			}},
+		}}
+
+		acls = ec2.NewNetworkAcls(client, logger)
+	})
+
+	Describe("List", func() {
+		var filter string
+
+		BeforeEach(func() {
+			filter = "banana"
+		})
+
+		It("returns a list of network acls that are not the default of their vpc", func() {
+			items, err := acls.List(filter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DescribeNetworkAclsPagesCall.CallCount).To(Equal(1))
+			Expect(client.DescribeNetworkAclsPagesCall.Receives.Input.Filters[0].Name).To(Equal(aws.String("default")))
+			Expect(client.DescribeNetworkAclsPagesCall.Receives.Input.Filters[0].Values).To(Equal([]*string{aws.String("false")}))
+
+			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("EC2 Network ACL"))
+			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("acl-banana"))
+
+			Expect(items).To(HaveLen(1))
+		})
+
+		Context("when the network acl name does not contain the filter", func() {
+			It("does not return it in the list", func() {
+				items, err := acls.List("kiwi")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+
+		Context("when the client fails to describe network acls", func() {
+			BeforeEach(func() {
+				client.DescribeNetworkAclsPagesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := acls.List(filter)
+				Expect(err).To(MatchError("Describe EC2 Network ACLs: some error"))
+			})
+		})
+
+		Context("when the user responds no to the prompt", func() {
+			BeforeEach(func() {
+				logger.PromptWithDetailsCall.Returns.Proceed = false
+			})
+
+			It("does not return it in the list", func() {
+				items, err := acls.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+	})
+
+	Describe("Delete", func() {
+		It("deletes the network acls in the vpc", func() {
+			err := acls.Delete(context.Background(), "the-vpc-id")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DescribeNetworkAclsPagesCall.Receives.Input.Filters[1].Name).To(Equal(aws.String("vpc-id")))
+			Expect(client.DescribeNetworkAclsPagesCall.Receives.Input.Filters[1].Values).To(Equal([]*string{aws.String("the-vpc-id")}))
+
+			Expect(client.DeleteNetworkAclCall.CallCount).To(Equal(1))
+			Expect(client.DeleteNetworkAclCall.Receives.Input.NetworkAclId).To(Equal(aws.String("acl-banana")))
+
+			Expect(logger.PrintfCall.Messages).To(ContainElement("[EC2 VPC: the-vpc-id] Deleted network acl acl-banana \n"))
+		})
+
+		Context("when the client fails to describe network acls", func() {
+			BeforeEach(func() {
+				client.DescribeNetworkAclsPagesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				err := acls.Delete(context.Background(), "the-vpc-id")
+				Expect(err).To(MatchError("Describe EC2 Network ACLs: some error"))
+			})
+		})
+
+		Context("when the client fails to delete a network acl", func() {
+			BeforeEach(func() {
+				client.DeleteNetworkAclCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				err := acls.Delete(context.Background(), "the-vpc-id")
+				Expect(err).To(MatchError("Delete acl-banana: Delete: some error"))
+			})
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(acls.Type()).To(Equal("ec2-network-acl"))
+		})
+	})
+})
@@ -9,7 +9,7 @@ import (
 )
 
 type networkInterfacesClient interface {
-	DescribeNetworkInterfaces(*awsec2.DescribeNetworkInterfacesInput) (*awsec2.DescribeNetworkInterfacesOutput, error)
+	DescribeNetworkInterfacesPages(*awsec2.DescribeNetworkInterfacesInput, func(*awsec2.DescribeNetworkInterfacesOutput, bool) bool) error
 	DeleteNetworkInterface(*awsec2.DeleteNetworkInterfaceInput) (*awsec2.DeleteNetworkInterfaceOutput, error)
 }
 
@@ -26,13 +26,17 @@ func NewNetworkInterfaces(client networkInterfacesClient, logger logger) Network
 }
 
 func (e NetworkInterfaces) List(filter string) ([]common.Deletable, error) {
-	networkInterfaces, err := e.client.DescribeNetworkInterfaces(&awsec2.DescribeNetworkInterfacesInput{})
+	var networkInterfaces []*awsec2.NetworkInterface
+	err := e.client.DescribeNetworkInterfacesPages(&awsec2.DescribeNetworkInterfacesInput{}, func(page *awsec2.DescribeNetworkInterfacesOutput, lastPage bool) bool {
+		networkInterfaces = append(networkInterfaces, page.NetworkInterfaces...)
+		return true
+	})
 	if err != nil {
 		return nil, fmt.Errorf("Describing EC2 Network Interfaces: %s", err)
 	}
 
 	var resources []common.Deletable
-	for _, i := range networkInterfaces.NetworkInterfaces {
+	for _, i := range networkInterfaces {
 		r := NewNetworkInterface(e.client, i.NetworkInterfaceId, i.TagSet)
 
 		if !strings.Contains(r.Name(), filter) {
@@ -31,11 +31,11 @@ var _ = Describe("NetworkInterfaces", func() {
 
 		BeforeEach(func() {
 			logger.PromptWithDetailsCall.Returns.Proceed = true
-			client.DescribeNetworkInterfacesCall.Returns.Output = &awsec2.DescribeNetworkInterfacesOutput{
+			client.DescribeNetworkInterfacesPagesCall.Returns.Pages = []*awsec2.DescribeNetworkInterfacesOutput{{
 				NetworkInterfaces: []*awsec2.NetworkInterface{{
 					NetworkInterfaceId: aws.String("banana"),
 				}},
-			}
+			}}
 			filter = "ban"
 		})
 
@@ -43,7 +43,7 @@ var _ = Describe("NetworkInterfaces", func() {
 			items, err := networkInterfaces.List(filter)
 			Expect(err).NotTo(HaveOccurred())
 
-			Expect(client.DescribeNetworkInterfacesCall.CallCount).To(Equal(1))
+			Expect(client.DescribeNetworkInterfacesPagesCall.CallCount).To(Equal(1))
 			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
 			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("EC2 Network Interface"))
 			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("banana"))
@@ -53,7 +53,7 @@ var _ = Describe("NetworkInterfaces", func() {
 
 		Context("when the client fails to list network interfaces", func() {
 			BeforeEach(func() {
-				client.DescribeNetworkInterfacesCall.Returns.Error = errors.New("some error")
+				client.DescribeNetworkInterfacesPagesCall.Returns.Error = errors.New("some error")
 			})
 
 			It("returns the error", func() {
@@ -67,7 +67,7 @@ var _ = Describe("NetworkInterfaces", func() {
 				items, err := networkInterfaces.List("kiwi")
 				Expect(err).NotTo(HaveOccurred())
 
-				Expect(client.DescribeNetworkInterfacesCall.CallCount).To(Equal(1))
+				Expect(client.DescribeNetworkInterfacesPagesCall.CallCount).To(Equal(1))
 				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
 				Expect(items).To(HaveLen(0))
 			})
@@ -75,7 +75,7 @@ var _ = Describe("NetworkInterfaces", func() {
 
 		Context("when the network interface has tags", func() {
 			BeforeEach(func() {
-				client.DescribeNetworkInterfacesCall.Returns.Output = &awsec2.DescribeNetworkInterfacesOutput{
+				client.DescribeNetworkInterfacesPagesCall.Returns.Pages = []*awsec2.DescribeNetworkInterfacesOutput{{
 					NetworkInterfaces: []*awsec2.NetworkInterface{{
 						NetworkInterfaceId: aws.String("banana"),
 						TagSet: []*awsec2.Tag{{
@@ -83,7 +83,7 @@ var _ = Describe("NetworkInterfaces", func() {
 							Value: aws.String("the-value"),
 						}},
 					}},
-				}
+				}}
 			})
 
 			It("uses them in the prompt", func() {
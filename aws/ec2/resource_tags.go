@@ -22,7 +22,8 @@ func NewResourceTags(client tagsClient) ResourceTags {
 }
 
 func (r ResourceTags) Delete(resourceType, resourceId string) error {
-	output, err := r.client.DescribeTags(&awsec2.DescribeTagsInput{
+	var tags []*awsec2.TagDescription
+	err := r.client.DescribeTagsPages(&awsec2.DescribeTagsInput{
 		Filters: []*awsec2.Filter{{
 			Name:   aws.String("resource-type"),
 			Values: []*string{aws.String(resourceType)},
@@ -30,12 +31,15 @@ func (r ResourceTags) Delete(resourceType, resourceId string) error {
 			Name:   aws.String("resource-id"),
 			Values: []*string{aws.String(resourceId)},
 		}},
+	}, func(page *awsec2.DescribeTagsOutput, lastPage bool) bool {
+		tags = append(tags, page.Tags...)
+		return true
 	})
 	if err != nil {
 		return fmt.Errorf("Describe tags: %s", err)
 	}
 
-	for _, t := range output.Tags {
+	for _, t := range tags {
 		_, err := r.client.DeleteTags(&awsec2.DeleteTagsInput{
 			Tags:      []*awsec2.Tag{{Key: t.Key, Value: t.Value}},
 			Resources: []*string{t.ResourceId},
@@ -24,24 +24,24 @@ var _ = Describe("ResourceTags", func() {
 
 	Describe("Delete", func() {
 		BeforeEach(func() {
-			client.DescribeTagsCall.Returns.Output = &awsec2.DescribeTagsOutput{
+			client.DescribeTagsPagesCall.Returns.Pages = []*awsec2.DescribeTagsOutput{{
 				Tags: []*awsec2.TagDescription{{
 					ResourceId: aws.String("the-resource-id"),
 					Key:        aws.String("the-key"),
 					Value:      aws.String("the-value"),
 				}},
-			}
+			}}
 		})
 
 		It("deletes the resource tags", func() {
 			err := resourceTags.Delete("vpc", "vpc-id")
 			Expect(err).NotTo(HaveOccurred())
 
-			Expect(client.DescribeTagsCall.CallCount).To(Equal(1))
-			Expect(client.DescribeTagsCall.Receives.Input.Filters[0].Name).To(Equal(aws.String("resource-type")))
-			Expect(client.DescribeTagsCall.Receives.Input.Filters[0].Values[0]).To(Equal(aws.String("vpc")))
-			Expect(client.DescribeTagsCall.Receives.Input.Filters[1].Name).To(Equal(aws.String("resource-id")))
-			Expect(client.DescribeTagsCall.Receives.Input.Filters[1].Values[0]).To(Equal(aws.String("vpc-id")))
+			Expect(client.DescribeTagsPagesCall.CallCount).To(Equal(1))
+			Expect(client.DescribeTagsPagesCall.Receives.Input.Filters[0].Name).To(Equal(aws.String("resource-type")))
+			Expect(client.DescribeTagsPagesCall.Receives.Input.Filters[0].Values[0]).To(Equal(aws.String("vpc")))
+			Expect(client.DescribeTagsPagesCall.Receives.Input.Filters[1].Name).To(Equal(aws.String("resource-id")))
+			Expect(client.DescribeTagsPagesCall.Receives.Input.Filters[1].Values[0]).To(Equal(aws.String("vpc-id")))
 
 			Expect(client.DeleteTagsCall.CallCount).To(Equal(1))
 			Expect(client.DeleteTagsCall.Receives.Input.Tags[0].Key).To(Equal(aws.String("the-key")))
@@ -49,9 +49,30 @@ var _ = Describe("ResourceTags", func() {
 			Expect(client.DeleteTagsCall.Receives.Input.Resources[0]).To(Equal(aws.String("the-resource-id")))
 		})
 
+		Context("when there is more than one page of tags", func() {
+			BeforeEach(func() {
+				client.DescribeTagsPagesCall.Returns.Pages = append(client.DescribeTagsPagesCall.Returns.Pages, &awsec2.DescribeTagsOutput{
+					Tags: []*awsec2.TagDescription{{
+						ResourceId: aws.String("the-resource-id"),
+						Key:        aws.String("other-key"),
+						Value:      aws.String("other-value"),
+					}},
+				})
+			})
+
+			It("deletes the tags from every page", func() {
+				err := resourceTags.Delete("vpc", "vpc-id")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(client.DescribeTagsPagesCall.CallCount).To(Equal(1))
+				Expect(client.DeleteTagsCall.CallCount).To(Equal(2))
+				Expect(client.DeleteTagsCall.Receives.Input.Tags[0].Key).To(Equal(aws.String("other-key")))
+			})
+		})
+
 		Context("when the client fails to describe tags", func() {
 			BeforeEach(func() {
-				client.DescribeTagsCall.Returns.Error = errors.New("some error")
+				client.DescribeTagsPagesCall.Returns.Error = errors.New("some error")
 			})
 
 			It("returns the error and does not try deleting them", func() {
@@ -8,7 +8,7 @@ import (
 )
 
 type routesClient interface {
-	DescribeRouteTables(*awsec2.DescribeRouteTablesInput) (*awsec2.DescribeRouteTablesOutput, error)
+	DescribeRouteTablesPages(*awsec2.DescribeRouteTablesInput, func(*awsec2.DescribeRouteTablesOutput, bool) bool) error
 	DisassociateRouteTable(*awsec2.DisassociateRouteTableInput) (*awsec2.DisassociateRouteTableOutput, error)
 	DeleteRouteTable(*awsec2.DeleteRouteTableInput) (*awsec2.DeleteRouteTableOutput, error)
 }
@@ -32,7 +32,8 @@ func NewRouteTables(client routesClient, logger logger, resourceTags resourceTag
 }
 
 func (u RouteTables) Delete(vpcId string) error {
-	routeTables, err := u.client.DescribeRouteTables(&awsec2.DescribeRouteTablesInput{
+	var routeTables []*awsec2.RouteTable
+	err := u.client.DescribeRouteTablesPages(&awsec2.DescribeRouteTablesInput{
 		Filters: []*awsec2.Filter{{
 			Name:   aws.String("vpc-id"),
 			Values: []*string{aws.String(vpcId)},
@@ -40,12 +41,15 @@ func (u RouteTables) Delete(vpcId string) error {
 			Name:   aws.String("association.main"),
 			Values: []*string{aws.String("false")},
 		}},
+	}, func(page *awsec2.DescribeRouteTablesOutput, lastPage bool) bool {
+		routeTables = append(routeTables, page.RouteTables...)
+		return true
 	})
 	if err != nil {
 		return fmt.Errorf("Describe EC2 Route Tables: %s", err)
 	}
 
-	for _, r := range routeTables.RouteTables {
+	for _, r := range routeTables {
 		n := *r.RouteTableId
 
 		for _, a := range r.Associations {
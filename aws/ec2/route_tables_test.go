@@ -30,23 +30,23 @@ var _ = Describe("RouteTables", func() {
 
 	Describe("Delete", func() {
 		BeforeEach(func() {
-			client.DescribeRouteTablesCall.Returns.Output = &awsec2.DescribeRouteTablesOutput{
+			client.DescribeRouteTablesPagesCall.Returns.Pages = []*awsec2.DescribeRouteTablesOutput{{
 				RouteTables: []*awsec2.RouteTable{{
 					RouteTableId: aws.String("the-route-table-id"),
 					VpcId:        aws.String("the-vpc-id"),
 				}},
-			}
+			}}
 		})
 
 		It("detaches and deletes the route tables", func() {
 			err := routeTables.Delete("the-vpc-id")
 			Expect(err).NotTo(HaveOccurred())
 
-			Expect(client.DescribeRouteTablesCall.CallCount).To(Equal(1))
-			Expect(client.DescribeRouteTablesCall.Receives.Input.Filters[0].Name).To(Equal(aws.String("vpc-id")))
-			Expect(client.DescribeRouteTablesCall.Receives.Input.Filters[0].Values[0]).To(Equal(aws.String("the-vpc-id")))
-			Expect(client.DescribeRouteTablesCall.Receives.Input.Filters[1].Name).To(Equal(aws.String("association.main")))
-			Expect(client.DescribeRouteTablesCall.Receives.Input.Filters[1].Values[0]).To(Equal(aws.String("false")))
+			Expect(client.DescribeRouteTablesPagesCall.CallCount).To(Equal(1))
+			Expect(client.DescribeRouteTablesPagesCall.Receives.Input.Filters[0].Name).To(Equal(aws.String("vpc-id")))
+			Expect(client.DescribeRouteTablesPagesCall.Receives.Input.Filters[0].Values[0]).To(Equal(aws.String("the-vpc-id")))
+			Expect(client.DescribeRouteTablesPagesCall.Receives.Input.Filters[1].Name).To(Equal(aws.String("association.main")))
+			Expect(client.DescribeRouteTablesPagesCall.Receives.Input.Filters[1].Values[0]).To(Equal(aws.String("false")))
 
 			Expect(client.DeleteRouteTableCall.CallCount).To(Equal(1))
 			Expect(client.DeleteRouteTableCall.Receives.Input.RouteTableId).To(Equal(aws.String("the-route-table-id")))
@@ -63,7 +63,7 @@ var _ = Describe("RouteTables", func() {
 
 		Context("when the client fails to describe route tables", func() {
 			BeforeEach(func() {
-				client.DescribeRouteTablesCall.Returns.Error = errors.New("some error")
+				client.DescribeRouteTablesPagesCall.Returns.Error = errors.New("some error")
 			})
 
 			It("returns the error and does not try deleting them", func() {
@@ -76,7 +76,7 @@ var _ = Describe("RouteTables", func() {
 
 		Context("when the route table has an association id", func() {
 			BeforeEach(func() {
-				client.DescribeRouteTablesCall.Returns.Output = &awsec2.DescribeRouteTablesOutput{
+				client.DescribeRouteTablesPagesCall.Returns.Pages = []*awsec2.DescribeRouteTablesOutput{{
 					RouteTables: []*awsec2.RouteTable{{
 						RouteTableId: aws.String("the-route-table-id"),
 						VpcId:        aws.String("the-vpc-id"),
@@ -87,14 +87,14 @@ var _ = Describe("RouteTables", func() {
 							SubnetId:                aws.String("the-subnet-id"),
 						}},
 					}},
-				}
+				}}
 			})
 
 			It("disassociates it from the subnet before trying to delete it", func() {
 				err := routeTables.Delete("the-vpc-id")
 				Expect(err).NotTo(HaveOccurred())
 
-				Expect(client.DescribeRouteTablesCall.CallCount).To(Equal(1))
+				Expect(client.DescribeRouteTablesPagesCall.CallCount).To(Equal(1))
 				Expect(client.DisassociateRouteTableCall.CallCount).To(Equal(1))
 				Expect(client.DisassociateRouteTableCall.Receives.Input.AssociationId).To(Equal(aws.String("the-association-id")))
 				Expect(client.DeleteRouteTableCall.CallCount).To(Equal(1))
@@ -9,7 +9,7 @@ import (
 )
 
 type securityGroupsClient interface {
-	DescribeSecurityGroups(*awsec2.DescribeSecurityGroupsInput) (*awsec2.DescribeSecurityGroupsOutput, error)
+	DescribeSecurityGroupsPages(*awsec2.DescribeSecurityGroupsInput, func(*awsec2.DescribeSecurityGroupsOutput, bool) bool) error
 	RevokeSecurityGroupIngress(*awsec2.RevokeSecurityGroupIngressInput) (*awsec2.RevokeSecurityGroupIngressOutput, error)
 	RevokeSecurityGroupEgress(*awsec2.RevokeSecurityGroupEgressInput) (*awsec2.RevokeSecurityGroupEgressOutput, error)
 	DeleteSecurityGroup(*awsec2.DeleteSecurityGroupInput) (*awsec2.DeleteSecurityGroupOutput, error)
@@ -30,13 +30,17 @@ func NewSecurityGroups(client securityGroupsClient, logger logger, resourceTags
 }
 
 func (s SecurityGroups) List(filter string) ([]common.Deletable, error) {
-	output, err := s.client.DescribeSecurityGroups(&awsec2.DescribeSecurityGroupsInput{})
+	var securityGroups []*awsec2.SecurityGroup
+	err := s.client.DescribeSecurityGroupsPages(&awsec2.DescribeSecurityGroupsInput{}, func(page *awsec2.DescribeSecurityGroupsOutput, lastPage bool) bool {
+		securityGroups = append(securityGroups, page.SecurityGroups...)
+		return true
+	})
 	if err != nil {
 		return nil, fmt.Errorf("Describe EC2 Security Groups: %s", err)
 	}
 
 	var resources []common.Deletable
-	for _, sg := range output.SecurityGroups {
+	for _, sg := range securityGroups {
 		if *sg.GroupName == "default" {
 			continue
 		}
@@ -33,12 +33,12 @@ var _ = Describe("SecurityGroups", func() {
 		var filter string
 
 		BeforeEach(func() {
-			client.DescribeSecurityGroupsCall.Returns.Output = &awsec2.DescribeSecurityGroupsOutput{
+			client.DescribeSecurityGroupsPagesCall.Returns.Pages = []*awsec2.DescribeSecurityGroupsOutput{{
 				SecurityGroups: []*awsec2.SecurityGroup{{
 					GroupName: aws.String("banana-group"),
 					GroupId:   aws.String("the-group-id"),
 				}},
-			}
+			}}
 			filter = "banana"
 		})
 
@@ -46,7 +46,7 @@ var _ = Describe("SecurityGroups", func() {
 			items, err := securityGroups.List(filter)
 			Expect(err).NotTo(HaveOccurred())
 
-			Expect(client.DescribeSecurityGroupsCall.CallCount).To(Equal(1))
+			Expect(client.DescribeSecurityGroupsPagesCall.CallCount).To(Equal(1))
 			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
 			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("EC2 Security Group"))
 			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("banana-group"))
@@ -56,7 +56,7 @@ var _ = Describe("SecurityGroups", func() {
 
 		Context("when the client fails to describe security groups", func() {
 			BeforeEach(func() {
-				client.DescribeSecurityGroupsCall.Returns.Error = errors.New("some error")
+				client.DescribeSecurityGroupsPagesCall.Returns.Error = errors.New("some error")
 			})
 
 			It("returns the error", func() {
@@ -70,7 +70,7 @@ var _ = Describe("SecurityGroups", func() {
 				items, err := securityGroups.List("kiwi")
 				Expect(err).NotTo(HaveOccurred())
 
-				Expect(client.DescribeSecurityGroupsCall.CallCount).To(Equal(1))
+				Expect(client.DescribeSecurityGroupsPagesCall.CallCount).To(Equal(1))
 				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
 				Expect(items).To(HaveLen(0))
 			})
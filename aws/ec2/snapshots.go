@@ -11,7 +11,7 @@ import (
 )
 
 type snapshotsClient interface {
-	DescribeSnapshots(*awsec2.DescribeSnapshotsInput) (*awsec2.DescribeSnapshotsOutput, error)
+	DescribeSnapshotsPages(*awsec2.DescribeSnapshotsInput, func(*awsec2.DescribeSnapshotsOutput, bool) bool) error
 	DeleteSnapshot(*awsec2.DeleteSnapshotInput) (*awsec2.DeleteSnapshotOutput, error)
 }
 
@@ -35,19 +35,23 @@ func (s Snapshots) List(filter string) ([]common.Deletable, error) {
 		return nil, fmt.Errorf("Get caller identity: %s", err)
 	}
 
-	output, err := s.client.DescribeSnapshots(&awsec2.DescribeSnapshotsInput{
+	var snapshots []*awsec2.Snapshot
+	err = s.client.DescribeSnapshotsPages(&awsec2.DescribeSnapshotsInput{
 		OwnerIds: []*string{caller.Account},
 		Filters: []*awsec2.Filter{{
 			Name:   aws.String("status"),
 			Values: []*string{aws.String("completed")},
 		}},
+	}, func(page *awsec2.DescribeSnapshotsOutput, lastPage bool) bool {
+		snapshots = append(snapshots, page.Snapshots...)
+		return true
 	})
 	if err != nil {
 		return nil, fmt.Errorf("Describe EC2 Snapshots: %s", err)
 	}
 
 	var resources []common.Deletable
-	for _, snapshot := range output.Snapshots {
+	for _, snapshot := range snapshots {
 		r := NewSnapshot(s.client, snapshot.SnapshotId)
 
 		if !strings.Contains(r.Name(), filter) {
@@ -35,11 +35,11 @@ var _ = Describe("Snapshots", func() {
 
 		BeforeEach(func() {
 			filter = "snap"
-			client.DescribeSnapshotsCall.Returns.Output = &awsec2.DescribeSnapshotsOutput{
+			client.DescribeSnapshotsPagesCall.Returns.Pages = []*awsec2.DescribeSnapshotsOutput{{
 				Snapshots: []*awsec2.Snapshot{{
 					SnapshotId: aws.String("the-snapshot-id"),
 				}},
-			}
+			}}
 			stsClient.GetCallerIdentityCall.Returns.Output = &awssts.GetCallerIdentityOutput{
 				Account: aws.String("the-account-id"),
 			}
@@ -51,10 +51,10 @@ var _ = Describe("Snapshots", func() {
 
 			Expect(stsClient.GetCallerIdentityCall.CallCount).To(Equal(1))
 
-			Expect(client.DescribeSnapshotsCall.CallCount).To(Equal(1))
-			Expect(client.DescribeSnapshotsCall.Receives.Input.OwnerIds[0]).To(Equal(aws.String("the-account-id")))
-			Expect(client.DescribeSnapshotsCall.Receives.Input.Filters[0].Name).To(Equal(aws.String("status")))
-			Expect(client.DescribeSnapshotsCall.Receives.Input.Filters[0].Values[0]).To(Equal(aws.String("completed")))
+			Expect(client.DescribeSnapshotsPagesCall.CallCount).To(Equal(1))
+			Expect(client.DescribeSnapshotsPagesCall.Receives.Input.OwnerIds[0]).To(Equal(aws.String("the-account-id")))
+			Expect(client.DescribeSnapshotsPagesCall.Receives.Input.Filters[0].Name).To(Equal(aws.String("status")))
+			Expect(client.DescribeSnapshotsPagesCall.Receives.Input.Filters[0].Values[0]).To(Equal(aws.String("completed")))
 
 			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
 			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("EC2 Snapshot"))
@@ -76,7 +76,7 @@ var _ = Describe("Snapshots", func() {
 
 		Context("when the client fails to describe snapshots", func() {
 			BeforeEach(func() {
-				client.DescribeSnapshotsCall.Returns.Error = errors.New("some error")
+				client.DescribeSnapshotsPagesCall.Returns.Error = errors.New("some error")
 			})
 
 			It("returns the error", func() {
@@ -90,7 +90,7 @@ var _ = Describe("Snapshots", func() {
 				items, err := snapshots.List("kiwi")
 				Expect(err).NotTo(HaveOccurred())
 
-				Expect(client.DescribeSnapshotsCall.CallCount).To(Equal(1))
+				Expect(client.DescribeSnapshotsPagesCall.CallCount).To(Equal(1))
 				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
 
 				Expect(items).To(HaveLen(0))
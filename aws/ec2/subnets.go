@@ -8,7 +8,7 @@ import (
 )
 
 type subnetsClient interface {
-	DescribeSubnets(*awsec2.DescribeSubnetsInput) (*awsec2.DescribeSubnetsOutput, error)
+	DescribeSubnetsPages(*awsec2.DescribeSubnetsInput, func(*awsec2.DescribeSubnetsOutput, bool) bool) error
 	DeleteSubnet(*awsec2.DeleteSubnetInput) (*awsec2.DeleteSubnetOutput, error)
 }
 
@@ -31,17 +31,21 @@ func NewSubnets(client subnetsClient, logger logger, resourceTags resourceTags)
 }
 
 func (u Subnets) Delete(vpcId string) error {
-	subnets, err := u.client.DescribeSubnets(&awsec2.DescribeSubnetsInput{
+	var subnets []*awsec2.Subnet
+	err := u.client.DescribeSubnetsPages(&awsec2.DescribeSubnetsInput{
 		Filters: []*awsec2.Filter{{
 			Name:   aws.String("vpc-id"),
 			Values: []*string{aws.String(vpcId)},
 		}},
+	}, func(page *awsec2.DescribeSubnetsOutput, lastPage bool) bool {
+		subnets = append(subnets, page.Subnets...)
+		return true
 	})
 	if err != nil {
 		return fmt.Errorf("Describe EC2 Subnets: %s", err)
 	}
 
-	for _, s := range subnets.Subnets {
+	for _, s := range subnets {
 		n := *s.SubnetId
 
 		_, err = u.client.DeleteSubnet(&awsec2.DeleteSubnetInput{SubnetId: s.SubnetId})
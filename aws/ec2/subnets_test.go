@@ -30,21 +30,21 @@ var _ = Describe("Subnets", func() {
 
 	Describe("Delete", func() {
 		BeforeEach(func() {
-			client.DescribeSubnetsCall.Returns.Output = &awsec2.DescribeSubnetsOutput{
+			client.DescribeSubnetsPagesCall.Returns.Pages = []*awsec2.DescribeSubnetsOutput{{
 				Subnets: []*awsec2.Subnet{{
 					SubnetId: aws.String("the-subnet-id"),
 					VpcId:    aws.String("the-vpc-id"),
 				}},
-			}
+			}}
 		})
 
 		It("deletes the subnets", func() {
 			err := subnets.Delete("the-vpc-id")
 			Expect(err).NotTo(HaveOccurred())
 
-			Expect(client.DescribeSubnetsCall.CallCount).To(Equal(1))
-			Expect(client.DescribeSubnetsCall.Receives.Input.Filters[0].Name).To(Equal(aws.String("vpc-id")))
-			Expect(client.DescribeSubnetsCall.Receives.Input.Filters[0].Values[0]).To(Equal(aws.String("the-vpc-id")))
+			Expect(client.DescribeSubnetsPagesCall.CallCount).To(Equal(1))
+			Expect(client.DescribeSubnetsPagesCall.Receives.Input.Filters[0].Name).To(Equal(aws.String("vpc-id")))
+			Expect(client.DescribeSubnetsPagesCall.Receives.Input.Filters[0].Values[0]).To(Equal(aws.String("the-vpc-id")))
 
 			Expect(client.DeleteSubnetCall.CallCount).To(Equal(1))
 			Expect(client.DeleteSubnetCall.Receives.Input.SubnetId).To(Equal(aws.String("the-subnet-id")))
@@ -60,7 +60,7 @@ var _ = Describe("Subnets", func() {
 
 		Context("when the client fails to describe subnets", func() {
 			BeforeEach(func() {
-				client.DescribeSubnetsCall.Returns.Error = errors.New("some error")
+				client.DescribeSubnetsPagesCall.Returns.Error = errors.New("some error")
 			})
 
 			It("returns the error and does not try deleting them", func() {
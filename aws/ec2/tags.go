@@ -9,7 +9,7 @@ import (
 )
 
 type tagsClient interface {
-	DescribeTags(*awsec2.DescribeTagsInput) (*awsec2.DescribeTagsOutput, error)
+	DescribeTagsPages(*awsec2.DescribeTagsInput, func(*awsec2.DescribeTagsOutput, bool) bool) error
 	DeleteTags(*awsec2.DeleteTagsInput) (*awsec2.DeleteTagsOutput, error)
 }
 
@@ -26,13 +26,17 @@ func NewTags(client tagsClient, logger logger) Tags {
 }
 
 func (a Tags) List(filter string) ([]common.Deletable, error) {
-	output, err := a.client.DescribeTags(&awsec2.DescribeTagsInput{})
+	var tags []*awsec2.TagDescription
+	err := a.client.DescribeTagsPages(&awsec2.DescribeTagsInput{}, func(page *awsec2.DescribeTagsOutput, lastPage bool) bool {
+		tags = append(tags, page.Tags...)
+		return true
+	})
 	if err != nil {
 		return nil, fmt.Errorf("Describe EC2 Tags: %s", err)
 	}
 
 	var resources []common.Deletable
-	for _, t := range output.Tags {
+	for _, t := range tags {
 		if *t.ResourceId != "" {
 			continue
 		}
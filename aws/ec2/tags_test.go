@@ -31,13 +31,13 @@ var _ = Describe("Tags", func() {
 
 		BeforeEach(func() {
 			logger.PromptWithDetailsCall.Returns.Proceed = true
-			client.DescribeTagsCall.Returns.Output = &awsec2.DescribeTagsOutput{
+			client.DescribeTagsPagesCall.Returns.Pages = []*awsec2.DescribeTagsOutput{{
 				Tags: []*awsec2.TagDescription{{
 					Key:        aws.String("the-key"),
 					Value:      aws.String("banana-tag"),
 					ResourceId: aws.String(""),
 				}},
-			}
+			}}
 			filter = "banana"
 		})
 
@@ -45,7 +45,7 @@ var _ = Describe("Tags", func() {
 			items, err := tags.List(filter)
 			Expect(err).NotTo(HaveOccurred())
 
-			Expect(client.DescribeTagsCall.CallCount).To(Equal(1))
+			Expect(client.DescribeTagsPagesCall.CallCount).To(Equal(1))
 			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
 			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("EC2 Tag"))
 			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("the-key:banana-tag"))
@@ -55,7 +55,7 @@ var _ = Describe("Tags", func() {
 
 		Context("when the client fails to list tags", func() {
 			BeforeEach(func() {
-				client.DescribeTagsCall.Returns.Error = errors.New("some error")
+				client.DescribeTagsPagesCall.Returns.Error = errors.New("some error")
 			})
 
 			It("returns the error", func() {
@@ -69,7 +69,7 @@ var _ = Describe("Tags", func() {
 				items, err := tags.List("kiwi")
 				Expect(err).NotTo(HaveOccurred())
 
-				Expect(client.DescribeTagsCall.CallCount).To(Equal(1))
+				Expect(client.DescribeTagsPagesCall.CallCount).To(Equal(1))
 				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
 				Expect(items).To(HaveLen(0))
 			})
@@ -77,20 +77,20 @@ var _ = Describe("Tags", func() {
 
 		Context("when the tag has a resource id", func() {
 			BeforeEach(func() {
-				client.DescribeTagsCall.Returns.Output = &awsec2.DescribeTagsOutput{
+				client.DescribeTagsPagesCall.Returns.Pages = []*awsec2.DescribeTagsOutput{{
 					Tags: []*awsec2.TagDescription{{
 						Key:        aws.String("the-key"),
 						Value:      aws.String("banana-tag"),
 						ResourceId: aws.String("banana"),
 					}},
-				}
+				}}
 			})
 
 			It("does not return it in the list", func() {
 				items, err := tags.List("banana")
 				Expect(err).NotTo(HaveOccurred())
 
-				Expect(client.DescribeTagsCall.CallCount).To(Equal(1))
+				Expect(client.DescribeTagsPagesCall.CallCount).To(Equal(1))
 				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
 				Expect(items).To(HaveLen(0))
 			})
@@ -0,0 +1,112 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/genevieve/leftovers/common"
+)
+
+type TransitGateway struct {
+	client      transitGatewaysClient
+	logger      logger
+	attachments transitGatewayDependency
+	routeTables transitGatewayDependency
+	id          *string
+	state       string
+	identifier  string
+	rtype       string
+}
+
+func NewTransitGateway(client transitGatewaysClient,
+	logger logger,
+	attachments transitGatewayDependency,
+	routeTables transitGatewayDependency,
+	id *string,
+	state string,
+	tags []*awsec2.Tag) TransitGateway {
+
+	identifier := *id
+
+	var extra []string
+	for _, t := range tags {
+		extra = append(extra, fmt.Sprintf("%s:%s", *t.Key, *t.Value))
+	}
+
+	if len(extra) > 0 {
+		identifier = fmt.Sprintf("%s (%s)", *id, strings.Join(extra, ", "))
+	}
+
+	return TransitGateway{
+		client:      client,
+		logger:      logger,
+		attachments: attachments,
+		routeTables: routeTables,
+		id:          id,
+		state:       state,
+		identifier:  identifier,
+		rtype:       "EC2 Transit Gateway",
+	}
+}
+
+func (t TransitGateway) Delete() error {
+	return t.DeleteContext(context.Background())
+}
+
+// DeleteContext deletes the attachments of the transit gateway, then its
+// route tables, then the gateway, and waits for it to be deleted.
+func (t TransitGateway) DeleteContext(ctx context.Context) error {
+	err := t.attachments.Delete(ctx, *t.id)
+	if err != nil {
+		return fmt.Errorf("Delete attachments: %s", err)
+	}
+
+	err = t.routeTables.Delete(ctx, *t.id)
+	if err != nil {
+		return fmt.Errorf("Delete route tables: %s", err)
+	}
+
+	_, err = t.client.DeleteTransitGateway(&awsec2.DeleteTransitGatewayInput{TransitGatewayId: t.id})
+	if err != nil {
+		return fmt.Errorf("Delete: %s", err)
+	}
+
+	refresh := transitGatewayRefresh(t.client, t.id)
+
+	poller := common.NewPoller(t.logger, refresh, []string{t.state, "deleting"}, []string{"deleted"})
+
+	_, err = poller.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("Waiting for deletion: %s", err)
+	}
+
+	return nil
+}
+
+func (t TransitGateway) Name() string {
+	return t.identifier
+}
+
+func (t TransitGateway) Type() string {
+	return t.rtype
+}
+
+func transitGatewayRefresh(client transitGatewaysClient, id *string) common.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.DescribeTransitGateways(&awsec2.DescribeTransitGatewaysInput{
+			TransitGatewayIds: []*string{id},
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		if len(resp.TransitGateways) == 0 {
+			return id, "deleted", nil
+		}
+
+		g := resp.TransitGateways[0]
+		return g, *g.State, nil
+	}
+}
@@ -0,0 +1,109 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/genevieve/leftovers/common"
+)
+
+type TransitGatewayAttachment struct {
+	client       transitGatewayAttachmentsClient
+	logger       logger
+	id           *string
+	resourceType string
+	resourceId   *string
+	state        string
+	identifier   string
+	rtype        string
+}
+
+func NewTransitGatewayAttachment(client transitGatewayAttachmentsClient, logger logger, attachment *awsec2.TransitGatewayAttachment) TransitGatewayAttachment {
+	id := attachment.TransitGatewayAttachmentId
+	identifier := *id
+
+	var extra []string
+	for _, t := range attachment.Tags {
+		extra = append(extra, fmt.Sprintf("%s:%s", *t.Key, *t.Value))
+	}
+
+	if len(extra) > 0 {
+		identifier = fmt.Sprintf("%s (%s)", *id, strings.Join(extra, ", "))
+	}
+
+	return TransitGatewayAttachment{
+		client:       client,
+		logger:       logger,
+		id:           id,
+		resourceType: *attachment.ResourceType,
+		resourceId:   attachment.ResourceId,
+		state:        *attachment.State,
+		identifier:   identifier,
+		rtype:        "EC2 Transit Gateway Attachment",
+	}
+}
+
+func (t TransitGatewayAttachment) Delete() error {
+	return t.DeleteContext(context.Background())
+}
+
+// DeleteContext deletes the attachment, or the vpn connection of a vpn
+// attachment, and waits for the attachment to be deleted.
+func (t TransitGatewayAttachment) DeleteContext(ctx context.Context) error {
+	var err error
+
+	switch t.resourceType {
+	case awsec2.TransitGatewayAttachmentResourceTypeVpc:
+		_, err = t.client.DeleteTransitGatewayVpcAttachment(&awsec2.DeleteTransitGatewayVpcAttachmentInput{TransitGatewayAttachmentId: t.id})
+	case awsec2.TransitGatewayAttachmentResourceTypePeering, awsec2.TransitGatewayAttachmentResourceTypeTgwPeering:
+		_, err = t.client.DeleteTransitGatewayPeeringAttachment(&awsec2.DeleteTransitGatewayPeeringAttachmentInput{TransitGatewayAttachmentId: t.id})
+	case awsec2.TransitGatewayAttachmentResourceTypeConnect:
+		_, err = t.client.DeleteTransitGatewayConnect(&awsec2.DeleteTransitGatewayConnectInput{TransitGatewayAttachmentId: t.id})
+	case awsec2.TransitGatewayAttachmentResourceTypeVpn:
+		_, err = t.client.DeleteVpnConnection(&awsec2.DeleteVpnConnectionInput{VpnConnectionId: t.resourceId})
+	default:
+		return fmt.Errorf("Delete: %s attachments can not be deleted", t.resourceType)
+	}
+	if err != nil {
+		return fmt.Errorf("Delete: %s", err)
+	}
+
+	refresh := transitGatewayAttachmentRefresh(t.client, t.id)
+
+	poller := common.NewPoller(t.logger, refresh, []string{t.state, "deleting"}, []string{"deleted"})
+
+	_, err = poller.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("Waiting for deletion: %s", err)
+	}
+
+	return nil
+}
+
+func (t TransitGatewayAttachment) Name() string {
+	return t.identifier
+}
+
+func (t TransitGatewayAttachment) Type() string {
+	return t.rtype
+}
+
+func transitGatewayAttachmentRefresh(client transitGatewayAttachmentsClient, id *string) common.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.DescribeTransitGatewayAttachments(&awsec2.DescribeTransitGatewayAttachmentsInput{
+			TransitGatewayAttachmentIds: []*string{id},
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		if len(resp.TransitGatewayAttachments) == 0 {
+			return id, "deleted", nil
+		}
+
+		a := resp.TransitGatewayAttachments[0]
+		return a, *a.State, nil
+	}
+}
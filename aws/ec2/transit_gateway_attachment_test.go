@@ -0,0 +1,129 @@
+package ec2_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/genevieve/leftovers/aws/ec2"
+	"github.com/genevieve/leftovers/aws/ec2/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TransitGatewayAttachment", func() {
+	var (
+		attachment   ec2.TransitGatewayAttachment
+		client       *fakes.TransitGatewayAttachmentsClient
+		logger       *fakes.Logger
+		id           *string
+		resourceType string
+	)
+
+	BeforeEach(func() {
+		client = &fakes.TransitGatewayAttachmentsClient{}
+		logger = &fakes.Logger{}
+		id = aws.String("the-id")
+		resourceType = "vpc"
+
+		client.DescribeTransitGatewayAttachmentsCall.Returns.Output = &awsec2.DescribeTransitGatewayAttachmentsOutput{
+			TransitGatewayAttachments: []*awsec2.TransitGatewayAttachment{{State: aws.String("deleted")}},
+		}
+	})
+
+	JustBeforeEach(func() {
+		attachment = ec2.NewTransitGatewayAttachment(client, logger, &awsec2.TransitGatewayAttachment{
+			TransitGatewayAttachmentId: id,
+			ResourceType:               aws.String(resourceType),
+			ResourceId:                 aws.String("the-resource-id"),
+			State:                      aws.String("available"),
+			Tags:                       []*awsec2.Tag{{Key: aws.String("the-key"), Value: aws.String("the-value")}},
+		})
+	})
+
+	Describe("Delete", func() {
+		It("deletes the vpc attachment and waits for it to be deleted", func() {
+			err := attachment.Delete()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DeleteTransitGatewayVpcAttachmentCall.CallCount).To(Equal(1))
+			Expect(client.DeleteTransitGatewayVpcAttachmentCall.Receives.Input.TransitGatewayAttachmentId).To(Equal(id))
+
+			Expect(client.DescribeTransitGatewayAttachmentsCall.CallCount).To(Equal(1))
+			Expect(client.DescribeTransitGatewayAttachmentsCall.Receives.Input.TransitGatewayAttachmentIds).To(Equal([]*string{id}))
+		})
+
+		Context("when the attachment is a peering attachment", func() {
+			BeforeEach(func() {
+				resourceType = "peering"
+			})
+
+			It("deletes the peering attachment", func() {
+				err := attachment.Delete()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(client.DeleteTransitGatewayPeeringAttachmentCall.CallCount).To(Equal(1))
+				Expect(client.DeleteTransitGatewayPeeringAttachmentCall.Receives.Input.TransitGatewayAttachmentId).To(Equal(id))
+			})
+		})
+
+		Context("when the attachment is a vpn attachment", func() {
+			BeforeEach(func() {
+				resourceType = "vpn"
+			})
+
+			It("deletes the vpn connection", func() {
+				err := attachment.Delete()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(client.DeleteVpnConnectionCall.CallCount).To(Equal(1))
+				Expect(client.DeleteVpnConnectionCall.Receives.Input.VpnConnectionId).To(Equal(aws.String("the-resource-id")))
+			})
+		})
+
+		Context("when the attachment can not be deleted", func() {
+			BeforeEach(func() {
+				resourceType = "direct-connect-gateway"
+			})
+
+			It("returns an error", func() {
+				err := attachment.Delete()
+				Expect(err).To(MatchError("Delete: direct-connect-gateway attachments can not be deleted"))
+			})
+		})
+
+		Context("when the client fails", func() {
+			BeforeEach(func() {
+				client.DeleteTransitGatewayVpcAttachmentCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := attachment.Delete()
+				Expect(err).To(MatchError("Delete: banana"))
+			})
+		})
+
+		Context("when the client fails while waiting", func() {
+			BeforeEach(func() {
+				client.DescribeTransitGatewayAttachmentsCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := attachment.Delete()
+				Expect(err).To(MatchError("Waiting for deletion: banana"))
+			})
+		})
+	})
+
+	Describe("Name", func() {
+		It("returns the identifier", func() {
+			Expect(attachment.Name()).To(Equal("the-id (the-key:the-value)"))
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(attachment.Type()).To(Equal("EC2 Transit Gateway Attachment"))
+		})
+	})
+})
@@ -0,0 +1,113 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/genevieve/leftovers/common"
+)
+
+type transitGatewayAttachmentsClient interface {
+	DescribeTransitGatewayAttachments(*awsec2.DescribeTransitGatewayAttachmentsInput) (*awsec2.DescribeTransitGatewayAttachmentsOutput, error)
+	DescribeTransitGatewayAttachmentsPages(*awsec2.DescribeTransitGatewayAttachmentsInput, func(*awsec2.DescribeTransitGatewayAttachmentsOutput, bool) bool) error
+	DeleteTransitGatewayVpcAttachment(*awsec2.DeleteTransitGatewayVpcAttachmentInput) (*awsec2.DeleteTransitGatewayVpcAttachmentOutput, error)
+	DeleteTransitGatewayPeeringAttachment(*awsec2.DeleteTransitGatewayPeeringAttachmentInput) (*awsec2.DeleteTransitGatewayPeeringAttachmentOutput, error)
+	DeleteTransitGatewayConnect(*awsec2.DeleteTransitGatewayConnectInput) (*awsec2.DeleteTransitGatewayConnectOutput, error)
+	DeleteVpnConnection(*awsec2.DeleteVpnConnectionInput) (*awsec2.DeleteVpnConnectionOutput, error)
+}
+
+type TransitGatewayAttachments struct {
+	client transitGatewayAttachmentsClient
+	logger logger
+}
+
+func NewTransitGatewayAttachments(client transitGatewayAttachmentsClient, logger logger) TransitGatewayAttachments {
+	return TransitGatewayAttachments{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (t TransitGatewayAttachments) List(filter string) ([]common.Deletable, error) {
+	attachments, err := t.describe(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []common.Deletable
+	for _, a := range attachments {
+		r := NewTransitGatewayAttachment(t.client, t.logger, a)
+
+		if !strings.Contains(r.Name(), filter) {
+			continue
+		}
+
+		proceed := t.logger.PromptWithDetails(r.Type(), r.Name())
+		if !proceed {
+			continue
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func (t TransitGatewayAttachments) Type() string {
+	return "ec2-transit-gateway-attachment"
+}
+
+// Delete deletes the attachments of the transit gateway
+// and waits for them to be deleted.
+func (t TransitGatewayAttachments) Delete(ctx context.Context, transitGatewayId string) error {
+	attachments, err := t.describe(&awsec2.Filter{
+		Name:   aws.String("transit-gateway-id"),
+		Values: []*string{aws.String(transitGatewayId)},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, a := range attachments {
+		err = NewTransitGatewayAttachment(t.client, t.logger, a).DeleteContext(ctx)
+		if err != nil {
+			return fmt.Errorf("Delete %s: %s", *a.TransitGatewayAttachmentId, err)
+		}
+
+		t.logger.Printf("[EC2 Transit Gateway: %s] Deleted attachment %s \n", transitGatewayId, *a.TransitGatewayAttachmentId)
+	}
+
+	return nil
+}
+
+// describe returns the attachments that match
+// the filter, if any, and can be deleted.
+func (t TransitGatewayAttachments) describe(filter *awsec2.Filter) ([]*awsec2.TransitGatewayAttachment, error) {
+	filters := []*awsec2.Filter{{
+		Name: aws.String("state"),
+		Values: []*string{
+			aws.String("pending"),
+			aws.String("pendingAcceptance"),
+			aws.String("available"),
+			aws.String("modifying"),
+			aws.String("failed"),
+		},
+	}}
+	if filter != nil {
+		filters = append(filters, filter)
+	}
+
+	var attachments []*awsec2.TransitGatewayAttachment
+	err := t.client.DescribeTransitGatewayAttachmentsPages(&awsec2.DescribeTransitGatewayAttachmentsInput{Filters: filters}, func(page *awsec2.DescribeTransitGatewayAttachmentsOutput, lastPage bool) bool {
+		attachments = append(attachments, page.TransitGatewayAttachments...)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Describe EC2 Transit Gateway Attachments: %s", err)
+	}
+
+	return attachments, nil
+}
@@ -0,0 +1,141 @@
+package ec2_test
+
+import (
+	"context"
+
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/genevieve/leftovers/aws/ec2"
+	"github.com/genevieve/leftovers/aws/ec2/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TransitGatewayAttachments", func() {
+	var (
+		client *fakes.TransitGatewayAttachmentsClient
+		logger *fakes.Logger
+
+		attachments ec2.TransitGatewayAttachments
+	)
+
+	BeforeEach(func() {
+		client = &fakes.TransitGatewayAttachmentsClient{}
+		logger = &fakes.Logger{}
+		logger.PromptWithDetailsCall.Returns.Proceed = true
+
+		client.DescribeTransitGatewayAttachmentsPagesCall.Returns.Pages = []*awsec2.DescribeTransitGatewayAttachmentsOutput{{
+			TransitGatewayAttachments: []*awsec2.TransitGatewayAttachment{{
+				TransitGatewayAttachmentId: aws.String("tgw-attach-banana"),
+				ResourceType:               aws.String("vpc"),
+				State:                      aws.String("available"),
+			}},
+		}}
+		client.DescribeTransitGatewayAttachmentsCall.Returns.Output = &awsec2.DescribeTransitGatewayAttachmentsOutput{}
+
+		attachments = ec2.NewTransitGatewayAttachments(client, logger)
+	})
+
+	Describe("List", func() {
+		var filter string
+
+		BeforeEach(func() {
+			filter = "banana"
+		})
+
+		It("returns a list of attachments that can be deleted", func() {
+			items, err := attachments.List(filter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DescribeTransitGatewayAttachmentsPagesCall.CallCount).To(Equal(1))
+			Expect(client.DescribeTransitGatewayAttachmentsPagesCall.Receives.Input.Filters[0].Name).To(Equal(aws.String("state")))
+
+			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("EC2 Transit Gateway Attachment"))
+			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("tgw-attach-banana"))
+
+			Expect(items).To(HaveLen(1))
+		})
+
+		Context("when the attachment name does not contain the filter", func() {
+			It("does not return it in the list", func() {
+				items, err := attachments.List("kiwi")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+
+		Context("when the client fails to describe attachments", func() {
+			BeforeEach(func() {
+				client.DescribeTransitGatewayAttachmentsPagesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := attachments.List(filter)
+				Expect(err).To(MatchError("Describe EC2 Transit Gateway Attachments: some error"))
+			})
+		})
+
+		Context("when the user responds no to the prompt", func() {
+			BeforeEach(func() {
+				logger.PromptWithDetailsCall.Returns.Proceed = false
+			})
+
+			It("does not return it in the list", func() {
+				items, err := attachments.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+	})
+
+	Describe("Delete", func() {
+		It("deletes the attachments of the transit gateway", func() {
+			err := attachments.Delete(context.Background(), "tgw-banana")
+			Expect(err).NotTo(HaveOccurred())
+
+			filters := client.DescribeTransitGatewayAttachmentsPagesCall.Receives.Input.Filters
+			Expect(filters[len(filters)-1].Name).To(Equal(aws.String("transit-gateway-id")))
+			Expect(filters[len(filters)-1].Values).To(Equal([]*string{aws.String("tgw-banana")}))
+
+			Expect(client.DeleteTransitGatewayVpcAttachmentCall.CallCount).To(Equal(1))
+			Expect(client.DeleteTransitGatewayVpcAttachmentCall.Receives.Input.TransitGatewayAttachmentId).To(Equal(aws.String("tgw-attach-banana")))
+
+			Expect(logger.PrintfCall.Messages).To(ContainElement("[EC2 Transit Gateway: tgw-banana] Deleted attachment tgw-attach-banana \n"))
+		})
+
+		Context("when the client fails to describe attachments", func() {
+			BeforeEach(func() {
+				client.DescribeTransitGatewayAttachmentsPagesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				err := attachments.Delete(context.Background(), "tgw-banana")
+				Expect(err).To(MatchError("Describe EC2 Transit Gateway Attachments: some error"))
+			})
+		})
+
+		Context("when the client fails to delete an attachment", func() {
+			BeforeEach(func() {
+				client.DeleteTransitGatewayVpcAttachmentCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				err := attachments.Delete(context.Background(), "tgw-banana")
+				Expect(err).To(MatchError("Delete tgw-attach-banana: Delete: some error"))
+			})
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(attachments.Type()).To(Equal("ec2-transit-gateway-attachment"))
+		})
+	})
+})
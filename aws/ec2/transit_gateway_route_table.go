@@ -0,0 +1,171 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/genevieve/leftovers/common"
+)
+
+type TransitGatewayRouteTable struct {
+	client     transitGatewayRouteTablesClient
+	logger     logger
+	id         *string
+	identifier string
+	rtype      string
+}
+
+func NewTransitGatewayRouteTable(client transitGatewayRouteTablesClient, logger logger, id *string, tags []*awsec2.Tag) TransitGatewayRouteTable {
+	identifier := *id
+
+	var extra []string
+	for _, t := range tags {
+		extra = append(extra, fmt.Sprintf("%s:%s", *t.Key, *t.Value))
+	}
+
+	if len(extra) > 0 {
+		identifier = fmt.Sprintf("%s (%s)", *id, strings.Join(extra, ", "))
+	}
+
+	return TransitGatewayRouteTable{
+		client:     client,
+		logger:     logger,
+		id:         id,
+		identifier: identifier,
+		rtype:      "EC2 Transit Gateway Route Table",
+	}
+}
+
+func (t TransitGatewayRouteTable) Delete() error {
+	return t.DeleteContext(context.Background())
+}
+
+// DeleteContext disables the propagations to the route table, disassociates its
+// attachments and waits for them to be disassociated, and deletes it.
+func (t TransitGatewayRouteTable) DeleteContext(ctx context.Context) error {
+	var propagations []*awsec2.TransitGatewayRouteTablePropagation
+	err := t.client.GetTransitGatewayRouteTablePropagationsPages(&awsec2.GetTransitGatewayRouteTablePropagationsInput{
+		TransitGatewayRouteTableId: t.id,
+	}, func(page *awsec2.GetTransitGatewayRouteTablePropagationsOutput, lastPage bool) bool {
+		propagations = append(propagations, page.TransitGatewayRouteTablePropagations...)
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("Get propagations: %s", err)
+	}
+
+	for _, p := range propagations {
+		if *p.State != awsec2.TransitGatewayPropagationStateEnabled {
+			continue
+		}
+
+		_, err = t.client.DisableTransitGatewayRouteTablePropagation(&awsec2.DisableTransitGatewayRouteTablePropagationInput{
+			TransitGatewayRouteTableId: t.id,
+			TransitGatewayAttachmentId: p.TransitGatewayAttachmentId,
+		})
+		if err != nil {
+			return fmt.Errorf("Disable propagation from %s: %s", *p.TransitGatewayAttachmentId, err)
+		}
+	}
+
+	associations, err := t.associations()
+	if err != nil {
+		return fmt.Errorf("Get associations: %s", err)
+	}
+
+	for _, a := range associations {
+		if *a.State != awsec2.TransitGatewayAssociationStateAssociated {
+			continue
+		}
+
+		_, err = t.client.DisassociateTransitGatewayRouteTable(&awsec2.DisassociateTransitGatewayRouteTableInput{
+			TransitGatewayRouteTableId: t.id,
+			TransitGatewayAttachmentId: a.TransitGatewayAttachmentId,
+		})
+		if err != nil {
+			return fmt.Errorf("Disassociate %s: %s", *a.TransitGatewayAttachmentId, err)
+		}
+	}
+
+	if len(associations) > 0 {
+		poller := common.NewPoller(t.logger, t.associationsRefresh(), []string{"disassociating"}, []string{"disassociated"})
+
+		_, err = poller.Wait(ctx)
+		if err != nil {
+			return fmt.Errorf("Waiting for disassociation: %s", err)
+		}
+	}
+
+	_, err = t.client.DeleteTransitGatewayRouteTable(&awsec2.DeleteTransitGatewayRouteTableInput{TransitGatewayRouteTableId: t.id})
+	if err != nil {
+		return fmt.Errorf("Delete: %s", err)
+	}
+
+	poller := common.NewPoller(t.logger, transitGatewayRouteTableRefresh(t.client, t.id), []string{"available", "deleting"}, []string{"deleted"})
+
+	_, err = poller.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("Waiting for deletion: %s", err)
+	}
+
+	return nil
+}
+
+func (t TransitGatewayRouteTable) Name() string {
+	return t.identifier
+}
+
+func (t TransitGatewayRouteTable) Type() string {
+	return t.rtype
+}
+
+func (t TransitGatewayRouteTable) associations() ([]*awsec2.TransitGatewayRouteTableAssociation, error) {
+	var associations []*awsec2.TransitGatewayRouteTableAssociation
+	err := t.client.GetTransitGatewayRouteTableAssociationsPages(&awsec2.GetTransitGatewayRouteTableAssociationsInput{
+		TransitGatewayRouteTableId: t.id,
+	}, func(page *awsec2.GetTransitGatewayRouteTableAssociationsOutput, lastPage bool) bool {
+		for _, a := range page.Associations {
+			if *a.State != awsec2.TransitGatewayAssociationStateDisassociated {
+				associations = append(associations, a)
+			}
+		}
+		return true
+	})
+
+	return associations, err
+}
+
+func (t TransitGatewayRouteTable) associationsRefresh() common.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		associations, err := t.associations()
+		if err != nil {
+			return nil, "", err
+		}
+
+		if len(associations) > 0 {
+			return associations, "disassociating", nil
+		}
+
+		return t.id, "disassociated", nil
+	}
+}
+
+func transitGatewayRouteTableRefresh(client transitGatewayRouteTablesClient, id *string) common.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.DescribeTransitGatewayRouteTables(&awsec2.DescribeTransitGatewayRouteTablesInput{
+			TransitGatewayRouteTableIds: []*string{id},
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		if len(resp.TransitGatewayRouteTables) == 0 {
+			return id, "deleted", nil
+		}
+
+		r := resp.TransitGatewayRouteTables[0]
+		return r, *r.State, nil
+	}
+}
@@ -0,0 +1,151 @@
+package ec2_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/genevieve/leftovers/aws/ec2"
+	"github.com/genevieve/leftovers/aws/ec2/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TransitGatewayRouteTable", func() {
+	var (
+		routeTable ec2.TransitGatewayRouteTable
+		client     *fakes.TransitGatewayRouteTablesClient
+		logger     *fakes.Logger
+		id         *string
+	)
+
+	BeforeEach(func() {
+		client = &fakes.TransitGatewayRouteTablesClient{}
+		logger = &fakes.Logger{}
+		id = aws.String("the-id")
+		tags := []*awsec2.Tag{{Key: aws.String("the-key"), Value: aws.String("the-value")}}
+
+		client.DescribeTransitGatewayRouteTablesCall.Returns.Output = &awsec2.DescribeTransitGatewayRouteTablesOutput{
+			TransitGatewayRouteTables: []*awsec2.TransitGatewayRouteTable{{State: aws.String("deleted")}},
+		}
+
+		routeTable = ec2.NewTransitGatewayRouteTable(client, logger, id, tags)
+	})
+
+	Describe("Delete", func() {
+		It("deletes the route table and waits for it to be deleted", func() {
+			err := routeTable.Delete()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.GetTransitGatewayRouteTablePropagationsPagesCall.CallCount).To(Equal(1))
+			Expect(client.GetTransitGatewayRouteTableAssociationsPagesCall.CallCount).To(Equal(1))
+
+			Expect(client.DeleteTransitGatewayRouteTableCall.CallCount).To(Equal(1))
+			Expect(client.DeleteTransitGatewayRouteTableCall.Receives.Input.TransitGatewayRouteTableId).To(Equal(id))
+
+			Expect(client.DescribeTransitGatewayRouteTablesCall.CallCount).To(Equal(1))
+			Expect(client.DescribeTransitGatewayRouteTablesCall.Receives.Input.TransitGatewayRouteTableIds).To(Equal([]*string{id}))
+		})
+
+		Context("when attachments propagate to the route table", func() {
+			BeforeEach(func() {
+				client.GetTransitGatewayRouteTablePropagationsPagesCall.Returns.Pages = []*awsec2.GetTransitGatewayRouteTablePropagationsOutput{{
+					TransitGatewayRouteTablePropagations: []*awsec2.TransitGatewayRouteTablePropagation{{
+						TransitGatewayAttachmentId: aws.String("the-attachment-id"),
+						State:                      aws.String("enabled"),
+					}, {
+						TransitGatewayAttachmentId: aws.String("the-other-attachment-id"),
+						State:                      aws.String("disabled"),
+					}},
+				}}
+			})
+
+			It("disables the enabled propagations", func() {
+				err := routeTable.Delete()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(client.DisableTransitGatewayRouteTablePropagationCall.CallCount).To(Equal(1))
+				Expect(client.DisableTransitGatewayRouteTablePropagationCall.Receives.Input.TransitGatewayRouteTableId).To(Equal(id))
+				Expect(client.DisableTransitGatewayRouteTablePropagationCall.Receives.Input.TransitGatewayAttachmentId).To(Equal(aws.String("the-attachment-id")))
+			})
+
+			Context("when the client fails to disable a propagation", func() {
+				BeforeEach(func() {
+					client.DisableTransitGatewayRouteTablePropagationCall.Returns.Error = errors.New("banana")
+				})
+
+				It("returns the error", func() {
+					err := routeTable.Delete()
+					Expect(err).To(MatchError("Disable propagation from the-attachment-id: banana"))
+				})
+			})
+		})
+
+		Context("when the route table has associations", func() {
+			BeforeEach(func() {
+				client.GetTransitGatewayRouteTableAssociationsPagesCall.Returns.Pages = []*awsec2.GetTransitGatewayRouteTableAssociationsOutput{{
+					Associations: []*awsec2.TransitGatewayRouteTableAssociation{{
+						TransitGatewayAttachmentId: aws.String("the-attachment-id"),
+						State:                      aws.String("associated"),
+					}},
+				}}
+				client.DisassociateTransitGatewayRouteTableCall.Returns.Error = errors.New("banana")
+			})
+
+			It("disassociates them", func() {
+				err := routeTable.Delete()
+				Expect(err).To(MatchError("Disassociate the-attachment-id: banana"))
+
+				Expect(client.DisassociateTransitGatewayRouteTableCall.CallCount).To(Equal(1))
+				Expect(client.DisassociateTransitGatewayRouteTableCall.Receives.Input.TransitGatewayRouteTableId).To(Equal(id))
+				Expect(client.DisassociateTransitGatewayRouteTableCall.Receives.Input.TransitGatewayAttachmentId).To(Equal(aws.String("the-attachment-id")))
+				Expect(client.DeleteTransitGatewayRouteTableCall.CallCount).To(Equal(0))
+			})
+		})
+
+		Context("when the client fails to get propagations", func() {
+			BeforeEach(func() {
+				client.GetTransitGatewayRouteTablePropagationsPagesCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := routeTable.Delete()
+				Expect(err).To(MatchError("Get propagations: banana"))
+			})
+		})
+
+		Context("when the client fails to get associations", func() {
+			BeforeEach(func() {
+				client.GetTransitGatewayRouteTableAssociationsPagesCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := routeTable.Delete()
+				Expect(err).To(MatchError("Get associations: banana"))
+			})
+		})
+
+		Context("when the client fails", func() {
+			BeforeEach(func() {
+				client.DeleteTransitGatewayRouteTableCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := routeTable.Delete()
+				Expect(err).To(MatchError("Delete: banana"))
+			})
+		})
+	})
+
+	Describe("Name", func() {
+		It("returns the identifier", func() {
+			Expect(routeTable.Name()).To(Equal("the-id (the-key:the-value)"))
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(routeTable.Type()).To(Equal("EC2 Transit Gateway Route Table"))
+		})
+	})
+})
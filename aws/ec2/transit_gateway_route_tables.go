@@ -0,0 +1,111 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/genevieve/leftovers/common"
+)
+
+type transitGatewayRouteTablesClient interface {
+	DescribeTransitGatewayRouteTables(*awsec2.DescribeTransitGatewayRouteTablesInput) (*awsec2.DescribeTransitGatewayRouteTablesOutput, error)
+	DescribeTransitGatewayRouteTablesPages(*awsec2.DescribeTransitGatewayRouteTablesInput, func(*awsec2.DescribeTransitGatewayRouteTablesOutput, bool) bool) error
+	GetTransitGatewayRouteTableAssociationsPages(*awsec2.GetTransitGatewayRouteTableAssociationsInput, func(*awsec2.GetTransitGatewayRouteTableAssociationsOutput, bool) bool) error
+	GetTransitGatewayRouteTablePropagationsPages(*awsec2.GetTransitGatewayRouteTablePropagationsInput, func(*awsec2.GetTransitGatewayRouteTablePropagationsOutput, bool) bool) error
+	DisassociateTransitGatewayRouteTable(*awsec2.DisassociateTransitGatewayRouteTableInput) (*awsec2.DisassociateTransitGatewayRouteTableOutput, error)
+	DisableTransitGatewayRouteTablePropagation(*awsec2.DisableTransitGatewayRouteTablePropagationInput) (*awsec2.DisableTransitGatewayRouteTablePropagationOutput, error)
+	DeleteTransitGatewayRouteTable(*awsec2.DeleteTransitGatewayRouteTableInput) (*awsec2.DeleteTransitGatewayRouteTableOutput, error)
+}
+
+type TransitGatewayRouteTables struct {
+	client transitGatewayRouteTablesClient
+	logger logger
+}
+
+func NewTransitGatewayRouteTables(client transitGatewayRouteTablesClient, logger logger) TransitGatewayRouteTables {
+	return TransitGatewayRouteTables{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (t TransitGatewayRouteTables) List(filter string) ([]common.Deletable, error) {
+	routeTables, err := t.describe(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []common.Deletable
+	for _, r := range routeTables {
+		routeTable := NewTransitGatewayRouteTable(t.client, t.logger, r.TransitGatewayRouteTableId, r.Tags)
+
+		if !strings.Contains(routeTable.Name(), filter) {
+			continue
+		}
+
+		proceed := t.logger.PromptWithDetails(routeTable.Type(), routeTable.Name())
+		if !proceed {
+			continue
+		}
+
+		resources = append(resources, routeTable)
+	}
+
+	return resources, nil
+}
+
+func (t TransitGatewayRouteTables) Type() string {
+	return "ec2-transit-gateway-route-table"
+}
+
+// Delete deletes the route tables of the transit gateway, except
+// for its default route table, which is deleted with the gateway.
+func (t TransitGatewayRouteTables) Delete(ctx context.Context, transitGatewayId string) error {
+	routeTables, err := t.describe(&awsec2.Filter{
+		Name:   aws.String("transit-gateway-id"),
+		Values: []*string{aws.String(transitGatewayId)},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, r := range routeTables {
+		err = NewTransitGatewayRouteTable(t.client, t.logger, r.TransitGatewayRouteTableId, r.Tags).DeleteContext(ctx)
+		if err != nil {
+			return fmt.Errorf("Delete %s: %s", *r.TransitGatewayRouteTableId, err)
+		}
+
+		t.logger.Printf("[EC2 Transit Gateway: %s] Deleted route table %s \n", transitGatewayId, *r.TransitGatewayRouteTableId)
+	}
+
+	return nil
+}
+
+// describe returns the route tables that match the filter, if any,
+// and are not being deleted or the default route table of their gateway.
+func (t TransitGatewayRouteTables) describe(filter *awsec2.Filter) ([]*awsec2.TransitGatewayRouteTable, error) {
+	filters := []*awsec2.Filter{{
+		Name:   aws.String("state"),
+		Values: []*string{aws.String("pending"), aws.String("available")},
+	}, {
+		Name:   aws.String("default-association-route-table"),
+		Values: []*string{aws.String("false")},
+	}}
+	if filter != nil {
+		filters = append(filters, filter)
+	}
+
+	var routeTables []*awsec2.TransitGatewayRouteTable
+	err := t.client.DescribeTransitGatewayRouteTablesPages(&awsec2.DescribeTransitGatewayRouteTablesInput{Filters: filters}, func(page *awsec2.DescribeTransitGatewayRouteTablesOutput, lastPage bool) bool {
+		routeTables = append(routeTables, page.TransitGatewayRouteTables...)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Describe EC2 Transit Gateway Route Tables: %s", err)
+	}
+
+	return routeTables, nil
+}
@@ -0,0 +1,142 @@
+package ec2_test
+
+import (
+	"context"
+
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/genevieve/leftovers/aws/ec2"
+	"github.com/genevieve/leftovers/aws/ec2/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TransitGatewayRouteTables", func() {
+	var (
+		client *fakes.TransitGatewayRouteTablesClient
+		logger *fakes.Logger
+
+		routeTables ec2.TransitGatewayRouteTables
+	)
+
+	BeforeEach(func() {
+		client = &fakes.TransitGatewayRouteTablesClient{}
+		logger = &fakes.Logger{}
+		logger.PromptWithDetailsCall.Returns.Proceed = true
+
+		client.DescribeTransitGatewayRouteTablesPagesCall.Returns.Pages = []*awsec2.DescribeTransitGatewayRouteTablesOutput{{
+			TransitGatewayRouteTables: []*awsec2.TransitGatewayRouteTable{{
+				TransitGatewayRouteTableId: aws.String("tgw-rtb-banana"),
+				State:                      aws.String("available"),
+			}},
+		}}
+		client.DescribeTransitGatewayRouteTablesCall.Returns.Output = &awsec2.DescribeTransitGatewayRouteTablesOutput{}
+
+		routeTables = ec2.NewTransitGatewayRouteTables(client, logger)
+	})
+
+	Describe("List", func() {
+		var filter string
+
+		BeforeEach(func() {
+			filter = "banana"
+		})
+
+		It("returns a list of route tables that are not the default", func() {
+			items, err := routeTables.List(filter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DescribeTransitGatewayRouteTablesPagesCall.CallCount).To(Equal(1))
+			filters := client.DescribeTransitGatewayRouteTablesPagesCall.Receives.Input.Filters
+			Expect(filters[1].Name).To(Equal(aws.String("default-association-route-table")))
+			Expect(filters[1].Values).To(Equal([]*string{aws.String("false")}))
+
+			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("EC2 Transit Gateway Route Table"))
+			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("tgw-rtb-banana"))
+
+			Expect(items).To(HaveLen(1))
+		})
+
+		Context("when the route table name does not contain the filter", func() {
+			It("does not return it in the list", func() {
+				items, err := routeTables.List("kiwi")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+
+		Context("when the client fails to describe route tables", func() {
+			BeforeEach(func() {
+				client.DescribeTransitGatewayRouteTablesPagesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := routeTables.List(filter)
+				Expect(err).To(MatchError("Describe EC2 Transit Gateway Route Tables: some error"))
+			})
+		})
+
+		Context("when the user responds no to the prompt", func() {
+			BeforeEach(func() {
+				logger.PromptWithDetailsCall.Returns.Proceed = false
+			})
+
+			It("does not return it in the list", func() {
+				items, err := routeTables.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+	})
+
+	Describe("Delete", func() {
+		It("deletes the route tables of the transit gateway", func() {
+			err := routeTables.Delete(context.Background(), "tgw-banana")
+			Expect(err).NotTo(HaveOccurred())
+
+			filters := client.DescribeTransitGatewayRouteTablesPagesCall.Receives.Input.Filters
+			Expect(filters[len(filters)-1].Name).To(Equal(aws.String("transit-gateway-id")))
+			Expect(filters[len(filters)-1].Values).To(Equal([]*string{aws.String("tgw-banana")}))
+
+			Expect(client.DeleteTransitGatewayRouteTableCall.CallCount).To(Equal(1))
+			Expect(client.DeleteTransitGatewayRouteTableCall.Receives.Input.TransitGatewayRouteTableId).To(Equal(aws.String("tgw-rtb-banana")))
+
+			Expect(logger.PrintfCall.Messages).To(ContainElement("[EC2 Transit Gateway: tgw-banana] Deleted route table tgw-rtb-banana \n"))
+		})
+
+		Context("when the client fails to describe route tables", func() {
+			BeforeEach(func() {
+				client.DescribeTransitGatewayRouteTablesPagesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				err := routeTables.Delete(context.Background(), "tgw-banana")
+				Expect(err).To(MatchError("Describe EC2 Transit Gateway Route Tables: some error"))
+			})
+		})
+
+		Context("when the client fails to delete a route table", func() {
+			BeforeEach(func() {
+				client.DeleteTransitGatewayRouteTableCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				err := routeTables.Delete(context.Background(), "tgw-banana")
+				Expect(err).To(MatchError("Delete tgw-rtb-banana: Delete: some error"))
+			})
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(routeTables.Type()).To(Equal("ec2-transit-gateway-route-table"))
+		})
+	})
+})
@@ -0,0 +1,118 @@
+package ec2_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/genevieve/leftovers/aws/ec2"
+	"github.com/genevieve/leftovers/aws/ec2/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TransitGateway", func() {
+	var (
+		gateway     ec2.TransitGateway
+		client      *fakes.TransitGatewaysClient
+		logger      *fakes.Logger
+		attachments *fakes.TransitGatewayDependency
+		routeTables *fakes.TransitGatewayDependency
+		id          *string
+	)
+
+	BeforeEach(func() {
+		client = &fakes.TransitGatewaysClient{}
+		logger = &fakes.Logger{}
+		attachments = &fakes.TransitGatewayDependency{}
+		routeTables = &fakes.TransitGatewayDependency{}
+		id = aws.String("the-id")
+		tags := []*awsec2.Tag{{Key: aws.String("the-key"), Value: aws.String("the-value")}}
+
+		client.DescribeTransitGatewaysCall.Returns.Output = &awsec2.DescribeTransitGatewaysOutput{
+			TransitGateways: []*awsec2.TransitGateway{{State: aws.String("deleted")}},
+		}
+
+		gateway = ec2.NewTransitGateway(client, logger, attachments, routeTables, id, "available", tags)
+	})
+
+	Describe("Delete", func() {
+		It("deletes the attachments, route tables and gateway and waits for it to be deleted", func() {
+			err := gateway.Delete()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(attachments.DeleteCall.CallCount).To(Equal(1))
+			Expect(attachments.DeleteCall.Receives.TransitGatewayId).To(Equal("the-id"))
+
+			Expect(routeTables.DeleteCall.CallCount).To(Equal(1))
+			Expect(routeTables.DeleteCall.Receives.TransitGatewayId).To(Equal("the-id"))
+
+			Expect(client.DeleteTransitGatewayCall.CallCount).To(Equal(1))
+			Expect(client.DeleteTransitGatewayCall.Receives.Input.TransitGatewayId).To(Equal(id))
+
+			Expect(client.DescribeTransitGatewaysCall.CallCount).To(Equal(1))
+			Expect(client.DescribeTransitGatewaysCall.Receives.Input.TransitGatewayIds).To(Equal([]*string{id}))
+		})
+
+		Context("when the gateway is gone while waiting", func() {
+			BeforeEach(func() {
+				client.DescribeTransitGatewaysCall.Returns.Output = &awsec2.DescribeTransitGatewaysOutput{}
+			})
+
+			It("is deleted", func() {
+				err := gateway.Delete()
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when the attachments fail to delete", func() {
+			BeforeEach(func() {
+				attachments.DeleteCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error before deleting the route tables", func() {
+				err := gateway.Delete()
+				Expect(err).To(MatchError("Delete attachments: banana"))
+
+				Expect(routeTables.DeleteCall.CallCount).To(Equal(0))
+				Expect(client.DeleteTransitGatewayCall.CallCount).To(Equal(0))
+			})
+		})
+
+		Context("when the route tables fail to delete", func() {
+			BeforeEach(func() {
+				routeTables.DeleteCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := gateway.Delete()
+				Expect(err).To(MatchError("Delete route tables: banana"))
+
+				Expect(client.DeleteTransitGatewayCall.CallCount).To(Equal(0))
+			})
+		})
+
+		Context("when the client fails", func() {
+			BeforeEach(func() {
+				client.DeleteTransitGatewayCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := gateway.Delete()
+				Expect(err).To(MatchError("Delete: banana"))
+			})
+		})
+	})
+
+	Describe("Name", func() {
+		It("returns the identifier", func() {
+			Expect(gateway.Name()).To(Equal("the-id (the-key:the-value)"))
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(gateway.Type()).To(Equal("EC2 Transit Gateway"))
+		})
+	})
+})
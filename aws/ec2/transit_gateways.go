@@ -0,0 +1,75 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/genevieve/leftovers/common"
+)
+
+type transitGatewaysClient interface {
+	DescribeTransitGateways(*awsec2.DescribeTransitGatewaysInput) (*awsec2.DescribeTransitGatewaysOutput, error)
+	DescribeTransitGatewaysPages(*awsec2.DescribeTransitGatewaysInput, func(*awsec2.DescribeTransitGatewaysOutput, bool) bool) error
+	DeleteTransitGateway(*awsec2.DeleteTransitGatewayInput) (*awsec2.DeleteTransitGatewayOutput, error)
+}
+
+type transitGatewayDependency interface {
+	Delete(ctx context.Context, transitGatewayId string) error
+}
+
+type TransitGateways struct {
+	client      transitGatewaysClient
+	logger      logger
+	attachments transitGatewayDependency
+	routeTables transitGatewayDependency
+}
+
+func NewTransitGateways(client transitGatewaysClient, logger logger, attachments, routeTables transitGatewayDependency) TransitGateways {
+	return TransitGateways{
+		client:      client,
+		logger:      logger,
+		attachments: attachments,
+		routeTables: routeTables,
+	}
+}
+
+func (t TransitGateways) List(filter string) ([]common.Deletable, error) {
+	var gateways []*awsec2.TransitGateway
+	err := t.client.DescribeTransitGatewaysPages(&awsec2.DescribeTransitGatewaysInput{
+		Filters: []*awsec2.Filter{{
+			Name:   aws.String("state"),
+			Values: []*string{aws.String("pending"), aws.String("available"), aws.String("modifying")},
+		}},
+	}, func(page *awsec2.DescribeTransitGatewaysOutput, lastPage bool) bool {
+		gateways = append(gateways, page.TransitGateways...)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Describe EC2 Transit Gateways: %s", err)
+	}
+
+	var resources []common.Deletable
+	for _, g := range gateways {
+		r := NewTransitGateway(t.client, t.logger, t.attachments, t.routeTables, g.TransitGatewayId, *g.State, g.Tags)
+
+		if !strings.Contains(r.Name(), filter) {
+			continue
+		}
+
+		proceed := t.logger.PromptWithDetails(r.Type(), r.Name())
+		if !proceed {
+			continue
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func (t TransitGateways) Type() string {
+	return "ec2-transit-gateway"
+}
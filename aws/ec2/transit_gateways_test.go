@@ -0,0 +1,102 @@
+package ec2_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/genevieve/leftovers/aws/ec2"
+	"github.com/genevieve/leftovers/aws/ec2/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TransitGateways", func() {
+	var (
+		client      *fakes.TransitGatewaysClient
+		logger      *fakes.Logger
+		attachments *fakes.TransitGatewayDependency
+		routeTables *fakes.TransitGatewayDependency
+
+		gateways ec2.TransitGateways
+	)
+
+	BeforeEach(func() {
+		client = &fakes.TransitGatewaysClient{}
+		logger = &fakes.Logger{}
+		logger.PromptWithDetailsCall.Returns.Proceed = true
+		attachments = &fakes.TransitGatewayDependency{}
+		routeTables = &fakes.TransitGatewayDependency{}
+
+		gateways = ec2.NewTransitGateways(client, logger, attachments, routeTables)
+	})
+
+	Describe("List", func() {
+		var filter string
+
+		BeforeEach(func() {
+			client.DescribeTransitGatewaysPagesCall.Returns.Pages = []*awsec2.DescribeTransitGatewaysOutput{{
+				TransitGateways: []*awsec2.TransitGateway{{
+					TransitGatewayId: aws.String("tgw-banana"),
+					State:            aws.String("available"),
+				}},
+			}}
+			filter = "banana"
+		})
+
+		It("returns a list of transit gateways to delete", func() {
+			items, err := gateways.List(filter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DescribeTransitGatewaysPagesCall.CallCount).To(Equal(1))
+			Expect(client.DescribeTransitGatewaysPagesCall.Receives.Input.Filters[0].Name).To(Equal(aws.String("state")))
+
+			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("EC2 Transit Gateway"))
+			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("tgw-banana"))
+
+			Expect(items).To(HaveLen(1))
+		})
+
+		Context("when the gateway name does not contain the filter", func() {
+			It("does not return it in the list", func() {
+				items, err := gateways.List("kiwi")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+
+		Context("when the client fails to describe transit gateways", func() {
+			BeforeEach(func() {
+				client.DescribeTransitGatewaysPagesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := gateways.List(filter)
+				Expect(err).To(MatchError("Describe EC2 Transit Gateways: some error"))
+			})
+		})
+
+		Context("when the user responds no to the prompt", func() {
+			BeforeEach(func() {
+				logger.PromptWithDetailsCall.Returns.Proceed = false
+			})
+
+			It("does not return it in the list", func() {
+				items, err := gateways.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(gateways.Type()).To(Equal("ec2-transit-gateway"))
+		})
+	})
+})
@@ -1,20 +1,25 @@
 package ec2
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/genevieve/leftovers/common"
 )
 
 type Volume struct {
 	client     volumesClient
+	logger     logger
 	id         *string
 	identifier string
+	backup     bool
 }
 
-func NewVolume(client volumesClient, id, state *string, tags []*awsec2.Tag) Volume {
+func NewVolume(client volumesClient, logger logger, id, state *string, tags []*awsec2.Tag, backup bool) Volume {
 	identifier := fmt.Sprintf("%s (State:%s)", *id, *state)
 
 	var extra []string
@@ -28,12 +33,27 @@ func NewVolume(client volumesClient, id, state *string, tags []*awsec2.Tag) Volu
 
 	return Volume{
 		client:     client,
+		logger:     logger,
 		id:         id,
 		identifier: identifier,
+		backup:     backup,
 	}
 }
 
 func (v Volume) Delete() error {
+	return v.DeleteContext(context.Background())
+}
+
+// DeleteContext snapshots the volume and waits for the snapshot
+// to complete if backup is enabled, then deletes the volume.
+func (v Volume) DeleteContext(ctx context.Context) error {
+	if v.backup {
+		err := v.snapshot(ctx)
+		if err != nil {
+			return fmt.Errorf("Backup: %s", err)
+		}
+	}
+
 	_, err := v.client.DeleteVolume(&awsec2.DeleteVolumeInput{VolumeId: v.id})
 	if err != nil {
 		if ec2err, ok := err.(awserr.Error); ok {
@@ -54,3 +74,44 @@ func (v Volume) Name() string {
 func (v Volume) Type() string {
 	return "EC2 Volume"
 }
+
+func (v Volume) snapshot(ctx context.Context) error {
+	snapshot, err := v.client.CreateSnapshot(&awsec2.CreateSnapshotInput{
+		VolumeId:    v.id,
+		Description: aws.String(common.BackupName(*v.id, 255)),
+	})
+	if err != nil {
+		return fmt.Errorf("Create snapshot: %s", err)
+	}
+
+	refresh := snapshotRefresh(v.client, snapshot.SnapshotId)
+	poller := common.NewPoller(v.logger, refresh, []string{"pending"}, []string{"completed"})
+
+	_, err = poller.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("Waiting for snapshot: %s", err)
+	}
+
+	v.logger.Printf("[%s: %s] Snapshot: %s\n", v.Type(), v.identifier, *snapshot.SnapshotId)
+
+	return nil
+}
+
+func snapshotRefresh(client volumesClient, id *string) common.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.DescribeSnapshots(&awsec2.DescribeSnapshotsInput{
+			SnapshotIds: []*string{id},
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		if resp == nil || len(resp.Snapshots) == 0 {
+			return nil, "", nil
+		}
+
+		s := resp.Snapshots[0]
+
+		return s, *s.State, nil
+	}
+}
@@ -17,17 +17,20 @@ var _ = Describe("Volume", func() {
 	var (
 		volume ec2.Volume
 		client *fakes.VolumesClient
+		logger *fakes.Logger
 		id     *string
 		state  *string
+		tags   []*awsec2.Tag
 	)
 
 	BeforeEach(func() {
 		client = &fakes.VolumesClient{}
+		logger = &fakes.Logger{}
 		id = aws.String("the-id")
 		state = aws.String("available")
-		tags := []*awsec2.Tag{{Key: aws.String("hi"), Value: aws.String("bye")}}
+		tags = []*awsec2.Tag{{Key: aws.String("hi"), Value: aws.String("bye")}}
 
-		volume = ec2.NewVolume(client, id, state, tags)
+		volume = ec2.NewVolume(client, logger, id, state, tags, false)
 	})
 
 	Describe("Delete", func() {
@@ -37,6 +40,49 @@ var _ = Describe("Volume", func() {
 
 			Expect(client.DeleteVolumeCall.CallCount).To(Equal(1))
 			Expect(client.DeleteVolumeCall.Receives.Input.VolumeId).To(Equal(id))
+
+			Expect(client.CreateSnapshotCall.CallCount).To(Equal(0))
+		})
+
+		Context("when backup is enabled", func() {
+			BeforeEach(func() {
+				client.CreateSnapshotCall.Returns.Output = &awsec2.Snapshot{SnapshotId: aws.String("the-snapshot-id")}
+				client.DescribeSnapshotsCall.Returns.Output = &awsec2.DescribeSnapshotsOutput{
+					Snapshots: []*awsec2.Snapshot{{
+						SnapshotId: aws.String("the-snapshot-id"),
+						State:      aws.String("completed"),
+					}},
+				}
+
+				volume = ec2.NewVolume(client, logger, id, state, tags, true)
+			})
+
+			It("snapshots the volume and waits for the snapshot before deleting it", func() {
+				err := volume.Delete()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(client.CreateSnapshotCall.CallCount).To(Equal(1))
+				Expect(client.CreateSnapshotCall.Receives.Input.VolumeId).To(Equal(id))
+				Expect(*client.CreateSnapshotCall.Receives.Input.Description).To(HavePrefix("the-id-leftovers-"))
+
+				Expect(client.DescribeSnapshotsCall.Receives.Input.SnapshotIds).To(Equal([]*string{aws.String("the-snapshot-id")}))
+				Expect(logger.PrintfCall.Messages).To(ContainElement("[EC2 Volume: the-id (State:available) (hi:bye)] Snapshot: the-snapshot-id\n"))
+
+				Expect(client.DeleteVolumeCall.CallCount).To(Equal(1))
+			})
+
+			Context("when the client fails to create the snapshot", func() {
+				BeforeEach(func() {
+					client.CreateSnapshotCall.Returns.Error = errors.New("banana")
+				})
+
+				It("does not delete the volume", func() {
+					err := volume.Delete()
+					Expect(err).To(MatchError("Backup: Create snapshot: banana"))
+
+					Expect(client.DeleteVolumeCall.CallCount).To(Equal(0))
+				})
+			})
 		})
 
 		Context("the volume has already been deleted", func() {
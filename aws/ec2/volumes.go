@@ -9,36 +9,45 @@ import (
 )
 
 type volumesClient interface {
-	DescribeVolumes(*awsec2.DescribeVolumesInput) (*awsec2.DescribeVolumesOutput, error)
+	DescribeVolumesPages(*awsec2.DescribeVolumesInput, func(*awsec2.DescribeVolumesOutput, bool) bool) error
 	DeleteVolume(*awsec2.DeleteVolumeInput) (*awsec2.DeleteVolumeOutput, error)
+
+	CreateSnapshot(*awsec2.CreateSnapshotInput) (*awsec2.Snapshot, error)
+	DescribeSnapshots(*awsec2.DescribeSnapshotsInput) (*awsec2.DescribeSnapshotsOutput, error)
 }
 
 type Volumes struct {
 	client volumesClient
 	logger logger
+	backup common.Backup
 }
 
-func NewVolumes(client volumesClient, logger logger) Volumes {
+func NewVolumes(client volumesClient, logger logger, backup common.Backup) Volumes {
 	return Volumes{
 		client: client,
 		logger: logger,
+		backup: backup,
 	}
 }
 
 func (v Volumes) List(filter string) ([]common.Deletable, error) {
-	output, err := v.client.DescribeVolumes(&awsec2.DescribeVolumesInput{
+	var volumes []*awsec2.Volume
+	err := v.client.DescribeVolumesPages(&awsec2.DescribeVolumesInput{
 		Filters: []*awsec2.Filter{{
 			Name:   aws.String("status"),
 			Values: []*string{aws.String("available")},
 		}},
+	}, func(page *awsec2.DescribeVolumesOutput, lastPage bool) bool {
+		volumes = append(volumes, page.Volumes...)
+		return true
 	})
 	if err != nil {
 		return nil, fmt.Errorf("Describe EC2 Volumes: %s", err)
 	}
 
 	var resources []common.Deletable
-	for _, volume := range output.Volumes {
-		r := NewVolume(v.client, volume.VolumeId, volume.State, volume.Tags)
+	for _, volume := range volumes {
+		r := NewVolume(v.client, v.logger, volume.VolumeId, volume.State, volume.Tags, v.backup.Enabled)
 
 		proceed := v.logger.PromptWithDetails(r.Type(), r.Name())
 		if !proceed {
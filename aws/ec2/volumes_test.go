@@ -7,6 +7,7 @@ import (
 	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/genevieve/leftovers/aws/ec2"
 	"github.com/genevieve/leftovers/aws/ec2/fakes"
+	"github.com/genevieve/leftovers/common"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -23,7 +24,7 @@ var _ = Describe("Volumes", func() {
 		client = &fakes.VolumesClient{}
 		logger = &fakes.Logger{}
 
-		volumes = ec2.NewVolumes(client, logger)
+		volumes = ec2.NewVolumes(client, logger, common.Backup{})
 	})
 
 	Describe("List", func() {
@@ -31,12 +32,12 @@ var _ = Describe("Volumes", func() {
 
 		BeforeEach(func() {
 			logger.PromptWithDetailsCall.Returns.Proceed = true
-			client.DescribeVolumesCall.Returns.Output = &awsec2.DescribeVolumesOutput{
+			client.DescribeVolumesPagesCall.Returns.Pages = []*awsec2.DescribeVolumesOutput{{
 				Volumes: []*awsec2.Volume{{
 					VolumeId: aws.String("banana"),
 					State:    aws.String("available"),
 				}},
-			}
+			}}
 			filter = "banana"
 		})
 
@@ -44,9 +45,9 @@ var _ = Describe("Volumes", func() {
 			items, err := volumes.List(filter)
 			Expect(err).NotTo(HaveOccurred())
 
-			Expect(client.DescribeVolumesCall.CallCount).To(Equal(1))
-			Expect(client.DescribeVolumesCall.Receives.Input.Filters[0].Name).To(Equal(aws.String("status")))
-			Expect(client.DescribeVolumesCall.Receives.Input.Filters[0].Values[0]).To(Equal(aws.String("available")))
+			Expect(client.DescribeVolumesPagesCall.CallCount).To(Equal(1))
+			Expect(client.DescribeVolumesPagesCall.Receives.Input.Filters[0].Name).To(Equal(aws.String("status")))
+			Expect(client.DescribeVolumesPagesCall.Receives.Input.Filters[0].Values[0]).To(Equal(aws.String("available")))
 
 			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
 			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("EC2 Volume"))
@@ -57,7 +58,7 @@ var _ = Describe("Volumes", func() {
 
 		Context("when the client fails to list volumes", func() {
 			BeforeEach(func() {
-				client.DescribeVolumesCall.Returns.Error = errors.New("some error")
+				client.DescribeVolumesPagesCall.Returns.Error = errors.New("some error")
 			})
 
 			It("returns the error", func() {
@@ -1,17 +1,35 @@
 package ec2
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
 )
 
+type vpcDependency interface {
+	Delete(ctx context.Context, vpcId string) error
+}
+
+// VpcDependencies are the resources in a vpc, other than its route
+// tables, subnets and internet gateways, that are deleted before it.
+type VpcDependencies struct {
+	NatGateways                vpcDependency
+	Endpoints                  vpcDependency
+	PeeringConnections         vpcDependency
+	NetworkAcls                vpcDependency
+	EgressOnlyInternetGateways vpcDependency
+	VpnGateways                vpcDependency
+	DhcpOptionsSets            vpcDependency
+}
+
 type Vpc struct {
 	client       vpcsClient
 	routes       routeTables
 	subnets      subnets
 	gateways     internetGateways
+	dependencies VpcDependencies
 	resourceTags resourceTags
 	id           *string
 	identifier   string
@@ -22,6 +40,7 @@ func NewVpc(client vpcsClient,
 	routes routeTables,
 	subnets subnets,
 	gateways internetGateways,
+	dependencies VpcDependencies,
 	resourceTags resourceTags,
 	id *string,
 	tags []*awsec2.Tag) Vpc {
@@ -42,6 +61,7 @@ func NewVpc(client vpcsClient,
 		routes:       routes,
 		subnets:      subnets,
 		gateways:     gateways,
+		dependencies: dependencies,
 		resourceTags: resourceTags,
 		id:           id,
 		identifier:   identifier,
@@ -50,7 +70,26 @@ func NewVpc(client vpcsClient,
 }
 
 func (v Vpc) Delete() error {
-	err := v.routes.Delete(*v.id)
+	return v.DeleteContext(context.Background())
+}
+
+func (v Vpc) DeleteContext(ctx context.Context) error {
+	err := v.dependencies.NatGateways.Delete(ctx, *v.id)
+	if err != nil {
+		return fmt.Errorf("Delete nat gateways: %s", err)
+	}
+
+	err = v.dependencies.Endpoints.Delete(ctx, *v.id)
+	if err != nil {
+		return fmt.Errorf("Delete endpoints: %s", err)
+	}
+
+	err = v.dependencies.PeeringConnections.Delete(ctx, *v.id)
+	if err != nil {
+		return fmt.Errorf("Delete peering connections: %s", err)
+	}
+
+	err = v.routes.Delete(*v.id)
 	if err != nil {
 		return fmt.Errorf("Delete routes: %s", err)
 	}
@@ -60,11 +99,31 @@ func (v Vpc) Delete() error {
 		return fmt.Errorf("Delete subnets: %s", err)
 	}
 
+	err = v.dependencies.NetworkAcls.Delete(ctx, *v.id)
+	if err != nil {
+		return fmt.Errorf("Delete network acls: %s", err)
+	}
+
 	err = v.gateways.Delete(*v.id)
 	if err != nil {
 		return fmt.Errorf("Delete internet gateways: %s", err)
 	}
 
+	err = v.dependencies.EgressOnlyInternetGateways.Delete(ctx, *v.id)
+	if err != nil {
+		return fmt.Errorf("Delete egress-only internet gateways: %s", err)
+	}
+
+	err = v.dependencies.VpnGateways.Delete(ctx, *v.id)
+	if err != nil {
+		return fmt.Errorf("Delete vpn gateways: %s", err)
+	}
+
+	err = v.dependencies.DhcpOptionsSets.Delete(ctx, *v.id)
+	if err != nil {
+		return fmt.Errorf("Delete dhcp options sets: %s", err)
+	}
+
 	_, err = v.client.DeleteVpc(&awsec2.DeleteVpcInput{VpcId: v.id})
 	if err != nil {
 		return fmt.Errorf("Delete: %s", err)
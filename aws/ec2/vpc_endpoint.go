@@ -0,0 +1,95 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/genevieve/leftovers/common"
+)
+
+type VpcEndpoint struct {
+	client     vpcEndpointsClient
+	logger     logger
+	id         *string
+	state      string
+	identifier string
+	rtype      string
+}
+
+func NewVpcEndpoint(client vpcEndpointsClient, logger logger, id *string, state string, tags []*awsec2.Tag) VpcEndpoint {
+	identifier := *id
+
+	var extra []string
+	for _, t := range tags {
+		extra = append(extra, fmt.Sprintf("%s:%s", *t.Key, *t.Value))
+	}
+
+	if len(extra) > 0 {
+		identifier = fmt.Sprintf("%s (%s)", *id, strings.Join(extra, ", "))
+	}
+
+	return VpcEndpoint{
+		client:     client,
+		logger:     logger,
+		id:         id,
+		state:      strings.ToLower(state),
+		identifier: identifier,
+		rtype:      "EC2 VPC Endpoint",
+	}
+}
+
+func (v VpcEndpoint) Delete() error {
+	return v.DeleteContext(context.Background())
+}
+
+func (v VpcEndpoint) DeleteContext(ctx context.Context) error {
+	resp, err := v.client.DeleteVpcEndpoints(&awsec2.DeleteVpcEndpointsInput{VpcEndpointIds: []*string{v.id}})
+	if err != nil {
+		return fmt.Errorf("Delete: %s", err)
+	}
+
+	for _, u := range resp.Unsuccessful {
+		return fmt.Errorf("Delete: %s", *u.Error.Message)
+	}
+
+	refresh := vpcEndpointRefresh(v.client, v.id)
+
+	poller := common.NewPoller(v.logger, refresh, []string{v.state, "deleting"}, []string{"deleted"})
+
+	_, err = poller.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("Waiting for deletion: %s", err)
+	}
+
+	return nil
+}
+
+func (v VpcEndpoint) Name() string {
+	return v.identifier
+}
+
+func (v VpcEndpoint) Type() string {
+	return v.rtype
+}
+
+func vpcEndpointRefresh(client vpcEndpointsClient, id *string) common.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.DescribeVpcEndpoints(&awsec2.DescribeVpcEndpointsInput{VpcEndpointIds: []*string{id}})
+		if err != nil {
+			if ec2err, ok := err.(awserr.Error); ok && ec2err.Code() == "InvalidVpcEndpointId.NotFound" {
+				return id, "deleted", nil
+			}
+			return nil, "", err
+		}
+
+		if len(resp.VpcEndpoints) == 0 {
+			return id, "deleted", nil
+		}
+
+		e := resp.VpcEndpoints[0]
+		return e, strings.ToLower(*e.State), nil
+	}
+}
@@ -0,0 +1,99 @@
+package ec2_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/genevieve/leftovers/aws/ec2"
+	"github.com/genevieve/leftovers/aws/ec2/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("VpcEndpoint", func() {
+	var (
+		endpoint ec2.VpcEndpoint
+		client   *fakes.VpcEndpointsClient
+		logger   *fakes.Logger
+		id       *string
+	)
+
+	BeforeEach(func() {
+		client = &fakes.VpcEndpointsClient{}
+		logger = &fakes.Logger{}
+		id = aws.String("the-id")
+		tags := []*awsec2.Tag{{Key: aws.String("the-key"), Value: aws.String("the-value")}}
+
+		endpoint = ec2.NewVpcEndpoint(client, logger, id, "available", tags)
+	})
+
+	Describe("Delete", func() {
+		BeforeEach(func() {
+			client.DeleteVpcEndpointsCall.Returns.Output = &awsec2.DeleteVpcEndpointsOutput{}
+			client.DescribeVpcEndpointsCall.Returns.Output = &awsec2.DescribeVpcEndpointsOutput{
+				VpcEndpoints: []*awsec2.VpcEndpoint{{State: aws.String("deleted")}},
+			}
+		})
+
+		It("deletes the endpoint and waits for it to be deleted", func() {
+			err := endpoint.Delete()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DeleteVpcEndpointsCall.CallCount).To(Equal(1))
+			Expect(client.DeleteVpcEndpointsCall.Receives.Input.VpcEndpointIds).To(Equal([]*string{id}))
+
+			Expect(client.DescribeVpcEndpointsCall.CallCount).To(Equal(1))
+			Expect(client.DescribeVpcEndpointsCall.Receives.Input.VpcEndpointIds).To(Equal([]*string{id}))
+		})
+
+		Context("when the endpoint is not found while waiting", func() {
+			BeforeEach(func() {
+				client.DescribeVpcEndpointsCall.Returns.Error = awserr.New("InvalidVpcEndpointId.NotFound", "", nil)
+			})
+
+			It("is deleted", func() {
+				err := endpoint.Delete()
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when the client fails", func() {
+			BeforeEach(func() {
+				client.DeleteVpcEndpointsCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := endpoint.Delete()
+				Expect(err).To(MatchError("Delete: banana"))
+			})
+		})
+
+		Context("when the endpoint could not be deleted", func() {
+			BeforeEach(func() {
+				client.DeleteVpcEndpointsCall.Returns.Output.Unsuccessful = []*awsec2.UnsuccessfulItem{{
+					ResourceId: id,
+					Error:      &awsec2.UnsuccessfulItemError{Message: aws.String("banana")},
+				}}
+			})
+
+			It("returns the error", func() {
+				err := endpoint.Delete()
+				Expect(err).To(MatchError("Delete: banana"))
+			})
+		})
+	})
+
+	Describe("Name", func() {
+		It("returns the identifier", func() {
+			Expect(endpoint.Name()).To(Equal("the-id (the-key:the-value)"))
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(endpoint.Type()).To(Equal("EC2 VPC Endpoint"))
+		})
+	})
+})
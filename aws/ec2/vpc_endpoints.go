@@ -0,0 +1,100 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/genevieve/leftovers/common"
+)
+
+type vpcEndpointsClient interface {
+	DescribeVpcEndpoints(*awsec2.DescribeVpcEndpointsInput) (*awsec2.DescribeVpcEndpointsOutput, error)
+	DescribeVpcEndpointsPages(*awsec2.DescribeVpcEndpointsInput, func(*awsec2.DescribeVpcEndpointsOutput, bool) bool) error
+	DeleteVpcEndpoints(*awsec2.DeleteVpcEndpointsInput) (*awsec2.DeleteVpcEndpointsOutput, error)
+}
+
+type VpcEndpoints struct {
+	client vpcEndpointsClient
+	logger logger
+}
+
+func NewVpcEndpoints(client vpcEndpointsClient, logger logger) VpcEndpoints {
+	return VpcEndpoints{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (v VpcEndpoints) List(filter string) ([]common.Deletable, error) {
+	endpoints, err := v.describe(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []common.Deletable
+	for _, e := range endpoints {
+		r := NewVpcEndpoint(v.client, v.logger, e.VpcEndpointId, *e.State, e.Tags)
+
+		if !strings.Contains(r.Name(), filter) {
+			continue
+		}
+
+		proceed := v.logger.PromptWithDetails(r.Type(), r.Name())
+		if !proceed {
+			continue
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func (v VpcEndpoints) Type() string {
+	return "ec2-vpc-endpoint"
+}
+
+// Delete deletes the endpoints in the vpc and waits for them to be deleted.
+func (v VpcEndpoints) Delete(ctx context.Context, vpcId string) error {
+	endpoints, err := v.describe([]*awsec2.Filter{{
+		Name:   aws.String("vpc-id"),
+		Values: []*string{aws.String(vpcId)},
+	}})
+	if err != nil {
+		return err
+	}
+
+	for _, e := range endpoints {
+		err = NewVpcEndpoint(v.client, v.logger, e.VpcEndpointId, *e.State, e.Tags).DeleteContext(ctx)
+		if err != nil {
+			return fmt.Errorf("Delete %s: %s", *e.VpcEndpointId, err)
+		}
+
+		v.logger.Printf("[EC2 VPC: %s] Deleted endpoint %s \n", vpcId, *e.VpcEndpointId)
+	}
+
+	return nil
+}
+
+// describe returns the endpoints that match the filters
+// and are not being deleted.
+func (v VpcEndpoints) describe(filters []*awsec2.Filter) ([]*awsec2.VpcEndpoint, error) {
+	var endpoints []*awsec2.VpcEndpoint
+	err := v.client.DescribeVpcEndpointsPages(&awsec2.DescribeVpcEndpointsInput{Filters: filters}, func(page *awsec2.DescribeVpcEndpointsOutput, lastPage bool) bool {
+		for _, e := range page.VpcEndpoints {
+			state := strings.ToLower(*e.State)
+			if state != "deleting" && state != "deleted" {
+				endpoints = append(endpoints, e)
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Describe EC2 VPC Endpoints: %s", err)
+	}
+
+	return endpoints, nil
+}
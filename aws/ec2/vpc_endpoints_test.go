@@ -0,0 +1,164 @@
+package ec2_test
+
+import (
+	"context"
+
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/genevieve/leftovers/aws/ec2"
+	"github.com/genevieve/leftovers/aws/ec2/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("VpcEndpoints", func() {
+	var (
+		client *fakes.VpcEndpointsClient
+		logger *fakes.Logger
+
+		endpoints ec2.VpcEndpoints
+	)
+
+	BeforeEach(func() {
+		client = &fakes.VpcEndpointsClient{}
+		logger = &fakes.Logger{}
+		logger.PromptWithDetailsCall.Returns.Proceed = true
+
+		client.DescribeVpcEndpointsPagesCall.Returns.Pages = []*awsec2.DescribeVpcEndpointsOutput{{
+			VpcEndpoints: []*awsec2.VpcEndpoint{{
+				VpcEndpointId: aws.String("vpce-banana"),
+				State:         aws.String("available"),
+			}, {
+				VpcEndpointId: aws.String("vpce-deleting-banana"),
+				State:         aws.String("deleting"),
+			}},
+		}}
+
+		endpoints = ec2.NewVpcEndpoints(client, logger)
+	})
+
+	Describe("List", func() {
+		var filter string
+
+		BeforeEach(func() {
+			filter = "banana"
+		})
+
+		It("returns a list of endpoints that are not being deleted", func() {
+			items, err := endpoints.List(filter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DescribeVpcEndpointsPagesCall.CallCount).To(Equal(1))
+
+			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("EC2 VPC Endpoint"))
+			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("vpce-banana"))
+
+			Expect(items).To(HaveLen(1))
+		})
+
+		Context("when there is more than one page of endpoints", func() {
+			BeforeEach(func() {
+				client.DescribeVpcEndpointsPagesCall.Returns.Pages = append(client.DescribeVpcEndpointsPagesCall.Returns.Pages, &awsec2.DescribeVpcEndpointsOutput{
+					VpcEndpoints: []*awsec2.VpcEndpoint{{
+						VpcEndpointId: aws.String("vpce-other-banana"),
+						State:         aws.String("available"),
+					}},
+				})
+			})
+
+			It("returns the endpoints from every page", func() {
+				items, err := endpoints.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("vpce-other-banana"))
+				Expect(items).To(HaveLen(2))
+			})
+		})
+
+		Context("when the endpoint name does not contain the filter", func() {
+			It("does not return it in the list", func() {
+				items, err := endpoints.List("kiwi")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+
+		Context("when the client fails to describe endpoints", func() {
+			BeforeEach(func() {
+				client.DescribeVpcEndpointsPagesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := endpoints.List(filter)
+				Expect(err).To(MatchError("Describe EC2 VPC Endpoints: some error"))
+			})
+		})
+
+		Context("when the user responds no to the prompt", func() {
+			BeforeEach(func() {
+				logger.PromptWithDetailsCall.Returns.Proceed = false
+			})
+
+			It("does not return it in the list", func() {
+				items, err := endpoints.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+	})
+
+	Describe("Delete", func() {
+		BeforeEach(func() {
+			client.DeleteVpcEndpointsCall.Returns.Output = &awsec2.DeleteVpcEndpointsOutput{}
+			client.DescribeVpcEndpointsCall.Returns.Output = &awsec2.DescribeVpcEndpointsOutput{}
+		})
+
+		It("deletes the endpoints in the vpc", func() {
+			err := endpoints.Delete(context.Background(), "the-vpc-id")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DescribeVpcEndpointsPagesCall.Receives.Input.Filters[0].Name).To(Equal(aws.String("vpc-id")))
+			Expect(client.DescribeVpcEndpointsPagesCall.Receives.Input.Filters[0].Values).To(Equal([]*string{aws.String("the-vpc-id")}))
+
+			Expect(client.DeleteVpcEndpointsCall.CallCount).To(Equal(1))
+			Expect(client.DeleteVpcEndpointsCall.Receives.Input.VpcEndpointIds).To(Equal([]*string{aws.String("vpce-banana")}))
+
+			Expect(logger.PrintfCall.Messages).To(ContainElement("[EC2 VPC: the-vpc-id] Deleted endpoint vpce-banana \n"))
+		})
+
+		Context("when the client fails to describe endpoints", func() {
+			BeforeEach(func() {
+				client.DescribeVpcEndpointsPagesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				err := endpoints.Delete(context.Background(), "the-vpc-id")
+				Expect(err).To(MatchError("Describe EC2 VPC Endpoints: some error"))
+			})
+		})
+
+		Context("when the client fails to delete an endpoint", func() {
+			BeforeEach(func() {
+				client.DeleteVpcEndpointsCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				err := endpoints.Delete(context.Background(), "the-vpc-id")
+				Expect(err).To(MatchError("Delete vpce-banana: Delete: some error"))
+			})
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(endpoints.Type()).To(Equal("ec2-vpc-endpoint"))
+		})
+	})
+})
@@ -0,0 +1,52 @@
+package ec2
+
+import (
+	"fmt"
+	"strings"
+
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+)
+
+type VpcPeeringConnection struct {
+	client     vpcPeeringConnectionsClient
+	id         *string
+	identifier string
+	rtype      string
+}
+
+func NewVpcPeeringConnection(client vpcPeeringConnectionsClient, id *string, tags []*awsec2.Tag) VpcPeeringConnection {
+	identifier := *id
+
+	var extra []string
+	for _, t := range tags {
+		extra = append(extra, fmt.Sprintf("%s:%s", *t.Key, *t.Value))
+	}
+
+	if len(extra) > 0 {
+		identifier = fmt.Sprintf("%s (%s)", *id, strings.Join(extra, ", "))
+	}
+
+	return VpcPeeringConnection{
+		client:     client,
+		id:         id,
+		identifier: identifier,
+		rtype:      "EC2 VPC Peering Connection",
+	}
+}
+
+func (v VpcPeeringConnection) Delete() error {
+	_, err := v.client.DeleteVpcPeeringConnection(&awsec2.DeleteVpcPeeringConnectionInput{VpcPeeringConnectionId: v.id})
+	if err != nil {
+		return fmt.Errorf("Delete: %s", err)
+	}
+
+	return nil
+}
+
+func (v VpcPeeringConnection) Name() string {
+	return v.identifier
+}
+
+func (v VpcPeeringConnection) Type() string {
+	return v.rtype
+}
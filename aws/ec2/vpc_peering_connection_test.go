@@ -0,0 +1,61 @@
+package ec2_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/genevieve/leftovers/aws/ec2"
+	"github.com/genevieve/leftovers/aws/ec2/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("VpcPeeringConnection", func() {
+	var (
+		connection ec2.VpcPeeringConnection
+		client     *fakes.VpcPeeringConnectionsClient
+		id         *string
+	)
+
+	BeforeEach(func() {
+		client = &fakes.VpcPeeringConnectionsClient{}
+		id = aws.String("the-id")
+		tags := []*awsec2.Tag{{Key: aws.String("the-key"), Value: aws.String("the-value")}}
+
+		connection = ec2.NewVpcPeeringConnection(client, id, tags)
+	})
+
+	Describe("Delete", func() {
+		It("deletes the peering connection", func() {
+			err := connection.Delete()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DeleteVpcPeeringConnectionCall.CallCount).To(Equal(1))
+			Expect(client.DeleteVpcPeeringConnectionCall.Receives.Input.VpcPeeringConnectionId).To(Equal(id))
+		})
+
+		Context("when the client fails", func() {
+			BeforeEach(func() {
+				client.DeleteVpcPeeringConnectionCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := connection.Delete()
+				Expect(err).To(MatchError("Delete: banana"))
+			})
+		})
+	})
+
+	Describe("Name", func() {
+		It("returns the identifier", func() {
+			Expect(connection.Name()).To(Equal("the-id (the-key:the-value)"))
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(connection.Type()).To(Equal("EC2 VPC Peering Connection"))
+		})
+	})
+})
@@ -0,0 +1,105 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/genevieve/leftovers/common"
+)
+
+type vpcPeeringConnectionsClient interface {
+	DescribeVpcPeeringConnectionsPages(*awsec2.DescribeVpcPeeringConnectionsInput, func(*awsec2.DescribeVpcPeeringConnectionsOutput, bool) bool) error
+	DeleteVpcPeeringConnection(*awsec2.DeleteVpcPeeringConnectionInput) (*awsec2.DeleteVpcPeeringConnectionOutput, error)
+}
+
+type VpcPeeringConnections struct {
+	client vpcPeeringConnectionsClient
+	logger logger
+}
+
+func NewVpcPeeringConnections(client vpcPeeringConnectionsClient, logger logger) VpcPeeringConnections {
+	return VpcPeeringConnections{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (v VpcPeeringConnections) List(filter string) ([]common.Deletable, error) {
+	connections, err := v.describe(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []common.Deletable
+	for _, c := range connections {
+		r := NewVpcPeeringConnection(v.client, c.VpcPeeringConnectionId, c.Tags)
+
+		if !strings.Contains(r.Name(), filter) {
+			continue
+		}
+
+		proceed := v.logger.PromptWithDetails(r.Type(), r.Name())
+		if !proceed {
+			continue
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func (v VpcPeeringConnections) Type() string {
+	return "ec2-vpc-peering-connection"
+}
+
+// Delete deletes the peering connections that
+// the vpc requested or accepted.
+func (v VpcPeeringConnections) Delete(ctx context.Context, vpcId string) error {
+	for _, side := range []string{"requester-vpc-info.vpc-id", "accepter-vpc-info.vpc-id"} {
+		connections, err := v.describe(&awsec2.Filter{
+			Name:   aws.String(side),
+			Values: []*string{aws.String(vpcId)},
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, c := range connections {
+			err = NewVpcPeeringConnection(v.client, c.VpcPeeringConnectionId, c.Tags).Delete()
+			if err != nil {
+				return fmt.Errorf("Delete %s: %s", *c.VpcPeeringConnectionId, err)
+			}
+
+			v.logger.Printf("[EC2 VPC: %s] Deleted peering connection %s \n", vpcId, *c.VpcPeeringConnectionId)
+		}
+	}
+
+	return nil
+}
+
+// describe returns the peering connections that match
+// the filter, if any, and can be deleted.
+func (v VpcPeeringConnections) describe(filter *awsec2.Filter) ([]*awsec2.VpcPeeringConnection, error) {
+	filters := []*awsec2.Filter{{
+		Name:   aws.String("status-code"),
+		Values: []*string{aws.String("pending-acceptance"), aws.String("active")},
+	}}
+	if filter != nil {
+		filters = append(filters, filter)
+	}
+
+	var connections []*awsec2.VpcPeeringConnection
+	err := v.client.DescribeVpcPeeringConnectionsPages(&awsec2.DescribeVpcPeeringConnectionsInput{Filters: filters}, func(page *awsec2.DescribeVpcPeeringConnectionsOutput, lastPage bool) bool {
+		connections = append(connections, page.VpcPeeringConnections...)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Describe EC2 VPC Peering Connections: %s", err)
+	}
+
+	return connections, nil
+}
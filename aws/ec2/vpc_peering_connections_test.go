@@ -0,0 +1,138 @@
+package ec2_test
+
+import (
+	"context"
+
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/genevieve/leftovers/aws/ec2"
+	"github.com/genevieve/leftovers/aws/ec2/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("VpcPeeringConnections", func() {
+	var (
+		client *fakes.VpcPeeringConnectionsClient
+		logger *fakes.Logger
+
+		connections ec2.VpcPeeringConnections
+	)
+
+	BeforeEach(func() {
+		client = &fakes.VpcPeeringConnectionsClient{}
+		logger = &fakes.Logger{}
+		logger.PromptWithDetailsCall.Returns.Proceed = true
+
+		client.DescribeVpcPeeringConnectionsPagesCall.Returns.Pages = []*awsec2.DescribeVpcPeeringConnectionsOutput{{
+			VpcPeeringConnections: []*awsec2.VpcPeeringConnection{{
+				VpcPeeringConnectionId: aws.String("pcx-banana"),
+			}},
+		}}
+
+		connections = ec2.NewVpcPeeringConnections(client, logger)
+	})
+
+	Describe("List", func() {
+		var filter string
+
+		BeforeEach(func() {
+			filter = "banana"
+		})
+
+		It("returns a list of peering connections that can be deleted", func() {
+			items, err := connections.List(filter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DescribeVpcPeeringConnectionsPagesCall.CallCount).To(Equal(1))
+			Expect(client.DescribeVpcPeeringConnectionsPagesCall.Receives.Input.Filters[0].Name).To(Equal(aws.String("status-code")))
+
+			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("EC2 VPC Peering Connection"))
+			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("pcx-banana"))
+
+			Expect(items).To(HaveLen(1))
+		})
+
+		Context("when the peering connection name does not contain the filter", func() {
+			It("does not return it in the list", func() {
+				items, err := connections.List("kiwi")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+
+		Context("when the client fails to describe peering connections", func() {
+			BeforeEach(func() {
+				client.DescribeVpcPeeringConnectionsPagesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := connections.List(filter)
+				Expect(err).To(MatchError("Describe EC2 VPC Peering Connections: some error"))
+			})
+		})
+
+		Context("when the user responds no to the prompt", func() {
+			BeforeEach(func() {
+				logger.PromptWithDetailsCall.Returns.Proceed = false
+			})
+
+			It("does not return it in the list", func() {
+				items, err := connections.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+	})
+
+	Describe("Delete", func() {
+		It("deletes the peering connections the vpc requested and accepted", func() {
+			err := connections.Delete(context.Background(), "the-vpc-id")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DescribeVpcPeeringConnectionsPagesCall.CallCount).To(Equal(2))
+			Expect(client.DescribeVpcPeeringConnectionsPagesCall.Receives.Input.Filters[1].Name).To(Equal(aws.String("accepter-vpc-info.vpc-id")))
+			Expect(client.DescribeVpcPeeringConnectionsPagesCall.Receives.Input.Filters[1].Values).To(Equal([]*string{aws.String("the-vpc-id")}))
+
+			Expect(client.DeleteVpcPeeringConnectionCall.CallCount).To(Equal(2))
+			Expect(client.DeleteVpcPeeringConnectionCall.Receives.Input.VpcPeeringConnectionId).To(Equal(aws.String("pcx-banana")))
+
+			Expect(logger.PrintfCall.Messages).To(ContainElement("[EC2 VPC: the-vpc-id] Deleted peering connection pcx-banana \n"))
+		})
+
+		Context("when the client fails to describe peering connections", func() {
+			BeforeEach(func() {
+				client.DescribeVpcPeeringConnectionsPagesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				err := connections.Delete(context.Background(), "the-vpc-id")
+				Expect(err).To(MatchError("Describe EC2 VPC Peering Connections: some error"))
+			})
+		})
+
+		Context("when the client fails to delete a peering connection", func() {
+			BeforeEach(func() {
+				client.DeleteVpcPeeringConnectionCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				err := connections.Delete(context.Background(), "the-vpc-id")
+				Expect(err).To(MatchError("Delete pcx-banana: Delete: some error"))
+			})
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(connections.Type()).To(Equal("ec2-vpc-peering-connection"))
+		})
+	})
+})
@@ -19,6 +19,13 @@ var _ = Describe("Vpc", func() {
 		routes       *fakes.RouteTables
 		subnets      *fakes.Subnets
 		gateways     *fakes.InternetGateways
+		natGateways  *fakes.VpcDependency
+		endpoints    *fakes.VpcDependency
+		peerings     *fakes.VpcDependency
+		networkAcls  *fakes.VpcDependency
+		egressOnly   *fakes.VpcDependency
+		vpnGateways  *fakes.VpcDependency
+		dhcpOptions  *fakes.VpcDependency
 		resourceTags *fakes.ResourceTags
 		id           *string
 	)
@@ -28,11 +35,28 @@ var _ = Describe("Vpc", func() {
 		routes = &fakes.RouteTables{}
 		subnets = &fakes.Subnets{}
 		gateways = &fakes.InternetGateways{}
+		natGateways = &fakes.VpcDependency{}
+		endpoints = &fakes.VpcDependency{}
+		peerings = &fakes.VpcDependency{}
+		networkAcls = &fakes.VpcDependency{}
+		egressOnly = &fakes.VpcDependency{}
+		vpnGateways = &fakes.VpcDependency{}
+		dhcpOptions = &fakes.VpcDependency{}
 		resourceTags = &fakes.ResourceTags{}
 		id = aws.String("the-id")
 		tags := []*awsec2.Tag{}
 
-		vpc = ec2.NewVpc(client, routes, subnets, gateways, resourceTags, id, tags)
+		dependencies := ec2.VpcDependencies{
+			NatGateways:                natGateways,
+			Endpoints:                  endpoints,
+			PeeringConnections:         peerings,
+			NetworkAcls:                networkAcls,
+			EgressOnlyInternetGateways: egressOnly,
+			VpnGateways:                vpnGateways,
+			DhcpOptionsSets:            dhcpOptions,
+		}
+
+		vpc = ec2.NewVpc(client, routes, subnets, gateways, dependencies, resourceTags, id, tags)
 	})
 
 	Describe("Delete", func() {
@@ -49,6 +73,11 @@ var _ = Describe("Vpc", func() {
 			Expect(gateways.DeleteCall.CallCount).To(Equal(1))
 			Expect(gateways.DeleteCall.Receives.VpcId).To(Equal(*id))
 
+			for _, d := range []*fakes.VpcDependency{natGateways, endpoints, peerings, networkAcls, egressOnly, vpnGateways, dhcpOptions} {
+				Expect(d.DeleteCall.CallCount).To(Equal(1))
+				Expect(d.DeleteCall.Receives.VpcId).To(Equal(*id))
+			}
+
 			Expect(client.DeleteVpcCall.CallCount).To(Equal(1))
 			Expect(client.DeleteVpcCall.Receives.Input.VpcId).To(Equal(id))
 
@@ -90,6 +119,83 @@ var _ = Describe("Vpc", func() {
 			})
 		})
 
+		Context("when deleting nat gateways fails", func() {
+			BeforeEach(func() {
+				natGateways.DeleteCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := vpc.Delete()
+				Expect(err).To(MatchError("Delete nat gateways: banana"))
+			})
+		})
+
+		Context("when deleting endpoints fails", func() {
+			BeforeEach(func() {
+				endpoints.DeleteCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := vpc.Delete()
+				Expect(err).To(MatchError("Delete endpoints: banana"))
+			})
+		})
+
+		Context("when deleting peering connections fails", func() {
+			BeforeEach(func() {
+				peerings.DeleteCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := vpc.Delete()
+				Expect(err).To(MatchError("Delete peering connections: banana"))
+			})
+		})
+
+		Context("when deleting network acls fails", func() {
+			BeforeEach(func() {
+				networkAcls.DeleteCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := vpc.Delete()
+				Expect(err).To(MatchError("Delete network acls: banana"))
+			})
+		})
+
+		Context("when deleting egress-only internet gateways fails", func() {
+			BeforeEach(func() {
+				egressOnly.DeleteCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := vpc.Delete()
+				Expect(err).To(MatchError("Delete egress-only internet gateways: banana"))
+			})
+		})
+
+		Context("when deleting vpn gateways fails", func() {
+			BeforeEach(func() {
+				vpnGateways.DeleteCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := vpc.Delete()
+				Expect(err).To(MatchError("Delete vpn gateways: banana"))
+			})
+		})
+
+		Context("when deleting dhcp options sets fails", func() {
+			BeforeEach(func() {
+				dhcpOptions.DeleteCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := vpc.Delete()
+				Expect(err).To(MatchError("Delete dhcp options sets: banana"))
+			})
+		})
+
 		Context("when deleting resource tags fails", func() {
 			BeforeEach(func() {
 				resourceTags.DeleteCall.Returns.Error = errors.New("banana")
@@ -10,7 +10,7 @@ import (
 )
 
 type vpcsClient interface {
-	DescribeVpcs(*awsec2.DescribeVpcsInput) (*awsec2.DescribeVpcsOutput, error)
+	DescribeVpcsPages(*awsec2.DescribeVpcsInput, func(*awsec2.DescribeVpcsOutput, bool) bool) error
 	DeleteVpc(*awsec2.DeleteVpcInput) (*awsec2.DeleteVpcOutput, error)
 }
 
@@ -20,34 +20,40 @@ type Vpcs struct {
 	routes       routeTables
 	subnets      subnets
 	gateways     internetGateways
+	dependencies VpcDependencies
 	resourceTags resourceTags
 }
 
-func NewVpcs(client vpcsClient, logger logger, routes routeTables, subnets subnets, gateways internetGateways, resourceTags resourceTags) Vpcs {
+func NewVpcs(client vpcsClient, logger logger, routes routeTables, subnets subnets, gateways internetGateways, dependencies VpcDependencies, resourceTags resourceTags) Vpcs {
 	return Vpcs{
 		client:       client,
 		logger:       logger,
 		routes:       routes,
 		subnets:      subnets,
 		gateways:     gateways,
+		dependencies: dependencies,
 		resourceTags: resourceTags,
 	}
 }
 
 func (v Vpcs) List(filter string) ([]common.Deletable, error) {
-	output, err := v.client.DescribeVpcs(&awsec2.DescribeVpcsInput{
+	var vpcs []*awsec2.Vpc
+	err := v.client.DescribeVpcsPages(&awsec2.DescribeVpcsInput{
 		Filters: []*awsec2.Filter{{
 			Name:   aws.String("isDefault"),
 			Values: []*string{aws.String("false")},
 		}},
+	}, func(page *awsec2.DescribeVpcsOutput, lastPage bool) bool {
+		vpcs = append(vpcs, page.Vpcs...)
+		return true
 	})
 	if err != nil {
 		return nil, fmt.Errorf("Describe EC2 VPCs: %s", err)
 	}
 
 	var resources []common.Deletable
-	for _, vpc := range output.Vpcs {
-		r := NewVpc(v.client, v.routes, v.subnets, v.gateways, v.resourceTags, vpc.VpcId, vpc.Tags)
+	for _, vpc := range vpcs {
+		r := NewVpc(v.client, v.routes, v.subnets, v.gateways, v.dependencies, v.resourceTags, vpc.VpcId, vpc.Tags)
 
 		if !strings.Contains(r.Name(), filter) {
 			continue
@@ -27,7 +27,7 @@ var _ = Describe("Vpcs", func() {
 		gateways := &fakes.InternetGateways{}
 		resourceTags := &fakes.ResourceTags{}
 
-		vpcs = ec2.NewVpcs(client, logger, routes, subnets, gateways, resourceTags)
+		vpcs = ec2.NewVpcs(client, logger, routes, subnets, gateways, ec2.VpcDependencies{}, resourceTags)
 	})
 
 	Describe("List", func() {
@@ -35,7 +35,7 @@ var _ = Describe("Vpcs", func() {
 
 		BeforeEach(func() {
 			logger.PromptWithDetailsCall.Returns.Proceed = true
-			client.DescribeVpcsCall.Returns.Output = &awsec2.DescribeVpcsOutput{
+			client.DescribeVpcsPagesCall.Returns.Pages = []*awsec2.DescribeVpcsOutput{{
 				Vpcs: []*awsec2.Vpc{{
 					IsDefault: aws.Bool(false),
 					Tags: []*awsec2.Tag{{
@@ -44,7 +44,7 @@ var _ = Describe("Vpcs", func() {
 					}},
 					VpcId: aws.String("the-vpc-id"),
 				}},
-			}
+			}}
 			filter = "ban"
 		})
 
@@ -52,9 +52,9 @@ var _ = Describe("Vpcs", func() {
 			items, err := vpcs.List(filter)
 			Expect(err).NotTo(HaveOccurred())
 
-			Expect(client.DescribeVpcsCall.CallCount).To(Equal(1))
-			Expect(client.DescribeVpcsCall.Receives.Input.Filters[0].Name).To(Equal(aws.String("isDefault")))
-			Expect(client.DescribeVpcsCall.Receives.Input.Filters[0].Values[0]).To(Equal(aws.String("false")))
+			Expect(client.DescribeVpcsPagesCall.CallCount).To(Equal(1))
+			Expect(client.DescribeVpcsPagesCall.Receives.Input.Filters[0].Name).To(Equal(aws.String("isDefault")))
+			Expect(client.DescribeVpcsPagesCall.Receives.Input.Filters[0].Values[0]).To(Equal(aws.String("false")))
 
 			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
 			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("EC2 VPC"))
@@ -63,6 +63,28 @@ var _ = Describe("Vpcs", func() {
 			Expect(items).To(HaveLen(1))
 		})
 
+		Context("when there is more than one page of vpcs", func() {
+			BeforeEach(func() {
+				client.DescribeVpcsPagesCall.Returns.Pages = append(client.DescribeVpcsPagesCall.Returns.Pages, &awsec2.DescribeVpcsOutput{
+					Vpcs: []*awsec2.Vpc{{
+						IsDefault: aws.Bool(false),
+						VpcId:     aws.String("the-banana-vpc-id"),
+					}},
+				})
+			})
+
+			It("returns the vpcs from every page", func() {
+				items, err := vpcs.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(client.DescribeVpcsPagesCall.CallCount).To(Equal(1))
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(2))
+				Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("the-banana-vpc-id"))
+
+				Expect(items).To(HaveLen(2))
+			})
+		})
+
 		Context("when the vpc tags contain the filter", func() {
 			It("does not return it in the list", func() {
 				items, err := vpcs.List("kiwi")
@@ -74,12 +96,12 @@ var _ = Describe("Vpcs", func() {
 
 		Context("when there is no tag name", func() {
 			BeforeEach(func() {
-				client.DescribeVpcsCall.Returns.Output = &awsec2.DescribeVpcsOutput{
+				client.DescribeVpcsPagesCall.Returns.Pages = []*awsec2.DescribeVpcsOutput{{
 					Vpcs: []*awsec2.Vpc{{
 						IsDefault: aws.Bool(false),
 						VpcId:     aws.String("the-vpc-id"),
 					}},
-				}
+				}}
 			})
 
 			It("uses just the vpc id in the prompt", func() {
@@ -93,7 +115,7 @@ var _ = Describe("Vpcs", func() {
 
 		Context("when the client fails to list vpcs", func() {
 			BeforeEach(func() {
-				client.DescribeVpcsCall.Returns.Error = errors.New("some error")
+				client.DescribeVpcsPagesCall.Returns.Error = errors.New("some error")
 			})
 
 			It("returns the error", func() {
@@ -0,0 +1,79 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/genevieve/leftovers/common"
+)
+
+type vpnConnectionsClient interface {
+	DescribeVpnConnections(*awsec2.DescribeVpnConnectionsInput) (*awsec2.DescribeVpnConnectionsOutput, error)
+	DeleteVpnConnection(*awsec2.DeleteVpnConnectionInput) (*awsec2.DeleteVpnConnectionOutput, error)
+}
+
+// deleteVpnConnections deletes the vpn connections of the gateway
+// with the provided id, and waits for them to be deleted, since
+// the gateway can not be deleted until they are.
+func deleteVpnConnections(ctx context.Context, client vpnConnectionsClient, logger logger, gatewayFilter, gatewayId string) error {
+	resp, err := client.DescribeVpnConnections(&awsec2.DescribeVpnConnectionsInput{
+		Filters: []*awsec2.Filter{{
+			Name:   aws.String(gatewayFilter),
+			Values: []*string{aws.String(gatewayId)},
+		}, {
+			Name:   aws.String("state"),
+			Values: []*string{aws.String("pending"), aws.String("available"), aws.String("deleting")},
+		}},
+	})
+	if err != nil {
+		return fmt.Errorf("Describe EC2 VPN Connections: %s", err)
+	}
+
+	if len(resp.VpnConnections) == 0 {
+		return nil
+	}
+
+	var ids []*string
+	for _, c := range resp.VpnConnections {
+		ids = append(ids, c.VpnConnectionId)
+
+		if *c.State == "deleting" {
+			continue
+		}
+
+		_, err = client.DeleteVpnConnection(&awsec2.DeleteVpnConnectionInput{VpnConnectionId: c.VpnConnectionId})
+		if err != nil {
+			return fmt.Errorf("Delete vpn connection %s: %s", *c.VpnConnectionId, err)
+		}
+	}
+
+	refresh := vpnConnectionsRefresh(client, ids)
+
+	poller := common.NewPoller(logger, refresh, []string{"deleting"}, []string{"deleted"})
+
+	_, err = poller.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("Waiting for vpn connections to be deleted: %s", err)
+	}
+
+	return nil
+}
+
+func vpnConnectionsRefresh(client vpnConnectionsClient, ids []*string) common.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.DescribeVpnConnections(&awsec2.DescribeVpnConnectionsInput{VpnConnectionIds: ids})
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, c := range resp.VpnConnections {
+			if *c.State != "deleted" {
+				return resp, "deleting", nil
+			}
+		}
+
+		return resp, "deleted", nil
+	}
+}
@@ -0,0 +1,119 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/genevieve/leftovers/common"
+)
+
+type VpnGateway struct {
+	client      vpnGatewaysClient
+	logger      logger
+	id          *string
+	attachments []*awsec2.VpcAttachment
+	identifier  string
+	rtype       string
+}
+
+func NewVpnGateway(client vpnGatewaysClient, logger logger, id *string, attachments []*awsec2.VpcAttachment, tags []*awsec2.Tag) VpnGateway {
+	identifier := *id
+
+	var extra []string
+	for _, t := range tags {
+		extra = append(extra, fmt.Sprintf("%s:%s", *t.Key, *t.Value))
+	}
+
+	if len(extra) > 0 {
+		identifier = fmt.Sprintf("%s (%s)", *id, strings.Join(extra, ", "))
+	}
+
+	return VpnGateway{
+		client:      client,
+		logger:      logger,
+		id:          id,
+		attachments: attachments,
+		identifier:  identifier,
+		rtype:       "EC2 VPN Gateway",
+	}
+}
+
+func (v VpnGateway) Delete() error {
+	return v.DeleteContext(context.Background())
+}
+
+// DeleteContext deletes the vpn connections of the gateway, detaches
+// it from its vpcs and waits for it to be detached, and deletes it.
+func (v VpnGateway) DeleteContext(ctx context.Context) error {
+	err := deleteVpnConnections(ctx, v.client, v.logger, "vpn-gateway-id", *v.id)
+	if err != nil {
+		return err
+	}
+
+	detaching := false
+	for _, a := range v.attachments {
+		if *a.State == "detached" {
+			continue
+		}
+		detaching = true
+
+		if *a.State == "detaching" {
+			continue
+		}
+
+		_, err = v.client.DetachVpnGateway(&awsec2.DetachVpnGatewayInput{VpnGatewayId: v.id, VpcId: a.VpcId})
+		if err != nil {
+			return fmt.Errorf("Detach from %s: %s", *a.VpcId, err)
+		}
+	}
+
+	if detaching {
+		refresh := vpnGatewayRefresh(v.client, v.id)
+
+		poller := common.NewPoller(v.logger, refresh, []string{"detaching"}, []string{"detached"})
+
+		_, err = poller.Wait(ctx)
+		if err != nil {
+			return fmt.Errorf("Waiting for detachment: %s", err)
+		}
+	}
+
+	_, err = v.client.DeleteVpnGateway(&awsec2.DeleteVpnGatewayInput{VpnGatewayId: v.id})
+	if err != nil {
+		return fmt.Errorf("Delete: %s", err)
+	}
+
+	return nil
+}
+
+func (v VpnGateway) Name() string {
+	return v.identifier
+}
+
+func (v VpnGateway) Type() string {
+	return v.rtype
+}
+
+func vpnGatewayRefresh(client vpnGatewaysClient, id *string) common.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.DescribeVpnGateways(&awsec2.DescribeVpnGatewaysInput{VpnGatewayIds: []*string{id}})
+		if err != nil {
+			return nil, "", err
+		}
+
+		if len(resp.VpnGateways) == 0 {
+			return nil, "", nil
+		}
+
+		g := resp.VpnGateways[0]
+		for _, a := range g.VpcAttachments {
+			if *a.State != "detached" {
+				return g, "detaching", nil
+			}
+		}
+
+		return g, "detached", nil
+	}
+}
@@ -0,0 +1,162 @@
+package ec2_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/genevieve/leftovers/aws/ec2"
+	"github.com/genevieve/leftovers/aws/ec2/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("VpnGateway", func() {
+	var (
+		gateway     ec2.VpnGateway
+		client      *fakes.VpnGatewaysClient
+		logger      *fakes.Logger
+		id          *string
+		attachments []*awsec2.VpcAttachment
+	)
+
+	BeforeEach(func() {
+		client = &fakes.VpnGatewaysClient{}
+		logger = &fakes.Logger{}
+		id = aws.String("the-id")
+		attachments = []*awsec2.VpcAttachment{{VpcId: aws.String("the-vpc-id"), State: aws.String("attached")}}
+		tags := []*awsec2.Tag{{Key: aws.String("the-key"), Value: aws.String("the-value")}}
+
+		client.DescribeVpnConnectionsCall.Returns.Output = &awsec2.DescribeVpnConnectionsOutput{}
+		client.DescribeVpnGatewaysCall.Returns.Output = &awsec2.DescribeVpnGatewaysOutput{
+			VpnGateways: []*awsec2.VpnGateway{{
+				VpnGatewayId:   id,
+				VpcAttachments: []*awsec2.VpcAttachment{{VpcId: aws.String("the-vpc-id"), State: aws.String("detached")}},
+			}},
+		}
+
+		gateway = ec2.NewVpnGateway(client, logger, id, attachments, tags)
+	})
+
+	Describe("Delete", func() {
+		It("detaches the gateway, waits for it to be detached, and deletes it", func() {
+			err := gateway.Delete()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DescribeVpnConnectionsCall.Receives.Input.Filters[0].Name).To(Equal(aws.String("vpn-gateway-id")))
+			Expect(client.DescribeVpnConnectionsCall.Receives.Input.Filters[0].Values).To(Equal([]*string{id}))
+			Expect(client.DeleteVpnConnectionCall.CallCount).To(Equal(0))
+
+			Expect(client.DetachVpnGatewayCall.CallCount).To(Equal(1))
+			Expect(client.DetachVpnGatewayCall.Receives.Input.VpnGatewayId).To(Equal(id))
+			Expect(client.DetachVpnGatewayCall.Receives.Input.VpcId).To(Equal(aws.String("the-vpc-id")))
+
+			Expect(client.DescribeVpnGatewaysCall.CallCount).To(Equal(1))
+			Expect(client.DescribeVpnGatewaysCall.Receives.Input.VpnGatewayIds).To(Equal([]*string{id}))
+
+			Expect(client.DeleteVpnGatewayCall.CallCount).To(Equal(1))
+			Expect(client.DeleteVpnGatewayCall.Receives.Input.VpnGatewayId).To(Equal(id))
+		})
+
+		Context("when the gateway has vpn connections", func() {
+			BeforeEach(func() {
+				client.DescribeVpnConnectionsCall.ReturnsOnCall = map[int]struct {
+					Output *awsec2.DescribeVpnConnectionsOutput
+					Error  error
+				}{
+					0: {Output: &awsec2.DescribeVpnConnectionsOutput{
+						VpnConnections: []*awsec2.VpnConnection{{VpnConnectionId: aws.String("the-connection-id"), State: aws.String("available")}},
+					}},
+				}
+				client.DescribeVpnConnectionsCall.Returns.Output = &awsec2.DescribeVpnConnectionsOutput{
+					VpnConnections: []*awsec2.VpnConnection{{VpnConnectionId: aws.String("the-connection-id"), State: aws.String("deleted")}},
+				}
+			})
+
+			It("deletes them and waits for them to be deleted first", func() {
+				err := gateway.Delete()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(client.DeleteVpnConnectionCall.CallCount).To(Equal(1))
+				Expect(client.DeleteVpnConnectionCall.Receives.Input.VpnConnectionId).To(Equal(aws.String("the-connection-id")))
+
+				Expect(client.DescribeVpnConnectionsCall.CallCount).To(Equal(2))
+				Expect(client.DescribeVpnConnectionsCall.Receives.Input.VpnConnectionIds).To(Equal([]*string{aws.String("the-connection-id")}))
+
+				Expect(client.DeleteVpnGatewayCall.CallCount).To(Equal(1))
+			})
+
+			Context("when the client fails to delete a vpn connection", func() {
+				BeforeEach(func() {
+					client.DeleteVpnConnectionCall.Returns.Error = errors.New("banana")
+				})
+
+				It("returns the error", func() {
+					err := gateway.Delete()
+					Expect(err).To(MatchError("Delete vpn connection the-connection-id: banana"))
+				})
+			})
+		})
+
+		Context("when the gateway is already detached", func() {
+			BeforeEach(func() {
+				attachments[0].State = aws.String("detached")
+			})
+
+			It("does not detach it or wait", func() {
+				err := gateway.Delete()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(client.DetachVpnGatewayCall.CallCount).To(Equal(0))
+				Expect(client.DescribeVpnGatewaysCall.CallCount).To(Equal(0))
+				Expect(client.DeleteVpnGatewayCall.CallCount).To(Equal(1))
+			})
+		})
+
+		Context("when the client fails to describe vpn connections", func() {
+			BeforeEach(func() {
+				client.DescribeVpnConnectionsCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := gateway.Delete()
+				Expect(err).To(MatchError("Describe EC2 VPN Connections: banana"))
+			})
+		})
+
+		Context("when the client fails to detach", func() {
+			BeforeEach(func() {
+				client.DetachVpnGatewayCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := gateway.Delete()
+				Expect(err).To(MatchError("Detach from the-vpc-id: banana"))
+			})
+		})
+
+		Context("when the client fails", func() {
+			BeforeEach(func() {
+				attachments[0].State = aws.String("detached")
+				client.DeleteVpnGatewayCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := gateway.Delete()
+				Expect(err).To(MatchError("Delete: banana"))
+			})
+		})
+	})
+
+	Describe("Name", func() {
+		It("returns the identifier", func() {
+			Expect(gateway.Name()).To(Equal("the-id (the-key:the-value)"))
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(gateway.Type()).To(Equal("EC2 VPN Gateway"))
+		})
+	})
+})
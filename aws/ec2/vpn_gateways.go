@@ -0,0 +1,101 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/genevieve/leftovers/common"
+)
+
+type vpnGatewaysClient interface {
+	vpnConnectionsClient
+
+	DescribeVpnGateways(*awsec2.DescribeVpnGatewaysInput) (*awsec2.DescribeVpnGatewaysOutput, error)
+	DetachVpnGateway(*awsec2.DetachVpnGatewayInput) (*awsec2.DetachVpnGatewayOutput, error)
+	DeleteVpnGateway(*awsec2.DeleteVpnGatewayInput) (*awsec2.DeleteVpnGatewayOutput, error)
+}
+
+type VpnGateways struct {
+	client vpnGatewaysClient
+	logger logger
+}
+
+func NewVpnGateways(client vpnGatewaysClient, logger logger) VpnGateways {
+	return VpnGateways{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (v VpnGateways) List(filter string) ([]common.Deletable, error) {
+	gateways, err := v.describe(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []common.Deletable
+	for _, g := range gateways {
+		r := NewVpnGateway(v.client, v.logger, g.VpnGatewayId, g.VpcAttachments, g.Tags)
+
+		if !strings.Contains(r.Name(), filter) {
+			continue
+		}
+
+		proceed := v.logger.PromptWithDetails(r.Type(), r.Name())
+		if !proceed {
+			continue
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func (v VpnGateways) Type() string {
+	return "ec2-vpn-gateway"
+}
+
+// Delete deletes the vpn gateways that are attached to the vpc.
+func (v VpnGateways) Delete(ctx context.Context, vpcId string) error {
+	gateways, err := v.describe(&awsec2.Filter{
+		Name:   aws.String("attachment.vpc-id"),
+		Values: []*string{aws.String(vpcId)},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, g := range gateways {
+		err = NewVpnGateway(v.client, v.logger, g.VpnGatewayId, g.VpcAttachments, g.Tags).DeleteContext(ctx)
+		if err != nil {
+			return fmt.Errorf("Delete %s: %s", *g.VpnGatewayId, err)
+		}
+
+		v.logger.Printf("[EC2 VPC: %s] Deleted vpn gateway %s \n", vpcId, *g.VpnGatewayId)
+	}
+
+	return nil
+}
+
+// describe returns the vpn gateways that match
+// the filter, if any, and are not being deleted.
+func (v VpnGateways) describe(filter *awsec2.Filter) ([]*awsec2.VpnGateway, error) {
+	filters := []*awsec2.Filter{{
+		Name:   aws.String("state"),
+		Values: []*string{aws.String("pending"), aws.String("available")},
+	}}
+	if filter != nil {
+		filters = append(filters, filter)
+	}
+
+	resp, err := v.client.DescribeVpnGateways(&awsec2.DescribeVpnGatewaysInput{Filters: filters})
+	if err != nil {
+		return nil, fmt.Errorf("Describe EC2 VPN Gateways: %s", err)
+	}
+
+	return resp.VpnGateways, nil
+}
@@ -0,0 +1,141 @@
+package ec2_test
+
+import (
+	"context"
+
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/genevieve/leftovers/aws/ec2"
+	"github.com/genevieve/leftovers/aws/ec2/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("VpnGateways", func() {
+	var (
+		client *fakes.VpnGatewaysClient
+		logger *fakes.Logger
+
+		gateways ec2.VpnGateways
+	)
+
+	BeforeEach(func() {
+		client = &fakes.VpnGatewaysClient{}
+		logger = &fakes.Logger{}
+		logger.PromptWithDetailsCall.Returns.Proceed = true
+
+		client.DescribeVpnGatewaysCall.Returns.Output = &awsec2.DescribeVpnGatewaysOutput{
+			VpnGateways: []*awsec2.VpnGateway{{
+				VpnGatewayId: aws.String("vgw-banana"),
+			}},
+		}
+
+		gateways = ec2.NewVpnGateways(client, logger)
+	})
+
+	Describe("List", func() {
+		var filter string
+
+		BeforeEach(func() {
+			filter = "banana"
+		})
+
+		It("returns a list of vpn gateways that are not being deleted", func() {
+			items, err := gateways.List(filter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DescribeVpnGatewaysCall.CallCount).To(Equal(1))
+			Expect(client.DescribeVpnGatewaysCall.Receives.Input.Filters[0].Name).To(Equal(aws.String("state")))
+
+			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("EC2 VPN Gateway"))
+			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("vgw-banana"))
+
+			Expect(items).To(HaveLen(1))
+		})
+
+		Context("when the gateway name does not contain the filter", func() {
+			It("does not return it in the list", func() {
+				items, err := gateways.List("kiwi")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+
+		Context("when the client fails to describe vpn gateways", func() {
+			BeforeEach(func() {
+				client.DescribeVpnGatewaysCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := gateways.List(filter)
+				Expect(err).To(MatchError("Describe EC2 VPN Gateways: some error"))
+			})
+		})
+
+		Context("when the user responds no to the prompt", func() {
+			BeforeEach(func() {
+				logger.PromptWithDetailsCall.Returns.Proceed = false
+			})
+
+			It("does not return it in the list", func() {
+				items, err := gateways.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+	})
+
+	Describe("Delete", func() {
+		BeforeEach(func() {
+			client.DescribeVpnConnectionsCall.Returns.Output = &awsec2.DescribeVpnConnectionsOutput{}
+		})
+
+		It("deletes the vpn gateways attached to the vpc", func() {
+			err := gateways.Delete(context.Background(), "the-vpc-id")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DescribeVpnGatewaysCall.Receives.Input.Filters[1].Name).To(Equal(aws.String("attachment.vpc-id")))
+			Expect(client.DescribeVpnGatewaysCall.Receives.Input.Filters[1].Values).To(Equal([]*string{aws.String("the-vpc-id")}))
+
+			Expect(client.DeleteVpnGatewayCall.CallCount).To(Equal(1))
+			Expect(client.DeleteVpnGatewayCall.Receives.Input.VpnGatewayId).To(Equal(aws.String("vgw-banana")))
+
+			Expect(logger.PrintfCall.Messages).To(ContainElement("[EC2 VPC: the-vpc-id] Deleted vpn gateway vgw-banana \n"))
+		})
+
+		Context("when the client fails to describe vpn gateways", func() {
+			BeforeEach(func() {
+				client.DescribeVpnGatewaysCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				err := gateways.Delete(context.Background(), "the-vpc-id")
+				Expect(err).To(MatchError("Describe EC2 VPN Gateways: some error"))
+			})
+		})
+
+		Context("when the client fails to delete a vpn gateway", func() {
+			BeforeEach(func() {
+				client.DeleteVpnGatewayCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				err := gateways.Delete(context.Background(), "the-vpc-id")
+				Expect(err).To(MatchError("Delete vgw-banana: Delete: some error"))
+			})
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(gateways.Type()).To(Equal("ec2-vpn-gateway"))
+		})
+	})
+})
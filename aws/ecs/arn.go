@@ -0,0 +1,10 @@
+package ecs
+
+import "strings"
+
+// nameFromARN returns the resource of the provided ARN without its type,
+// like the name of a cluster or the family and revision of a task definition.
+func nameFromARN(arn string) string {
+	parts := strings.SplitN(arn, "/", 2)
+	return parts[len(parts)-1]
+}
@@ -0,0 +1,147 @@
+package ecs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/genevieve/leftovers/common"
+)
+
+type Cluster struct {
+	client     clustersClient
+	logger     logger
+	arn        *string
+	identifier string
+	rtype      string
+}
+
+func NewCluster(client clustersClient, logger logger, arn *string) Cluster {
+	return Cluster{
+		client:     client,
+		logger:     logger,
+		arn:        arn,
+		identifier: nameFromARN(*arn),
+		rtype:      "ECS Cluster",
+	}
+}
+
+func (c Cluster) Delete() error {
+	return c.DeleteContext(context.Background())
+}
+
+// DeleteContext scales each service in the cluster to zero and deletes it, stops
+// the tasks that are left, deregisters the container instances, waits for
+// the services to be inactive, and finally deletes the cluster.
+func (c Cluster) DeleteContext(ctx context.Context) error {
+	var services []*string
+	err := c.client.ListServicesPages(&awsecs.ListServicesInput{Cluster: c.arn}, func(page *awsecs.ListServicesOutput, lastPage bool) bool {
+		services = append(services, page.ServiceArns...)
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("List services: %s", err)
+	}
+
+	for _, s := range services {
+		_, err = c.client.UpdateService(&awsecs.UpdateServiceInput{
+			Cluster:      c.arn,
+			Service:      s,
+			DesiredCount: aws.Int64(0),
+		})
+		if err != nil {
+			return fmt.Errorf("Drain service %s: %s", nameFromARN(*s), err)
+		}
+
+		_, err = c.client.DeleteService(&awsecs.DeleteServiceInput{
+			Cluster: c.arn,
+			Service: s,
+			Force:   aws.Bool(true),
+		})
+		if err != nil {
+			return fmt.Errorf("Delete service %s: %s", nameFromARN(*s), err)
+		}
+	}
+
+	var tasks []*string
+	err = c.client.ListTasksPages(&awsecs.ListTasksInput{Cluster: c.arn}, func(page *awsecs.ListTasksOutput, lastPage bool) bool {
+		tasks = append(tasks, page.TaskArns...)
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("List tasks: %s", err)
+	}
+
+	for _, t := range tasks {
+		_, err = c.client.StopTask(&awsecs.StopTaskInput{Cluster: c.arn, Task: t})
+		if err != nil {
+			return fmt.Errorf("Stop task %s: %s", nameFromARN(*t), err)
+		}
+	}
+
+	var instances []*string
+	err = c.client.ListContainerInstancesPages(&awsecs.ListContainerInstancesInput{Cluster: c.arn}, func(page *awsecs.ListContainerInstancesOutput, lastPage bool) bool {
+		instances = append(instances, page.ContainerInstanceArns...)
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("List container instances: %s", err)
+	}
+
+	for _, i := range instances {
+		_, err = c.client.DeregisterContainerInstance(&awsecs.DeregisterContainerInstanceInput{
+			Cluster:           c.arn,
+			ContainerInstance: i,
+			Force:             aws.Bool(true),
+		})
+		if err != nil {
+			return fmt.Errorf("Deregister container instance %s: %s", nameFromARN(*i), err)
+		}
+	}
+
+	for _, s := range services {
+		refresh := serviceRefresh(c.client, c.arn, s)
+
+		poller := common.NewPoller(c.logger, refresh, []string{"ACTIVE", "DRAINING"}, []string{"INACTIVE"})
+
+		_, err = poller.Wait(ctx)
+		if err != nil {
+			return fmt.Errorf("Waiting for service %s to be deleted: %s", nameFromARN(*s), err)
+		}
+	}
+
+	_, err = c.client.DeleteCluster(&awsecs.DeleteClusterInput{Cluster: c.arn})
+	if err != nil {
+		return fmt.Errorf("Delete: %s", err)
+	}
+
+	return nil
+}
+
+func (c Cluster) Name() string {
+	return c.identifier
+}
+
+func (c Cluster) Type() string {
+	return c.rtype
+}
+
+func serviceRefresh(client clustersClient, cluster, service *string) common.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.DescribeServices(&awsecs.DescribeServicesInput{
+			Cluster:  cluster,
+			Services: []*string{service},
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		if len(resp.Services) == 0 {
+			return service, "INACTIVE", nil
+		}
+
+		s := resp.Services[0]
+		return s, *s.Status, nil
+	}
+}
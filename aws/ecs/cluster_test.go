@@ -0,0 +1,129 @@
+package ecs_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/genevieve/leftovers/aws/ecs"
+	"github.com/genevieve/leftovers/aws/ecs/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cluster", func() {
+	var (
+		cluster ecs.Cluster
+		client  *fakes.ClustersClient
+		logger  *fakes.Logger
+		arn     *string
+		service *string
+	)
+
+	BeforeEach(func() {
+		client = &fakes.ClustersClient{}
+		logger = &fakes.Logger{}
+		arn = aws.String("arn:aws:ecs:us-east-1:123456789012:cluster/the-cluster")
+		service = aws.String("arn:aws:ecs:us-east-1:123456789012:service/the-cluster/the-service")
+
+		cluster = ecs.NewCluster(client, logger, arn)
+	})
+
+	Describe("Delete", func() {
+		BeforeEach(func() {
+			client.ListServicesPagesCall.Returns.Pages = []*awsecs.ListServicesOutput{{
+				ServiceArns: []*string{service},
+			}}
+			client.ListTasksPagesCall.Returns.Pages = []*awsecs.ListTasksOutput{{
+				TaskArns: []*string{aws.String("arn:aws:ecs:us-east-1:123456789012:task/the-cluster/the-task")},
+			}}
+			client.ListContainerInstancesPagesCall.Returns.Pages = []*awsecs.ListContainerInstancesOutput{{
+				ContainerInstanceArns: []*string{aws.String("arn:aws:ecs:us-east-1:123456789012:container-instance/the-cluster/the-instance")},
+			}}
+			client.DescribeServicesCall.Returns.Output = &awsecs.DescribeServicesOutput{
+				Services: []*awsecs.Service{{
+					ServiceArn: service,
+					Status:     aws.String("INACTIVE"),
+				}},
+			}
+		})
+
+		It("drains and deletes the services, deregisters the container instances and deletes the cluster", func() {
+			err := cluster.Delete()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.ListServicesPagesCall.Receives.Input.Cluster).To(Equal(arn))
+
+			Expect(client.UpdateServiceCall.CallCount).To(Equal(1))
+			Expect(client.UpdateServiceCall.Receives.Input.Cluster).To(Equal(arn))
+			Expect(client.UpdateServiceCall.Receives.Input.Service).To(Equal(service))
+			Expect(client.UpdateServiceCall.Receives.Input.DesiredCount).To(Equal(aws.Int64(0)))
+
+			Expect(client.DeleteServiceCall.CallCount).To(Equal(1))
+			Expect(client.DeleteServiceCall.Receives.Input.Service).To(Equal(service))
+			Expect(client.DeleteServiceCall.Receives.Input.Force).To(Equal(aws.Bool(true)))
+
+			Expect(client.StopTaskCall.CallCount).To(Equal(1))
+			Expect(client.StopTaskCall.Receives.Input.Task).To(Equal(aws.String("arn:aws:ecs:us-east-1:123456789012:task/the-cluster/the-task")))
+
+			Expect(client.DeregisterContainerInstanceCall.CallCount).To(Equal(1))
+			Expect(client.DeregisterContainerInstanceCall.Receives.Input.ContainerInstance).To(Equal(aws.String("arn:aws:ecs:us-east-1:123456789012:container-instance/the-cluster/the-instance")))
+			Expect(client.DeregisterContainerInstanceCall.Receives.Input.Force).To(Equal(aws.Bool(true)))
+
+			Expect(client.DescribeServicesCall.CallCount).To(Equal(1))
+			Expect(client.DescribeServicesCall.Receives.Input.Services).To(Equal([]*string{service}))
+
+			Expect(client.DeleteClusterCall.CallCount).To(Equal(1))
+			Expect(client.DeleteClusterCall.Receives.Input.Cluster).To(Equal(arn))
+		})
+
+		Context("when the client fails to drain a service", func() {
+			BeforeEach(func() {
+				client.UpdateServiceCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := cluster.Delete()
+				Expect(err).To(MatchError("Drain service the-cluster/the-service: banana"))
+
+				Expect(client.DeleteClusterCall.CallCount).To(Equal(0))
+			})
+		})
+
+		Context("when the client fails to deregister a container instance", func() {
+			BeforeEach(func() {
+				client.DeregisterContainerInstanceCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := cluster.Delete()
+				Expect(err).To(MatchError("Deregister container instance the-cluster/the-instance: banana"))
+
+				Expect(client.DeleteClusterCall.CallCount).To(Equal(0))
+			})
+		})
+
+		Context("when the client fails to delete the cluster", func() {
+			BeforeEach(func() {
+				client.DeleteClusterCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := cluster.Delete()
+				Expect(err).To(MatchError("Delete: banana"))
+			})
+		})
+	})
+
+	Describe("Name", func() {
+		It("returns the identifier", func() {
+			Expect(cluster.Name()).To(Equal("the-cluster"))
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(cluster.Type()).To(Equal("ECS Cluster"))
+		})
+	})
+})
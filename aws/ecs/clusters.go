@@ -0,0 +1,72 @@
+package ecs
+
+import (
+	"fmt"
+	"strings"
+
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/genevieve/leftovers/common"
+)
+
+type clustersClient interface {
+	ListClustersPages(*awsecs.ListClustersInput, func(*awsecs.ListClustersOutput, bool) bool) error
+	ListServicesPages(*awsecs.ListServicesInput, func(*awsecs.ListServicesOutput, bool) bool) error
+	DescribeServices(*awsecs.DescribeServicesInput) (*awsecs.DescribeServicesOutput, error)
+	UpdateService(*awsecs.UpdateServiceInput) (*awsecs.UpdateServiceOutput, error)
+	DeleteService(*awsecs.DeleteServiceInput) (*awsecs.DeleteServiceOutput, error)
+	ListTasksPages(*awsecs.ListTasksInput, func(*awsecs.ListTasksOutput, bool) bool) error
+	StopTask(*awsecs.StopTaskInput) (*awsecs.StopTaskOutput, error)
+	ListContainerInstancesPages(*awsecs.ListContainerInstancesInput, func(*awsecs.ListContainerInstancesOutput, bool) bool) error
+	DeregisterContainerInstance(*awsecs.DeregisterContainerInstanceInput) (*awsecs.DeregisterContainerInstanceOutput, error)
+	DeleteCluster(*awsecs.DeleteClusterInput) (*awsecs.DeleteClusterOutput, error)
+}
+
+type logger interface {
+	Printf(m string, a ...interface{})
+	PromptWithDetails(resourceType, resourceName string) bool
+}
+
+type Clusters struct {
+	client clustersClient
+	logger logger
+}
+
+func NewClusters(client clustersClient, logger logger) Clusters {
+	return Clusters{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (c Clusters) List(filter string) ([]common.Deletable, error) {
+	var arns []*string
+	err := c.client.ListClustersPages(&awsecs.ListClustersInput{}, func(page *awsecs.ListClustersOutput, lastPage bool) bool {
+		arns = append(arns, page.ClusterArns...)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("List ECS Clusters: %s", err)
+	}
+
+	var resources []common.Deletable
+	for _, arn := range arns {
+		r := NewCluster(c.client, c.logger, arn)
+
+		if !strings.Contains(r.Name(), filter) {
+			continue
+		}
+
+		proceed := c.logger.PromptWithDetails(r.Type(), r.Name())
+		if !proceed {
+			continue
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func (c Clusters) Type() string {
+	return "ecs-cluster"
+}
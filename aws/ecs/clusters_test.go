@@ -0,0 +1,90 @@
+package ecs_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/genevieve/leftovers/aws/ecs"
+	"github.com/genevieve/leftovers/aws/ecs/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Clusters", func() {
+	var (
+		client *fakes.ClustersClient
+		logger *fakes.Logger
+
+		clusters ecs.Clusters
+	)
+
+	BeforeEach(func() {
+		client = &fakes.ClustersClient{}
+		logger = &fakes.Logger{}
+		logger.PromptWithDetailsCall.Returns.Proceed = true
+
+		clusters = ecs.NewClusters(client, logger)
+	})
+
+	Describe("List", func() {
+		var filter string
+
+		BeforeEach(func() {
+			client.ListClustersPagesCall.Returns.Pages = []*awsecs.ListClustersOutput{{
+				ClusterArns: []*string{aws.String("arn:aws:ecs:us-east-1:123456789012:cluster/banana-cluster")},
+			}, {
+				ClusterArns: []*string{aws.String("arn:aws:ecs:us-east-1:123456789012:cluster/other-banana-cluster")},
+			}}
+			filter = "banana"
+		})
+
+		It("returns a list of ecs clusters from every page to delete", func() {
+			items, err := clusters.List(filter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.ListClustersPagesCall.CallCount).To(Equal(1))
+
+			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(2))
+			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("ECS Cluster"))
+			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("other-banana-cluster"))
+
+			Expect(items).To(HaveLen(2))
+		})
+
+		Context("when the cluster name does not contain the filter", func() {
+			It("does not return it to the list", func() {
+				items, err := clusters.List("kiwi")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+
+		Context("when the client fails to list clusters", func() {
+			BeforeEach(func() {
+				client.ListClustersPagesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := clusters.List(filter)
+				Expect(err).To(MatchError("List ECS Clusters: some error"))
+			})
+		})
+
+		Context("when the user responds no to the prompt", func() {
+			BeforeEach(func() {
+				logger.PromptWithDetailsCall.Returns.Proceed = false
+			})
+
+			It("does not return it to the list", func() {
+				items, err := clusters.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(2))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,215 @@
+package fakes
+
+import (
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+)
+
+type ClustersClient struct {
+	ListClustersPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsecs.ListClustersInput
+		}
+		Returns struct {
+			Pages []*awsecs.ListClustersOutput
+			Error error
+		}
+	}
+
+	ListServicesPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsecs.ListServicesInput
+		}
+		Returns struct {
+			Pages []*awsecs.ListServicesOutput
+			Error error
+		}
+	}
+
+	DescribeServicesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsecs.DescribeServicesInput
+		}
+		Returns struct {
+			Output *awsecs.DescribeServicesOutput
+			Error  error
+		}
+	}
+
+	UpdateServiceCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsecs.UpdateServiceInput
+		}
+		Returns struct {
+			Output *awsecs.UpdateServiceOutput
+			Error  error
+		}
+	}
+
+	DeleteServiceCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsecs.DeleteServiceInput
+		}
+		Returns struct {
+			Output *awsecs.DeleteServiceOutput
+			Error  error
+		}
+	}
+
+	ListTasksPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsecs.ListTasksInput
+		}
+		Returns struct {
+			Pages []*awsecs.ListTasksOutput
+			Error error
+		}
+	}
+
+	StopTaskCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsecs.StopTaskInput
+		}
+		Returns struct {
+			Output *awsecs.StopTaskOutput
+			Error  error
+		}
+	}
+
+	ListContainerInstancesPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsecs.ListContainerInstancesInput
+		}
+		Returns struct {
+			Pages []*awsecs.ListContainerInstancesOutput
+			Error error
+		}
+	}
+
+	DeregisterContainerInstanceCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsecs.DeregisterContainerInstanceInput
+		}
+		Returns struct {
+			Output *awsecs.DeregisterContainerInstanceOutput
+			Error  error
+		}
+	}
+
+	DeleteClusterCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsecs.DeleteClusterInput
+		}
+		Returns struct {
+			Output *awsecs.DeleteClusterOutput
+			Error  error
+		}
+	}
+}
+
+func (c *ClustersClient) ListClustersPages(input *awsecs.ListClustersInput, fn func(*awsecs.ListClustersOutput, bool) bool) error {
+	c.ListClustersPagesCall.CallCount++
+	c.ListClustersPagesCall.Receives.Input = input
+
+	pages := c.ListClustersPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return c.ListClustersPagesCall.Returns.Error
+}
+
+func (c *ClustersClient) ListServicesPages(input *awsecs.ListServicesInput, fn func(*awsecs.ListServicesOutput, bool) bool) error {
+	c.ListServicesPagesCall.CallCount++
+	c.ListServicesPagesCall.Receives.Input = input
+
+	pages := c.ListServicesPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return c.ListServicesPagesCall.Returns.Error
+}
+
+func (c *ClustersClient) DescribeServices(input *awsecs.DescribeServicesInput) (*awsecs.DescribeServicesOutput, error) {
+	c.DescribeServicesCall.CallCount++
+	c.DescribeServicesCall.Receives.Input = input
+
+	return c.DescribeServicesCall.Returns.Output, c.DescribeServicesCall.Returns.Error
+}
+
+func (c *ClustersClient) UpdateService(input *awsecs.UpdateServiceInput) (*awsecs.UpdateServiceOutput, error) {
+	c.UpdateServiceCall.CallCount++
+	c.UpdateServiceCall.Receives.Input = input
+
+	return c.UpdateServiceCall.Returns.Output, c.UpdateServiceCall.Returns.Error
+}
+
+func (c *ClustersClient) DeleteService(input *awsecs.DeleteServiceInput) (*awsecs.DeleteServiceOutput, error) {
+	c.DeleteServiceCall.CallCount++
+	c.DeleteServiceCall.Receives.Input = input
+
+	return c.DeleteServiceCall.Returns.Output, c.DeleteServiceCall.Returns.Error
+}
+
+func (c *ClustersClient) ListTasksPages(input *awsecs.ListTasksInput, fn func(*awsecs.ListTasksOutput, bool) bool) error {
+	c.ListTasksPagesCall.CallCount++
+	c.ListTasksPagesCall.Receives.Input = input
+
+	pages := c.ListTasksPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return c.ListTasksPagesCall.Returns.Error
+}
+
+func (c *ClustersClient) StopTask(input *awsecs.StopTaskInput) (*awsecs.StopTaskOutput, error) {
+	c.StopTaskCall.CallCount++
+	c.StopTaskCall.Receives.Input = input
+
+	return c.StopTaskCall.Returns.Output, c.StopTaskCall.Returns.Error
+}
+
+func (c *ClustersClient) ListContainerInstancesPages(input *awsecs.ListContainerInstancesInput, fn func(*awsecs.ListContainerInstancesOutput, bool) bool) error {
+	c.ListContainerInstancesPagesCall.CallCount++
+	c.ListContainerInstancesPagesCall.Receives.Input = input
+
+	pages := c.ListContainerInstancesPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return c.ListContainerInstancesPagesCall.Returns.Error
+}
+
+func (c *ClustersClient) DeregisterContainerInstance(input *awsecs.DeregisterContainerInstanceInput) (*awsecs.DeregisterContainerInstanceOutput, error) {
+	c.DeregisterContainerInstanceCall.CallCount++
+	c.DeregisterContainerInstanceCall.Receives.Input = input
+
+	return c.DeregisterContainerInstanceCall.Returns.Output, c.DeregisterContainerInstanceCall.Returns.Error
+}
+
+func (c *ClustersClient) DeleteCluster(input *awsecs.DeleteClusterInput) (*awsecs.DeleteClusterOutput, error) {
+	c.DeleteClusterCall.CallCount++
+	c.DeleteClusterCall.Receives.Input = input
+
+	return c.DeleteClusterCall.Returns.Output, c.DeleteClusterCall.Returns.Error
+}
@@ -0,0 +1,50 @@
+package fakes
+
+import (
+	awsecr "github.com/aws/aws-sdk-go/service/ecr"
+)
+
+type RepositoriesClient struct {
+	DescribeRepositoriesPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsecr.DescribeRepositoriesInput
+		}
+		Returns struct {
+			Pages []*awsecr.DescribeRepositoriesOutput
+			Error error
+		}
+	}
+
+	DeleteRepositoryCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsecr.DeleteRepositoryInput
+		}
+		Returns struct {
+			Output *awsecr.DeleteRepositoryOutput
+			Error  error
+		}
+	}
+}
+
+func (r *RepositoriesClient) DescribeRepositoriesPages(input *awsecr.DescribeRepositoriesInput, fn func(*awsecr.DescribeRepositoriesOutput, bool) bool) error {
+	r.DescribeRepositoriesPagesCall.CallCount++
+	r.DescribeRepositoriesPagesCall.Receives.Input = input
+
+	pages := r.DescribeRepositoriesPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return r.DescribeRepositoriesPagesCall.Returns.Error
+}
+
+func (r *RepositoriesClient) DeleteRepository(input *awsecr.DeleteRepositoryInput) (*awsecr.DeleteRepositoryOutput, error) {
+	r.DeleteRepositoryCall.CallCount++
+	r.DeleteRepositoryCall.Receives.Input = input
+
+	return r.DeleteRepositoryCall.Returns.Output, r.DeleteRepositoryCall.Returns.Error
+}
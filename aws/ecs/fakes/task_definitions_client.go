@@ -0,0 +1,50 @@
+package fakes
+
+import (
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+)
+
+type TaskDefinitionsClient struct {
+	ListTaskDefinitionsPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsecs.ListTaskDefinitionsInput
+		}
+		Returns struct {
+			Pages []*awsecs.ListTaskDefinitionsOutput
+			Error error
+		}
+	}
+
+	DeregisterTaskDefinitionCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsecs.DeregisterTaskDefinitionInput
+		}
+		Returns struct {
+			Output *awsecs.DeregisterTaskDefinitionOutput
+			Error  error
+		}
+	}
+}
+
+func (t *TaskDefinitionsClient) ListTaskDefinitionsPages(input *awsecs.ListTaskDefinitionsInput, fn func(*awsecs.ListTaskDefinitionsOutput, bool) bool) error {
+	t.ListTaskDefinitionsPagesCall.CallCount++
+	t.ListTaskDefinitionsPagesCall.Receives.Input = input
+
+	pages := t.ListTaskDefinitionsPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return t.ListTaskDefinitionsPagesCall.Returns.Error
+}
+
+func (t *TaskDefinitionsClient) DeregisterTaskDefinition(input *awsecs.DeregisterTaskDefinitionInput) (*awsecs.DeregisterTaskDefinitionOutput, error) {
+	t.DeregisterTaskDefinitionCall.CallCount++
+	t.DeregisterTaskDefinitionCall.Receives.Input = input
+
+	return t.DeregisterTaskDefinitionCall.Returns.Output, t.DeregisterTaskDefinitionCall.Returns.Error
+}
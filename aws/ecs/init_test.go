@@ -0,0 +1,13 @@
+package ecs_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestECS(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "aws/ecs")
+}
@@ -0,0 +1,59 @@
+package ecs
+
+import (
+	"fmt"
+	"strings"
+
+	awsecr "github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/genevieve/leftovers/common"
+)
+
+type repositoriesClient interface {
+	DescribeRepositoriesPages(*awsecr.DescribeRepositoriesInput, func(*awsecr.DescribeRepositoriesOutput, bool) bool) error
+	DeleteRepository(*awsecr.DeleteRepositoryInput) (*awsecr.DeleteRepositoryOutput, error)
+}
+
+type Repositories struct {
+	client repositoriesClient
+	logger logger
+}
+
+func NewRepositories(client repositoriesClient, logger logger) Repositories {
+	return Repositories{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (r Repositories) List(filter string) ([]common.Deletable, error) {
+	var repositories []*awsecr.Repository
+	err := r.client.DescribeRepositoriesPages(&awsecr.DescribeRepositoriesInput{}, func(page *awsecr.DescribeRepositoriesOutput, lastPage bool) bool {
+		repositories = append(repositories, page.Repositories...)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Describe ECR Repositories: %s", err)
+	}
+
+	var resources []common.Deletable
+	for _, repository := range repositories {
+		resource := NewRepository(r.client, repository.RepositoryName, repository.RegistryId)
+
+		if !strings.Contains(resource.Name(), filter) {
+			continue
+		}
+
+		proceed := r.logger.PromptWithDetails(resource.Type(), resource.Name())
+		if !proceed {
+			continue
+		}
+
+		resources = append(resources, resource)
+	}
+
+	return resources, nil
+}
+
+func (r Repositories) Type() string {
+	return "ecr-repository"
+}
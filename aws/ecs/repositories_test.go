@@ -0,0 +1,82 @@
+package ecs_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecr "github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/genevieve/leftovers/aws/ecs"
+	"github.com/genevieve/leftovers/aws/ecs/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Repositories", func() {
+	var (
+		client *fakes.RepositoriesClient
+		logger *fakes.Logger
+
+		repositories ecs.Repositories
+	)
+
+	BeforeEach(func() {
+		client = &fakes.RepositoriesClient{}
+		logger = &fakes.Logger{}
+		logger.PromptWithDetailsCall.Returns.Proceed = true
+
+		repositories = ecs.NewRepositories(client, logger)
+	})
+
+	Describe("List", func() {
+		var filter string
+
+		BeforeEach(func() {
+			client.DescribeRepositoriesPagesCall.Returns.Pages = []*awsecr.DescribeRepositoriesOutput{{
+				Repositories: []*awsecr.Repository{{
+					RepositoryName: aws.String("banana-repo"),
+					RegistryId:     aws.String("123456789012"),
+				}},
+			}, {
+				Repositories: []*awsecr.Repository{{
+					RepositoryName: aws.String("other-banana-repo"),
+					RegistryId:     aws.String("123456789012"),
+				}},
+			}}
+			filter = "banana"
+		})
+
+		It("returns a list of ecr repositories from every page to delete", func() {
+			items, err := repositories.List(filter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DescribeRepositoriesPagesCall.CallCount).To(Equal(1))
+
+			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(2))
+			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("ECR Repository"))
+			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("other-banana-repo"))
+
+			Expect(items).To(HaveLen(2))
+		})
+
+		Context("when the repository name does not contain the filter", func() {
+			It("does not return it to the list", func() {
+				items, err := repositories.List("kiwi")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+
+		Context("when the client fails to describe repositories", func() {
+			BeforeEach(func() {
+				client.DescribeRepositoriesPagesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := repositories.List(filter)
+				Expect(err).To(MatchError("Describe ECR Repositories: some error"))
+			})
+		})
+	})
+})
@@ -0,0 +1,48 @@
+package ecs
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecr "github.com/aws/aws-sdk-go/service/ecr"
+)
+
+type Repository struct {
+	client     repositoriesClient
+	name       *string
+	registryId *string
+	identifier string
+	rtype      string
+}
+
+func NewRepository(client repositoriesClient, name, registryId *string) Repository {
+	return Repository{
+		client:     client,
+		name:       name,
+		registryId: registryId,
+		identifier: *name,
+		rtype:      "ECR Repository",
+	}
+}
+
+// Delete deletes the repository with the images that are in it.
+func (r Repository) Delete() error {
+	_, err := r.client.DeleteRepository(&awsecr.DeleteRepositoryInput{
+		RepositoryName: r.name,
+		RegistryId:     r.registryId,
+		Force:          aws.Bool(true),
+	})
+	if err != nil {
+		return fmt.Errorf("Delete: %s", err)
+	}
+
+	return nil
+}
+
+func (r Repository) Name() string {
+	return r.identifier
+}
+
+func (r Repository) Type() string {
+	return r.rtype
+}
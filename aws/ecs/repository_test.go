@@ -0,0 +1,63 @@
+package ecs_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/genevieve/leftovers/aws/ecs"
+	"github.com/genevieve/leftovers/aws/ecs/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Repository", func() {
+	var (
+		repository ecs.Repository
+		client     *fakes.RepositoriesClient
+		name       *string
+		registryId *string
+	)
+
+	BeforeEach(func() {
+		client = &fakes.RepositoriesClient{}
+		name = aws.String("the-repo")
+		registryId = aws.String("123456789012")
+
+		repository = ecs.NewRepository(client, name, registryId)
+	})
+
+	Describe("Delete", func() {
+		It("force deletes the repository with its images", func() {
+			err := repository.Delete()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DeleteRepositoryCall.CallCount).To(Equal(1))
+			Expect(client.DeleteRepositoryCall.Receives.Input.RepositoryName).To(Equal(name))
+			Expect(client.DeleteRepositoryCall.Receives.Input.RegistryId).To(Equal(registryId))
+			Expect(client.DeleteRepositoryCall.Receives.Input.Force).To(Equal(aws.Bool(true)))
+		})
+
+		Context("when the client fails", func() {
+			BeforeEach(func() {
+				client.DeleteRepositoryCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := repository.Delete()
+				Expect(err).To(MatchError("Delete: banana"))
+			})
+		})
+	})
+
+	Describe("Name", func() {
+		It("returns the identifier", func() {
+			Expect(repository.Name()).To(Equal("the-repo"))
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(repository.Type()).To(Equal("ECR Repository"))
+		})
+	})
+})
@@ -0,0 +1,40 @@
+package ecs
+
+import (
+	"fmt"
+
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+)
+
+type TaskDefinition struct {
+	client     taskDefinitionsClient
+	arn        *string
+	identifier string
+	rtype      string
+}
+
+func NewTaskDefinition(client taskDefinitionsClient, arn *string) TaskDefinition {
+	return TaskDefinition{
+		client:     client,
+		arn:        arn,
+		identifier: nameFromARN(*arn),
+		rtype:      "ECS Task Definition",
+	}
+}
+
+func (t TaskDefinition) Delete() error {
+	_, err := t.client.DeregisterTaskDefinition(&awsecs.DeregisterTaskDefinitionInput{TaskDefinition: t.arn})
+	if err != nil {
+		return fmt.Errorf("Deregister: %s", err)
+	}
+
+	return nil
+}
+
+func (t TaskDefinition) Name() string {
+	return t.identifier
+}
+
+func (t TaskDefinition) Type() string {
+	return t.rtype
+}
@@ -0,0 +1,59 @@
+package ecs_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/genevieve/leftovers/aws/ecs"
+	"github.com/genevieve/leftovers/aws/ecs/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TaskDefinition", func() {
+	var (
+		taskDefinition ecs.TaskDefinition
+		client         *fakes.TaskDefinitionsClient
+		arn            *string
+	)
+
+	BeforeEach(func() {
+		client = &fakes.TaskDefinitionsClient{}
+		arn = aws.String("arn:aws:ecs:us-east-1:123456789012:task-definition/the-family:3")
+
+		taskDefinition = ecs.NewTaskDefinition(client, arn)
+	})
+
+	Describe("Delete", func() {
+		It("deregisters the task definition", func() {
+			err := taskDefinition.Delete()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DeregisterTaskDefinitionCall.CallCount).To(Equal(1))
+			Expect(client.DeregisterTaskDefinitionCall.Receives.Input.TaskDefinition).To(Equal(arn))
+		})
+
+		Context("when the client fails", func() {
+			BeforeEach(func() {
+				client.DeregisterTaskDefinitionCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := taskDefinition.Delete()
+				Expect(err).To(MatchError("Deregister: banana"))
+			})
+		})
+	})
+
+	Describe("Name", func() {
+		It("returns the family and revision", func() {
+			Expect(taskDefinition.Name()).To(Equal("the-family:3"))
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(taskDefinition.Type()).To(Equal("ECS Task Definition"))
+		})
+	})
+})
@@ -0,0 +1,62 @@
+package ecs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/genevieve/leftovers/common"
+)
+
+type taskDefinitionsClient interface {
+	ListTaskDefinitionsPages(*awsecs.ListTaskDefinitionsInput, func(*awsecs.ListTaskDefinitionsOutput, bool) bool) error
+	DeregisterTaskDefinition(*awsecs.DeregisterTaskDefinitionInput) (*awsecs.DeregisterTaskDefinitionOutput, error)
+}
+
+type TaskDefinitions struct {
+	client taskDefinitionsClient
+	logger logger
+}
+
+func NewTaskDefinitions(client taskDefinitionsClient, logger logger) TaskDefinitions {
+	return TaskDefinitions{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (t TaskDefinitions) List(filter string) ([]common.Deletable, error) {
+	var arns []*string
+	err := t.client.ListTaskDefinitionsPages(&awsecs.ListTaskDefinitionsInput{
+		Status: aws.String(awsecs.TaskDefinitionStatusActive),
+	}, func(page *awsecs.ListTaskDefinitionsOutput, lastPage bool) bool {
+		arns = append(arns, page.TaskDefinitionArns...)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("List ECS Task Definitions: %s", err)
+	}
+
+	var resources []common.Deletable
+	for _, arn := range arns {
+		r := NewTaskDefinition(t.client, arn)
+
+		if !strings.Contains(r.Name(), filter) {
+			continue
+		}
+
+		proceed := t.logger.PromptWithDetails(r.Type(), r.Name())
+		if !proceed {
+			continue
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func (t TaskDefinitions) Type() string {
+	return "ecs-task-definition"
+}
@@ -0,0 +1,81 @@
+package ecs_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/genevieve/leftovers/aws/ecs"
+	"github.com/genevieve/leftovers/aws/ecs/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TaskDefinitions", func() {
+	var (
+		client *fakes.TaskDefinitionsClient
+		logger *fakes.Logger
+
+		taskDefinitions ecs.TaskDefinitions
+	)
+
+	BeforeEach(func() {
+		client = &fakes.TaskDefinitionsClient{}
+		logger = &fakes.Logger{}
+		logger.PromptWithDetailsCall.Returns.Proceed = true
+
+		taskDefinitions = ecs.NewTaskDefinitions(client, logger)
+	})
+
+	Describe("List", func() {
+		var filter string
+
+		BeforeEach(func() {
+			client.ListTaskDefinitionsPagesCall.Returns.Pages = []*awsecs.ListTaskDefinitionsOutput{{
+				TaskDefinitionArns: []*string{aws.String("arn:aws:ecs:us-east-1:123456789012:task-definition/banana:1")},
+			}, {
+				TaskDefinitionArns: []*string{aws.String("arn:aws:ecs:us-east-1:123456789012:task-definition/banana:2")},
+			}}
+			filter = "banana"
+		})
+
+		It("returns a list of active task definitions from every page to delete", func() {
+			items, err := taskDefinitions.List(filter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.ListTaskDefinitionsPagesCall.CallCount).To(Equal(1))
+			Expect(client.ListTaskDefinitionsPagesCall.Receives.Input.Status).To(Equal(aws.String("ACTIVE")))
+
+			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(2))
+			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("ECS Task Definition"))
+			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("banana:2"))
+
+			Expect(items).To(HaveLen(2))
+		})
+
+		Context("when the client fails to list task definitions", func() {
+			BeforeEach(func() {
+				client.ListTaskDefinitionsPagesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := taskDefinitions.List(filter)
+				Expect(err).To(MatchError("List ECS Task Definitions: some error"))
+			})
+		})
+
+		Context("when the user responds no to the prompt", func() {
+			BeforeEach(func() {
+				logger.PromptWithDetailsCall.Returns.Proceed = false
+			})
+
+			It("does not return it to the list", func() {
+				items, err := taskDefinitions.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(2))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,144 @@
+package fakes
+
+import (
+	awsefs "github.com/aws/aws-sdk-go/service/efs"
+)
+
+type FileSystemsClient struct {
+	DescribeFileSystemsPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsefs.DescribeFileSystemsInput
+		}
+		Returns struct {
+			Pages []*awsefs.DescribeFileSystemsOutput
+			Error error
+		}
+	}
+
+	DescribeMountTargetsPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsefs.DescribeMountTargetsInput
+		}
+		Returns struct {
+			Pages []*awsefs.DescribeMountTargetsOutput
+			Error error
+		}
+		ReturnsOnCall map[int]struct {
+			Pages []*awsefs.DescribeMountTargetsOutput
+			Error error
+		}
+	}
+
+	DeleteMountTargetCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsefs.DeleteMountTargetInput
+		}
+		Returns struct {
+			Output *awsefs.DeleteMountTargetOutput
+			Error  error
+		}
+	}
+
+	DescribeAccessPointsPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsefs.DescribeAccessPointsInput
+		}
+		Returns struct {
+			Pages []*awsefs.DescribeAccessPointsOutput
+			Error error
+		}
+	}
+
+	DeleteAccessPointCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsefs.DeleteAccessPointInput
+		}
+		Returns struct {
+			Output *awsefs.DeleteAccessPointOutput
+			Error  error
+		}
+	}
+
+	DeleteFileSystemCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsefs.DeleteFileSystemInput
+		}
+		Returns struct {
+			Output *awsefs.DeleteFileSystemOutput
+			Error  error
+		}
+	}
+}
+
+func (f *FileSystemsClient) DescribeFileSystemsPages(input *awsefs.DescribeFileSystemsInput, fn func(*awsefs.DescribeFileSystemsOutput, bool) bool) error {
+	f.DescribeFileSystemsPagesCall.CallCount++
+	f.DescribeFileSystemsPagesCall.Receives.Input = input
+
+	pages := f.DescribeFileSystemsPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return f.DescribeFileSystemsPagesCall.Returns.Error
+}
+
+func (f *FileSystemsClient) DescribeMountTargetsPages(input *awsefs.DescribeMountTargetsInput, fn func(*awsefs.DescribeMountTargetsOutput, bool) bool) error {
+	f.DescribeMountTargetsPagesCall.CallCount++
+	f.DescribeMountTargetsPagesCall.Receives.Input = input
+
+	pages, err := f.DescribeMountTargetsPagesCall.Returns.Pages, f.DescribeMountTargetsPagesCall.Returns.Error
+	if ret, ok := f.DescribeMountTargetsPagesCall.ReturnsOnCall[f.DescribeMountTargetsPagesCall.CallCount-1]; ok {
+		pages, err = ret.Pages, ret.Error
+	}
+
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return err
+}
+
+func (f *FileSystemsClient) DeleteMountTarget(input *awsefs.DeleteMountTargetInput) (*awsefs.DeleteMountTargetOutput, error) {
+	f.DeleteMountTargetCall.CallCount++
+	f.DeleteMountTargetCall.Receives.Input = input
+
+	return f.DeleteMountTargetCall.Returns.Output, f.DeleteMountTargetCall.Returns.Error
+}
+
+func (f *FileSystemsClient) DescribeAccessPointsPages(input *awsefs.DescribeAccessPointsInput, fn func(*awsefs.DescribeAccessPointsOutput, bool) bool) error {
+	f.DescribeAccessPointsPagesCall.CallCount++
+	f.DescribeAccessPointsPagesCall.Receives.Input = input
+
+	pages := f.DescribeAccessPointsPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return f.DescribeAccessPointsPagesCall.Returns.Error
+}
+
+func (f *FileSystemsClient) DeleteAccessPoint(input *awsefs.DeleteAccessPointInput) (*awsefs.DeleteAccessPointOutput, error) {
+	f.DeleteAccessPointCall.CallCount++
+	f.DeleteAccessPointCall.Receives.Input = input
+
+	return f.DeleteAccessPointCall.Returns.Output, f.DeleteAccessPointCall.Returns.Error
+}
+
+func (f *FileSystemsClient) DeleteFileSystem(input *awsefs.DeleteFileSystemInput) (*awsefs.DeleteFileSystemOutput, error) {
+	f.DeleteFileSystemCall.CallCount++
+	f.DeleteFileSystemCall.Receives.Input = input
+
+	return f.DeleteFileSystemCall.Returns.Output, f.DeleteFileSystemCall.Returns.Error
+}
@@ -0,0 +1,133 @@
+package efs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	awsefs "github.com/aws/aws-sdk-go/service/efs"
+	"github.com/genevieve/leftovers/common"
+)
+
+type FileSystem struct {
+	client     fileSystemsClient
+	logger     logger
+	id         *string
+	identifier string
+	rtype      string
+}
+
+func NewFileSystem(client fileSystemsClient, logger logger, id *string, tags []*awsefs.Tag) FileSystem {
+	identifier := *id
+
+	var extra []string
+	for _, t := range tags {
+		extra = append(extra, fmt.Sprintf("%s:%s", *t.Key, *t.Value))
+	}
+
+	if len(extra) > 0 {
+		identifier = fmt.Sprintf("%s (%s)", *id, strings.Join(extra, ", "))
+	}
+
+	return FileSystem{
+		client:     client,
+		logger:     logger,
+		id:         id,
+		identifier: identifier,
+		rtype:      "EFS File System",
+	}
+}
+
+func (f FileSystem) Delete() error {
+	return f.DeleteContext(context.Background())
+}
+
+// DeleteContext deletes the mount targets of the file system and waits for them
+// to be deleted, since they hold network interfaces in the subnets of the
+// vpc, then deletes the access points and the file system.
+func (f FileSystem) DeleteContext(ctx context.Context) error {
+	mountTargets, err := f.mountTargets()
+	if err != nil {
+		return fmt.Errorf("Describe mount targets: %s", err)
+	}
+
+	for _, m := range mountTargets {
+		if *m.LifeCycleState == awsefs.LifeCycleStateDeleting {
+			continue
+		}
+
+		_, err = f.client.DeleteMountTarget(&awsefs.DeleteMountTargetInput{MountTargetId: m.MountTargetId})
+		if err != nil {
+			return fmt.Errorf("Delete mount target %s: %s", *m.MountTargetId, err)
+		}
+
+		f.logger.Printf("[%s: %s] Deleted mount target %s \n", f.rtype, f.identifier, *m.MountTargetId)
+	}
+
+	if len(mountTargets) > 0 {
+		poller := common.NewPoller(f.logger, f.mountTargetsRefresh(), []string{"deleting"}, []string{"deleted"})
+
+		_, err = poller.Wait(ctx)
+		if err != nil {
+			return fmt.Errorf("Waiting for mount targets: %s", err)
+		}
+	}
+
+	var accessPoints []*awsefs.AccessPointDescription
+	err = f.client.DescribeAccessPointsPages(&awsefs.DescribeAccessPointsInput{FileSystemId: f.id}, func(page *awsefs.DescribeAccessPointsOutput, lastPage bool) bool {
+		accessPoints = append(accessPoints, page.AccessPoints...)
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("Describe access points: %s", err)
+	}
+
+	for _, a := range accessPoints {
+		_, err = f.client.DeleteAccessPoint(&awsefs.DeleteAccessPointInput{AccessPointId: a.AccessPointId})
+		if err != nil {
+			return fmt.Errorf("Delete access point %s: %s", *a.AccessPointId, err)
+		}
+
+		f.logger.Printf("[%s: %s] Deleted access point %s \n", f.rtype, f.identifier, *a.AccessPointId)
+	}
+
+	_, err = f.client.DeleteFileSystem(&awsefs.DeleteFileSystemInput{FileSystemId: f.id})
+	if err != nil {
+		return fmt.Errorf("Delete: %s", err)
+	}
+
+	return nil
+}
+
+func (f FileSystem) Name() string {
+	return f.identifier
+}
+
+func (f FileSystem) Type() string {
+	return f.rtype
+}
+
+func (f FileSystem) mountTargets() ([]*awsefs.MountTargetDescription, error) {
+	var mountTargets []*awsefs.MountTargetDescription
+	err := f.client.DescribeMountTargetsPages(&awsefs.DescribeMountTargetsInput{FileSystemId: f.id}, func(page *awsefs.DescribeMountTargetsOutput, lastPage bool) bool {
+		mountTargets = append(mountTargets, page.MountTargets...)
+		return true
+	})
+
+	return mountTargets, err
+}
+
+func (f FileSystem) mountTargetsRefresh() common.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		mountTargets, err := f.mountTargets()
+		if err != nil {
+			return nil, "", err
+		}
+
+		if len(mountTargets) > 0 {
+			return mountTargets, "deleting", nil
+		}
+
+		return f.id, "deleted", nil
+	}
+}
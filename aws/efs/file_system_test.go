@@ -0,0 +1,164 @@
+package efs_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsefs "github.com/aws/aws-sdk-go/service/efs"
+	"github.com/genevieve/leftovers/aws/efs"
+	"github.com/genevieve/leftovers/aws/efs/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FileSystem", func() {
+	var (
+		fileSystem efs.FileSystem
+		client     *fakes.FileSystemsClient
+		logger     *fakes.Logger
+		id         *string
+	)
+
+	BeforeEach(func() {
+		client = &fakes.FileSystemsClient{}
+		logger = &fakes.Logger{}
+		id = aws.String("fs-1")
+		tags := []*awsefs.Tag{{Key: aws.String("the-key"), Value: aws.String("the-value")}}
+
+		fileSystem = efs.NewFileSystem(client, logger, id, tags)
+	})
+
+	Describe("Delete", func() {
+		It("deletes the file system", func() {
+			err := fileSystem.Delete()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DescribeMountTargetsPagesCall.CallCount).To(Equal(1))
+			Expect(client.DescribeMountTargetsPagesCall.Receives.Input.FileSystemId).To(Equal(id))
+			Expect(client.DeleteMountTargetCall.CallCount).To(Equal(0))
+
+			Expect(client.DescribeAccessPointsPagesCall.Receives.Input.FileSystemId).To(Equal(id))
+
+			Expect(client.DeleteFileSystemCall.CallCount).To(Equal(1))
+			Expect(client.DeleteFileSystemCall.Receives.Input.FileSystemId).To(Equal(id))
+		})
+
+		Context("when the file system has mount targets and access points", func() {
+			BeforeEach(func() {
+				client.DescribeMountTargetsPagesCall.ReturnsOnCall = map[int]struct {
+					Pages []*awsefs.DescribeMountTargetsOutput
+					Error error
+				}{
+					0: {Pages: []*awsefs.DescribeMountTargetsOutput{{
+						MountTargets: []*awsefs.MountTargetDescription{{
+							MountTargetId:  aws.String("fsmt-1"),
+							LifeCycleState: aws.String("available"),
+						}},
+					}}},
+				}
+				client.DescribeAccessPointsPagesCall.Returns.Pages = []*awsefs.DescribeAccessPointsOutput{{
+					AccessPoints: []*awsefs.AccessPointDescription{{AccessPointId: aws.String("fsap-1")}},
+				}}
+			})
+
+			It("deletes the mount targets, waits for them to be deleted, and deletes the access points", func() {
+				err := fileSystem.Delete()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(client.DeleteMountTargetCall.CallCount).To(Equal(1))
+				Expect(client.DeleteMountTargetCall.Receives.Input.MountTargetId).To(Equal(aws.String("fsmt-1")))
+				Expect(client.DescribeMountTargetsPagesCall.CallCount).To(Equal(2))
+
+				Expect(client.DeleteAccessPointCall.CallCount).To(Equal(1))
+				Expect(client.DeleteAccessPointCall.Receives.Input.AccessPointId).To(Equal(aws.String("fsap-1")))
+
+				Expect(logger.PrintfCall.Messages).To(ContainElement("[EFS File System: fs-1 (the-key:the-value)] Deleted mount target fsmt-1 \n"))
+				Expect(logger.PrintfCall.Messages).To(ContainElement("[EFS File System: fs-1 (the-key:the-value)] Deleted access point fsap-1 \n"))
+
+				Expect(client.DeleteFileSystemCall.CallCount).To(Equal(1))
+			})
+
+			Context("when the client fails to delete a mount target", func() {
+				BeforeEach(func() {
+					client.DeleteMountTargetCall.Returns.Error = errors.New("banana")
+				})
+
+				It("returns the error", func() {
+					err := fileSystem.Delete()
+					Expect(err).To(MatchError("Delete mount target fsmt-1: banana"))
+
+					Expect(client.DeleteFileSystemCall.CallCount).To(Equal(0))
+				})
+			})
+
+			Context("when the client fails while waiting for the mount targets", func() {
+				BeforeEach(func() {
+					client.DescribeMountTargetsPagesCall.Returns.Error = errors.New("banana")
+				})
+
+				It("returns the error", func() {
+					err := fileSystem.Delete()
+					Expect(err).To(MatchError("Waiting for mount targets: banana"))
+				})
+			})
+
+			Context("when the client fails to delete an access point", func() {
+				BeforeEach(func() {
+					client.DeleteAccessPointCall.Returns.Error = errors.New("banana")
+				})
+
+				It("returns the error", func() {
+					err := fileSystem.Delete()
+					Expect(err).To(MatchError("Delete access point fsap-1: banana"))
+
+					Expect(client.DeleteFileSystemCall.CallCount).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when the client fails to describe mount targets", func() {
+			BeforeEach(func() {
+				client.DescribeMountTargetsPagesCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := fileSystem.Delete()
+				Expect(err).To(MatchError("Describe mount targets: banana"))
+			})
+		})
+
+		Context("when the client fails to describe access points", func() {
+			BeforeEach(func() {
+				client.DescribeAccessPointsPagesCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := fileSystem.Delete()
+				Expect(err).To(MatchError("Describe access points: banana"))
+			})
+		})
+
+		Context("when the client fails", func() {
+			BeforeEach(func() {
+				client.DeleteFileSystemCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := fileSystem.Delete()
+				Expect(err).To(MatchError("Delete: banana"))
+			})
+		})
+	})
+
+	Describe("Name", func() {
+		It("returns the identifier", func() {
+			Expect(fileSystem.Name()).To(Equal("fs-1 (the-key:the-value)"))
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(fileSystem.Type()).To(Equal("EFS File System"))
+		})
+	})
+})
@@ -0,0 +1,67 @@
+package efs
+
+import (
+	"fmt"
+	"strings"
+
+	awsefs "github.com/aws/aws-sdk-go/service/efs"
+	"github.com/genevieve/leftovers/common"
+)
+
+type fileSystemsClient interface {
+	DescribeFileSystemsPages(*awsefs.DescribeFileSystemsInput, func(*awsefs.DescribeFileSystemsOutput, bool) bool) error
+	DescribeMountTargetsPages(*awsefs.DescribeMountTargetsInput, func(*awsefs.DescribeMountTargetsOutput, bool) bool) error
+	DeleteMountTarget(*awsefs.DeleteMountTargetInput) (*awsefs.DeleteMountTargetOutput, error)
+	DescribeAccessPointsPages(*awsefs.DescribeAccessPointsInput, func(*awsefs.DescribeAccessPointsOutput, bool) bool) error
+	DeleteAccessPoint(*awsefs.DeleteAccessPointInput) (*awsefs.DeleteAccessPointOutput, error)
+	DeleteFileSystem(*awsefs.DeleteFileSystemInput) (*awsefs.DeleteFileSystemOutput, error)
+}
+
+type FileSystems struct {
+	client fileSystemsClient
+	logger logger
+}
+
+func NewFileSystems(client fileSystemsClient, logger logger) FileSystems {
+	return FileSystems{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (f FileSystems) List(filter string) ([]common.Deletable, error) {
+	var fileSystems []*awsefs.FileSystemDescription
+	err := f.client.DescribeFileSystemsPages(&awsefs.DescribeFileSystemsInput{}, func(page *awsefs.DescribeFileSystemsOutput, lastPage bool) bool {
+		fileSystems = append(fileSystems, page.FileSystems...)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Describe EFS File Systems: %s", err)
+	}
+
+	var resources []common.Deletable
+	for _, fs := range fileSystems {
+		if *fs.LifeCycleState == awsefs.LifeCycleStateDeleting || *fs.LifeCycleState == awsefs.LifeCycleStateDeleted {
+			continue
+		}
+
+		r := NewFileSystem(f.client, f.logger, fs.FileSystemId, fs.Tags)
+
+		if !strings.Contains(r.Name(), filter) {
+			continue
+		}
+
+		proceed := f.logger.PromptWithDetails(r.Type(), r.Name())
+		if !proceed {
+			continue
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func (f FileSystems) Type() string {
+	return "efs-file-system"
+}
@@ -0,0 +1,102 @@
+package efs_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsefs "github.com/aws/aws-sdk-go/service/efs"
+	"github.com/genevieve/leftovers/aws/efs"
+	"github.com/genevieve/leftovers/aws/efs/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FileSystems", func() {
+	var (
+		client *fakes.FileSystemsClient
+		logger *fakes.Logger
+
+		fileSystems efs.FileSystems
+	)
+
+	BeforeEach(func() {
+		client = &fakes.FileSystemsClient{}
+		logger = &fakes.Logger{}
+		logger.PromptWithDetailsCall.Returns.Proceed = true
+
+		fileSystems = efs.NewFileSystems(client, logger)
+	})
+
+	Describe("List", func() {
+		var filter string
+
+		BeforeEach(func() {
+			client.DescribeFileSystemsPagesCall.Returns.Pages = []*awsefs.DescribeFileSystemsOutput{{
+				FileSystems: []*awsefs.FileSystemDescription{{
+					FileSystemId:   aws.String("fs-1"),
+					LifeCycleState: aws.String("available"),
+					Tags:           []*awsefs.Tag{{Key: aws.String("Name"), Value: aws.String("banana-nfs")}},
+				}, {
+					FileSystemId:   aws.String("fs-2"),
+					LifeCycleState: aws.String("deleting"),
+					Tags:           []*awsefs.Tag{{Key: aws.String("Name"), Value: aws.String("banana-nfs")}},
+				}},
+			}}
+			filter = "banana"
+		})
+
+		It("returns the file systems that are not being deleted", func() {
+			items, err := fileSystems.List(filter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DescribeFileSystemsPagesCall.CallCount).To(Equal(1))
+
+			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("EFS File System"))
+			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("fs-1 (Name:banana-nfs)"))
+
+			Expect(items).To(HaveLen(1))
+		})
+
+		Context("when neither the id nor the tags contain the filter", func() {
+			It("does not return it to the list", func() {
+				items, err := fileSystems.List("kiwi")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+
+		Context("when the client fails to describe file systems", func() {
+			BeforeEach(func() {
+				client.DescribeFileSystemsPagesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := fileSystems.List(filter)
+				Expect(err).To(MatchError("Describe EFS File Systems: some error"))
+			})
+		})
+
+		Context("when the user responds no to the prompt", func() {
+			BeforeEach(func() {
+				logger.PromptWithDetailsCall.Returns.Proceed = false
+			})
+
+			It("does not return it to the list", func() {
+				items, err := fileSystems.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(fileSystems.Type()).To(Equal("efs-file-system"))
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package efs_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestEFS(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "aws/efs")
+}
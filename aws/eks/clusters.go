@@ -9,7 +9,7 @@ import (
 )
 
 type clustersClient interface {
-	ListClusters(*awseks.ListClustersInput) (*awseks.ListClustersOutput, error)
+	ListClustersPages(*awseks.ListClustersInput, func(*awseks.ListClustersOutput, bool) bool) error
 	DeleteCluster(*awseks.DeleteClusterInput) (*awseks.DeleteClusterOutput, error)
 }
 
@@ -31,13 +31,17 @@ func NewClusters(client clustersClient, logger logger) Clusters {
 }
 
 func (c Clusters) List(filter string) ([]common.Deletable, error) {
-	clusters, err := c.client.ListClusters(&awseks.ListClustersInput{})
+	var clusters []*string
+	err := c.client.ListClustersPages(&awseks.ListClustersInput{}, func(page *awseks.ListClustersOutput, lastPage bool) bool {
+		clusters = append(clusters, page.Clusters...)
+		return true
+	})
 	if err != nil {
 		return nil, fmt.Errorf("List EKS Clusters: %s", err)
 	}
 
 	var resources []common.Deletable
-	for _, cluster := range clusters.Clusters {
+	for _, cluster := range clusters {
 		r := NewCluster(c.client, cluster)
 
 		if !strings.Contains(r.Name(), filter) {
@@ -29,16 +29,16 @@ var _ = Describe("Clusters", func() {
 
 	Describe("List", func() {
 		BeforeEach(func() {
-			client.ListClustersCall.Returns.Output = &awseks.ListClustersOutput{
+			client.ListClustersPagesCall.Returns.Pages = []*awseks.ListClustersOutput{{
 				Clusters: []*string{aws.String("the-cluster-id")},
-			}
+			}}
 		})
 
 		It("returns a list of eks clusters to delete", func() {
 			items, err := clusters.List("")
 			Expect(err).NotTo(HaveOccurred())
 
-			Expect(client.ListClustersCall.CallCount).To(Equal(1))
+			Expect(client.ListClustersPagesCall.CallCount).To(Equal(1))
 
 			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
 			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("EKS Cluster"))
@@ -47,9 +47,28 @@ var _ = Describe("Clusters", func() {
 			Expect(items).To(HaveLen(1))
 		})
 
+		Context("when there is more than one page of clusters", func() {
+			BeforeEach(func() {
+				client.ListClustersPagesCall.Returns.Pages = append(client.ListClustersPagesCall.Returns.Pages, &awseks.ListClustersOutput{
+					Clusters: []*string{aws.String("the-other-cluster-id")},
+				})
+			})
+
+			It("returns the clusters from every page", func() {
+				items, err := clusters.List("")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(client.ListClustersPagesCall.CallCount).To(Equal(1))
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(2))
+				Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("the-other-cluster-id"))
+
+				Expect(items).To(HaveLen(2))
+			})
+		})
+
 		Context("when the client fails to list clusters", func() {
 			BeforeEach(func() {
-				client.ListClustersCall.Returns.Error = errors.New("some error")
+				client.ListClustersPagesCall.Returns.Error = errors.New("some error")
 			})
 
 			It("returns the error", func() {
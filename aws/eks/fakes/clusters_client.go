@@ -5,14 +5,14 @@ import (
 )
 
 type ClustersClient struct {
-	ListClustersCall struct {
+	ListClustersPagesCall struct {
 		CallCount int
 		Receives  struct {
 			Input *awseks.ListClustersInput
 		}
 		Returns struct {
-			Output *awseks.ListClustersOutput
-			Error  error
+			Pages []*awseks.ListClustersOutput
+			Error error
 		}
 	}
 
@@ -28,11 +28,18 @@ type ClustersClient struct {
 	}
 }
 
-func (c *ClustersClient) ListClusters(input *awseks.ListClustersInput) (*awseks.ListClustersOutput, error) {
-	c.ListClustersCall.CallCount++
-	c.ListClustersCall.Receives.Input = input
+func (c *ClustersClient) ListClustersPages(input *awseks.ListClustersInput, fn func(*awseks.ListClustersOutput, bool) bool) error {
+	c.ListClustersPagesCall.CallCount++
+	c.ListClustersPagesCall.Receives.Input = input
+
+	pages := c.ListClustersPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
 
-	return c.ListClustersCall.Returns.Output, c.ListClustersCall.Returns.Error
+	return c.ListClustersPagesCall.Returns.Error
 }
 
 func (c *ClustersClient) DeleteCluster(input *awseks.DeleteClusterInput) (*awseks.DeleteClusterOutput, error) {
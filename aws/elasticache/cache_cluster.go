@@ -0,0 +1,93 @@
+package elasticache
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awselasticache "github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/genevieve/leftovers/common"
+)
+
+type CacheCluster struct {
+	client     cacheClustersClient
+	logger     logger
+	id         *string
+	status     string
+	identifier string
+	rtype      string
+	backup     bool
+}
+
+// NewCacheCluster returns a CacheCluster. If backup is true and the
+// cluster runs redis, a final snapshot is taken on deletion.
+// Memcached clusters do not support snapshots.
+func NewCacheCluster(client cacheClustersClient, logger logger, id *string, status, engine string, backup bool) CacheCluster {
+	return CacheCluster{
+		client:     client,
+		logger:     logger,
+		id:         id,
+		status:     status,
+		identifier: *id,
+		rtype:      "ElastiCache Cache Cluster",
+		backup:     backup && engine == "redis",
+	}
+}
+
+func (c CacheCluster) Delete() error {
+	return c.DeleteContext(context.Background())
+}
+
+func (c CacheCluster) DeleteContext(ctx context.Context) error {
+	input := &awselasticache.DeleteCacheClusterInput{CacheClusterId: c.id}
+
+	if c.backup {
+		input.FinalSnapshotIdentifier = aws.String(common.BackupName(c.identifier, 255))
+	}
+
+	_, err := c.client.DeleteCacheCluster(input)
+	if err != nil {
+		return fmt.Errorf("Delete: %s", err)
+	}
+
+	if c.backup {
+		c.logger.Printf("[%s: %s] Final snapshot: %s\n", c.rtype, c.identifier, *input.FinalSnapshotIdentifier)
+	}
+
+	poller := common.NewPoller(c.logger, cacheClusterRefresh(c.client, c.id), []string{c.status, "snapshotting", "deleting"}, []string{"deleted"})
+
+	_, err = poller.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("Waiting for deletion: %s", err)
+	}
+
+	return nil
+}
+
+func (c CacheCluster) Name() string {
+	return c.identifier
+}
+
+func (c CacheCluster) Type() string {
+	return c.rtype
+}
+
+func cacheClusterRefresh(client cacheClustersClient, id *string) common.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.DescribeCacheClusters(&awselasticache.DescribeCacheClustersInput{CacheClusterId: id})
+		if err != nil {
+			if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == awselasticache.ErrCodeCacheClusterNotFoundFault {
+				return id, "deleted", nil
+			}
+			return nil, "", err
+		}
+
+		if len(resp.CacheClusters) == 0 {
+			return id, "deleted", nil
+		}
+
+		c := resp.CacheClusters[0]
+		return c, *c.CacheClusterStatus, nil
+	}
+}
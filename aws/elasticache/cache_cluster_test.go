@@ -0,0 +1,110 @@
+package elasticache_test
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/genevieve/leftovers/aws/elasticache"
+	"github.com/genevieve/leftovers/aws/elasticache/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CacheCluster", func() {
+	var (
+		cluster elasticache.CacheCluster
+		client  *fakes.CacheClustersClient
+		logger  *fakes.Logger
+		id      *string
+	)
+
+	BeforeEach(func() {
+		client = &fakes.CacheClustersClient{}
+		logger = &fakes.Logger{}
+		id = aws.String("the-cluster")
+
+		client.DescribeCacheClustersCall.Returns.Error = awserr.New("CacheClusterNotFound", "", nil)
+
+		cluster = elasticache.NewCacheCluster(client, logger, id, "available", "redis", false)
+	})
+
+	Describe("Delete", func() {
+		It("deletes the cluster and waits for it to be deleted", func() {
+			err := cluster.Delete()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DeleteCacheClusterCall.CallCount).To(Equal(1))
+			Expect(client.DeleteCacheClusterCall.Receives.Input.CacheClusterId).To(Equal(id))
+			Expect(client.DeleteCacheClusterCall.Receives.Input.FinalSnapshotIdentifier).To(BeNil())
+
+			Expect(client.DescribeCacheClustersCall.CallCount).To(Equal(1))
+			Expect(client.DescribeCacheClustersCall.Receives.Input.CacheClusterId).To(Equal(id))
+		})
+
+		Context("when backup is enabled", func() {
+			BeforeEach(func() {
+				cluster = elasticache.NewCacheCluster(client, logger, id, "available", "redis", true)
+			})
+
+			It("takes a final snapshot and logs its name", func() {
+				err := cluster.Delete()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(*client.DeleteCacheClusterCall.Receives.Input.FinalSnapshotIdentifier).To(HavePrefix("the-cluster-leftovers-"))
+
+				Expect(logger.PrintfCall.Messages).To(ContainElement(
+					fmt.Sprintf("[ElastiCache Cache Cluster: the-cluster] Final snapshot: %s\n", *client.DeleteCacheClusterCall.Receives.Input.FinalSnapshotIdentifier),
+				))
+			})
+
+			Context("when the cluster runs memcached", func() {
+				BeforeEach(func() {
+					cluster = elasticache.NewCacheCluster(client, logger, id, "available", "memcached", true)
+				})
+
+				It("does not take a final snapshot", func() {
+					err := cluster.Delete()
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(client.DeleteCacheClusterCall.Receives.Input.FinalSnapshotIdentifier).To(BeNil())
+				})
+			})
+		})
+
+		Context("when the client fails", func() {
+			BeforeEach(func() {
+				client.DeleteCacheClusterCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := cluster.Delete()
+				Expect(err).To(MatchError("Delete: banana"))
+			})
+		})
+
+		Context("when the client fails while waiting", func() {
+			BeforeEach(func() {
+				client.DescribeCacheClustersCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := cluster.Delete()
+				Expect(err).To(MatchError("Waiting for deletion: banana"))
+			})
+		})
+	})
+
+	Describe("Name", func() {
+		It("returns the identifier", func() {
+			Expect(cluster.Name()).To(Equal("the-cluster"))
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(cluster.Type()).To(Equal("ElastiCache Cache Cluster"))
+		})
+	})
+})
@@ -0,0 +1,68 @@
+package elasticache
+
+import (
+	"fmt"
+	"strings"
+
+	awselasticache "github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/genevieve/leftovers/common"
+)
+
+type cacheClustersClient interface {
+	DescribeCacheClusters(*awselasticache.DescribeCacheClustersInput) (*awselasticache.DescribeCacheClustersOutput, error)
+	DescribeCacheClustersPages(*awselasticache.DescribeCacheClustersInput, func(*awselasticache.DescribeCacheClustersOutput, bool) bool) error
+	DeleteCacheCluster(*awselasticache.DeleteCacheClusterInput) (*awselasticache.DeleteCacheClusterOutput, error)
+}
+
+type CacheClusters struct {
+	client cacheClustersClient
+	logger logger
+	backup common.Backup
+}
+
+func NewCacheClusters(client cacheClustersClient, logger logger, backup common.Backup) CacheClusters {
+	return CacheClusters{
+		client: client,
+		logger: logger,
+		backup: backup,
+	}
+}
+
+// List returns the clusters that are not members of a replication group,
+// since those are deleted with their replication group.
+func (c CacheClusters) List(filter string) ([]common.Deletable, error) {
+	var clusters []*awselasticache.CacheCluster
+	err := c.client.DescribeCacheClustersPages(&awselasticache.DescribeCacheClustersInput{}, func(page *awselasticache.DescribeCacheClustersOutput, lastPage bool) bool {
+		clusters = append(clusters, page.CacheClusters...)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Describe ElastiCache Cache Clusters: %s", err)
+	}
+
+	var resources []common.Deletable
+	for _, cluster := range clusters {
+		if cluster.ReplicationGroupId != nil || *cluster.CacheClusterStatus == "deleting" {
+			continue
+		}
+
+		r := NewCacheCluster(c.client, c.logger, cluster.CacheClusterId, *cluster.CacheClusterStatus, *cluster.Engine, c.backup.Enabled)
+
+		if !strings.Contains(r.Name(), filter) {
+			continue
+		}
+
+		proceed := c.logger.PromptWithDetails(r.Type(), r.Name())
+		if !proceed {
+			continue
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func (c CacheClusters) Type() string {
+	return "elasticache-cache-cluster"
+}
@@ -0,0 +1,108 @@
+package elasticache_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awselasticache "github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/genevieve/leftovers/aws/elasticache"
+	"github.com/genevieve/leftovers/aws/elasticache/fakes"
+	"github.com/genevieve/leftovers/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CacheClusters", func() {
+	var (
+		client *fakes.CacheClustersClient
+		logger *fakes.Logger
+
+		clusters elasticache.CacheClusters
+	)
+
+	BeforeEach(func() {
+		client = &fakes.CacheClustersClient{}
+		logger = &fakes.Logger{}
+		logger.PromptWithDetailsCall.Returns.Proceed = true
+
+		clusters = elasticache.NewCacheClusters(client, logger, common.Backup{})
+	})
+
+	Describe("List", func() {
+		var filter string
+
+		BeforeEach(func() {
+			client.DescribeCacheClustersPagesCall.Returns.Pages = []*awselasticache.DescribeCacheClustersOutput{{
+				CacheClusters: []*awselasticache.CacheCluster{{
+					CacheClusterId:     aws.String("the-banana-cluster"),
+					CacheClusterStatus: aws.String("available"),
+					Engine:             aws.String("memcached"),
+				}, {
+					CacheClusterId:     aws.String("the-banana-group-001"),
+					CacheClusterStatus: aws.String("available"),
+					Engine:             aws.String("redis"),
+					ReplicationGroupId: aws.String("the-banana-group"),
+				}, {
+					CacheClusterId:     aws.String("the-deleting-banana-cluster"),
+					CacheClusterStatus: aws.String("deleting"),
+					Engine:             aws.String("redis"),
+				}},
+			}}
+			filter = "banana"
+		})
+
+		It("returns the clusters that are not in a replication group or being deleted", func() {
+			items, err := clusters.List(filter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DescribeCacheClustersPagesCall.CallCount).To(Equal(1))
+
+			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("ElastiCache Cache Cluster"))
+			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("the-banana-cluster"))
+
+			Expect(items).To(HaveLen(1))
+		})
+
+		Context("when the cluster name does not contain the filter", func() {
+			It("does not return it to the list", func() {
+				items, err := clusters.List("kiwi")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+
+		Context("when the client fails to describe clusters", func() {
+			BeforeEach(func() {
+				client.DescribeCacheClustersPagesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := clusters.List(filter)
+				Expect(err).To(MatchError("Describe ElastiCache Cache Clusters: some error"))
+			})
+		})
+
+		Context("when the user responds no to the prompt", func() {
+			BeforeEach(func() {
+				logger.PromptWithDetailsCall.Returns.Proceed = false
+			})
+
+			It("does not return it to the list", func() {
+				items, err := clusters.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(clusters.Type()).To(Equal("elasticache-cache-cluster"))
+		})
+	})
+})
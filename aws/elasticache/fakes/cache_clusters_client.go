@@ -0,0 +1,68 @@
+package fakes
+
+import (
+	awselasticache "github.com/aws/aws-sdk-go/service/elasticache"
+)
+
+type CacheClustersClient struct {
+	DescribeCacheClustersCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awselasticache.DescribeCacheClustersInput
+		}
+		Returns struct {
+			Output *awselasticache.DescribeCacheClustersOutput
+			Error  error
+		}
+	}
+
+	DescribeCacheClustersPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awselasticache.DescribeCacheClustersInput
+		}
+		Returns struct {
+			Pages []*awselasticache.DescribeCacheClustersOutput
+			Error error
+		}
+	}
+
+	DeleteCacheClusterCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awselasticache.DeleteCacheClusterInput
+		}
+		Returns struct {
+			Output *awselasticache.DeleteCacheClusterOutput
+			Error  error
+		}
+	}
+}
+
+func (c *CacheClustersClient) DescribeCacheClusters(input *awselasticache.DescribeCacheClustersInput) (*awselasticache.DescribeCacheClustersOutput, error) {
+	c.DescribeCacheClustersCall.CallCount++
+	c.DescribeCacheClustersCall.Receives.Input = input
+
+	return c.DescribeCacheClustersCall.Returns.Output, c.DescribeCacheClustersCall.Returns.Error
+}
+
+func (c *CacheClustersClient) DescribeCacheClustersPages(input *awselasticache.DescribeCacheClustersInput, fn func(*awselasticache.DescribeCacheClustersOutput, bool) bool) error {
+	c.DescribeCacheClustersPagesCall.CallCount++
+	c.DescribeCacheClustersPagesCall.Receives.Input = input
+
+	pages := c.DescribeCacheClustersPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return c.DescribeCacheClustersPagesCall.Returns.Error
+}
+
+func (c *CacheClustersClient) DeleteCacheCluster(input *awselasticache.DeleteCacheClusterInput) (*awselasticache.DeleteCacheClusterOutput, error) {
+	c.DeleteCacheClusterCall.CallCount++
+	c.DeleteCacheClusterCall.Receives.Input = input
+
+	return c.DeleteCacheClusterCall.Returns.Output, c.DeleteCacheClusterCall.Returns.Error
+}
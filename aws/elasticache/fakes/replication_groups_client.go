@@ -0,0 +1,68 @@
+package fakes
+
+import (
+	awselasticache "github.com/aws/aws-sdk-go/service/elasticache"
+)
+
+type ReplicationGroupsClient struct {
+	DescribeReplicationGroupsCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awselasticache.DescribeReplicationGroupsInput
+		}
+		Returns struct {
+			Output *awselasticache.DescribeReplicationGroupsOutput
+			Error  error
+		}
+	}
+
+	DescribeReplicationGroupsPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awselasticache.DescribeReplicationGroupsInput
+		}
+		Returns struct {
+			Pages []*awselasticache.DescribeReplicationGroupsOutput
+			Error error
+		}
+	}
+
+	DeleteReplicationGroupCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awselasticache.DeleteReplicationGroupInput
+		}
+		Returns struct {
+			Output *awselasticache.DeleteReplicationGroupOutput
+			Error  error
+		}
+	}
+}
+
+func (r *ReplicationGroupsClient) DescribeReplicationGroups(input *awselasticache.DescribeReplicationGroupsInput) (*awselasticache.DescribeReplicationGroupsOutput, error) {
+	r.DescribeReplicationGroupsCall.CallCount++
+	r.DescribeReplicationGroupsCall.Receives.Input = input
+
+	return r.DescribeReplicationGroupsCall.Returns.Output, r.DescribeReplicationGroupsCall.Returns.Error
+}
+
+func (r *ReplicationGroupsClient) DescribeReplicationGroupsPages(input *awselasticache.DescribeReplicationGroupsInput, fn func(*awselasticache.DescribeReplicationGroupsOutput, bool) bool) error {
+	r.DescribeReplicationGroupsPagesCall.CallCount++
+	r.DescribeReplicationGroupsPagesCall.Receives.Input = input
+
+	pages := r.DescribeReplicationGroupsPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return r.DescribeReplicationGroupsPagesCall.Returns.Error
+}
+
+func (r *ReplicationGroupsClient) DeleteReplicationGroup(input *awselasticache.DeleteReplicationGroupInput) (*awselasticache.DeleteReplicationGroupOutput, error) {
+	r.DeleteReplicationGroupCall.CallCount++
+	r.DeleteReplicationGroupCall.Receives.Input = input
+
+	return r.DeleteReplicationGroupCall.Returns.Output, r.DeleteReplicationGroupCall.Returns.Error
+}
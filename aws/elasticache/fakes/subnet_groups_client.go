@@ -0,0 +1,50 @@
+package fakes
+
+import (
+	awselasticache "github.com/aws/aws-sdk-go/service/elasticache"
+)
+
+type SubnetGroupsClient struct {
+	DescribeCacheSubnetGroupsPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awselasticache.DescribeCacheSubnetGroupsInput
+		}
+		Returns struct {
+			Pages []*awselasticache.DescribeCacheSubnetGroupsOutput
+			Error error
+		}
+	}
+
+	DeleteCacheSubnetGroupCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awselasticache.DeleteCacheSubnetGroupInput
+		}
+		Returns struct {
+			Output *awselasticache.DeleteCacheSubnetGroupOutput
+			Error  error
+		}
+	}
+}
+
+func (s *SubnetGroupsClient) DescribeCacheSubnetGroupsPages(input *awselasticache.DescribeCacheSubnetGroupsInput, fn func(*awselasticache.DescribeCacheSubnetGroupsOutput, bool) bool) error {
+	s.DescribeCacheSubnetGroupsPagesCall.CallCount++
+	s.DescribeCacheSubnetGroupsPagesCall.Receives.Input = input
+
+	pages := s.DescribeCacheSubnetGroupsPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return s.DescribeCacheSubnetGroupsPagesCall.Returns.Error
+}
+
+func (s *SubnetGroupsClient) DeleteCacheSubnetGroup(input *awselasticache.DeleteCacheSubnetGroupInput) (*awselasticache.DeleteCacheSubnetGroupOutput, error) {
+	s.DeleteCacheSubnetGroupCall.CallCount++
+	s.DeleteCacheSubnetGroupCall.Receives.Input = input
+
+	return s.DeleteCacheSubnetGroupCall.Returns.Output, s.DeleteCacheSubnetGroupCall.Returns.Error
+}
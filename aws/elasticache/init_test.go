@@ -0,0 +1,13 @@
+package elasticache_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestElastiCache(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "aws/elasticache")
+}
@@ -0,0 +1,95 @@
+package elasticache
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awselasticache "github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/genevieve/leftovers/common"
+)
+
+type ReplicationGroup struct {
+	client     replicationGroupsClient
+	logger     logger
+	id         *string
+	status     string
+	identifier string
+	rtype      string
+	backup     bool
+}
+
+func NewReplicationGroup(client replicationGroupsClient, logger logger, id *string, status string, backup bool) ReplicationGroup {
+	return ReplicationGroup{
+		client:     client,
+		logger:     logger,
+		id:         id,
+		status:     status,
+		identifier: *id,
+		rtype:      "ElastiCache Replication Group",
+		backup:     backup,
+	}
+}
+
+func (r ReplicationGroup) Delete() error {
+	return r.DeleteContext(context.Background())
+}
+
+// DeleteContext deletes the replication group with all of its clusters,
+// taking a final snapshot if backup is true, and waits for it to be deleted.
+func (r ReplicationGroup) DeleteContext(ctx context.Context) error {
+	input := &awselasticache.DeleteReplicationGroupInput{
+		ReplicationGroupId:   r.id,
+		RetainPrimaryCluster: aws.Bool(false),
+	}
+
+	if r.backup {
+		input.FinalSnapshotIdentifier = aws.String(common.BackupName(r.identifier, 255))
+	}
+
+	_, err := r.client.DeleteReplicationGroup(input)
+	if err != nil {
+		return fmt.Errorf("Delete: %s", err)
+	}
+
+	if r.backup {
+		r.logger.Printf("[%s: %s] Final snapshot: %s\n", r.rtype, r.identifier, *input.FinalSnapshotIdentifier)
+	}
+
+	poller := common.NewPoller(r.logger, replicationGroupRefresh(r.client, r.id), []string{r.status, "snapshotting", "deleting"}, []string{"deleted"})
+
+	_, err = poller.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("Waiting for deletion: %s", err)
+	}
+
+	return nil
+}
+
+func (r ReplicationGroup) Name() string {
+	return r.identifier
+}
+
+func (r ReplicationGroup) Type() string {
+	return r.rtype
+}
+
+func replicationGroupRefresh(client replicationGroupsClient, id *string) common.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.DescribeReplicationGroups(&awselasticache.DescribeReplicationGroupsInput{ReplicationGroupId: id})
+		if err != nil {
+			if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == awselasticache.ErrCodeReplicationGroupNotFoundFault {
+				return id, "deleted", nil
+			}
+			return nil, "", err
+		}
+
+		if len(resp.ReplicationGroups) == 0 {
+			return id, "deleted", nil
+		}
+
+		g := resp.ReplicationGroups[0]
+		return g, *g.Status, nil
+	}
+}
@@ -0,0 +1,98 @@
+package elasticache_test
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/genevieve/leftovers/aws/elasticache"
+	"github.com/genevieve/leftovers/aws/elasticache/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ReplicationGroup", func() {
+	var (
+		group  elasticache.ReplicationGroup
+		client *fakes.ReplicationGroupsClient
+		logger *fakes.Logger
+		id     *string
+	)
+
+	BeforeEach(func() {
+		client = &fakes.ReplicationGroupsClient{}
+		logger = &fakes.Logger{}
+		id = aws.String("the-group")
+
+		client.DescribeReplicationGroupsCall.Returns.Error = awserr.New("ReplicationGroupNotFoundFault", "", nil)
+
+		group = elasticache.NewReplicationGroup(client, logger, id, "available", false)
+	})
+
+	Describe("Delete", func() {
+		It("deletes the replication group and waits for it to be deleted", func() {
+			err := group.Delete()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DeleteReplicationGroupCall.CallCount).To(Equal(1))
+			Expect(client.DeleteReplicationGroupCall.Receives.Input.ReplicationGroupId).To(Equal(id))
+			Expect(client.DeleteReplicationGroupCall.Receives.Input.RetainPrimaryCluster).To(Equal(aws.Bool(false)))
+			Expect(client.DeleteReplicationGroupCall.Receives.Input.FinalSnapshotIdentifier).To(BeNil())
+
+			Expect(client.DescribeReplicationGroupsCall.CallCount).To(Equal(1))
+			Expect(client.DescribeReplicationGroupsCall.Receives.Input.ReplicationGroupId).To(Equal(id))
+		})
+
+		Context("when backup is enabled", func() {
+			BeforeEach(func() {
+				group = elasticache.NewReplicationGroup(client, logger, id, "available", true)
+			})
+
+			It("takes a final snapshot and logs its name", func() {
+				err := group.Delete()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(*client.DeleteReplicationGroupCall.Receives.Input.FinalSnapshotIdentifier).To(HavePrefix("the-group-leftovers-"))
+
+				Expect(logger.PrintfCall.Messages).To(ContainElement(
+					fmt.Sprintf("[ElastiCache Replication Group: the-group] Final snapshot: %s\n", *client.DeleteReplicationGroupCall.Receives.Input.FinalSnapshotIdentifier),
+				))
+			})
+		})
+
+		Context("when the client fails", func() {
+			BeforeEach(func() {
+				client.DeleteReplicationGroupCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := group.Delete()
+				Expect(err).To(MatchError("Delete: banana"))
+			})
+		})
+
+		Context("when the client fails while waiting", func() {
+			BeforeEach(func() {
+				client.DescribeReplicationGroupsCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := group.Delete()
+				Expect(err).To(MatchError("Waiting for deletion: banana"))
+			})
+		})
+	})
+
+	Describe("Name", func() {
+		It("returns the identifier", func() {
+			Expect(group.Name()).To(Equal("the-group"))
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(group.Type()).To(Equal("ElastiCache Replication Group"))
+		})
+	})
+})
@@ -0,0 +1,66 @@
+package elasticache
+
+import (
+	"fmt"
+	"strings"
+
+	awselasticache "github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/genevieve/leftovers/common"
+)
+
+type replicationGroupsClient interface {
+	DescribeReplicationGroups(*awselasticache.DescribeReplicationGroupsInput) (*awselasticache.DescribeReplicationGroupsOutput, error)
+	DescribeReplicationGroupsPages(*awselasticache.DescribeReplicationGroupsInput, func(*awselasticache.DescribeReplicationGroupsOutput, bool) bool) error
+	DeleteReplicationGroup(*awselasticache.DeleteReplicationGroupInput) (*awselasticache.DeleteReplicationGroupOutput, error)
+}
+
+type ReplicationGroups struct {
+	client replicationGroupsClient
+	logger logger
+	backup common.Backup
+}
+
+func NewReplicationGroups(client replicationGroupsClient, logger logger, backup common.Backup) ReplicationGroups {
+	return ReplicationGroups{
+		client: client,
+		logger: logger,
+		backup: backup,
+	}
+}
+
+func (r ReplicationGroups) List(filter string) ([]common.Deletable, error) {
+	var groups []*awselasticache.ReplicationGroup
+	err := r.client.DescribeReplicationGroupsPages(&awselasticache.DescribeReplicationGroupsInput{}, func(page *awselasticache.DescribeReplicationGroupsOutput, lastPage bool) bool {
+		groups = append(groups, page.ReplicationGroups...)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Describe ElastiCache Replication Groups: %s", err)
+	}
+
+	var resources []common.Deletable
+	for _, g := range groups {
+		if *g.Status == "deleting" {
+			continue
+		}
+
+		resource := NewReplicationGroup(r.client, r.logger, g.ReplicationGroupId, *g.Status, r.backup.Enabled)
+
+		if !strings.Contains(resource.Name(), filter) {
+			continue
+		}
+
+		proceed := r.logger.PromptWithDetails(resource.Type(), resource.Name())
+		if !proceed {
+			continue
+		}
+
+		resources = append(resources, resource)
+	}
+
+	return resources, nil
+}
+
+func (r ReplicationGroups) Type() string {
+	return "elasticache-replication-group"
+}
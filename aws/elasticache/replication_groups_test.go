@@ -0,0 +1,101 @@
+package elasticache_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awselasticache "github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/genevieve/leftovers/aws/elasticache"
+	"github.com/genevieve/leftovers/aws/elasticache/fakes"
+	"github.com/genevieve/leftovers/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ReplicationGroups", func() {
+	var (
+		client *fakes.ReplicationGroupsClient
+		logger *fakes.Logger
+
+		groups elasticache.ReplicationGroups
+	)
+
+	BeforeEach(func() {
+		client = &fakes.ReplicationGroupsClient{}
+		logger = &fakes.Logger{}
+		logger.PromptWithDetailsCall.Returns.Proceed = true
+
+		groups = elasticache.NewReplicationGroups(client, logger, common.Backup{})
+	})
+
+	Describe("List", func() {
+		var filter string
+
+		BeforeEach(func() {
+			client.DescribeReplicationGroupsPagesCall.Returns.Pages = []*awselasticache.DescribeReplicationGroupsOutput{{
+				ReplicationGroups: []*awselasticache.ReplicationGroup{{
+					ReplicationGroupId: aws.String("the-banana-group"),
+					Status:             aws.String("available"),
+				}, {
+					ReplicationGroupId: aws.String("the-deleting-banana-group"),
+					Status:             aws.String("deleting"),
+				}},
+			}}
+			filter = "banana"
+		})
+
+		It("returns the replication groups that are not being deleted", func() {
+			items, err := groups.List(filter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DescribeReplicationGroupsPagesCall.CallCount).To(Equal(1))
+
+			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("ElastiCache Replication Group"))
+			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("the-banana-group"))
+
+			Expect(items).To(HaveLen(1))
+		})
+
+		Context("when the replication group name does not contain the filter", func() {
+			It("does not return it to the list", func() {
+				items, err := groups.List("kiwi")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+
+		Context("when the client fails to describe replication groups", func() {
+			BeforeEach(func() {
+				client.DescribeReplicationGroupsPagesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := groups.List(filter)
+				Expect(err).To(MatchError("Describe ElastiCache Replication Groups: some error"))
+			})
+		})
+
+		Context("when the user responds no to the prompt", func() {
+			BeforeEach(func() {
+				logger.PromptWithDetailsCall.Returns.Proceed = false
+			})
+
+			It("does not return it to the list", func() {
+				items, err := groups.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(groups.Type()).To(Equal("elasticache-replication-group"))
+		})
+	})
+})
@@ -0,0 +1,40 @@
+package elasticache
+
+import (
+	"fmt"
+
+	awselasticache "github.com/aws/aws-sdk-go/service/elasticache"
+)
+
+type SubnetGroup struct {
+	client     subnetGroupsClient
+	name       *string
+	identifier string
+	rtype      string
+}
+
+func NewSubnetGroup(client subnetGroupsClient, name *string) SubnetGroup {
+	return SubnetGroup{
+		client:     client,
+		name:       name,
+		identifier: *name,
+		rtype:      "ElastiCache Subnet Group",
+	}
+}
+
+func (s SubnetGroup) Delete() error {
+	_, err := s.client.DeleteCacheSubnetGroup(&awselasticache.DeleteCacheSubnetGroupInput{CacheSubnetGroupName: s.name})
+	if err != nil {
+		return fmt.Errorf("Delete: %s", err)
+	}
+
+	return nil
+}
+
+func (s SubnetGroup) Name() string {
+	return s.identifier
+}
+
+func (s SubnetGroup) Type() string {
+	return s.rtype
+}
@@ -0,0 +1,59 @@
+package elasticache_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/genevieve/leftovers/aws/elasticache"
+	"github.com/genevieve/leftovers/aws/elasticache/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SubnetGroup", func() {
+	var (
+		subnetGroup elasticache.SubnetGroup
+		client      *fakes.SubnetGroupsClient
+		name        *string
+	)
+
+	BeforeEach(func() {
+		client = &fakes.SubnetGroupsClient{}
+		name = aws.String("the-subnet-group")
+
+		subnetGroup = elasticache.NewSubnetGroup(client, name)
+	})
+
+	Describe("Delete", func() {
+		It("deletes the subnet group", func() {
+			err := subnetGroup.Delete()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DeleteCacheSubnetGroupCall.CallCount).To(Equal(1))
+			Expect(client.DeleteCacheSubnetGroupCall.Receives.Input.CacheSubnetGroupName).To(Equal(name))
+		})
+
+		Context("when the client fails", func() {
+			BeforeEach(func() {
+				client.DeleteCacheSubnetGroupCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := subnetGroup.Delete()
+				Expect(err).To(MatchError("Delete: banana"))
+			})
+		})
+	})
+
+	Describe("Name", func() {
+		It("returns the identifier", func() {
+			Expect(subnetGroup.Name()).To(Equal("the-subnet-group"))
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(subnetGroup.Type()).To(Equal("ElastiCache Subnet Group"))
+		})
+	})
+})
@@ -0,0 +1,63 @@
+package elasticache
+
+import (
+	"fmt"
+	"strings"
+
+	awselasticache "github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/genevieve/leftovers/common"
+)
+
+type subnetGroupsClient interface {
+	DescribeCacheSubnetGroupsPages(*awselasticache.DescribeCacheSubnetGroupsInput, func(*awselasticache.DescribeCacheSubnetGroupsOutput, bool) bool) error
+	DeleteCacheSubnetGroup(*awselasticache.DeleteCacheSubnetGroupInput) (*awselasticache.DeleteCacheSubnetGroupOutput, error)
+}
+
+type SubnetGroups struct {
+	client subnetGroupsClient
+	logger logger
+}
+
+func NewSubnetGroups(client subnetGroupsClient, logger logger) SubnetGroups {
+	return SubnetGroups{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (s SubnetGroups) List(filter string) ([]common.Deletable, error) {
+	var groups []*awselasticache.CacheSubnetGroup
+	err := s.client.DescribeCacheSubnetGroupsPages(&awselasticache.DescribeCacheSubnetGroupsInput{}, func(page *awselasticache.DescribeCacheSubnetGroupsOutput, lastPage bool) bool {
+		groups = append(groups, page.CacheSubnetGroups...)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Describe ElastiCache Subnet Groups: %s", err)
+	}
+
+	var resources []common.Deletable
+	for _, g := range groups {
+		if *g.CacheSubnetGroupName == "default" {
+			continue
+		}
+
+		r := NewSubnetGroup(s.client, g.CacheSubnetGroupName)
+
+		if !strings.Contains(r.Name(), filter) {
+			continue
+		}
+
+		proceed := s.logger.PromptWithDetails(r.Type(), r.Name())
+		if !proceed {
+			continue
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func (s SubnetGroups) Type() string {
+	return "elasticache-subnet-group"
+}
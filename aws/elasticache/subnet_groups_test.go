@@ -0,0 +1,97 @@
+package elasticache_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awselasticache "github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/genevieve/leftovers/aws/elasticache"
+	"github.com/genevieve/leftovers/aws/elasticache/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SubnetGroups", func() {
+	var (
+		client *fakes.SubnetGroupsClient
+		logger *fakes.Logger
+
+		subnetGroups elasticache.SubnetGroups
+	)
+
+	BeforeEach(func() {
+		client = &fakes.SubnetGroupsClient{}
+		logger = &fakes.Logger{}
+		logger.PromptWithDetailsCall.Returns.Proceed = true
+
+		subnetGroups = elasticache.NewSubnetGroups(client, logger)
+	})
+
+	Describe("List", func() {
+		var filter string
+
+		BeforeEach(func() {
+			client.DescribeCacheSubnetGroupsPagesCall.Returns.Pages = []*awselasticache.DescribeCacheSubnetGroupsOutput{{
+				CacheSubnetGroups: []*awselasticache.CacheSubnetGroup{
+					{CacheSubnetGroupName: aws.String("default")},
+					{CacheSubnetGroupName: aws.String("the-subnet-group")},
+				},
+			}}
+			filter = ""
+		})
+
+		It("returns the subnet groups other than the default", func() {
+			items, err := subnetGroups.List(filter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DescribeCacheSubnetGroupsPagesCall.CallCount).To(Equal(1))
+
+			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("ElastiCache Subnet Group"))
+			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("the-subnet-group"))
+
+			Expect(items).To(HaveLen(1))
+		})
+
+		Context("when the subnet group name does not contain the filter", func() {
+			It("does not return it to the list", func() {
+				items, err := subnetGroups.List("kiwi")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+
+		Context("when the client fails to describe subnet groups", func() {
+			BeforeEach(func() {
+				client.DescribeCacheSubnetGroupsPagesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := subnetGroups.List(filter)
+				Expect(err).To(MatchError("Describe ElastiCache Subnet Groups: some error"))
+			})
+		})
+
+		Context("when the user responds no to the prompt", func() {
+			BeforeEach(func() {
+				logger.PromptWithDetailsCall.Returns.Proceed = false
+			})
+
+			It("does not return it to the list", func() {
+				items, err := subnetGroups.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(subnetGroups.Type()).To(Equal("elasticache-subnet-group"))
+		})
+	})
+})
@@ -0,0 +1,25 @@
+package fakes
+
+type Listeners struct {
+	ResetCall struct {
+		CallCount int
+	}
+
+	AddCall struct {
+		CallCount int
+		Receives  struct {
+			LoadBalancer string
+			Certificates []string
+		}
+	}
+}
+
+func (l *Listeners) Reset() {
+	l.ResetCall.CallCount++
+}
+
+func (l *Listeners) Add(loadBalancer string, certificates []string) {
+	l.AddCall.CallCount++
+	l.AddCall.Receives.LoadBalancer = loadBalancer
+	l.AddCall.Receives.Certificates = certificates
+}
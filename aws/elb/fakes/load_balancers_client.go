@@ -3,14 +3,14 @@ package fakes
 import "github.com/aws/aws-sdk-go/service/elb"
 
 type LoadBalancersClient struct {
-	DescribeLoadBalancersCall struct {
+	DescribeLoadBalancersPagesCall struct {
 		CallCount int
 		Receives  struct {
 			Input *elb.DescribeLoadBalancersInput
 		}
 		Returns struct {
-			Output *elb.DescribeLoadBalancersOutput
-			Error  error
+			Pages []*elb.DescribeLoadBalancersOutput
+			Error error
 		}
 	}
 
@@ -26,11 +26,18 @@ type LoadBalancersClient struct {
 	}
 }
 
-func (e *LoadBalancersClient) DescribeLoadBalancers(input *elb.DescribeLoadBalancersInput) (*elb.DescribeLoadBalancersOutput, error) {
-	e.DescribeLoadBalancersCall.CallCount++
-	e.DescribeLoadBalancersCall.Receives.Input = input
+func (e *LoadBalancersClient) DescribeLoadBalancersPages(input *elb.DescribeLoadBalancersInput, fn func(*elb.DescribeLoadBalancersOutput, bool) bool) error {
+	e.DescribeLoadBalancersPagesCall.CallCount++
+	e.DescribeLoadBalancersPagesCall.Receives.Input = input
+
+	pages := e.DescribeLoadBalancersPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
 
-	return e.DescribeLoadBalancersCall.Returns.Output, e.DescribeLoadBalancersCall.Returns.Error
+	return e.DescribeLoadBalancersPagesCall.Returns.Error
 }
 
 func (e *LoadBalancersClient) DeleteLoadBalancer(input *elb.DeleteLoadBalancerInput) (*elb.DeleteLoadBalancerOutput, error) {
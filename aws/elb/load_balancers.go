@@ -9,30 +9,46 @@ import (
 )
 
 type loadBalancersClient interface {
-	DescribeLoadBalancers(*awselb.DescribeLoadBalancersInput) (*awselb.DescribeLoadBalancersOutput, error)
+	DescribeLoadBalancersPages(*awselb.DescribeLoadBalancersInput, func(*awselb.DescribeLoadBalancersOutput, bool) bool) error
 	DeleteLoadBalancer(*awselb.DeleteLoadBalancerInput) (*awselb.DeleteLoadBalancerOutput, error)
 }
 
+type listeners interface {
+	Reset()
+	Add(loadBalancer string, certificates []string)
+}
+
 type LoadBalancers struct {
-	client loadBalancersClient
-	logger logger
+	client    loadBalancersClient
+	logger    logger
+	listeners listeners
 }
 
-func NewLoadBalancers(client loadBalancersClient, logger logger) LoadBalancers {
+// NewLoadBalancers returns the classic load balancers to delete. The load
+// balancers that are selected, and the certificates of their listeners,
+// are added to the provided listeners.
+func NewLoadBalancers(client loadBalancersClient, logger logger, listeners listeners) LoadBalancers {
 	return LoadBalancers{
-		client: client,
-		logger: logger,
+		client:    client,
+		logger:    logger,
+		listeners: listeners,
 	}
 }
 
 func (l LoadBalancers) List(filter string) ([]common.Deletable, error) {
-	loadBalancers, err := l.client.DescribeLoadBalancers(&awselb.DescribeLoadBalancersInput{})
+	l.listeners.Reset()
+
+	var loadBalancers []*awselb.LoadBalancerDescription
+	err := l.client.DescribeLoadBalancersPages(&awselb.DescribeLoadBalancersInput{}, func(page *awselb.DescribeLoadBalancersOutput, lastPage bool) bool {
+		loadBalancers = append(loadBalancers, page.LoadBalancerDescriptions...)
+		return true
+	})
 	if err != nil {
 		return nil, fmt.Errorf("Describe ELB Load Balancers: %s", err)
 	}
 
 	var resources []common.Deletable
-	for _, lb := range loadBalancers.LoadBalancerDescriptions {
+	for _, lb := range loadBalancers {
 		r := NewLoadBalancer(l.client, lb.LoadBalancerName)
 
 		if !strings.Contains(r.Name(), filter) {
@@ -44,6 +60,14 @@ func (l LoadBalancers) List(filter string) ([]common.Deletable, error) {
 			continue
 		}
 
+		var certificates []string
+		for _, d := range lb.ListenerDescriptions {
+			if d.Listener != nil && d.Listener.SSLCertificateId != nil {
+				certificates = append(certificates, *d.Listener.SSLCertificateId)
+			}
+		}
+		l.listeners.Add(fmt.Sprintf("loadbalancer/%s", *lb.LoadBalancerName), certificates)
+
 		resources = append(resources, r)
 	}
 
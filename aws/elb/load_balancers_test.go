@@ -13,8 +13,9 @@ import (
 
 var _ = Describe("LoadBalancers", func() {
 	var (
-		client *fakes.LoadBalancersClient
-		logger *fakes.Logger
+		client    *fakes.LoadBalancersClient
+		logger    *fakes.Logger
+		listeners *fakes.Listeners
 
 		loadBalancers elb.LoadBalancers
 	)
@@ -22,8 +23,9 @@ var _ = Describe("LoadBalancers", func() {
 	BeforeEach(func() {
 		client = &fakes.LoadBalancersClient{}
 		logger = &fakes.Logger{}
+		listeners = &fakes.Listeners{}
 
-		loadBalancers = elb.NewLoadBalancers(client, logger)
+		loadBalancers = elb.NewLoadBalancers(client, logger, listeners)
 	})
 
 	Describe("List", func() {
@@ -31,11 +33,16 @@ var _ = Describe("LoadBalancers", func() {
 
 		BeforeEach(func() {
 			logger.PromptWithDetailsCall.Returns.Proceed = true
-			client.DescribeLoadBalancersCall.Returns.Output = &awselb.DescribeLoadBalancersOutput{
+			client.DescribeLoadBalancersPagesCall.Returns.Pages = []*awselb.DescribeLoadBalancersOutput{{
 				LoadBalancerDescriptions: []*awselb.LoadBalancerDescription{{
 					LoadBalancerName: aws.String("banana"),
+					ListenerDescriptions: []*awselb.ListenerDescription{{
+						Listener: &awselb.Listener{SSLCertificateId: aws.String("the-certificate-arn")},
+					}, {
+						Listener: &awselb.Listener{},
+					}},
 				}},
-			}
+			}}
 			filter = "ban"
 		})
 
@@ -43,16 +50,26 @@ var _ = Describe("LoadBalancers", func() {
 			items, err := loadBalancers.List(filter)
 			Expect(err).NotTo(HaveOccurred())
 
-			Expect(client.DescribeLoadBalancersCall.CallCount).To(Equal(1))
+			Expect(client.DescribeLoadBalancersPagesCall.CallCount).To(Equal(1))
 			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("ELB Load Balancer"))
 			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("banana"))
 
 			Expect(items).To(HaveLen(1))
 		})
 
+		It("adds the load balancers to delete and the certificates of their listeners to the listeners", func() {
+			_, err := loadBalancers.List(filter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(listeners.ResetCall.CallCount).To(Equal(1))
+			Expect(listeners.AddCall.CallCount).To(Equal(1))
+			Expect(listeners.AddCall.Receives.LoadBalancer).To(Equal("loadbalancer/banana"))
+			Expect(listeners.AddCall.Receives.Certificates).To(Equal([]string{"the-certificate-arn"}))
+		})
+
 		Context("when the client fails to list load balancers", func() {
 			BeforeEach(func() {
-				client.DescribeLoadBalancersCall.Returns.Error = errors.New("some error")
+				client.DescribeLoadBalancersPagesCall.Returns.Error = errors.New("some error")
 			})
 
 			It("returns the error", func() {
@@ -66,7 +83,7 @@ var _ = Describe("LoadBalancers", func() {
 				items, err := loadBalancers.List("kiwi")
 				Expect(err).NotTo(HaveOccurred())
 
-				Expect(client.DescribeLoadBalancersCall.CallCount).To(Equal(1))
+				Expect(client.DescribeLoadBalancersPagesCall.CallCount).To(Equal(1))
 				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
 				Expect(items).To(HaveLen(0))
 			})
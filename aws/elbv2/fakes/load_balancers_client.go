@@ -3,14 +3,25 @@ package fakes
 import "github.com/aws/aws-sdk-go/service/elbv2"
 
 type LoadBalancersClient struct {
-	DescribeLoadBalancersCall struct {
+	DescribeLoadBalancersPagesCall struct {
 		CallCount int
 		Receives  struct {
 			Input *elbv2.DescribeLoadBalancersInput
 		}
 		Returns struct {
-			Output *elbv2.DescribeLoadBalancersOutput
-			Error  error
+			Pages []*elbv2.DescribeLoadBalancersOutput
+			Error error
+		}
+	}
+
+	DescribeListenersPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *elbv2.DescribeListenersInput
+		}
+		Returns struct {
+			Pages []*elbv2.DescribeListenersOutput
+			Error error
 		}
 	}
 
@@ -26,11 +37,32 @@ type LoadBalancersClient struct {
 	}
 }
 
-func (e *LoadBalancersClient) DescribeLoadBalancers(input *elbv2.DescribeLoadBalancersInput) (*elbv2.DescribeLoadBalancersOutput, error) {
-	e.DescribeLoadBalancersCall.CallCount++
-	e.DescribeLoadBalancersCall.Receives.Input = input
+func (e *LoadBalancersClient) DescribeLoadBalancersPages(input *elbv2.DescribeLoadBalancersInput, fn func(*elbv2.DescribeLoadBalancersOutput, bool) bool) error {
+	e.DescribeLoadBalancersPagesCall.CallCount++
+	e.DescribeLoadBalancersPagesCall.Receives.Input = input
+
+	pages := e.DescribeLoadBalancersPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return e.DescribeLoadBalancersPagesCall.Returns.Error
+}
+
+func (e *LoadBalancersClient) DescribeListenersPages(input *elbv2.DescribeListenersInput, fn func(*elbv2.DescribeListenersOutput, bool) bool) error {
+	e.DescribeListenersPagesCall.CallCount++
+	e.DescribeListenersPagesCall.Receives.Input = input
+
+	pages := e.DescribeListenersPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
 
-	return e.DescribeLoadBalancersCall.Returns.Output, e.DescribeLoadBalancersCall.Returns.Error
+	return e.DescribeListenersPagesCall.Returns.Error
 }
 
 func (e *LoadBalancersClient) DeleteLoadBalancer(input *elbv2.DeleteLoadBalancerInput) (*elbv2.DeleteLoadBalancerOutput, error) {
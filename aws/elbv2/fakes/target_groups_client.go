@@ -3,14 +3,14 @@ package fakes
 import "github.com/aws/aws-sdk-go/service/elbv2"
 
 type TargetGroupsClient struct {
-	DescribeTargetGroupsCall struct {
+	DescribeTargetGroupsPagesCall struct {
 		CallCount int
 		Receives  struct {
 			Input *elbv2.DescribeTargetGroupsInput
 		}
 		Returns struct {
-			Output *elbv2.DescribeTargetGroupsOutput
-			Error  error
+			Pages []*elbv2.DescribeTargetGroupsOutput
+			Error error
 		}
 	}
 
@@ -26,11 +26,18 @@ type TargetGroupsClient struct {
 	}
 }
 
-func (e *TargetGroupsClient) DescribeTargetGroups(input *elbv2.DescribeTargetGroupsInput) (*elbv2.DescribeTargetGroupsOutput, error) {
-	e.DescribeTargetGroupsCall.CallCount++
-	e.DescribeTargetGroupsCall.Receives.Input = input
+func (e *TargetGroupsClient) DescribeTargetGroupsPages(input *elbv2.DescribeTargetGroupsInput, fn func(*elbv2.DescribeTargetGroupsOutput, bool) bool) error {
+	e.DescribeTargetGroupsPagesCall.CallCount++
+	e.DescribeTargetGroupsPagesCall.Receives.Input = input
+
+	pages := e.DescribeTargetGroupsPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
 
-	return e.DescribeTargetGroupsCall.Returns.Output, e.DescribeTargetGroupsCall.Returns.Error
+	return e.DescribeTargetGroupsPagesCall.Returns.Error
 }
 
 func (e *TargetGroupsClient) DeleteTargetGroup(input *elbv2.DeleteTargetGroupInput) (*elbv2.DeleteTargetGroupOutput, error) {
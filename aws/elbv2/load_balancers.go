@@ -9,31 +9,48 @@ import (
 )
 
 type loadBalancersClient interface {
-	DescribeLoadBalancers(*awselbv2.DescribeLoadBalancersInput) (*awselbv2.DescribeLoadBalancersOutput, error)
+	DescribeLoadBalancersPages(*awselbv2.DescribeLoadBalancersInput, func(*awselbv2.DescribeLoadBalancersOutput, bool) bool) error
+	DescribeListenersPages(*awselbv2.DescribeListenersInput, func(*awselbv2.DescribeListenersOutput, bool) bool) error
 	DeleteLoadBalancer(*awselbv2.DeleteLoadBalancerInput) (*awselbv2.DeleteLoadBalancerOutput, error)
 }
 
+type listeners interface {
+	Reset()
+	Add(loadBalancer string, certificates []string)
+}
+
 type LoadBalancers struct {
-	client loadBalancersClient
-	logger logger
+	client    loadBalancersClient
+	logger    logger
+	listeners listeners
 }
 
-func NewLoadBalancers(client loadBalancersClient, logger logger) LoadBalancers {
+// NewLoadBalancers returns the application and network load balancers to
+// delete. The load balancers that are selected, and the certificates of
+// their listeners, are added to the provided listeners.
+func NewLoadBalancers(client loadBalancersClient, logger logger, listeners listeners) LoadBalancers {
 	return LoadBalancers{
-		client: client,
-		logger: logger,
+		client:    client,
+		logger:    logger,
+		listeners: listeners,
 	}
 }
 
 func (l LoadBalancers) List(filter string) ([]common.Deletable, error) {
-	loadBalancers, err := l.client.DescribeLoadBalancers(&awselbv2.DescribeLoadBalancersInput{})
+	l.listeners.Reset()
+
+	var loadBalancers []*awselbv2.LoadBalancer
+	err := l.client.DescribeLoadBalancersPages(&awselbv2.DescribeLoadBalancersInput{}, func(page *awselbv2.DescribeLoadBalancersOutput, lastPage bool) bool {
+		loadBalancers = append(loadBalancers, page.LoadBalancers...)
+		return true
+	})
 	if err != nil {
 		return nil, fmt.Errorf("Describe ELBV2 Load Balancers: %s", err)
 
 	}
 
 	var resources []common.Deletable
-	for _, lb := range loadBalancers.LoadBalancers {
+	for _, lb := range loadBalancers {
 		r := NewLoadBalancer(l.client, lb.LoadBalancerName, lb.LoadBalancerArn)
 
 		if !strings.Contains(r.Name(), filter) {
@@ -45,6 +62,20 @@ func (l LoadBalancers) List(filter string) ([]common.Deletable, error) {
 			continue
 		}
 
+		var certificates []string
+		err = l.client.DescribeListenersPages(&awselbv2.DescribeListenersInput{LoadBalancerArn: lb.LoadBalancerArn}, func(page *awselbv2.DescribeListenersOutput, lastPage bool) bool {
+			for _, listener := range page.Listeners {
+				for _, c := range listener.Certificates {
+					certificates = append(certificates, *c.CertificateArn)
+				}
+			}
+			return true
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Describe listeners of ELBV2 Load Balancer %s: %s", *lb.LoadBalancerName, err)
+		}
+		l.listeners.Add(*lb.LoadBalancerArn, certificates)
+
 		resources = append(resources, r)
 	}
 
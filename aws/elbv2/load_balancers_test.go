@@ -13,8 +13,9 @@ import (
 
 var _ = Describe("LoadBalancers", func() {
 	var (
-		client *fakes.LoadBalancersClient
-		logger *fakes.Logger
+		client    *fakes.LoadBalancersClient
+		logger    *fakes.Logger
+		listeners *fakes.Listeners
 
 		loadBalancers elbv2.LoadBalancers
 	)
@@ -22,8 +23,9 @@ var _ = Describe("LoadBalancers", func() {
 	BeforeEach(func() {
 		client = &fakes.LoadBalancersClient{}
 		logger = &fakes.Logger{}
+		listeners = &fakes.Listeners{}
 
-		loadBalancers = elbv2.NewLoadBalancers(client, logger)
+		loadBalancers = elbv2.NewLoadBalancers(client, logger, listeners)
 	})
 
 	Describe("List", func() {
@@ -31,12 +33,19 @@ var _ = Describe("LoadBalancers", func() {
 
 		BeforeEach(func() {
 			logger.PromptWithDetailsCall.Returns.Proceed = true
-			client.DescribeLoadBalancersCall.Returns.Output = &awselbv2.DescribeLoadBalancersOutput{
+			client.DescribeLoadBalancersPagesCall.Returns.Pages = []*awselbv2.DescribeLoadBalancersOutput{{
 				LoadBalancers: []*awselbv2.LoadBalancer{{
 					LoadBalancerName: aws.String("banana"),
 					LoadBalancerArn:  aws.String("the-arn"),
 				}},
-			}
+			}}
+			client.DescribeListenersPagesCall.Returns.Pages = []*awselbv2.DescribeListenersOutput{{
+				Listeners: []*awselbv2.Listener{{
+					Certificates: []*awselbv2.Certificate{{CertificateArn: aws.String("the-certificate-arn")}},
+				}},
+			}, {
+				Listeners: []*awselbv2.Listener{{}},
+			}}
 			filter = "banana"
 		})
 
@@ -44,16 +53,40 @@ var _ = Describe("LoadBalancers", func() {
 			items, err := loadBalancers.List(filter)
 			Expect(err).NotTo(HaveOccurred())
 
-			Expect(client.DescribeLoadBalancersCall.CallCount).To(Equal(1))
+			Expect(client.DescribeLoadBalancersPagesCall.CallCount).To(Equal(1))
 			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("ELBV2 Load Balancer"))
 			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("banana"))
 
 			Expect(items).To(HaveLen(1))
 		})
 
+		It("adds the load balancers to delete and the certificates of their listeners to the listeners", func() {
+			_, err := loadBalancers.List(filter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DescribeListenersPagesCall.CallCount).To(Equal(1))
+			Expect(client.DescribeListenersPagesCall.Receives.Input.LoadBalancerArn).To(Equal(aws.String("the-arn")))
+
+			Expect(listeners.ResetCall.CallCount).To(Equal(1))
+			Expect(listeners.AddCall.CallCount).To(Equal(1))
+			Expect(listeners.AddCall.Receives.LoadBalancer).To(Equal("the-arn"))
+			Expect(listeners.AddCall.Receives.Certificates).To(Equal([]string{"the-certificate-arn"}))
+		})
+
+		Context("when the client fails to describe the listeners", func() {
+			BeforeEach(func() {
+				client.DescribeListenersPagesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := loadBalancers.List(filter)
+				Expect(err).To(MatchError("Describe listeners of ELBV2 Load Balancer banana: some error"))
+			})
+		})
+
 		Context("when the client fails to list load balancers", func() {
 			BeforeEach(func() {
-				client.DescribeLoadBalancersCall.Returns.Error = errors.New("some error")
+				client.DescribeLoadBalancersPagesCall.Returns.Error = errors.New("some error")
 			})
 
 			It("returns the error", func() {
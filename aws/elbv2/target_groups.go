@@ -9,7 +9,7 @@ import (
 )
 
 type targetGroupsClient interface {
-	DescribeTargetGroups(*awselbv2.DescribeTargetGroupsInput) (*awselbv2.DescribeTargetGroupsOutput, error)
+	DescribeTargetGroupsPages(*awselbv2.DescribeTargetGroupsInput, func(*awselbv2.DescribeTargetGroupsOutput, bool) bool) error
 	DeleteTargetGroup(*awselbv2.DeleteTargetGroupInput) (*awselbv2.DeleteTargetGroupOutput, error)
 }
 
@@ -26,13 +26,17 @@ func NewTargetGroups(client targetGroupsClient, logger logger) TargetGroups {
 }
 
 func (t TargetGroups) List(filter string) ([]common.Deletable, error) {
-	targetGroups, err := t.client.DescribeTargetGroups(&awselbv2.DescribeTargetGroupsInput{})
+	var targetGroups []*awselbv2.TargetGroup
+	err := t.client.DescribeTargetGroupsPages(&awselbv2.DescribeTargetGroupsInput{}, func(page *awselbv2.DescribeTargetGroupsOutput, lastPage bool) bool {
+		targetGroups = append(targetGroups, page.TargetGroups...)
+		return true
+	})
 	if err != nil {
 		return nil, fmt.Errorf("Describe ELBV2 Target Groups: %s", err)
 	}
 
 	var resources []common.Deletable
-	for _, g := range targetGroups.TargetGroups {
+	for _, g := range targetGroups {
 		r := NewTargetGroup(t.client, g.TargetGroupName, g.TargetGroupArn)
 
 		if !strings.Contains(r.Name(), filter) {
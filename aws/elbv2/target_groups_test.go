@@ -32,12 +32,12 @@ var _ = Describe("TargetGroups", func() {
 
 		BeforeEach(func() {
 			logger.PromptWithDetailsCall.Returns.Proceed = true
-			client.DescribeTargetGroupsCall.Returns.Output = &awselbv2.DescribeTargetGroupsOutput{
+			client.DescribeTargetGroupsPagesCall.Returns.Pages = []*awselbv2.DescribeTargetGroupsOutput{{
 				TargetGroups: []*awselbv2.TargetGroup{{
 					TargetGroupName: aws.String("precursor-banana"),
 					TargetGroupArn:  aws.String("precursor-arn"),
 				}},
-			}
+			}}
 			filter = "banana"
 		})
 
@@ -45,7 +45,7 @@ var _ = Describe("TargetGroups", func() {
 			items, err := targetGroups.List(filter)
 			Expect(err).NotTo(HaveOccurred())
 
-			Expect(client.DescribeTargetGroupsCall.CallCount).To(Equal(1))
+			Expect(client.DescribeTargetGroupsPagesCall.CallCount).To(Equal(1))
 
 			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
 			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("ELBV2 Target Group"))
@@ -54,9 +54,31 @@ var _ = Describe("TargetGroups", func() {
 			Expect(items).To(HaveLen(1))
 		})
 
+		Context("when there is more than one page of target groups", func() {
+			BeforeEach(func() {
+				client.DescribeTargetGroupsPagesCall.Returns.Pages = append(client.DescribeTargetGroupsPagesCall.Returns.Pages, &awselbv2.DescribeTargetGroupsOutput{
+					TargetGroups: []*awselbv2.TargetGroup{{
+						TargetGroupName: aws.String("successor-banana"),
+						TargetGroupArn:  aws.String("successor-arn"),
+					}},
+				})
+			})
+
+			It("returns the target groups from every page", func() {
+				items, err := targetGroups.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(client.DescribeTargetGroupsPagesCall.CallCount).To(Equal(1))
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(2))
+				Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("successor-banana"))
+
+				Expect(items).To(HaveLen(2))
+			})
+		})
+
 		Context("when the client fails to describe target groups", func() {
 			BeforeEach(func() {
-				client.DescribeTargetGroupsCall.Returns.Error = errors.New("error")
+				client.DescribeTargetGroupsPagesCall.Returns.Error = errors.New("error")
 			})
 
 			It("returns the error", func() {
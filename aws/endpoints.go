@@ -0,0 +1,37 @@
+package aws
+
+import "github.com/aws/aws-sdk-go/aws/endpoints"
+
+// Endpoints are the URLs that requests are sent to instead of AWS, ie.
+// for LocalStack. Services maps the endpoint id of a service, like ec2,
+// s3 or elasticloadbalancing, to its URL. Default is used for the
+// services that are not in Services. Any other service is resolved
+// for its region as usual, which covers GovCloud and China.
+type Endpoints struct {
+	Default  string
+	Services map[string]string
+}
+
+// EndpointFor resolves the endpoint of the provided service and region.
+func (e Endpoints) EndpointFor(service, region string, opts ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+	url, ok := e.Services[service]
+	if !ok {
+		url = e.Default
+	}
+
+	if url == "" {
+		return endpoints.DefaultResolver().EndpointFor(service, region, opts...)
+	}
+
+	return endpoints.ResolvedEndpoint{
+		URL:           url,
+		SigningRegion: region,
+	}, nil
+}
+
+// custom returns true if requests to the provided service
+// are sent to an endpoint other than AWS.
+func (e Endpoints) custom(service string) bool {
+	_, ok := e.Services[service]
+	return ok || e.Default != ""
+}
@@ -0,0 +1,53 @@
+package aws_test
+
+import (
+	"github.com/genevieve/leftovers/aws"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Endpoints", func() {
+	var endpoints aws.Endpoints
+
+	BeforeEach(func() {
+		endpoints = aws.Endpoints{
+			Services: map[string]string{"s3": "http://localhost:4572"},
+		}
+	})
+
+	Describe("EndpointFor", func() {
+		It("resolves the services with endpoints to them", func() {
+			resolved, err := endpoints.EndpointFor("s3", "us-east-1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resolved.URL).To(Equal("http://localhost:4572"))
+			Expect(resolved.SigningRegion).To(Equal("us-east-1"))
+		})
+
+		It("resolves the other services for their region", func() {
+			resolved, err := endpoints.EndpointFor("ec2", "us-gov-west-1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resolved.URL).To(Equal("https://ec2.us-gov-west-1.amazonaws.com"))
+
+			resolved, err = endpoints.EndpointFor("ec2", "cn-north-1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resolved.URL).To(Equal("https://ec2.cn-north-1.amazonaws.com.cn"))
+		})
+
+		Context("when there is a default endpoint", func() {
+			BeforeEach(func() {
+				endpoints.Default = "http://localhost:4566"
+			})
+
+			It("resolves the other services to it", func() {
+				resolved, err := endpoints.EndpointFor("ec2", "us-east-1")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resolved.URL).To(Equal("http://localhost:4566"))
+
+				resolved, err = endpoints.EndpointFor("s3", "us-east-1")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resolved.URL).To(Equal("http://localhost:4572"))
+			})
+		})
+	})
+})
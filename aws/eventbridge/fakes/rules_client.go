@@ -0,0 +1,120 @@
+package fakes
+
+import (
+	awseventbridge "github.com/aws/aws-sdk-go/service/eventbridge"
+)
+
+type RulesClient struct {
+	ListEventBusesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awseventbridge.ListEventBusesInput
+		}
+		Returns struct {
+			Output *awseventbridge.ListEventBusesOutput
+			Error  error
+		}
+	}
+
+	ListRulesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awseventbridge.ListRulesInput
+		}
+		Returns struct {
+			Output *awseventbridge.ListRulesOutput
+			Error  error
+		}
+		Stub func(*awseventbridge.ListRulesInput) (*awseventbridge.ListRulesOutput, error)
+	}
+
+	ListTagsForResourceCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awseventbridge.ListTagsForResourceInput
+		}
+		Returns struct {
+			Output *awseventbridge.ListTagsForResourceOutput
+			Error  error
+		}
+	}
+
+	ListTargetsByRuleCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awseventbridge.ListTargetsByRuleInput
+		}
+		Returns struct {
+			Output *awseventbridge.ListTargetsByRuleOutput
+			Error  error
+		}
+	}
+
+	RemoveTargetsCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awseventbridge.RemoveTargetsInput
+		}
+		Returns struct {
+			Output *awseventbridge.RemoveTargetsOutput
+			Error  error
+		}
+	}
+
+	DeleteRuleCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awseventbridge.DeleteRuleInput
+		}
+		Returns struct {
+			Output *awseventbridge.DeleteRuleOutput
+			Error  error
+		}
+	}
+}
+
+func (r *RulesClient) ListEventBuses(input *awseventbridge.ListEventBusesInput) (*awseventbridge.ListEventBusesOutput, error) {
+	r.ListEventBusesCall.CallCount++
+	r.ListEventBusesCall.Receives.Input = input
+
+	return r.ListEventBusesCall.Returns.Output, r.ListEventBusesCall.Returns.Error
+}
+
+func (r *RulesClient) ListRules(input *awseventbridge.ListRulesInput) (*awseventbridge.ListRulesOutput, error) {
+	r.ListRulesCall.CallCount++
+	r.ListRulesCall.Receives.Input = input
+
+	if r.ListRulesCall.Stub != nil {
+		return r.ListRulesCall.Stub(input)
+	}
+
+	return r.ListRulesCall.Returns.Output, r.ListRulesCall.Returns.Error
+}
+
+func (r *RulesClient) ListTagsForResource(input *awseventbridge.ListTagsForResourceInput) (*awseventbridge.ListTagsForResourceOutput, error) {
+	r.ListTagsForResourceCall.CallCount++
+	r.ListTagsForResourceCall.Receives.Input = input
+
+	return r.ListTagsForResourceCall.Returns.Output, r.ListTagsForResourceCall.Returns.Error
+}
+
+func (r *RulesClient) ListTargetsByRule(input *awseventbridge.ListTargetsByRuleInput) (*awseventbridge.ListTargetsByRuleOutput, error) {
+	r.ListTargetsByRuleCall.CallCount++
+	r.ListTargetsByRuleCall.Receives.Input = input
+
+	return r.ListTargetsByRuleCall.Returns.Output, r.ListTargetsByRuleCall.Returns.Error
+}
+
+func (r *RulesClient) RemoveTargets(input *awseventbridge.RemoveTargetsInput) (*awseventbridge.RemoveTargetsOutput, error) {
+	r.RemoveTargetsCall.CallCount++
+	r.RemoveTargetsCall.Receives.Input = input
+
+	return r.RemoveTargetsCall.Returns.Output, r.RemoveTargetsCall.Returns.Error
+}
+
+func (r *RulesClient) DeleteRule(input *awseventbridge.DeleteRuleInput) (*awseventbridge.DeleteRuleOutput, error) {
+	r.DeleteRuleCall.CallCount++
+	r.DeleteRuleCall.Receives.Input = input
+
+	return r.DeleteRuleCall.Returns.Output, r.DeleteRuleCall.Returns.Error
+}
@@ -0,0 +1,13 @@
+package eventbridge_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestEventBridge(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "aws/eventbridge")
+}
@@ -0,0 +1,99 @@
+package eventbridge
+
+import (
+	"fmt"
+	"strings"
+
+	awseventbridge "github.com/aws/aws-sdk-go/service/eventbridge"
+)
+
+type Rule struct {
+	client       rulesClient
+	logger       logger
+	name         *string
+	eventBusName *string
+	identifier   string
+	rtype        string
+}
+
+func NewRule(client rulesClient, logger logger, name, eventBusName *string, tags []*awseventbridge.Tag) Rule {
+	identifier := *name
+
+	var extra []string
+	if eventBusName != nil && *eventBusName != "default" {
+		extra = append(extra, fmt.Sprintf("EventBus:%s", *eventBusName))
+	}
+
+	for _, t := range tags {
+		extra = append(extra, fmt.Sprintf("%s:%s", *t.Key, *t.Value))
+	}
+
+	if len(extra) > 0 {
+		identifier = fmt.Sprintf("%s (%s)", *name, strings.Join(extra, ", "))
+	}
+
+	return Rule{
+		client:       client,
+		logger:       logger,
+		name:         name,
+		eventBusName: eventBusName,
+		identifier:   identifier,
+		rtype:        "EventBridge Rule",
+	}
+}
+
+// Delete removes the targets of the rule, since
+// a rule with targets can not be deleted, and deletes it.
+func (r Rule) Delete() error {
+	var ids []*string
+
+	input := &awseventbridge.ListTargetsByRuleInput{Rule: r.name, EventBusName: r.eventBusName}
+	for {
+		resp, err := r.client.ListTargetsByRule(input)
+		if err != nil {
+			return fmt.Errorf("List targets: %s", err)
+		}
+
+		for _, t := range resp.Targets {
+			ids = append(ids, t.Id)
+		}
+
+		if resp.NextToken == nil {
+			break
+		}
+		input.NextToken = resp.NextToken
+	}
+
+	if len(ids) > 0 {
+		resp, err := r.client.RemoveTargets(&awseventbridge.RemoveTargetsInput{
+			Rule:         r.name,
+			EventBusName: r.eventBusName,
+			Ids:          ids,
+		})
+		if err != nil {
+			return fmt.Errorf("Remove targets: %s", err)
+		}
+
+		if len(resp.FailedEntries) > 0 {
+			f := resp.FailedEntries[0]
+			return fmt.Errorf("Remove target %s: %s", *f.TargetId, *f.ErrorMessage)
+		}
+
+		r.logger.Printf("[%s: %s] Removed %d targets \n", r.rtype, r.identifier, len(ids))
+	}
+
+	_, err := r.client.DeleteRule(&awseventbridge.DeleteRuleInput{Name: r.name, EventBusName: r.eventBusName})
+	if err != nil {
+		return fmt.Errorf("Delete: %s", err)
+	}
+
+	return nil
+}
+
+func (r Rule) Name() string {
+	return r.identifier
+}
+
+func (r Rule) Type() string {
+	return r.rtype
+}
@@ -0,0 +1,135 @@
+package eventbridge_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awseventbridge "github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/genevieve/leftovers/aws/eventbridge"
+	"github.com/genevieve/leftovers/aws/eventbridge/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Rule", func() {
+	var (
+		rule   eventbridge.Rule
+		client *fakes.RulesClient
+		logger *fakes.Logger
+		name   *string
+		bus    *string
+	)
+
+	BeforeEach(func() {
+		client = &fakes.RulesClient{}
+		logger = &fakes.Logger{}
+		name = aws.String("the-rule")
+		bus = aws.String("default")
+		tags := []*awseventbridge.Tag{{Key: aws.String("the-key"), Value: aws.String("the-value")}}
+
+		client.ListTargetsByRuleCall.Returns.Output = &awseventbridge.ListTargetsByRuleOutput{}
+
+		rule = eventbridge.NewRule(client, logger, name, bus, tags)
+	})
+
+	Describe("Delete", func() {
+		It("deletes the rule", func() {
+			err := rule.Delete()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.ListTargetsByRuleCall.CallCount).To(Equal(1))
+			Expect(client.ListTargetsByRuleCall.Receives.Input.Rule).To(Equal(name))
+			Expect(client.ListTargetsByRuleCall.Receives.Input.EventBusName).To(Equal(bus))
+
+			Expect(client.RemoveTargetsCall.CallCount).To(Equal(0))
+
+			Expect(client.DeleteRuleCall.CallCount).To(Equal(1))
+			Expect(client.DeleteRuleCall.Receives.Input.Name).To(Equal(name))
+			Expect(client.DeleteRuleCall.Receives.Input.EventBusName).To(Equal(bus))
+		})
+
+		Context("when the rule has targets", func() {
+			BeforeEach(func() {
+				client.ListTargetsByRuleCall.Returns.Output = &awseventbridge.ListTargetsByRuleOutput{
+					Targets: []*awseventbridge.Target{{Id: aws.String("the-target")}},
+				}
+				client.RemoveTargetsCall.Returns.Output = &awseventbridge.RemoveTargetsOutput{}
+			})
+
+			It("removes the targets before deleting the rule", func() {
+				err := rule.Delete()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(client.RemoveTargetsCall.CallCount).To(Equal(1))
+				Expect(client.RemoveTargetsCall.Receives.Input.Rule).To(Equal(name))
+				Expect(client.RemoveTargetsCall.Receives.Input.EventBusName).To(Equal(bus))
+				Expect(client.RemoveTargetsCall.Receives.Input.Ids).To(Equal([]*string{aws.String("the-target")}))
+
+				Expect(logger.PrintfCall.Messages).To(ContainElement("[EventBridge Rule: the-rule (the-key:the-value)] Removed 1 targets \n"))
+
+				Expect(client.DeleteRuleCall.CallCount).To(Equal(1))
+			})
+
+			Context("when a target fails to be removed", func() {
+				BeforeEach(func() {
+					client.RemoveTargetsCall.Returns.Output.FailedEntries = []*awseventbridge.RemoveTargetsResultEntry{{
+						TargetId:     aws.String("the-target"),
+						ErrorMessage: aws.String("banana"),
+					}}
+				})
+
+				It("returns the error", func() {
+					err := rule.Delete()
+					Expect(err).To(MatchError("Remove target the-target: banana"))
+
+					Expect(client.DeleteRuleCall.CallCount).To(Equal(0))
+				})
+			})
+
+			Context("when the client fails to remove targets", func() {
+				BeforeEach(func() {
+					client.RemoveTargetsCall.Returns.Error = errors.New("banana")
+				})
+
+				It("returns the error", func() {
+					err := rule.Delete()
+					Expect(err).To(MatchError("Remove targets: banana"))
+				})
+			})
+		})
+
+		Context("when the client fails to list targets", func() {
+			BeforeEach(func() {
+				client.ListTargetsByRuleCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := rule.Delete()
+				Expect(err).To(MatchError("List targets: banana"))
+			})
+		})
+
+		Context("when the client fails", func() {
+			BeforeEach(func() {
+				client.DeleteRuleCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := rule.Delete()
+				Expect(err).To(MatchError("Delete: banana"))
+			})
+		})
+	})
+
+	Describe("Name", func() {
+		It("returns the identifier", func() {
+			Expect(rule.Name()).To(Equal("the-rule (the-key:the-value)"))
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(rule.Type()).To(Equal("EventBridge Rule"))
+		})
+	})
+})
@@ -0,0 +1,105 @@
+package eventbridge
+
+import (
+	"fmt"
+	"strings"
+
+	awseventbridge "github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/genevieve/leftovers/common"
+)
+
+type rulesClient interface {
+	ListEventBuses(*awseventbridge.ListEventBusesInput) (*awseventbridge.ListEventBusesOutput, error)
+	ListRules(*awseventbridge.ListRulesInput) (*awseventbridge.ListRulesOutput, error)
+	ListTagsForResource(*awseventbridge.ListTagsForResourceInput) (*awseventbridge.ListTagsForResourceOutput, error)
+	ListTargetsByRule(*awseventbridge.ListTargetsByRuleInput) (*awseventbridge.ListTargetsByRuleOutput, error)
+	RemoveTargets(*awseventbridge.RemoveTargetsInput) (*awseventbridge.RemoveTargetsOutput, error)
+	DeleteRule(*awseventbridge.DeleteRuleInput) (*awseventbridge.DeleteRuleOutput, error)
+}
+
+type logger interface {
+	Printf(m string, a ...interface{})
+	PromptWithDetails(resourceType, resourceName string) bool
+}
+
+type Rules struct {
+	client rulesClient
+	logger logger
+}
+
+func NewRules(client rulesClient, logger logger) Rules {
+	return Rules{
+		client: client,
+		logger: logger,
+	}
+}
+
+// List returns the rules of every event bus. Rules that are managed
+// by another service are skipped, since that service deletes them.
+func (r Rules) List(filter string) ([]common.Deletable, error) {
+	var buses []*awseventbridge.EventBus
+
+	busesInput := &awseventbridge.ListEventBusesInput{}
+	for {
+		resp, err := r.client.ListEventBuses(busesInput)
+		if err != nil {
+			return nil, fmt.Errorf("List EventBridge Event Buses: %s", err)
+		}
+
+		buses = append(buses, resp.EventBuses...)
+
+		if resp.NextToken == nil {
+			break
+		}
+		busesInput.NextToken = resp.NextToken
+	}
+
+	var rules []*awseventbridge.Rule
+	for _, bus := range buses {
+		input := &awseventbridge.ListRulesInput{EventBusName: bus.Name}
+		for {
+			resp, err := r.client.ListRules(input)
+			if err != nil {
+				return nil, fmt.Errorf("List EventBridge Rules of %s: %s", *bus.Name, err)
+			}
+
+			rules = append(rules, resp.Rules...)
+
+			if resp.NextToken == nil {
+				break
+			}
+			input.NextToken = resp.NextToken
+		}
+	}
+
+	var resources []common.Deletable
+	for _, rule := range rules {
+		if rule.ManagedBy != nil {
+			continue
+		}
+
+		tags, err := r.client.ListTagsForResource(&awseventbridge.ListTagsForResourceInput{ResourceARN: rule.Arn})
+		if err != nil {
+			return nil, fmt.Errorf("List tags for EventBridge Rule %s: %s", *rule.Name, err)
+		}
+
+		resource := NewRule(r.client, r.logger, rule.Name, rule.EventBusName, tags.Tags)
+
+		if !strings.Contains(resource.Name(), filter) {
+			continue
+		}
+
+		proceed := r.logger.PromptWithDetails(resource.Type(), resource.Name())
+		if !proceed {
+			continue
+		}
+
+		resources = append(resources, resource)
+	}
+
+	return resources, nil
+}
+
+func (r Rules) Type() string {
+	return "eventbridge-rule"
+}
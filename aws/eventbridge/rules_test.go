@@ -0,0 +1,167 @@
+package eventbridge_test
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awseventbridge "github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/genevieve/leftovers/aws/eventbridge"
+	"github.com/genevieve/leftovers/aws/eventbridge/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Rules", func() {
+	var (
+		client *fakes.RulesClient
+		logger *fakes.Logger
+
+		rules eventbridge.Rules
+	)
+
+	BeforeEach(func() {
+		client = &fakes.RulesClient{}
+		logger = &fakes.Logger{}
+		logger.PromptWithDetailsCall.Returns.Proceed = true
+
+		rules = eventbridge.NewRules(client, logger)
+	})
+
+	Describe("List", func() {
+		var filter string
+
+		BeforeEach(func() {
+			client.ListEventBusesCall.Returns.Output = &awseventbridge.ListEventBusesOutput{
+				EventBuses: []*awseventbridge.EventBus{{Name: aws.String("default")}},
+			}
+			client.ListRulesCall.Returns.Output = &awseventbridge.ListRulesOutput{
+				Rules: []*awseventbridge.Rule{{
+					Name:         aws.String("the-rule"),
+					Arn:          aws.String("the-rule-arn"),
+					EventBusName: aws.String("default"),
+				}, {
+					Name:         aws.String("the-managed-rule"),
+					Arn:          aws.String("the-managed-rule-arn"),
+					EventBusName: aws.String("default"),
+					ManagedBy:    aws.String("some-service"),
+				}},
+			}
+			client.ListTagsForResourceCall.Returns.Output = &awseventbridge.ListTagsForResourceOutput{
+				Tags: []*awseventbridge.Tag{{Key: aws.String("env"), Value: aws.String("banana")}},
+			}
+			filter = "banana"
+		})
+
+		It("returns the rules whose name or tags contain the filter", func() {
+			items, err := rules.List(filter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.ListEventBusesCall.CallCount).To(Equal(1))
+			Expect(client.ListRulesCall.CallCount).To(Equal(1))
+			Expect(client.ListRulesCall.Receives.Input.EventBusName).To(Equal(aws.String("default")))
+			Expect(client.ListTagsForResourceCall.CallCount).To(Equal(1))
+			Expect(client.ListTagsForResourceCall.Receives.Input.ResourceARN).To(Equal(aws.String("the-rule-arn")))
+
+			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("EventBridge Rule"))
+			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("the-rule (env:banana)"))
+
+			Expect(items).To(HaveLen(1))
+		})
+
+		Context("when there are custom event buses", func() {
+			BeforeEach(func() {
+				client.ListEventBusesCall.Returns.Output.EventBuses = append(client.ListEventBusesCall.Returns.Output.EventBuses,
+					&awseventbridge.EventBus{Name: aws.String("the-bus")})
+
+				client.ListRulesCall.Stub = func(input *awseventbridge.ListRulesInput) (*awseventbridge.ListRulesOutput, error) {
+					return &awseventbridge.ListRulesOutput{
+						Rules: []*awseventbridge.Rule{{
+							Name:         aws.String("the-rule"),
+							Arn:          aws.String(fmt.Sprintf("%s-rule-arn", *input.EventBusName)),
+							EventBusName: input.EventBusName,
+						}},
+					}, nil
+				}
+			})
+
+			It("returns the rules of every event bus", func() {
+				items, err := rules.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(client.ListRulesCall.CallCount).To(Equal(2))
+				Expect(client.ListRulesCall.Receives.Input.EventBusName).To(Equal(aws.String("the-bus")))
+				Expect(client.ListTagsForResourceCall.Receives.Input.ResourceARN).To(Equal(aws.String("the-bus-rule-arn")))
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(2))
+				Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("the-rule (EventBus:the-bus, env:banana)"))
+
+				Expect(items).To(HaveLen(2))
+			})
+		})
+
+		Context("when the client fails to list event buses", func() {
+			BeforeEach(func() {
+				client.ListEventBusesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := rules.List(filter)
+				Expect(err).To(MatchError("List EventBridge Event Buses: some error"))
+			})
+		})
+
+		Context("when neither the name nor the tags contain the filter", func() {
+			It("does not return it to the list", func() {
+				items, err := rules.List("mango")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+
+		Context("when the client fails to list rules", func() {
+			BeforeEach(func() {
+				client.ListRulesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := rules.List(filter)
+				Expect(err).To(MatchError("List EventBridge Rules of default: some error"))
+			})
+		})
+
+		Context("when the client fails to list tags", func() {
+			BeforeEach(func() {
+				client.ListTagsForResourceCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := rules.List(filter)
+				Expect(err).To(MatchError("List tags for EventBridge Rule the-rule: some error"))
+			})
+		})
+
+		Context("when the user responds no to the prompt", func() {
+			BeforeEach(func() {
+				logger.PromptWithDetailsCall.Returns.Proceed = false
+			})
+
+			It("does not return it to the list", func() {
+				items, err := rules.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(rules.Type()).To(Equal("eventbridge-rule"))
+		})
+	})
+})
@@ -8,7 +8,7 @@ import (
 )
 
 type accessKeysClient interface {
-	ListAccessKeys(*awsiam.ListAccessKeysInput) (*awsiam.ListAccessKeysOutput, error)
+	ListAccessKeysPages(*awsiam.ListAccessKeysInput, func(*awsiam.ListAccessKeysOutput, bool) bool) error
 	DeleteAccessKey(*awsiam.DeleteAccessKeyInput) (*awsiam.DeleteAccessKeyOutput, error)
 }
 
@@ -29,12 +29,16 @@ func NewAccessKeys(client accessKeysClient, logger logger) AccessKeys {
 }
 
 func (k AccessKeys) Delete(userName string) error {
-	accessKeys, err := k.client.ListAccessKeys(&awsiam.ListAccessKeysInput{UserName: aws.String(userName)})
+	var accessKeys []*awsiam.AccessKeyMetadata
+	err := k.client.ListAccessKeysPages(&awsiam.ListAccessKeysInput{UserName: aws.String(userName)}, func(page *awsiam.ListAccessKeysOutput, lastPage bool) bool {
+		accessKeys = append(accessKeys, page.AccessKeyMetadata...)
+		return true
+	})
 	if err != nil {
 		return fmt.Errorf("List IAM Access Keys: %s", err)
 	}
 
-	for _, a := range accessKeys.AccessKeyMetadata {
+	for _, a := range accessKeys {
 		n := *a.AccessKeyId
 
 		_, err = k.client.DeleteAccessKey(&awsiam.DeleteAccessKeyInput{
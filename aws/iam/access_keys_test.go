@@ -28,19 +28,19 @@ var _ = Describe("AccessKeys", func() {
 
 	Describe("Delete", func() {
 		BeforeEach(func() {
-			client.ListAccessKeysCall.Returns.Output = &awsiam.ListAccessKeysOutput{
+			client.ListAccessKeysPagesCall.Returns.Pages = []*awsiam.ListAccessKeysOutput{{
 				AccessKeyMetadata: []*awsiam.AccessKeyMetadata{{
 					AccessKeyId: aws.String("banana"),
 				}},
-			}
+			}}
 		})
 
 		It("detaches and deletes the accessKeys", func() {
 			err := accessKeys.Delete("the-user")
 			Expect(err).NotTo(HaveOccurred())
 
-			Expect(client.ListAccessKeysCall.CallCount).To(Equal(1))
-			Expect(client.ListAccessKeysCall.Receives.Input.UserName).To(Equal(aws.String("the-user")))
+			Expect(client.ListAccessKeysPagesCall.CallCount).To(Equal(1))
+			Expect(client.ListAccessKeysPagesCall.Receives.Input.UserName).To(Equal(aws.String("the-user")))
 
 			Expect(client.DeleteAccessKeyCall.CallCount).To(Equal(1))
 			Expect(client.DeleteAccessKeyCall.Receives.Input.UserName).To(Equal(aws.String("the-user")))
@@ -53,7 +53,7 @@ var _ = Describe("AccessKeys", func() {
 
 		Context("when the client fails to list access keys", func() {
 			BeforeEach(func() {
-				client.ListAccessKeysCall.Returns.Error = errors.New("some error")
+				client.ListAccessKeysPagesCall.Returns.Error = errors.New("some error")
 			})
 
 			It("returns the error", func() {
@@ -3,14 +3,14 @@ package fakes
 import "github.com/aws/aws-sdk-go/service/iam"
 
 type AccessKeysClient struct {
-	ListAccessKeysCall struct {
+	ListAccessKeysPagesCall struct {
 		CallCount int
 		Receives  struct {
 			Input *iam.ListAccessKeysInput
 		}
 		Returns struct {
-			Output *iam.ListAccessKeysOutput
-			Error  error
+			Pages []*iam.ListAccessKeysOutput
+			Error error
 		}
 	}
 
@@ -26,11 +26,18 @@ type AccessKeysClient struct {
 	}
 }
 
-func (i *AccessKeysClient) ListAccessKeys(input *iam.ListAccessKeysInput) (*iam.ListAccessKeysOutput, error) {
-	i.ListAccessKeysCall.CallCount++
-	i.ListAccessKeysCall.Receives.Input = input
+func (i *AccessKeysClient) ListAccessKeysPages(input *iam.ListAccessKeysInput, fn func(*iam.ListAccessKeysOutput, bool) bool) error {
+	i.ListAccessKeysPagesCall.CallCount++
+	i.ListAccessKeysPagesCall.Receives.Input = input
+
+	pages := i.ListAccessKeysPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
 
-	return i.ListAccessKeysCall.Returns.Output, i.ListAccessKeysCall.Returns.Error
+	return i.ListAccessKeysPagesCall.Returns.Error
 }
 
 func (i *AccessKeysClient) DeleteAccessKey(input *iam.DeleteAccessKeyInput) (*iam.DeleteAccessKeyOutput, error) {
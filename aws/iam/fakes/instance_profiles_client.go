@@ -3,14 +3,14 @@ package fakes
 import "github.com/aws/aws-sdk-go/service/iam"
 
 type InstanceProfilesClient struct {
-	ListInstanceProfilesCall struct {
+	ListInstanceProfilesPagesCall struct {
 		CallCount int
 		Receives  struct {
 			Input *iam.ListInstanceProfilesInput
 		}
 		Returns struct {
-			Output *iam.ListInstanceProfilesOutput
-			Error  error
+			Pages []*iam.ListInstanceProfilesOutput
+			Error error
 		}
 	}
 
@@ -37,11 +37,18 @@ type InstanceProfilesClient struct {
 	}
 }
 
-func (i *InstanceProfilesClient) ListInstanceProfiles(input *iam.ListInstanceProfilesInput) (*iam.ListInstanceProfilesOutput, error) {
-	i.ListInstanceProfilesCall.CallCount++
-	i.ListInstanceProfilesCall.Receives.Input = input
+func (i *InstanceProfilesClient) ListInstanceProfilesPages(input *iam.ListInstanceProfilesInput, fn func(*iam.ListInstanceProfilesOutput, bool) bool) error {
+	i.ListInstanceProfilesPagesCall.CallCount++
+	i.ListInstanceProfilesPagesCall.Receives.Input = input
+
+	pages := i.ListInstanceProfilesPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
 
-	return i.ListInstanceProfilesCall.Returns.Output, i.ListInstanceProfilesCall.Returns.Error
+	return i.ListInstanceProfilesPagesCall.Returns.Error
 }
 
 func (i *InstanceProfilesClient) DeleteInstanceProfile(input *iam.DeleteInstanceProfileInput) (*iam.DeleteInstanceProfileOutput, error) {
@@ -0,0 +1,20 @@
+package fakes
+
+type Listeners struct {
+	ReleasesCall struct {
+		CallCount int
+		Receives  struct {
+			Certificate string
+		}
+		Returns struct {
+			Releases bool
+		}
+	}
+}
+
+func (l *Listeners) Releases(certificate string) bool {
+	l.ReleasesCall.CallCount++
+	l.ReleasesCall.Receives.Certificate = certificate
+
+	return l.ReleasesCall.Returns.Releases
+}
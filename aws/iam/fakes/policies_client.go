@@ -3,25 +3,25 @@ package fakes
 import awsiam "github.com/aws/aws-sdk-go/service/iam"
 
 type PoliciesClient struct {
-	ListPoliciesCall struct {
+	ListPoliciesPagesCall struct {
 		CallCount int
 		Receives  struct {
 			Input *awsiam.ListPoliciesInput
 		}
 		Returns struct {
-			Output *awsiam.ListPoliciesOutput
-			Error  error
+			Pages []*awsiam.ListPoliciesOutput
+			Error error
 		}
 	}
 
-	ListPolicyVersionsCall struct {
+	ListPolicyVersionsPagesCall struct {
 		CallCount int
 		Receives  struct {
 			Input *awsiam.ListPolicyVersionsInput
 		}
 		Returns struct {
-			Output *awsiam.ListPolicyVersionsOutput
-			Error  error
+			Pages []*awsiam.ListPolicyVersionsOutput
+			Error error
 		}
 	}
 
@@ -48,18 +48,32 @@ type PoliciesClient struct {
 	}
 }
 
-func (i *PoliciesClient) ListPolicies(input *awsiam.ListPoliciesInput) (*awsiam.ListPoliciesOutput, error) {
-	i.ListPoliciesCall.CallCount++
-	i.ListPoliciesCall.Receives.Input = input
+func (i *PoliciesClient) ListPoliciesPages(input *awsiam.ListPoliciesInput, fn func(*awsiam.ListPoliciesOutput, bool) bool) error {
+	i.ListPoliciesPagesCall.CallCount++
+	i.ListPoliciesPagesCall.Receives.Input = input
+
+	pages := i.ListPoliciesPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
 
-	return i.ListPoliciesCall.Returns.Output, i.ListPoliciesCall.Returns.Error
+	return i.ListPoliciesPagesCall.Returns.Error
 }
 
-func (i *PoliciesClient) ListPolicyVersions(input *awsiam.ListPolicyVersionsInput) (*awsiam.ListPolicyVersionsOutput, error) {
-	i.ListPolicyVersionsCall.CallCount++
-	i.ListPolicyVersionsCall.Receives.Input = input
+func (i *PoliciesClient) ListPolicyVersionsPages(input *awsiam.ListPolicyVersionsInput, fn func(*awsiam.ListPolicyVersionsOutput, bool) bool) error {
+	i.ListPolicyVersionsPagesCall.CallCount++
+	i.ListPolicyVersionsPagesCall.Receives.Input = input
+
+	pages := i.ListPolicyVersionsPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
 
-	return i.ListPolicyVersionsCall.Returns.Output, i.ListPolicyVersionsCall.Returns.Error
+	return i.ListPolicyVersionsPagesCall.Returns.Error
 }
 
 func (i *PoliciesClient) DeletePolicy(input *awsiam.DeletePolicyInput) (*awsiam.DeletePolicyOutput, error) {
@@ -3,25 +3,25 @@ package fakes
 import awsiam "github.com/aws/aws-sdk-go/service/iam"
 
 type RolePoliciesClient struct {
-	ListAttachedRolePoliciesCall struct {
+	ListAttachedRolePoliciesPagesCall struct {
 		CallCount int
 		Receives  struct {
 			Input *awsiam.ListAttachedRolePoliciesInput
 		}
 		Returns struct {
-			Output *awsiam.ListAttachedRolePoliciesOutput
-			Error  error
+			Pages []*awsiam.ListAttachedRolePoliciesOutput
+			Error error
 		}
 	}
 
-	ListRolePoliciesCall struct {
+	ListRolePoliciesPagesCall struct {
 		CallCount int
 		Receives  struct {
 			Input *awsiam.ListRolePoliciesInput
 		}
 		Returns struct {
-			Output *awsiam.ListRolePoliciesOutput
-			Error  error
+			Pages []*awsiam.ListRolePoliciesOutput
+			Error error
 		}
 	}
 
@@ -48,18 +48,32 @@ type RolePoliciesClient struct {
 	}
 }
 
-func (i *RolePoliciesClient) ListAttachedRolePolicies(input *awsiam.ListAttachedRolePoliciesInput) (*awsiam.ListAttachedRolePoliciesOutput, error) {
-	i.ListAttachedRolePoliciesCall.CallCount++
-	i.ListAttachedRolePoliciesCall.Receives.Input = input
+func (i *RolePoliciesClient) ListAttachedRolePoliciesPages(input *awsiam.ListAttachedRolePoliciesInput, fn func(*awsiam.ListAttachedRolePoliciesOutput, bool) bool) error {
+	i.ListAttachedRolePoliciesPagesCall.CallCount++
+	i.ListAttachedRolePoliciesPagesCall.Receives.Input = input
+
+	pages := i.ListAttachedRolePoliciesPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
 
-	return i.ListAttachedRolePoliciesCall.Returns.Output, i.ListAttachedRolePoliciesCall.Returns.Error
+	return i.ListAttachedRolePoliciesPagesCall.Returns.Error
 }
 
-func (i *RolePoliciesClient) ListRolePolicies(input *awsiam.ListRolePoliciesInput) (*awsiam.ListRolePoliciesOutput, error) {
-	i.ListRolePoliciesCall.CallCount++
-	i.ListRolePoliciesCall.Receives.Input = input
+func (i *RolePoliciesClient) ListRolePoliciesPages(input *awsiam.ListRolePoliciesInput, fn func(*awsiam.ListRolePoliciesOutput, bool) bool) error {
+	i.ListRolePoliciesPagesCall.CallCount++
+	i.ListRolePoliciesPagesCall.Receives.Input = input
+
+	pages := i.ListRolePoliciesPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
 
-	return i.ListRolePoliciesCall.Returns.Output, i.ListRolePoliciesCall.Returns.Error
+	return i.ListRolePoliciesPagesCall.Returns.Error
 }
 
 func (i *RolePoliciesClient) DetachRolePolicy(input *awsiam.DetachRolePolicyInput) (*awsiam.DetachRolePolicyOutput, error) {
@@ -3,14 +3,14 @@ package fakes
 import "github.com/aws/aws-sdk-go/service/iam"
 
 type RolesClient struct {
-	ListRolesCall struct {
+	ListRolesPagesCall struct {
 		CallCount int
 		Receives  struct {
 			Input *iam.ListRolesInput
 		}
 		Returns struct {
-			Output *iam.ListRolesOutput
-			Error  error
+			Pages []*iam.ListRolesOutput
+			Error error
 		}
 	}
 
@@ -26,11 +26,18 @@ type RolesClient struct {
 	}
 }
 
-func (i *RolesClient) ListRoles(input *iam.ListRolesInput) (*iam.ListRolesOutput, error) {
-	i.ListRolesCall.CallCount++
-	i.ListRolesCall.Receives.Input = input
+func (i *RolesClient) ListRolesPages(input *iam.ListRolesInput, fn func(*iam.ListRolesOutput, bool) bool) error {
+	i.ListRolesPagesCall.CallCount++
+	i.ListRolesPagesCall.Receives.Input = input
+
+	pages := i.ListRolesPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
 
-	return i.ListRolesCall.Returns.Output, i.ListRolesCall.Returns.Error
+	return i.ListRolesPagesCall.Returns.Error
 }
 
 func (i *RolesClient) DeleteRole(input *iam.DeleteRoleInput) (*iam.DeleteRoleOutput, error) {
@@ -3,14 +3,14 @@ package fakes
 import "github.com/aws/aws-sdk-go/service/iam"
 
 type ServerCertificatesClient struct {
-	ListServerCertificatesCall struct {
+	ListServerCertificatesPagesCall struct {
 		CallCount int
 		Receives  struct {
 			Input *iam.ListServerCertificatesInput
 		}
 		Returns struct {
-			Output *iam.ListServerCertificatesOutput
-			Error  error
+			Pages []*iam.ListServerCertificatesOutput
+			Error error
 		}
 	}
 
@@ -23,19 +23,34 @@ type ServerCertificatesClient struct {
 			Output *iam.DeleteServerCertificateOutput
 			Error  error
 		}
+		ReturnsOnCall map[int]struct {
+			Output *iam.DeleteServerCertificateOutput
+			Error  error
+		}
 	}
 }
 
-func (i *ServerCertificatesClient) ListServerCertificates(input *iam.ListServerCertificatesInput) (*iam.ListServerCertificatesOutput, error) {
-	i.ListServerCertificatesCall.CallCount++
-	i.ListServerCertificatesCall.Receives.Input = input
+func (i *ServerCertificatesClient) ListServerCertificatesPages(input *iam.ListServerCertificatesInput, fn func(*iam.ListServerCertificatesOutput, bool) bool) error {
+	i.ListServerCertificatesPagesCall.CallCount++
+	i.ListServerCertificatesPagesCall.Receives.Input = input
 
-	return i.ListServerCertificatesCall.Returns.Output, i.ListServerCertificatesCall.Returns.Error
+	pages := i.ListServerCertificatesPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return i.ListServerCertificatesPagesCall.Returns.Error
 }
 
 func (i *ServerCertificatesClient) DeleteServerCertificate(input *iam.DeleteServerCertificateInput) (*iam.DeleteServerCertificateOutput, error) {
 	i.DeleteServerCertificateCall.CallCount++
 	i.DeleteServerCertificateCall.Receives.Input = input
 
+	if ret, ok := i.DeleteServerCertificateCall.ReturnsOnCall[i.DeleteServerCertificateCall.CallCount-1]; ok {
+		return ret.Output, ret.Error
+	}
+
 	return i.DeleteServerCertificateCall.Returns.Output, i.DeleteServerCertificateCall.Returns.Error
 }
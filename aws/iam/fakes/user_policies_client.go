@@ -3,14 +3,14 @@ package fakes
 import "github.com/aws/aws-sdk-go/service/iam"
 
 type UserPoliciesClient struct {
-	ListAttachedUserPoliciesCall struct {
+	ListAttachedUserPoliciesPagesCall struct {
 		CallCount int
 		Receives  struct {
 			Input *iam.ListAttachedUserPoliciesInput
 		}
 		Returns struct {
-			Output *iam.ListAttachedUserPoliciesOutput
-			Error  error
+			Pages []*iam.ListAttachedUserPoliciesOutput
+			Error error
 		}
 	}
 
@@ -37,11 +37,18 @@ type UserPoliciesClient struct {
 	}
 }
 
-func (i *UserPoliciesClient) ListAttachedUserPolicies(input *iam.ListAttachedUserPoliciesInput) (*iam.ListAttachedUserPoliciesOutput, error) {
-	i.ListAttachedUserPoliciesCall.CallCount++
-	i.ListAttachedUserPoliciesCall.Receives.Input = input
+func (i *UserPoliciesClient) ListAttachedUserPoliciesPages(input *iam.ListAttachedUserPoliciesInput, fn func(*iam.ListAttachedUserPoliciesOutput, bool) bool) error {
+	i.ListAttachedUserPoliciesPagesCall.CallCount++
+	i.ListAttachedUserPoliciesPagesCall.Receives.Input = input
+
+	pages := i.ListAttachedUserPoliciesPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
 
-	return i.ListAttachedUserPoliciesCall.Returns.Output, i.ListAttachedUserPoliciesCall.Returns.Error
+	return i.ListAttachedUserPoliciesPagesCall.Returns.Error
 }
 
 func (i *UserPoliciesClient) DetachUserPolicy(input *iam.DetachUserPolicyInput) (*iam.DetachUserPolicyOutput, error) {
@@ -3,14 +3,14 @@ package fakes
 import "github.com/aws/aws-sdk-go/service/iam"
 
 type UsersClient struct {
-	ListUsersCall struct {
+	ListUsersPagesCall struct {
 		CallCount int
 		Receives  struct {
 			Input *iam.ListUsersInput
 		}
 		Returns struct {
-			Output *iam.ListUsersOutput
-			Error  error
+			Pages []*iam.ListUsersOutput
+			Error error
 		}
 	}
 
@@ -26,11 +26,18 @@ type UsersClient struct {
 	}
 }
 
-func (i *UsersClient) ListUsers(input *iam.ListUsersInput) (*iam.ListUsersOutput, error) {
-	i.ListUsersCall.CallCount++
-	i.ListUsersCall.Receives.Input = input
+func (i *UsersClient) ListUsersPages(input *iam.ListUsersInput, fn func(*iam.ListUsersOutput, bool) bool) error {
+	i.ListUsersPagesCall.CallCount++
+	i.ListUsersPagesCall.Receives.Input = input
+
+	pages := i.ListUsersPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
 
-	return i.ListUsersCall.Returns.Output, i.ListUsersCall.Returns.Error
+	return i.ListUsersPagesCall.Returns.Error
 }
 
 func (i *UsersClient) DeleteUser(input *iam.DeleteUserInput) (*iam.DeleteUserOutput, error) {
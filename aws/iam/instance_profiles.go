@@ -9,7 +9,7 @@ import (
 )
 
 type instanceProfilesClient interface {
-	ListInstanceProfiles(*awsiam.ListInstanceProfilesInput) (*awsiam.ListInstanceProfilesOutput, error)
+	ListInstanceProfilesPages(*awsiam.ListInstanceProfilesInput, func(*awsiam.ListInstanceProfilesOutput, bool) bool) error
 	RemoveRoleFromInstanceProfile(*awsiam.RemoveRoleFromInstanceProfileInput) (*awsiam.RemoveRoleFromInstanceProfileOutput, error)
 	DeleteInstanceProfile(*awsiam.DeleteInstanceProfileInput) (*awsiam.DeleteInstanceProfileOutput, error)
 }
@@ -27,13 +27,17 @@ func NewInstanceProfiles(client instanceProfilesClient, logger logger) InstanceP
 }
 
 func (i InstanceProfiles) List(filter string) ([]common.Deletable, error) {
-	profiles, err := i.client.ListInstanceProfiles(&awsiam.ListInstanceProfilesInput{})
+	var profiles []*awsiam.InstanceProfile
+	err := i.client.ListInstanceProfilesPages(&awsiam.ListInstanceProfilesInput{}, func(page *awsiam.ListInstanceProfilesOutput, lastPage bool) bool {
+		profiles = append(profiles, page.InstanceProfiles...)
+		return true
+	})
 	if err != nil {
 		return nil, fmt.Errorf("List IAM Instance Profiles: %s", err)
 	}
 
 	var resources []common.Deletable
-	for _, p := range profiles.InstanceProfiles {
+	for _, p := range profiles {
 		r := NewInstanceProfile(i.client, p.InstanceProfileName, p.Roles, i.logger)
 
 		if !strings.Contains(r.Name(), filter) {
@@ -31,18 +31,18 @@ var _ = Describe("InstanceProfiles", func() {
 	Describe("List", func() {
 		BeforeEach(func() {
 			logger.PromptWithDetailsCall.Returns.Proceed = true
-			client.ListInstanceProfilesCall.Returns.Output = &awsiam.ListInstanceProfilesOutput{
+			client.ListInstanceProfilesPagesCall.Returns.Pages = []*awsiam.ListInstanceProfilesOutput{{
 				InstanceProfiles: []*awsiam.InstanceProfile{{
 					InstanceProfileName: aws.String("banana-profile"),
 				}},
-			}
+			}}
 		})
 
 		It("returns a list of instance profiles to delete", func() {
 			items, err := instanceProfiles.List(filter)
 			Expect(err).NotTo(HaveOccurred())
 
-			Expect(client.ListInstanceProfilesCall.CallCount).To(Equal(1))
+			Expect(client.ListInstanceProfilesPagesCall.CallCount).To(Equal(1))
 
 			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
 			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("IAM Instance Profile"))
@@ -64,7 +64,7 @@ var _ = Describe("InstanceProfiles", func() {
 
 		Context("when the client fails to list instance profiles", func() {
 			BeforeEach(func() {
-				client.ListInstanceProfilesCall.Returns.Error = errors.New("listing error")
+				client.ListInstanceProfilesPagesCall.Returns.Error = errors.New("listing error")
 			})
 
 			It("returns the error and does not try deleting them", func() {
@@ -10,8 +10,8 @@ import (
 )
 
 type policiesClient interface {
-	ListPolicies(*awsiam.ListPoliciesInput) (*awsiam.ListPoliciesOutput, error)
-	ListPolicyVersions(*awsiam.ListPolicyVersionsInput) (*awsiam.ListPolicyVersionsOutput, error)
+	ListPoliciesPages(*awsiam.ListPoliciesInput, func(*awsiam.ListPoliciesOutput, bool) bool) error
+	ListPolicyVersionsPages(*awsiam.ListPolicyVersionsInput, func(*awsiam.ListPolicyVersionsOutput, bool) bool) error
 	DeletePolicyVersion(*awsiam.DeletePolicyVersionInput) (*awsiam.DeletePolicyVersionOutput, error)
 	DeletePolicy(*awsiam.DeletePolicyInput) (*awsiam.DeletePolicyOutput, error)
 }
@@ -29,13 +29,17 @@ func NewPolicies(client policiesClient, logger logger) Policies {
 }
 
 func (p Policies) List(filter string) ([]common.Deletable, error) {
-	policies, err := p.client.ListPolicies(&awsiam.ListPoliciesInput{Scope: aws.String("Local")})
+	var policies []*awsiam.Policy
+	err := p.client.ListPoliciesPages(&awsiam.ListPoliciesInput{Scope: aws.String("Local")}, func(page *awsiam.ListPoliciesOutput, lastPage bool) bool {
+		policies = append(policies, page.Policies...)
+		return true
+	})
 	if err != nil {
 		return nil, fmt.Errorf("List IAM Policies: %s", err)
 	}
 
 	var resources []common.Deletable
-	for _, o := range policies.Policies {
+	for _, o := range policies {
 		r := NewPolicy(p.client, p.logger, o.PolicyName, o.Arn)
 
 		if !strings.Contains(r.Name(), filter) {
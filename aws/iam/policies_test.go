@@ -31,19 +31,19 @@ var _ = Describe("Policies", func() {
 	Describe("List", func() {
 		BeforeEach(func() {
 			logger.PromptWithDetailsCall.Returns.Proceed = true
-			client.ListPoliciesCall.Returns.Output = &awsiam.ListPoliciesOutput{
+			client.ListPoliciesPagesCall.Returns.Pages = []*awsiam.ListPoliciesOutput{{
 				Policies: []*awsiam.Policy{{
 					Arn:        aws.String("the-policy-arn"),
 					PolicyName: aws.String("banana-policy"),
 				}},
-			}
+			}}
 		})
 
 		It("returns a list of policies to delete", func() {
 			items, err := policies.List(filter)
 			Expect(err).NotTo(HaveOccurred())
 
-			Expect(client.ListPoliciesCall.CallCount).To(Equal(1))
+			Expect(client.ListPoliciesPagesCall.CallCount).To(Equal(1))
 
 			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
 			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("IAM Policy"))
@@ -54,7 +54,7 @@ var _ = Describe("Policies", func() {
 
 		Context("when the client fails to list policies", func() {
 			BeforeEach(func() {
-				client.ListPoliciesCall.Returns.Error = errors.New("some error")
+				client.ListPoliciesPagesCall.Returns.Error = errors.New("some error")
 			})
 
 			It("returns the error and does not try deleting them", func() {
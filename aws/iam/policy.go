@@ -27,12 +27,16 @@ func NewPolicy(client policiesClient, logger logger, name, arn *string) Policy {
 }
 
 func (p Policy) Delete() error {
-	versions, err := p.client.ListPolicyVersions(&awsiam.ListPolicyVersionsInput{PolicyArn: p.arn})
+	var versions []*awsiam.PolicyVersion
+	err := p.client.ListPolicyVersionsPages(&awsiam.ListPolicyVersionsInput{PolicyArn: p.arn}, func(page *awsiam.ListPolicyVersionsOutput, lastPage bool) bool {
+		versions = append(versions, page.Versions...)
+		return true
+	})
 	if err != nil {
 		return fmt.Errorf("List IAM Policy Versions: %s", err)
 	}
 
-	for _, v := range versions.Versions {
+	for _, v := range versions {
 		if !*v.IsDefaultVersion {
 			_, err := p.client.DeletePolicyVersion(&awsiam.DeletePolicyVersionInput{
 				PolicyArn: p.arn,
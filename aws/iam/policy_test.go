@@ -29,9 +29,9 @@ var _ = Describe("Policy", func() {
 
 		policy = iam.NewPolicy(client, logger, name, arn)
 
-		client.ListPolicyVersionsCall.Returns.Output = &awsiam.ListPolicyVersionsOutput{
+		client.ListPolicyVersionsPagesCall.Returns.Pages = []*awsiam.ListPolicyVersionsOutput{{
 			Versions: []*awsiam.PolicyVersion{},
-		}
+		}}
 	})
 
 	Describe("Delete", func() {
@@ -45,19 +45,19 @@ var _ = Describe("Policy", func() {
 
 		Context("when the policy has non-default versions", func() {
 			BeforeEach(func() {
-				client.ListPolicyVersionsCall.Returns.Output = &awsiam.ListPolicyVersionsOutput{
+				client.ListPolicyVersionsPagesCall.Returns.Pages = []*awsiam.ListPolicyVersionsOutput{{
 					Versions: []*awsiam.PolicyVersion{
 						{IsDefaultVersion: aws.Bool(true), VersionId: aws.String("v2")},
 						{IsDefaultVersion: aws.Bool(false), VersionId: aws.String("v1")},
 					},
-				}
+				}}
 			})
 
 			It("deletes all non-default versions", func() {
 				err := policy.Delete()
 				Expect(err).NotTo(HaveOccurred())
 
-				Expect(client.ListPolicyVersionsCall.CallCount).To(Equal(1))
+				Expect(client.ListPolicyVersionsPagesCall.CallCount).To(Equal(1))
 
 				Expect(client.DeletePolicyVersionCall.CallCount).To(Equal(1))
 				Expect(client.DeletePolicyVersionCall.Receives.Input.PolicyArn).To(Equal(arn))
@@ -93,7 +93,7 @@ var _ = Describe("Policy", func() {
 
 		Context("when the client fails to list policy versions", func() {
 			BeforeEach(func() {
-				client.ListPolicyVersionsCall.Returns.Error = errors.New("some error")
+				client.ListPolicyVersionsPagesCall.Returns.Error = errors.New("some error")
 			})
 
 			It("returns the error", func() {
@@ -8,8 +8,8 @@ import (
 )
 
 type rolePoliciesClient interface {
-	ListAttachedRolePolicies(*awsiam.ListAttachedRolePoliciesInput) (*awsiam.ListAttachedRolePoliciesOutput, error)
-	ListRolePolicies(*awsiam.ListRolePoliciesInput) (*awsiam.ListRolePoliciesOutput, error)
+	ListAttachedRolePoliciesPages(*awsiam.ListAttachedRolePoliciesInput, func(*awsiam.ListAttachedRolePoliciesOutput, bool) bool) error
+	ListRolePoliciesPages(*awsiam.ListRolePoliciesInput, func(*awsiam.ListRolePoliciesOutput, bool) bool) error
 	DetachRolePolicy(*awsiam.DetachRolePolicyInput) (*awsiam.DetachRolePolicyOutput, error)
 	DeleteRolePolicy(*awsiam.DeleteRolePolicyInput) (*awsiam.DeleteRolePolicyOutput, error)
 }
@@ -31,12 +31,16 @@ func NewRolePolicies(client rolePoliciesClient, logger logger) RolePolicies {
 }
 
 func (o RolePolicies) Delete(roleName string) error {
-	attachedPolicies, err := o.client.ListAttachedRolePolicies(&awsiam.ListAttachedRolePoliciesInput{RoleName: aws.String(roleName)})
+	var attachedPolicies []*awsiam.AttachedPolicy
+	err := o.client.ListAttachedRolePoliciesPages(&awsiam.ListAttachedRolePoliciesInput{RoleName: aws.String(roleName)}, func(page *awsiam.ListAttachedRolePoliciesOutput, lastPage bool) bool {
+		attachedPolicies = append(attachedPolicies, page.AttachedPolicies...)
+		return true
+	})
 	if err != nil {
 		return fmt.Errorf("List IAM Attached Role Policies: %s", err)
 	}
 
-	for _, p := range attachedPolicies.AttachedPolicies {
+	for _, p := range attachedPolicies {
 		n := *p.PolicyName
 
 		_, err := o.client.DetachRolePolicy(&awsiam.DetachRolePolicyInput{
@@ -60,12 +64,16 @@ func (o RolePolicies) Delete(roleName string) error {
 		}
 	}
 
-	policies, err := o.client.ListRolePolicies(&awsiam.ListRolePoliciesInput{RoleName: aws.String(roleName)})
+	var policyNames []*string
+	err = o.client.ListRolePoliciesPages(&awsiam.ListRolePoliciesInput{RoleName: aws.String(roleName)}, func(page *awsiam.ListRolePoliciesOutput, lastPage bool) bool {
+		policyNames = append(policyNames, page.PolicyNames...)
+		return true
+	})
 	if err != nil {
 		return fmt.Errorf("List IAM Role Policies: %s", err)
 	}
 
-	for _, p := range policies.PolicyNames {
+	for _, p := range policyNames {
 		n := *p
 
 		_, err = o.client.DeleteRolePolicy(&awsiam.DeleteRolePolicyInput{
@@ -28,21 +28,21 @@ var _ = Describe("RolePolicies", func() {
 
 	Describe("Delete", func() {
 		BeforeEach(func() {
-			client.ListAttachedRolePoliciesCall.Returns.Output = &awsiam.ListAttachedRolePoliciesOutput{
+			client.ListAttachedRolePoliciesPagesCall.Returns.Pages = []*awsiam.ListAttachedRolePoliciesOutput{{
 				AttachedPolicies: []*awsiam.AttachedPolicy{{
 					PolicyName: aws.String("the-policy"),
 					PolicyArn:  aws.String("the-policy-arn"),
 				}},
-			}
-			client.ListRolePoliciesCall.Returns.Output = &awsiam.ListRolePoliciesOutput{}
+			}}
+			client.ListRolePoliciesPagesCall.Returns.Pages = []*awsiam.ListRolePoliciesOutput{{}}
 		})
 
 		It("detaches and deletes the attached policies", func() {
 			err := policies.Delete("banana")
 			Expect(err).NotTo(HaveOccurred())
 
-			Expect(client.ListAttachedRolePoliciesCall.CallCount).To(Equal(1))
-			Expect(client.ListAttachedRolePoliciesCall.Receives.Input.RoleName).To(Equal(aws.String("banana")))
+			Expect(client.ListAttachedRolePoliciesPagesCall.CallCount).To(Equal(1))
+			Expect(client.ListAttachedRolePoliciesPagesCall.Receives.Input.RoleName).To(Equal(aws.String("banana")))
 
 			Expect(client.DetachRolePolicyCall.CallCount).To(Equal(1))
 			Expect(client.DetachRolePolicyCall.Receives.Input.RoleName).To(Equal(aws.String("banana")))
@@ -60,18 +60,18 @@ var _ = Describe("RolePolicies", func() {
 
 		Context("when the policies are not attached", func() {
 			BeforeEach(func() {
-				client.ListAttachedRolePoliciesCall.Returns.Output = &awsiam.ListAttachedRolePoliciesOutput{}
-				client.ListRolePoliciesCall.Returns.Output = &awsiam.ListRolePoliciesOutput{
+				client.ListAttachedRolePoliciesPagesCall.Returns.Pages = []*awsiam.ListAttachedRolePoliciesOutput{{}}
+				client.ListRolePoliciesPagesCall.Returns.Pages = []*awsiam.ListRolePoliciesOutput{{
 					PolicyNames: []*string{aws.String("the-not-attached-policy")},
-				}
+				}}
 			})
 
 			It("deletes the policies", func() {
 				err := policies.Delete("banana")
 				Expect(err).NotTo(HaveOccurred())
 
-				Expect(client.ListRolePoliciesCall.CallCount).To(Equal(1))
-				Expect(client.ListRolePoliciesCall.Receives.Input.RoleName).To(Equal(aws.String("banana")))
+				Expect(client.ListRolePoliciesPagesCall.CallCount).To(Equal(1))
+				Expect(client.ListRolePoliciesPagesCall.Receives.Input.RoleName).To(Equal(aws.String("banana")))
 
 				Expect(client.DetachRolePolicyCall.CallCount).To(Equal(0))
 
@@ -87,8 +87,8 @@ var _ = Describe("RolePolicies", func() {
 
 		Context("when the client fails to list attached role policies", func() {
 			BeforeEach(func() {
-				client.ListAttachedRolePoliciesCall.Returns.Error = errors.New("some error")
-				client.ListRolePoliciesCall.Returns.Output = &awsiam.ListRolePoliciesOutput{}
+				client.ListAttachedRolePoliciesPagesCall.Returns.Error = errors.New("some error")
+				client.ListRolePoliciesPagesCall.Returns.Pages = []*awsiam.ListRolePoliciesOutput{{}}
 			})
 
 			It("returns the error and does not try deleting them", func() {
@@ -102,8 +102,8 @@ var _ = Describe("RolePolicies", func() {
 
 		Context("when the client fails to list role policies", func() {
 			BeforeEach(func() {
-				client.ListAttachedRolePoliciesCall.Returns.Output = &awsiam.ListAttachedRolePoliciesOutput{}
-				client.ListRolePoliciesCall.Returns.Error = errors.New("some error")
+				client.ListAttachedRolePoliciesPagesCall.Returns.Pages = []*awsiam.ListAttachedRolePoliciesOutput{{}}
+				client.ListRolePoliciesPagesCall.Returns.Error = errors.New("some error")
 			})
 
 			It("returns the error and does not try deleting them", func() {
@@ -9,7 +9,7 @@ import (
 )
 
 type rolesClient interface {
-	ListRoles(*awsiam.ListRolesInput) (*awsiam.ListRolesOutput, error)
+	ListRolesPages(*awsiam.ListRolesInput, func(*awsiam.ListRolesOutput, bool) bool) error
 	DeleteRole(*awsiam.DeleteRoleInput) (*awsiam.DeleteRoleOutput, error)
 }
 
@@ -28,13 +28,17 @@ func NewRoles(client rolesClient, logger logger, policies rolePolicies) Roles {
 }
 
 func (o Roles) List(filter string) ([]common.Deletable, error) {
-	roles, err := o.client.ListRoles(&awsiam.ListRolesInput{})
+	var roles []*awsiam.Role
+	err := o.client.ListRolesPages(&awsiam.ListRolesInput{}, func(page *awsiam.ListRolesOutput, lastPage bool) bool {
+		roles = append(roles, page.Roles...)
+		return true
+	})
 	if err != nil {
 		return nil, fmt.Errorf("List IAM Roles: %s", err)
 	}
 
 	var resources []common.Deletable
-	for _, role := range roles.Roles {
+	for _, role := range roles {
 		r := NewRole(o.client, o.policies, role.RoleName)
 
 		if !strings.Contains(r.Name(), filter) {
@@ -33,18 +33,18 @@ var _ = Describe("Roles", func() {
 	Describe("List", func() {
 		BeforeEach(func() {
 			logger.PromptWithDetailsCall.Returns.Proceed = true
-			client.ListRolesCall.Returns.Output = &awsiam.ListRolesOutput{
+			client.ListRolesPagesCall.Returns.Pages = []*awsiam.ListRolesOutput{{
 				Roles: []*awsiam.Role{{
 					RoleName: aws.String("banana-role"),
 				}},
-			}
+			}}
 		})
 
 		It("returns a list of iam roles and associated policies to delete", func() {
 			items, err := roles.List(filter)
 			Expect(err).NotTo(HaveOccurred())
 
-			Expect(client.ListRolesCall.CallCount).To(Equal(1))
+			Expect(client.ListRolesPagesCall.CallCount).To(Equal(1))
 			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
 			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("IAM Role"))
 			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("banana-role"))
@@ -52,9 +52,30 @@ var _ = Describe("Roles", func() {
 			Expect(items).To(HaveLen(1))
 		})
 
+		Context("when there is more than one page of roles", func() {
+			BeforeEach(func() {
+				client.ListRolesPagesCall.Returns.Pages = append(client.ListRolesPagesCall.Returns.Pages, &awsiam.ListRolesOutput{
+					Roles: []*awsiam.Role{{
+						RoleName: aws.String("banana-role-two"),
+					}},
+				})
+			})
+
+			It("returns the roles from every page", func() {
+				items, err := roles.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(client.ListRolesPagesCall.CallCount).To(Equal(1))
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(2))
+				Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("banana-role-two"))
+
+				Expect(items).To(HaveLen(2))
+			})
+		})
+
 		Context("when the client fails to list roles", func() {
 			BeforeEach(func() {
-				client.ListRolesCall.Returns.Error = errors.New("some error")
+				client.ListRolesPagesCall.Returns.Error = errors.New("some error")
 			})
 
 			It("returns the error", func() {
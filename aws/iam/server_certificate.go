@@ -1,49 +1,74 @@
 package iam
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	awsiam "github.com/aws/aws-sdk-go/service/iam"
+	"github.com/genevieve/leftovers/common"
 )
 
+// releaseTimeout is how long to wait for the load balancers
+// that are being deleted to release a server certificate.
+const releaseTimeout = 2 * time.Minute
+
 type ServerCertificate struct {
 	client     serverCertificatesClient
+	logger     logger
+	listeners  listeners
 	name       *string
+	arn        *string
 	identifier string
 	rtype      string
 }
 
-func NewServerCertificate(client serverCertificatesClient, name *string) ServerCertificate {
+func NewServerCertificate(client serverCertificatesClient, logger logger, listeners listeners, name, arn *string) ServerCertificate {
 	return ServerCertificate{
 		client:     client,
+		logger:     logger,
+		listeners:  listeners,
 		name:       name,
+		arn:        arn,
 		identifier: *name,
 		rtype:      "IAM Server Certificate",
 	}
 }
 
 func (s ServerCertificate) Delete() error {
-	return retry(10, time.Second, func() error {
-		_, err := s.client.DeleteServerCertificate(&awsiam.DeleteServerCertificateInput{
-			ServerCertificateName: s.name})
+	return s.DeleteContext(context.Background())
+}
 
-		if err != nil {
-			if awsErr, ok := err.(awserr.Error); ok {
-				if awsErr.Code() == "NoSuchEntity" {
-					return nil
-				}
+// DeleteContext deletes the server certificate. A conflict means that it is
+// still in use, which AWS reports with the resources that use it. It is only
+// deleted once it is released if the listeners of load balancers that are
+// being deleted use it, and otherwise the conflict is returned.
+func (s ServerCertificate) DeleteContext(ctx context.Context) error {
+	result, state, err := serverCertificateDelete(s.client, s.name)()
+	if err != nil {
+		return fmt.Errorf("Delete: %s", err)
+	}
 
-				if awsErr.Code() == "DeleteConflict" {
-					return awsErr
-				}
-			}
-			return nonRetryableError{fmt.Errorf("Delete: %s", err)}
+	if state == "in-use" {
+		if !s.listeners.Releases(*s.arn) {
+			return fmt.Errorf("Delete: %s", result)
+		}
+
+		s.logger.Printf("[%s: %s] In use by load balancers that are being deleted, waiting for it to be released\n", s.rtype, s.identifier)
+
+		ctx, cancel := context.WithTimeout(ctx, releaseTimeout)
+		defer cancel()
+
+		poller := common.NewPoller(s.logger, serverCertificateDelete(s.client, s.name), []string{"in-use"}, []string{"deleted"})
+
+		_, err = poller.Wait(ctx)
+		if err != nil {
+			return fmt.Errorf("Waiting for certificate to be released: %s", err)
 		}
+	}
 
-		return nil
-	})
+	return nil
 }
 
 func (s ServerCertificate) Name() string {
@@ -54,23 +79,23 @@ func (s ServerCertificate) Type() string {
 	return s.rtype
 }
 
-func retry(attempts int, sleep time.Duration, f func() error) error {
-	if err := f(); err != nil {
-		if s, ok := err.(nonRetryableError); ok {
-			return s.error
-		}
-
-		if attempts--; attempts > 0 {
-			time.Sleep(2 * time.Second)
-			return retry(attempts, 2*sleep, f)
+// serverCertificateDelete tries to delete the server certificate and
+// returns whether it is still in use, with the conflict if it is.
+func serverCertificateDelete(client serverCertificatesClient, name *string) common.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		_, err := client.DeleteServerCertificate(&awsiam.DeleteServerCertificateInput{ServerCertificateName: name})
+		if err != nil {
+			if awsErr, ok := err.(awserr.Error); ok {
+				switch awsErr.Code() {
+				case awsiam.ErrCodeNoSuchEntityException:
+					return name, "deleted", nil
+				case awsiam.ErrCodeDeleteConflictException:
+					return awsErr, "in-use", nil
+				}
+			}
+			return nil, "", err
 		}
 
-		return err
+		return name, "deleted", nil
 	}
-
-	return nil
-}
-
-type nonRetryableError struct {
-	error
 }
@@ -4,6 +4,8 @@ import (
 	"errors"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awsiam "github.com/aws/aws-sdk-go/service/iam"
 	"github.com/genevieve/leftovers/aws/iam"
 	"github.com/genevieve/leftovers/aws/iam/fakes"
 
@@ -15,14 +17,20 @@ var _ = Describe("ServerCertificate", func() {
 	var (
 		serverCertificate iam.ServerCertificate
 		client            *fakes.ServerCertificatesClient
+		logger            *fakes.Logger
+		listeners         *fakes.Listeners
 		name              *string
+		arn               *string
 	)
 
 	BeforeEach(func() {
 		client = &fakes.ServerCertificatesClient{}
+		logger = &fakes.Logger{}
+		listeners = &fakes.Listeners{}
 		name = aws.String("the-name")
+		arn = aws.String("the-arn")
 
-		serverCertificate = iam.NewServerCertificate(client, name)
+		serverCertificate = iam.NewServerCertificate(client, logger, listeners, name, arn)
 	})
 
 	Describe("Delete", func() {
@@ -34,6 +42,63 @@ var _ = Describe("ServerCertificate", func() {
 			Expect(client.DeleteServerCertificateCall.Receives.Input.ServerCertificateName).To(Equal(name))
 		})
 
+		Context("when the server certificate is in use", func() {
+			BeforeEach(func() {
+				client.DeleteServerCertificateCall.ReturnsOnCall = map[int]struct {
+					Output *awsiam.DeleteServerCertificateOutput
+					Error  error
+				}{
+					0: {Error: awserr.New("DeleteConflict", "Certificate: the-name is currently in use by the-load-balancer-arn.", nil)},
+				}
+				listeners.ReleasesCall.Returns.Releases = true
+			})
+
+			It("waits for the load balancers that are being deleted to release it and deletes it", func() {
+				err := serverCertificate.Delete()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(listeners.ReleasesCall.Receives.Certificate).To(Equal("the-arn"))
+
+				Expect(logger.PrintfCall.Messages).To(ContainElement("[IAM Server Certificate: the-name] In use by load balancers that are being deleted, waiting for it to be released\n"))
+				Expect(client.DeleteServerCertificateCall.CallCount).To(Equal(2))
+			})
+
+			Context("when the load balancers that use it are not being deleted", func() {
+				BeforeEach(func() {
+					listeners.ReleasesCall.Returns.Releases = false
+				})
+
+				It("returns the conflict with the resources that use it without waiting", func() {
+					err := serverCertificate.Delete()
+					Expect(err).To(MatchError("Delete: DeleteConflict: Certificate: the-name is currently in use by the-load-balancer-arn."))
+
+					Expect(client.DeleteServerCertificateCall.CallCount).To(Equal(1))
+				})
+			})
+
+			Context("when the client fails while waiting", func() {
+				BeforeEach(func() {
+					client.DeleteServerCertificateCall.Returns.Error = errors.New("banana")
+				})
+
+				It("returns the error", func() {
+					err := serverCertificate.Delete()
+					Expect(err).To(MatchError("Waiting for certificate to be released: banana"))
+				})
+			})
+		})
+
+		Context("when the server certificate no longer exists", func() {
+			BeforeEach(func() {
+				client.DeleteServerCertificateCall.Returns.Error = awserr.New("NoSuchEntity", "", nil)
+			})
+
+			It("does not return an error", func() {
+				err := serverCertificate.Delete()
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
 		Context("when the client fails", func() {
 			BeforeEach(func() {
 				client.DeleteServerCertificateCall.Returns.Error = errors.New("banana")
@@ -9,31 +9,44 @@ import (
 )
 
 type serverCertificatesClient interface {
-	ListServerCertificates(*awsiam.ListServerCertificatesInput) (*awsiam.ListServerCertificatesOutput, error)
+	ListServerCertificatesPages(*awsiam.ListServerCertificatesInput, func(*awsiam.ListServerCertificatesOutput, bool) bool) error
 	DeleteServerCertificate(*awsiam.DeleteServerCertificateInput) (*awsiam.DeleteServerCertificateOutput, error)
 }
 
+type listeners interface {
+	Releases(certificate string) bool
+}
+
 type ServerCertificates struct {
-	client serverCertificatesClient
-	logger logger
+	client    serverCertificatesClient
+	logger    logger
+	listeners listeners
 }
 
-func NewServerCertificates(client serverCertificatesClient, logger logger) ServerCertificates {
+// NewServerCertificates returns the server certificates to delete. A server
+// certificate that is in use is only waited on if the provided listeners are
+// deleting load balancers that use it.
+func NewServerCertificates(client serverCertificatesClient, logger logger, listeners listeners) ServerCertificates {
 	return ServerCertificates{
-		client: client,
-		logger: logger,
+		client:    client,
+		logger:    logger,
+		listeners: listeners,
 	}
 }
 
 func (s ServerCertificates) List(filter string) ([]common.Deletable, error) {
-	certificates, err := s.client.ListServerCertificates(&awsiam.ListServerCertificatesInput{})
+	var certificates []*awsiam.ServerCertificateMetadata
+	err := s.client.ListServerCertificatesPages(&awsiam.ListServerCertificatesInput{}, func(page *awsiam.ListServerCertificatesOutput, lastPage bool) bool {
+		certificates = append(certificates, page.ServerCertificateMetadataList...)
+		return true
+	})
 	if err != nil {
 		return nil, fmt.Errorf("List IAM Server Certificates: %s", err)
 	}
 
 	var resources []common.Deletable
-	for _, c := range certificates.ServerCertificateMetadataList {
-		r := NewServerCertificate(s.client, c.ServerCertificateName)
+	for _, c := range certificates {
+		r := NewServerCertificate(s.client, s.logger, s.listeners, c.ServerCertificateName, c.Arn)
 
 		if !strings.Contains(r.Name(), filter) {
 			continue
@@ -25,24 +25,24 @@ var _ = Describe("ServerCertificates", func() {
 		logger = &fakes.Logger{}
 		filter = "banana"
 
-		serverCertificates = iam.NewServerCertificates(client, logger)
+		serverCertificates = iam.NewServerCertificates(client, logger, &fakes.Listeners{})
 	})
 
 	Describe("List", func() {
 		BeforeEach(func() {
 			logger.PromptWithDetailsCall.Returns.Proceed = true
-			client.ListServerCertificatesCall.Returns.Output = &awsiam.ListServerCertificatesOutput{
+			client.ListServerCertificatesPagesCall.Returns.Pages = []*awsiam.ListServerCertificatesOutput{{
 				ServerCertificateMetadataList: []*awsiam.ServerCertificateMetadata{{
 					ServerCertificateName: aws.String("banana-cert"),
 				}},
-			}
+			}}
 		})
 
 		It("returns a list of iam server certificates to delete", func() {
 			items, err := serverCertificates.List(filter)
 			Expect(err).NotTo(HaveOccurred())
 
-			Expect(client.ListServerCertificatesCall.CallCount).To(Equal(1))
+			Expect(client.ListServerCertificatesPagesCall.CallCount).To(Equal(1))
 			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
 			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("IAM Server Certificate"))
 			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("banana-cert"))
@@ -52,7 +52,7 @@ var _ = Describe("ServerCertificates", func() {
 
 		Context("when the client fails to list server certificates", func() {
 			BeforeEach(func() {
-				client.ListServerCertificatesCall.Returns.Error = errors.New("some error")
+				client.ListServerCertificatesPagesCall.Returns.Error = errors.New("some error")
 			})
 
 			It("returns the error", func() {
@@ -9,7 +9,7 @@ import (
 )
 
 type userPoliciesClient interface {
-	ListAttachedUserPolicies(*awsiam.ListAttachedUserPoliciesInput) (*awsiam.ListAttachedUserPoliciesOutput, error)
+	ListAttachedUserPoliciesPages(*awsiam.ListAttachedUserPoliciesInput, func(*awsiam.ListAttachedUserPoliciesOutput, bool) bool) error
 	DetachUserPolicy(*awsiam.DetachUserPolicyInput) (*awsiam.DetachUserPolicyOutput, error)
 	DeleteUserPolicy(*awsiam.DeleteUserPolicyInput) (*awsiam.DeleteUserPolicyOutput, error)
 }
@@ -31,12 +31,16 @@ func NewUserPolicies(client userPoliciesClient, logger logger) UserPolicies {
 }
 
 func (o UserPolicies) Delete(userName string) error {
-	policies, err := o.client.ListAttachedUserPolicies(&awsiam.ListAttachedUserPoliciesInput{UserName: aws.String(userName)})
+	var attachedPolicies []*awsiam.AttachedPolicy
+	err := o.client.ListAttachedUserPoliciesPages(&awsiam.ListAttachedUserPoliciesInput{UserName: aws.String(userName)}, func(page *awsiam.ListAttachedUserPoliciesOutput, lastPage bool) bool {
+		attachedPolicies = append(attachedPolicies, page.AttachedPolicies...)
+		return true
+	})
 	if err != nil {
 		return fmt.Errorf("List IAM User Policies: %s", err)
 	}
 
-	for _, p := range policies.AttachedPolicies {
+	for _, p := range attachedPolicies {
 		n := *p.PolicyName
 
 		_, err = o.client.DetachUserPolicy(&awsiam.DetachUserPolicyInput{
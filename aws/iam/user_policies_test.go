@@ -29,20 +29,20 @@ var _ = Describe("UserPolicies", func() {
 
 	Describe("Delete", func() {
 		BeforeEach(func() {
-			client.ListAttachedUserPoliciesCall.Returns.Output = &awsiam.ListAttachedUserPoliciesOutput{
+			client.ListAttachedUserPoliciesPagesCall.Returns.Pages = []*awsiam.ListAttachedUserPoliciesOutput{{
 				AttachedPolicies: []*awsiam.AttachedPolicy{{
 					PolicyName: aws.String("the-policy"),
 					PolicyArn:  aws.String("the-policy-arn"),
 				}},
-			}
+			}}
 		})
 
 		It("detaches and deletes the policies", func() {
 			err := policies.Delete("banana")
 			Expect(err).NotTo(HaveOccurred())
 
-			Expect(client.ListAttachedUserPoliciesCall.CallCount).To(Equal(1))
-			Expect(client.ListAttachedUserPoliciesCall.Receives.Input.UserName).To(Equal(aws.String("banana")))
+			Expect(client.ListAttachedUserPoliciesPagesCall.CallCount).To(Equal(1))
+			Expect(client.ListAttachedUserPoliciesPagesCall.Receives.Input.UserName).To(Equal(aws.String("banana")))
 
 			Expect(client.DetachUserPolicyCall.CallCount).To(Equal(1))
 			Expect(client.DetachUserPolicyCall.Receives.Input.UserName).To(Equal(aws.String("banana")))
@@ -60,7 +60,7 @@ var _ = Describe("UserPolicies", func() {
 
 		Context("when the client fails to list attached user policies", func() {
 			BeforeEach(func() {
-				client.ListAttachedUserPoliciesCall.Returns.Error = errors.New("some error")
+				client.ListAttachedUserPoliciesPagesCall.Returns.Error = errors.New("some error")
 			})
 
 			It("returns the error and does not try deleting them", func() {
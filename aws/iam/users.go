@@ -9,7 +9,7 @@ import (
 )
 
 type usersClient interface {
-	ListUsers(*awsiam.ListUsersInput) (*awsiam.ListUsersOutput, error)
+	ListUsersPages(*awsiam.ListUsersInput, func(*awsiam.ListUsersOutput, bool) bool) error
 	DeleteUser(*awsiam.DeleteUserInput) (*awsiam.DeleteUserOutput, error)
 }
 
@@ -30,13 +30,17 @@ func NewUsers(client usersClient, logger logger, policies userPolicies, accessKe
 }
 
 func (u Users) List(filter string) ([]common.Deletable, error) {
-	users, err := u.client.ListUsers(&awsiam.ListUsersInput{})
+	var users []*awsiam.User
+	err := u.client.ListUsersPages(&awsiam.ListUsersInput{}, func(page *awsiam.ListUsersOutput, lastPage bool) bool {
+		users = append(users, page.Users...)
+		return true
+	})
 	if err != nil {
 		return nil, fmt.Errorf("List IAM Users: %s", err)
 	}
 
 	var resources []common.Deletable
-	for _, r := range users.Users {
+	for _, r := range users {
 		r := NewUser(u.client, u.policies, u.accessKeys, r.UserName)
 
 		if !strings.Contains(r.Name(), filter) {
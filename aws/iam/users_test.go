@@ -35,18 +35,18 @@ var _ = Describe("Users", func() {
 	Describe("List", func() {
 		BeforeEach(func() {
 			logger.PromptWithDetailsCall.Returns.Proceed = true
-			client.ListUsersCall.Returns.Output = &awsiam.ListUsersOutput{
+			client.ListUsersPagesCall.Returns.Pages = []*awsiam.ListUsersOutput{{
 				Users: []*awsiam.User{{
 					UserName: aws.String("banana-user"),
 				}},
-			}
+			}}
 		})
 
 		It("returns a list of iam users to delete", func() {
 			items, err := users.List(filter)
 			Expect(err).NotTo(HaveOccurred())
 
-			Expect(client.ListUsersCall.CallCount).To(Equal(1))
+			Expect(client.ListUsersPagesCall.CallCount).To(Equal(1))
 
 			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
 			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("IAM User"))
@@ -57,7 +57,7 @@ var _ = Describe("Users", func() {
 
 		Context("when the client fails to list users", func() {
 			BeforeEach(func() {
-				client.ListUsersCall.Returns.Error = errors.New("some error")
+				client.ListUsersPagesCall.Returns.Error = errors.New("some error")
 			})
 
 			It("returns the error and does not try deleting them", func() {
@@ -9,7 +9,7 @@ import (
 )
 
 type aliasesClient interface {
-	ListAliases(*awskms.ListAliasesInput) (*awskms.ListAliasesOutput, error)
+	ListAliasesPages(*awskms.ListAliasesInput, func(*awskms.ListAliasesOutput, bool) bool) error
 	DeleteAlias(*awskms.DeleteAliasInput) (*awskms.DeleteAliasOutput, error)
 }
 
@@ -26,13 +26,17 @@ func NewAliases(client aliasesClient, logger logger) Aliases {
 }
 
 func (a Aliases) List(filter string) ([]common.Deletable, error) {
-	aliases, err := a.client.ListAliases(&awskms.ListAliasesInput{})
+	var aliases []*awskms.AliasListEntry
+	err := a.client.ListAliasesPages(&awskms.ListAliasesInput{}, func(page *awskms.ListAliasesOutput, lastPage bool) bool {
+		aliases = append(aliases, page.Aliases...)
+		return true
+	})
 	if err != nil {
 		return nil, fmt.Errorf("Listing KMS Aliases: %s", err)
 	}
 
 	var resources []common.Deletable
-	for _, alias := range aliases.Aliases {
+	for _, alias := range aliases {
 		r := NewAlias(a.client, alias.AliasName)
 
 		if !strings.Contains(r.Name(), filter) {
@@ -31,11 +31,11 @@ var _ = Describe("Aliases", func() {
 
 		BeforeEach(func() {
 			logger.PromptWithDetailsCall.Returns.Proceed = true
-			client.ListAliasesCall.Returns.Output = &awskms.ListAliasesOutput{
+			client.ListAliasesPagesCall.Returns.Pages = []*awskms.ListAliasesOutput{{
 				Aliases: []*awskms.AliasListEntry{{
 					AliasName: aws.String("banana"),
 				}},
-			}
+			}}
 			filter = "ban"
 		})
 
@@ -43,7 +43,7 @@ var _ = Describe("Aliases", func() {
 			items, err := aliases.List(filter)
 			Expect(err).NotTo(HaveOccurred())
 
-			Expect(client.ListAliasesCall.CallCount).To(Equal(1))
+			Expect(client.ListAliasesPagesCall.CallCount).To(Equal(1))
 			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("KMS Alias"))
 			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("banana"))
 
@@ -53,11 +53,11 @@ var _ = Describe("Aliases", func() {
 		Context("when the alias name does not contain the filter", func() {
 			BeforeEach(func() {
 				logger.PromptWithDetailsCall.Returns.Proceed = true
-				client.ListAliasesCall.Returns.Output = &awskms.ListAliasesOutput{
+				client.ListAliasesPagesCall.Returns.Pages = []*awskms.ListAliasesOutput{{
 					Aliases: []*awskms.AliasListEntry{{
 						AliasName: aws.String("nope"),
 					}},
-				}
+				}}
 				filter = "banana"
 			})
 
@@ -65,7 +65,7 @@ var _ = Describe("Aliases", func() {
 				items, err := aliases.List(filter)
 				Expect(err).NotTo(HaveOccurred())
 
-				Expect(client.ListAliasesCall.CallCount).To(Equal(1))
+				Expect(client.ListAliasesPagesCall.CallCount).To(Equal(1))
 				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
 				Expect(items).To(HaveLen(0))
 			})
@@ -73,7 +73,7 @@ var _ = Describe("Aliases", func() {
 
 		Context("when the client fails to describe aliases", func() {
 			BeforeEach(func() {
-				client.ListAliasesCall.Returns.Error = errors.New("some error")
+				client.ListAliasesPagesCall.Returns.Error = errors.New("some error")
 			})
 
 			It("returns the error", func() {
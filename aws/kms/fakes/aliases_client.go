@@ -3,14 +3,14 @@ package fakes
 import "github.com/aws/aws-sdk-go/service/kms"
 
 type AliasesClient struct {
-	ListAliasesCall struct {
+	ListAliasesPagesCall struct {
 		CallCount int
 		Receives  struct {
 			Input *kms.ListAliasesInput
 		}
 		Returns struct {
-			Output *kms.ListAliasesOutput
-			Error  error
+			Pages []*kms.ListAliasesOutput
+			Error error
 		}
 	}
 
@@ -26,11 +26,18 @@ type AliasesClient struct {
 	}
 }
 
-func (a *AliasesClient) ListAliases(input *kms.ListAliasesInput) (*kms.ListAliasesOutput, error) {
-	a.ListAliasesCall.CallCount++
-	a.ListAliasesCall.Receives.Input = input
+func (a *AliasesClient) ListAliasesPages(input *kms.ListAliasesInput, fn func(*kms.ListAliasesOutput, bool) bool) error {
+	a.ListAliasesPagesCall.CallCount++
+	a.ListAliasesPagesCall.Receives.Input = input
+
+	pages := a.ListAliasesPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
 
-	return a.ListAliasesCall.Returns.Output, a.ListAliasesCall.Returns.Error
+	return a.ListAliasesPagesCall.Returns.Error
 }
 
 func (a *AliasesClient) DeleteAlias(input *kms.DeleteAliasInput) (*kms.DeleteAliasOutput, error) {
@@ -3,14 +3,14 @@ package fakes
 import "github.com/aws/aws-sdk-go/service/kms"
 
 type KeysClient struct {
-	ListKeysCall struct {
+	ListKeysPagesCall struct {
 		CallCount int
 		Receives  struct {
 			Input *kms.ListKeysInput
 		}
 		Returns struct {
-			Output *kms.ListKeysOutput
-			Error  error
+			Pages []*kms.ListKeysOutput
+			Error error
 		}
 	}
 	DescribeKeyCall struct {
@@ -23,14 +23,14 @@ type KeysClient struct {
 			Error  error
 		}
 	}
-	ListResourceTagsCall struct {
+	ListResourceTagsPagesCall struct {
 		CallCount int
 		Receives  struct {
 			Input *kms.ListResourceTagsInput
 		}
 		Returns struct {
-			Output *kms.ListResourceTagsOutput
-			Error  error
+			Pages []*kms.ListResourceTagsOutput
+			Error error
 		}
 	}
 	DisableKeyCall struct {
@@ -55,11 +55,18 @@ type KeysClient struct {
 	}
 }
 
-func (k *KeysClient) ListKeys(input *kms.ListKeysInput) (*kms.ListKeysOutput, error) {
-	k.ListKeysCall.CallCount++
-	k.ListKeysCall.Receives.Input = input
+func (k *KeysClient) ListKeysPages(input *kms.ListKeysInput, fn func(*kms.ListKeysOutput, bool) bool) error {
+	k.ListKeysPagesCall.CallCount++
+	k.ListKeysPagesCall.Receives.Input = input
+
+	pages := k.ListKeysPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
 
-	return k.ListKeysCall.Returns.Output, k.ListKeysCall.Returns.Error
+	return k.ListKeysPagesCall.Returns.Error
 }
 
 func (k *KeysClient) DescribeKey(input *kms.DescribeKeyInput) (*kms.DescribeKeyOutput, error) {
@@ -69,11 +76,18 @@ func (k *KeysClient) DescribeKey(input *kms.DescribeKeyInput) (*kms.DescribeKeyO
 	return k.DescribeKeyCall.Returns.Output, k.DescribeKeyCall.Returns.Error
 }
 
-func (k *KeysClient) ListResourceTags(input *kms.ListResourceTagsInput) (*kms.ListResourceTagsOutput, error) {
-	k.ListResourceTagsCall.CallCount++
-	k.ListResourceTagsCall.Receives.Input = input
+func (k *KeysClient) ListResourceTagsPages(input *kms.ListResourceTagsInput, fn func(*kms.ListResourceTagsOutput, bool) bool) error {
+	k.ListResourceTagsPagesCall.CallCount++
+	k.ListResourceTagsPagesCall.Receives.Input = input
+
+	pages := k.ListResourceTagsPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
 
-	return k.ListResourceTagsCall.Returns.Output, k.ListResourceTagsCall.Returns.Error
+	return k.ListResourceTagsPagesCall.Returns.Error
 }
 
 func (k *KeysClient) DisableKey(input *kms.DisableKeyInput) (*kms.DisableKeyOutput, error) {
@@ -9,9 +9,9 @@ import (
 )
 
 type keysClient interface {
-	ListKeys(*awskms.ListKeysInput) (*awskms.ListKeysOutput, error)
+	ListKeysPages(*awskms.ListKeysInput, func(*awskms.ListKeysOutput, bool) bool) error
 	DescribeKey(*awskms.DescribeKeyInput) (*awskms.DescribeKeyOutput, error)
-	ListResourceTags(*awskms.ListResourceTagsInput) (*awskms.ListResourceTagsOutput, error)
+	ListResourceTagsPages(*awskms.ListResourceTagsInput, func(*awskms.ListResourceTagsOutput, bool) bool) error
 	DisableKey(*awskms.DisableKeyInput) (*awskms.DisableKeyOutput, error)
 	ScheduleKeyDeletion(*awskms.ScheduleKeyDeletionInput) (*awskms.ScheduleKeyDeletionOutput, error)
 }
@@ -30,21 +30,32 @@ func NewKeys(client keysClient, logger logger) Keys {
 }
 
 func (k Keys) List(filter string) ([]common.Deletable, error) {
-	keys, err := k.client.ListKeys(&awskms.ListKeysInput{})
+	var keys []*awskms.KeyListEntry
+	err := k.client.ListKeysPages(&awskms.ListKeysInput{}, func(page *awskms.ListKeysOutput, lastPage bool) bool {
+		keys = append(keys, page.Keys...)
+		return true
+	})
 	if err != nil {
 		return nil, fmt.Errorf("Listing KMS Keys: %s", err)
 	}
 
 	var resources []common.Deletable
-	for _, key := range keys.Keys {
+	for _, key := range keys {
 		metadata, _ := k.client.DescribeKey(&awskms.DescribeKeyInput{KeyId: key.KeyId})
 		if metadata == nil || *metadata.KeyMetadata.KeyState != awskms.KeyStateEnabled {
 			continue
 		}
 
-		tags, _ := k.client.ListResourceTags(&awskms.ListResourceTagsInput{KeyId: key.KeyId})
+		var tags []*awskms.Tag
+		err := k.client.ListResourceTagsPages(&awskms.ListResourceTagsInput{KeyId: key.KeyId}, func(page *awskms.ListResourceTagsOutput, lastPage bool) bool {
+			tags = append(tags, page.Tags...)
+			return true
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Listing KMS Key Tags for %s: %s", *key.KeyId, err)
+		}
 
-		r := NewKey(k.client, key.KeyId, metadata.KeyMetadata, tags.Tags)
+		r := NewKey(k.client, key.KeyId, metadata.KeyMetadata, tags)
 
 		if !strings.Contains(r.Name(), filter) {
 			continue
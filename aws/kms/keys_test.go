@@ -30,20 +30,20 @@ var _ = Describe("Keys", func() {
 		var filter string
 		BeforeEach(func() {
 			logger.PromptWithDetailsCall.Returns.Proceed = true
-			client.ListKeysCall.Returns.Output = &awskms.ListKeysOutput{
+			client.ListKeysPagesCall.Returns.Pages = []*awskms.ListKeysOutput{{
 				Keys: []*awskms.KeyListEntry{{
 					KeyId: aws.String("banana"),
 				}},
-			}
+			}}
 			client.DescribeKeyCall.Returns.Output = &awskms.DescribeKeyOutput{
 				KeyMetadata: &awskms.KeyMetadata{
 					Description: aws.String(""),
 					KeyState:    aws.String("Enabled"),
 				},
 			}
-			client.ListResourceTagsCall.Returns.Output = &awskms.ListResourceTagsOutput{
+			client.ListResourceTagsPagesCall.Returns.Pages = []*awskms.ListResourceTagsOutput{{
 				Tags: []*awskms.Tag{},
-			}
+			}}
 			filter = "ban"
 		})
 
@@ -51,21 +51,60 @@ var _ = Describe("Keys", func() {
 			items, err := keys.List(filter)
 			Expect(err).NotTo(HaveOccurred())
 
-			Expect(client.ListKeysCall.CallCount).To(Equal(1))
+			Expect(client.ListKeysPagesCall.CallCount).To(Equal(1))
 			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("KMS Key"))
 			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("banana"))
 
 			Expect(items).To(HaveLen(1))
 		})
 
+		Context("when there is more than one page of tags", func() {
+			BeforeEach(func() {
+				client.ListResourceTagsPagesCall.Returns.Pages = []*awskms.ListResourceTagsOutput{{
+					Tags: []*awskms.Tag{{TagKey: aws.String("env"), TagValue: aws.String("lime")}},
+				}, {
+					Tags: []*awskms.Tag{{TagKey: aws.String("owner"), TagValue: aws.String("mango")}},
+				}}
+			})
+
+			It("identifies the key by the tags from every page", func() {
+				_, err := keys.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(client.ListResourceTagsPagesCall.CallCount).To(Equal(1))
+				Expect(client.ListResourceTagsPagesCall.Receives.Input.KeyId).To(Equal(aws.String("banana")))
+				Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("banana (env:lime, owner:mango)"))
+			})
+		})
+
+		Context("when there is more than one page of keys", func() {
+			BeforeEach(func() {
+				client.ListKeysPagesCall.Returns.Pages = append(client.ListKeysPagesCall.Returns.Pages, &awskms.ListKeysOutput{
+					Keys: []*awskms.KeyListEntry{{
+						KeyId: aws.String("banana-two"),
+					}},
+				})
+			})
+
+			It("returns the keys from every page", func() {
+				items, err := keys.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(client.ListKeysPagesCall.CallCount).To(Equal(1))
+				Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("banana-two"))
+
+				Expect(items).To(HaveLen(2))
+			})
+		})
+
 		Context("when the alias name does not contain the filter", func() {
 			BeforeEach(func() {
 				logger.PromptWithDetailsCall.Returns.Proceed = true
-				client.ListKeysCall.Returns.Output = &awskms.ListKeysOutput{
+				client.ListKeysPagesCall.Returns.Pages = []*awskms.ListKeysOutput{{
 					Keys: []*awskms.KeyListEntry{{
 						KeyId: aws.String("banana"),
 					}},
-				}
+				}}
 				filter = "kiwi"
 			})
 
@@ -73,7 +112,7 @@ var _ = Describe("Keys", func() {
 				items, err := keys.List(filter)
 				Expect(err).NotTo(HaveOccurred())
 
-				Expect(client.ListKeysCall.CallCount).To(Equal(1))
+				Expect(client.ListKeysPagesCall.CallCount).To(Equal(1))
 				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
 				Expect(items).To(HaveLen(0))
 			})
@@ -81,7 +120,7 @@ var _ = Describe("Keys", func() {
 
 		Context("when the client fails to list keys", func() {
 			BeforeEach(func() {
-				client.ListKeysCall.Returns.Error = errors.New("some error")
+				client.ListKeysPagesCall.Returns.Error = errors.New("some error")
 			})
 
 			It("returns the error", func() {
@@ -103,12 +142,12 @@ var _ = Describe("Keys", func() {
 
 		Context("when the client fails to list resource tags", func() {
 			BeforeEach(func() {
-				client.ListResourceTagsCall.Returns.Error = errors.New("some error")
+				client.ListResourceTagsPagesCall.Returns.Error = errors.New("some error")
 			})
 
-			It("ignores the error", func() {
+			It("returns the error", func() {
 				_, err := keys.List(filter)
-				Expect(err).NotTo(HaveOccurred())
+				Expect(err).To(MatchError("Listing KMS Key Tags for banana: some error"))
 			})
 		})
 
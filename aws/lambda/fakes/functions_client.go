@@ -0,0 +1,111 @@
+package fakes
+
+import (
+	awslambda "github.com/aws/aws-sdk-go/service/lambda"
+)
+
+type FunctionsClient struct {
+	ListFunctionsPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awslambda.ListFunctionsInput
+		}
+		Returns struct {
+			Pages []*awslambda.ListFunctionsOutput
+			Error error
+		}
+	}
+
+	ListTagsCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awslambda.ListTagsInput
+		}
+		Returns struct {
+			Output *awslambda.ListTagsOutput
+			Error  error
+		}
+	}
+
+	ListEventSourceMappingsPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awslambda.ListEventSourceMappingsInput
+		}
+		Returns struct {
+			Pages []*awslambda.ListEventSourceMappingsOutput
+			Error error
+		}
+	}
+
+	DeleteEventSourceMappingCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awslambda.DeleteEventSourceMappingInput
+		}
+		Returns struct {
+			Output *awslambda.EventSourceMappingConfiguration
+			Error  error
+		}
+	}
+
+	DeleteFunctionCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awslambda.DeleteFunctionInput
+		}
+		Returns struct {
+			Output *awslambda.DeleteFunctionOutput
+			Error  error
+		}
+	}
+}
+
+func (f *FunctionsClient) ListFunctionsPages(input *awslambda.ListFunctionsInput, fn func(*awslambda.ListFunctionsOutput, bool) bool) error {
+	f.ListFunctionsPagesCall.CallCount++
+	f.ListFunctionsPagesCall.Receives.Input = input
+
+	pages := f.ListFunctionsPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return f.ListFunctionsPagesCall.Returns.Error
+}
+
+func (f *FunctionsClient) ListTags(input *awslambda.ListTagsInput) (*awslambda.ListTagsOutput, error) {
+	f.ListTagsCall.CallCount++
+	f.ListTagsCall.Receives.Input = input
+
+	return f.ListTagsCall.Returns.Output, f.ListTagsCall.Returns.Error
+}
+
+func (f *FunctionsClient) ListEventSourceMappingsPages(input *awslambda.ListEventSourceMappingsInput, fn func(*awslambda.ListEventSourceMappingsOutput, bool) bool) error {
+	f.ListEventSourceMappingsPagesCall.CallCount++
+	f.ListEventSourceMappingsPagesCall.Receives.Input = input
+
+	pages := f.ListEventSourceMappingsPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return f.ListEventSourceMappingsPagesCall.Returns.Error
+}
+
+func (f *FunctionsClient) DeleteEventSourceMapping(input *awslambda.DeleteEventSourceMappingInput) (*awslambda.EventSourceMappingConfiguration, error) {
+	f.DeleteEventSourceMappingCall.CallCount++
+	f.DeleteEventSourceMappingCall.Receives.Input = input
+
+	return f.DeleteEventSourceMappingCall.Returns.Output, f.DeleteEventSourceMappingCall.Returns.Error
+}
+
+func (f *FunctionsClient) DeleteFunction(input *awslambda.DeleteFunctionInput) (*awslambda.DeleteFunctionOutput, error) {
+	f.DeleteFunctionCall.CallCount++
+	f.DeleteFunctionCall.Receives.Input = input
+
+	return f.DeleteFunctionCall.Returns.Output, f.DeleteFunctionCall.Returns.Error
+}
@@ -0,0 +1,75 @@
+package fakes
+
+import (
+	awslambda "github.com/aws/aws-sdk-go/service/lambda"
+)
+
+type LayersClient struct {
+	ListLayersPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awslambda.ListLayersInput
+		}
+		Returns struct {
+			Pages []*awslambda.ListLayersOutput
+			Error error
+		}
+	}
+
+	ListLayerVersionsPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awslambda.ListLayerVersionsInput
+		}
+		Returns struct {
+			Pages []*awslambda.ListLayerVersionsOutput
+			Error error
+		}
+	}
+
+	DeleteLayerVersionCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awslambda.DeleteLayerVersionInput
+		}
+		Returns struct {
+			Output *awslambda.DeleteLayerVersionOutput
+			Error  error
+		}
+	}
+}
+
+func (l *LayersClient) ListLayersPages(input *awslambda.ListLayersInput, fn func(*awslambda.ListLayersOutput, bool) bool) error {
+	l.ListLayersPagesCall.CallCount++
+	l.ListLayersPagesCall.Receives.Input = input
+
+	pages := l.ListLayersPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return l.ListLayersPagesCall.Returns.Error
+}
+
+func (l *LayersClient) ListLayerVersionsPages(input *awslambda.ListLayerVersionsInput, fn func(*awslambda.ListLayerVersionsOutput, bool) bool) error {
+	l.ListLayerVersionsPagesCall.CallCount++
+	l.ListLayerVersionsPagesCall.Receives.Input = input
+
+	pages := l.ListLayerVersionsPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return l.ListLayerVersionsPagesCall.Returns.Error
+}
+
+func (l *LayersClient) DeleteLayerVersion(input *awslambda.DeleteLayerVersionInput) (*awslambda.DeleteLayerVersionOutput, error) {
+	l.DeleteLayerVersionCall.CallCount++
+	l.DeleteLayerVersionCall.Receives.Input = input
+
+	return l.DeleteLayerVersionCall.Returns.Output, l.DeleteLayerVersionCall.Returns.Error
+}
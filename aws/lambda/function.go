@@ -0,0 +1,77 @@
+package lambda
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	awslambda "github.com/aws/aws-sdk-go/service/lambda"
+)
+
+type Function struct {
+	client     functionsClient
+	name       *string
+	identifier string
+	rtype      string
+}
+
+func NewFunction(client functionsClient, name *string, tags map[string]*string) Function {
+	identifier := *name
+
+	var keys []string
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var extra []string
+	for _, k := range keys {
+		extra = append(extra, fmt.Sprintf("%s:%s", k, *tags[k]))
+	}
+
+	if len(extra) > 0 {
+		identifier = fmt.Sprintf("%s (%s)", *name, strings.Join(extra, ", "))
+	}
+
+	return Function{
+		client:     client,
+		name:       name,
+		identifier: identifier,
+		rtype:      "Lambda Function",
+	}
+}
+
+// Delete removes the event source mappings of the function,
+// and deletes the function with all of its versions and aliases.
+func (f Function) Delete() error {
+	var mappings []*awslambda.EventSourceMappingConfiguration
+	err := f.client.ListEventSourceMappingsPages(&awslambda.ListEventSourceMappingsInput{FunctionName: f.name}, func(page *awslambda.ListEventSourceMappingsOutput, lastPage bool) bool {
+		mappings = append(mappings, page.EventSourceMappings...)
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("List event source mappings: %s", err)
+	}
+
+	for _, m := range mappings {
+		_, err = f.client.DeleteEventSourceMapping(&awslambda.DeleteEventSourceMappingInput{UUID: m.UUID})
+		if err != nil {
+			return fmt.Errorf("Delete event source mapping %s: %s", *m.UUID, err)
+		}
+	}
+
+	_, err = f.client.DeleteFunction(&awslambda.DeleteFunctionInput{FunctionName: f.name})
+	if err != nil {
+		return fmt.Errorf("Delete: %s", err)
+	}
+
+	return nil
+}
+
+func (f Function) Name() string {
+	return f.identifier
+}
+
+func (f Function) Type() string {
+	return f.rtype
+}
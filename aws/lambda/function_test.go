@@ -0,0 +1,85 @@
+package lambda_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awslambda "github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/genevieve/leftovers/aws/lambda"
+	"github.com/genevieve/leftovers/aws/lambda/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Function", func() {
+	var (
+		function lambda.Function
+		client   *fakes.FunctionsClient
+		name     *string
+	)
+
+	BeforeEach(func() {
+		client = &fakes.FunctionsClient{}
+		name = aws.String("the-function")
+
+		function = lambda.NewFunction(client, name, map[string]*string{"the-key": aws.String("the-value")})
+	})
+
+	Describe("Delete", func() {
+		BeforeEach(func() {
+			client.ListEventSourceMappingsPagesCall.Returns.Pages = []*awslambda.ListEventSourceMappingsOutput{{
+				EventSourceMappings: []*awslambda.EventSourceMappingConfiguration{{UUID: aws.String("the-mapping")}},
+			}}
+		})
+
+		It("removes the event source mappings and deletes the function", func() {
+			err := function.Delete()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.ListEventSourceMappingsPagesCall.Receives.Input.FunctionName).To(Equal(name))
+
+			Expect(client.DeleteEventSourceMappingCall.CallCount).To(Equal(1))
+			Expect(client.DeleteEventSourceMappingCall.Receives.Input.UUID).To(Equal(aws.String("the-mapping")))
+
+			Expect(client.DeleteFunctionCall.CallCount).To(Equal(1))
+			Expect(client.DeleteFunctionCall.Receives.Input.FunctionName).To(Equal(name))
+			Expect(client.DeleteFunctionCall.Receives.Input.Qualifier).To(BeNil())
+		})
+
+		Context("when the client fails to delete an event source mapping", func() {
+			BeforeEach(func() {
+				client.DeleteEventSourceMappingCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := function.Delete()
+				Expect(err).To(MatchError("Delete event source mapping the-mapping: banana"))
+
+				Expect(client.DeleteFunctionCall.CallCount).To(Equal(0))
+			})
+		})
+
+		Context("when the client fails to delete the function", func() {
+			BeforeEach(func() {
+				client.DeleteFunctionCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := function.Delete()
+				Expect(err).To(MatchError("Delete: banana"))
+			})
+		})
+	})
+
+	Describe("Name", func() {
+		It("returns the identifier", func() {
+			Expect(function.Name()).To(Equal("the-function (the-key:the-value)"))
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(function.Type()).To(Equal("Lambda Function"))
+		})
+	})
+})
@@ -0,0 +1,72 @@
+package lambda
+
+import (
+	"fmt"
+	"strings"
+
+	awslambda "github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/genevieve/leftovers/common"
+)
+
+type functionsClient interface {
+	ListFunctionsPages(*awslambda.ListFunctionsInput, func(*awslambda.ListFunctionsOutput, bool) bool) error
+	ListTags(*awslambda.ListTagsInput) (*awslambda.ListTagsOutput, error)
+	ListEventSourceMappingsPages(*awslambda.ListEventSourceMappingsInput, func(*awslambda.ListEventSourceMappingsOutput, bool) bool) error
+	DeleteEventSourceMapping(*awslambda.DeleteEventSourceMappingInput) (*awslambda.EventSourceMappingConfiguration, error)
+	DeleteFunction(*awslambda.DeleteFunctionInput) (*awslambda.DeleteFunctionOutput, error)
+}
+
+type logger interface {
+	Printf(m string, a ...interface{})
+	PromptWithDetails(resourceType, resourceName string) bool
+}
+
+type Functions struct {
+	client functionsClient
+	logger logger
+}
+
+func NewFunctions(client functionsClient, logger logger) Functions {
+	return Functions{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (f Functions) List(filter string) ([]common.Deletable, error) {
+	var functions []*awslambda.FunctionConfiguration
+	err := f.client.ListFunctionsPages(&awslambda.ListFunctionsInput{}, func(page *awslambda.ListFunctionsOutput, lastPage bool) bool {
+		functions = append(functions, page.Functions...)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("List Lambda Functions: %s", err)
+	}
+
+	var resources []common.Deletable
+	for _, function := range functions {
+		tags, err := f.client.ListTags(&awslambda.ListTagsInput{Resource: function.FunctionArn})
+		if err != nil {
+			return nil, fmt.Errorf("List tags for Lambda Function %s: %s", *function.FunctionName, err)
+		}
+
+		r := NewFunction(f.client, function.FunctionName, tags.Tags)
+
+		if !strings.Contains(r.Name(), filter) {
+			continue
+		}
+
+		proceed := f.logger.PromptWithDetails(r.Type(), r.Name())
+		if !proceed {
+			continue
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func (f Functions) Type() string {
+	return "lambda-function"
+}
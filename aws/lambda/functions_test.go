@@ -0,0 +1,127 @@
+package lambda_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awslambda "github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/genevieve/leftovers/aws/lambda"
+	"github.com/genevieve/leftovers/aws/lambda/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Functions", func() {
+	var (
+		client *fakes.FunctionsClient
+		logger *fakes.Logger
+
+		functions lambda.Functions
+	)
+
+	BeforeEach(func() {
+		client = &fakes.FunctionsClient{}
+		logger = &fakes.Logger{}
+		logger.PromptWithDetailsCall.Returns.Proceed = true
+
+		functions = lambda.NewFunctions(client, logger)
+	})
+
+	Describe("List", func() {
+		var filter string
+
+		BeforeEach(func() {
+			client.ListFunctionsPagesCall.Returns.Pages = []*awslambda.ListFunctionsOutput{{
+				Functions: []*awslambda.FunctionConfiguration{{
+					FunctionName: aws.String("the-function"),
+					FunctionArn:  aws.String("the-function-arn"),
+				}},
+			}}
+			client.ListTagsCall.Returns.Output = &awslambda.ListTagsOutput{
+				Tags: map[string]*string{"env": aws.String("banana"), "app": aws.String("kiwi")},
+			}
+			filter = "banana"
+		})
+
+		It("returns a list of lambda functions whose name or tags contain the filter", func() {
+			items, err := functions.List(filter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.ListFunctionsPagesCall.CallCount).To(Equal(1))
+			Expect(client.ListTagsCall.Receives.Input.Resource).To(Equal(aws.String("the-function-arn")))
+
+			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("Lambda Function"))
+			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("the-function (app:kiwi, env:banana)"))
+
+			Expect(items).To(HaveLen(1))
+		})
+
+		Context("when there is more than one page of functions", func() {
+			BeforeEach(func() {
+				client.ListFunctionsPagesCall.Returns.Pages = append(client.ListFunctionsPagesCall.Returns.Pages, &awslambda.ListFunctionsOutput{
+					Functions: []*awslambda.FunctionConfiguration{{
+						FunctionName: aws.String("the-other-function"),
+						FunctionArn:  aws.String("the-other-function-arn"),
+					}},
+				})
+			})
+
+			It("returns the functions from every page", func() {
+				items, err := functions.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(client.ListTagsCall.CallCount).To(Equal(2))
+				Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("the-other-function (app:kiwi, env:banana)"))
+
+				Expect(items).To(HaveLen(2))
+			})
+		})
+
+		Context("when neither the name nor the tags contain the filter", func() {
+			It("does not return it to the list", func() {
+				items, err := functions.List("mango")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+
+		Context("when the client fails to list functions", func() {
+			BeforeEach(func() {
+				client.ListFunctionsPagesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := functions.List(filter)
+				Expect(err).To(MatchError("List Lambda Functions: some error"))
+			})
+		})
+
+		Context("when the client fails to list tags", func() {
+			BeforeEach(func() {
+				client.ListTagsCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := functions.List(filter)
+				Expect(err).To(MatchError("List tags for Lambda Function the-function: some error"))
+			})
+		})
+
+		Context("when the user responds no to the prompt", func() {
+			BeforeEach(func() {
+				logger.PromptWithDetailsCall.Returns.Proceed = false
+			})
+
+			It("does not return it to the list", func() {
+				items, err := functions.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package lambda_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestLambda(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "aws/lambda")
+}
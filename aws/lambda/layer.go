@@ -0,0 +1,55 @@
+package lambda
+
+import (
+	"fmt"
+
+	awslambda "github.com/aws/aws-sdk-go/service/lambda"
+)
+
+type Layer struct {
+	client     layersClient
+	name       *string
+	identifier string
+	rtype      string
+}
+
+func NewLayer(client layersClient, name *string) Layer {
+	return Layer{
+		client:     client,
+		name:       name,
+		identifier: *name,
+		rtype:      "Lambda Layer",
+	}
+}
+
+// Delete deletes every version of the layer.
+func (l Layer) Delete() error {
+	var versions []*awslambda.LayerVersionsListItem
+	err := l.client.ListLayerVersionsPages(&awslambda.ListLayerVersionsInput{LayerName: l.name}, func(page *awslambda.ListLayerVersionsOutput, lastPage bool) bool {
+		versions = append(versions, page.LayerVersions...)
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("List versions: %s", err)
+	}
+
+	for _, v := range versions {
+		_, err = l.client.DeleteLayerVersion(&awslambda.DeleteLayerVersionInput{
+			LayerName:     l.name,
+			VersionNumber: v.Version,
+		})
+		if err != nil {
+			return fmt.Errorf("Delete version %d: %s", *v.Version, err)
+		}
+	}
+
+	return nil
+}
+
+func (l Layer) Name() string {
+	return l.identifier
+}
+
+func (l Layer) Type() string {
+	return l.rtype
+}
@@ -0,0 +1,71 @@
+package lambda_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awslambda "github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/genevieve/leftovers/aws/lambda"
+	"github.com/genevieve/leftovers/aws/lambda/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Layer", func() {
+	var (
+		layer  lambda.Layer
+		client *fakes.LayersClient
+		name   *string
+	)
+
+	BeforeEach(func() {
+		client = &fakes.LayersClient{}
+		name = aws.String("the-layer")
+
+		layer = lambda.NewLayer(client, name)
+	})
+
+	Describe("Delete", func() {
+		BeforeEach(func() {
+			client.ListLayerVersionsPagesCall.Returns.Pages = []*awslambda.ListLayerVersionsOutput{{
+				LayerVersions: []*awslambda.LayerVersionsListItem{{Version: aws.Int64(1)}},
+			}, {
+				LayerVersions: []*awslambda.LayerVersionsListItem{{Version: aws.Int64(2)}},
+			}}
+		})
+
+		It("deletes every version of the layer", func() {
+			err := layer.Delete()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.ListLayerVersionsPagesCall.Receives.Input.LayerName).To(Equal(name))
+
+			Expect(client.DeleteLayerVersionCall.CallCount).To(Equal(2))
+			Expect(client.DeleteLayerVersionCall.Receives.Input.LayerName).To(Equal(name))
+			Expect(client.DeleteLayerVersionCall.Receives.Input.VersionNumber).To(Equal(aws.Int64(2)))
+		})
+
+		Context("when the client fails to delete a version", func() {
+			BeforeEach(func() {
+				client.DeleteLayerVersionCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := layer.Delete()
+				Expect(err).To(MatchError("Delete version 1: banana"))
+			})
+		})
+	})
+
+	Describe("Name", func() {
+		It("returns the identifier", func() {
+			Expect(layer.Name()).To(Equal("the-layer"))
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(layer.Type()).To(Equal("Lambda Layer"))
+		})
+	})
+})
@@ -0,0 +1,60 @@
+package lambda
+
+import (
+	"fmt"
+	"strings"
+
+	awslambda "github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/genevieve/leftovers/common"
+)
+
+type layersClient interface {
+	ListLayersPages(*awslambda.ListLayersInput, func(*awslambda.ListLayersOutput, bool) bool) error
+	ListLayerVersionsPages(*awslambda.ListLayerVersionsInput, func(*awslambda.ListLayerVersionsOutput, bool) bool) error
+	DeleteLayerVersion(*awslambda.DeleteLayerVersionInput) (*awslambda.DeleteLayerVersionOutput, error)
+}
+
+type Layers struct {
+	client layersClient
+	logger logger
+}
+
+func NewLayers(client layersClient, logger logger) Layers {
+	return Layers{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (l Layers) List(filter string) ([]common.Deletable, error) {
+	var layers []*awslambda.LayersListItem
+	err := l.client.ListLayersPages(&awslambda.ListLayersInput{}, func(page *awslambda.ListLayersOutput, lastPage bool) bool {
+		layers = append(layers, page.Layers...)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("List Lambda Layers: %s", err)
+	}
+
+	var resources []common.Deletable
+	for _, layer := range layers {
+		r := NewLayer(l.client, layer.LayerName)
+
+		if !strings.Contains(r.Name(), filter) {
+			continue
+		}
+
+		proceed := l.logger.PromptWithDetails(r.Type(), r.Name())
+		if !proceed {
+			continue
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func (l Layers) Type() string {
+	return "lambda-layer"
+}
@@ -0,0 +1,76 @@
+package lambda_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awslambda "github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/genevieve/leftovers/aws/lambda"
+	"github.com/genevieve/leftovers/aws/lambda/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Layers", func() {
+	var (
+		client *fakes.LayersClient
+		logger *fakes.Logger
+
+		layers lambda.Layers
+	)
+
+	BeforeEach(func() {
+		client = &fakes.LayersClient{}
+		logger = &fakes.Logger{}
+		logger.PromptWithDetailsCall.Returns.Proceed = true
+
+		layers = lambda.NewLayers(client, logger)
+	})
+
+	Describe("List", func() {
+		var filter string
+
+		BeforeEach(func() {
+			client.ListLayersPagesCall.Returns.Pages = []*awslambda.ListLayersOutput{{
+				Layers: []*awslambda.LayersListItem{{LayerName: aws.String("banana-layer")}},
+			}, {
+				Layers: []*awslambda.LayersListItem{{LayerName: aws.String("other-banana-layer")}},
+			}}
+			filter = "banana"
+		})
+
+		It("returns a list of lambda layers from every page to delete", func() {
+			items, err := layers.List(filter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.ListLayersPagesCall.CallCount).To(Equal(1))
+
+			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(2))
+			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("Lambda Layer"))
+			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("other-banana-layer"))
+
+			Expect(items).To(HaveLen(2))
+		})
+
+		Context("when the layer name does not contain the filter", func() {
+			It("does not return it to the list", func() {
+				items, err := layers.List("kiwi")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+
+		Context("when the client fails to list layers", func() {
+			BeforeEach(func() {
+				client.ListLayersPagesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := layers.List(filter)
+				Expect(err).To(MatchError("List Lambda Layers: some error"))
+			})
+		})
+	})
+})
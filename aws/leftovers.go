@@ -1,33 +1,43 @@
 package aws
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"net/http"
 
 	awslib "github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
 	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
-	awseks "github.com/aws/aws-sdk-go/service/eks"
-	awselb "github.com/aws/aws-sdk-go/service/elb"
-	awselbv2 "github.com/aws/aws-sdk-go/service/elbv2"
 	awsiam "github.com/aws/aws-sdk-go/service/iam"
-	awskms "github.com/aws/aws-sdk-go/service/kms"
-	awsrds "github.com/aws/aws-sdk-go/service/rds"
 	awsroute53 "github.com/aws/aws-sdk-go/service/route53"
 	awss3 "github.com/aws/aws-sdk-go/service/s3"
-	awssts "github.com/aws/aws-sdk-go/service/sts"
 	"github.com/fatih/color"
 	"github.com/genevieve/leftovers/app"
+	"github.com/genevieve/leftovers/aws/acm"
+	"github.com/genevieve/leftovers/aws/autoscaling"
+	"github.com/genevieve/leftovers/aws/cloudformation"
+	"github.com/genevieve/leftovers/aws/cloudwatch"
+	"github.com/genevieve/leftovers/aws/dynamodb"
 	"github.com/genevieve/leftovers/aws/ec2"
+	"github.com/genevieve/leftovers/aws/ecs"
+	"github.com/genevieve/leftovers/aws/efs"
 	"github.com/genevieve/leftovers/aws/eks"
+	"github.com/genevieve/leftovers/aws/elasticache"
 	"github.com/genevieve/leftovers/aws/elb"
 	"github.com/genevieve/leftovers/aws/elbv2"
+	"github.com/genevieve/leftovers/aws/eventbridge"
 	"github.com/genevieve/leftovers/aws/iam"
 	"github.com/genevieve/leftovers/aws/kms"
+	"github.com/genevieve/leftovers/aws/lambda"
 	"github.com/genevieve/leftovers/aws/rds"
+	"github.com/genevieve/leftovers/aws/redshift"
 	"github.com/genevieve/leftovers/aws/route53"
 	"github.com/genevieve/leftovers/aws/s3"
+	"github.com/genevieve/leftovers/aws/secretsmanager"
+	"github.com/genevieve/leftovers/aws/sns"
+	"github.com/genevieve/leftovers/aws/sqs"
+	"github.com/genevieve/leftovers/aws/ssm"
 	"github.com/genevieve/leftovers/common"
 )
 
@@ -42,48 +52,150 @@ type Leftovers struct {
 	resources    []resource
 }
 
+// DeleteArgs change how resources are deleted. Secrets are deleted
+// without a recovery window if ForceDeleteWithoutRecovery is true.
+type DeleteArgs struct {
+	ForceDeleteWithoutRecovery bool
+}
+
 // NewLeftovers returns a new Leftovers for AWS that can be used to list resources,
-// list types, or delete resources for the provided account. It returns an error
-// if the credentials provided are invalid.
-func NewLeftovers(logger logger, accessKeyId, secretAccessKey, sessionToken, region string) (Leftovers, error) {
-	if accessKeyId == "" {
-		return Leftovers{}, errors.New("Missing aws access key id.")
+// list types, or delete resources for the account of the provided credentials.
+// It returns an error if no credentials are found. The region can be a
+// comma-separated list of regions, or all to sweep every region that is
+// enabled for the account, which are described in the region of the
+// profile, and defaults to the region of the profile.
+// Global services are only swept once, and when more than one region is swept
+// the names of regional resources are prefixed with their region. If backup is
+// enabled, stateful resources are snapshotted or archived before they are deleted.
+// Services are sent requests at the provided endpoints instead of AWS, if any.
+// If a transport is provided, it wraps the transport that requests are sent with.
+func NewLeftovers(logger logger, authArgs AuthArgs, region string, endpoints Endpoints, transport common.WrapTransport, backup common.Backup, deleteArgs DeleteArgs) (Leftovers, error) {
+	base, regions, err := newBaseSession(authArgs, region, endpoints, transport)
+	if err != nil {
+		return Leftovers{}, err
 	}
 
-	if secretAccessKey == "" {
-		return Leftovers{}, errors.New("Missing secret access key.")
+	return newLeftovers(logger, base, regions, backup, deleteArgs)
+}
+
+// newBaseSession returns the session of the provided credentials, in the
+// first of the regions to sweep, or in the region of the profile when
+// every region is swept, and those regions.
+func newBaseSession(authArgs AuthArgs, region string, endpoints Endpoints, transport common.WrapTransport) (*session.Session, []string, error) {
+	regions := parseRegions(region)
+
+	config := &awslib.Config{
+		HTTPClient:                    &http.Client{},
+		EndpointResolver:              endpoints,
+		S3ForcePathStyle:              awslib.Bool(endpoints.custom(awss3.EndpointsID)),
+		CredentialsChainVerboseErrors: awslib.Bool(true),
+	}
+	all := len(regions) == 1 && regions[0] == allRegions
+	if len(regions) > 0 && !all {
+		config.Region = awslib.String(regions[0])
 	}
 
-	if region == "" {
-		return Leftovers{}, errors.New("Missing region.")
+	base, err := newSession(authArgs, config, transport)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	config := &awslib.Config{
-		Credentials: credentials.NewStaticCredentials(accessKeyId, secretAccessKey, sessionToken),
-		Region:      awslib.String(region),
+	// The enabled regions are described in the region of the profile,
+	// which is in the partition of the account.
+	if all && awslib.StringValue(base.Config.Region) == "" {
+		base = base.Copy(&awslib.Config{Region: awslib.String(regionsRegion)})
+	}
+
+	if len(regions) == 0 && awslib.StringValue(base.Config.Region) != "" {
+		regions = []string{*base.Config.Region}
+	}
+
+	if len(regions) == 0 {
+		return nil, nil, errors.New("Missing region.")
+	}
+
+	return base, regions, nil
+}
+
+// newLeftovers returns a new Leftovers for the account of the provided
+// session that sweeps the provided regions, or every enabled region.
+func newLeftovers(logger logger, base *session.Session, regions []string, backup common.Backup, deleteArgs DeleteArgs) (Leftovers, error) {
+	regionSession := func(region string) *session.Session {
+		return base.Copy(&awslib.Config{Region: awslib.String(region)})
+	}
+
+	if len(regions) == 1 && regions[0] == allRegions {
+		var err error
+		regions, err = enabledRegions(awsec2.New(base))
+		if err != nil {
+			return Leftovers{}, err
+		}
+	}
+
+	clients := make([]regionClients, len(regions))
+	for i, r := range regions {
+		clients[i] = newRegionClients(regionSession(r), logger)
+		if len(regions) > 1 {
+			clients[i].logger = regionalLogger{logger: logger, region: r}
+		}
+	}
+
+	// inRegions returns the resource that the provided function
+	// builds with the clients of each region.
+	inRegions := func(build func(c regionClients) resource) resource {
+		if len(regions) == 1 {
+			return build(clients[0])
+		}
+
+		r := regionalResource{regions: regions}
+		for _, c := range clients {
+			r.resources = append(r.resources, build(c))
+		}
+		return r
+	}
+
+	// regional returns the resource that the provided function builds
+	// with the clients of each region, whose logger does not prompt for
+	// the resources that are deleted with the CloudFormation stacks of
+	// their region.
+	regional := func(build func(c regionClients) resource) resource {
+		return inRegions(func(c regionClients) resource {
+			c.logger = stackOwnedLogger{logger: c.logger, members: []*cloudformation.Members{c.members}}
+			return build(c)
+		})
+	}
+
+	// globalLogger does not prompt for the resources that are
+	// deleted with the CloudFormation stacks of any region.
+	var members []*cloudformation.Members
+	for _, c := range clients {
+		members = append(members, c.members)
 	}
-	sess := session.New(config)
+	globalLogger := stackOwnedLogger{logger: logger, members: members}
+
+	// globalListeners are the listeners of the load
+	// balancers that are being deleted in any region.
+	var globalListeners allListeners
+	for _, c := range clients {
+		globalListeners = append(globalListeners, c.elbListeners, c.elbv2Listeners)
+	}
+
+	// Global services are sent requests from the first region.
+	sess := regionSession(regions[0])
 
-	eksClient := awseks.New(sess)
-	ec2Client := awsec2.New(sess)
-	elbClient := awselb.New(sess)
-	elbv2Client := awselbv2.New(sess)
-	kmsClient := awskms.New(sess)
 	iamClient := awsiam.New(sess)
-	rdsClient := awsrds.New(sess)
 	route53Client := awsroute53.New(sess)
-	s3Client := awss3.New(sess)
-	stsClient := awssts.New(sess)
+	s3Client := clients[0].s3
 
 	rolePolicies := iam.NewRolePolicies(iamClient, logger)
 	userPolicies := iam.NewUserPolicies(iamClient, logger)
 	accessKeys := iam.NewAccessKeys(iamClient, logger)
 
-	internetGateways := ec2.NewInternetGateways(ec2Client, logger)
-	resourceTags := ec2.NewResourceTags(ec2Client)
-	routeTables := ec2.NewRouteTables(ec2Client, logger, resourceTags)
-	subnets := ec2.NewSubnets(ec2Client, logger, resourceTags)
-	bucketManager := s3.NewBucketManager(region)
+	bucketManager := s3.NewBucketManager(sess)
+	bucketClients := map[string]s3.BucketsClient{}
+	for i, r := range regions {
+		bucketClients[r] = clients[i].s3
+	}
 
 	recordSets := route53.NewRecordSets(route53Client)
 
@@ -93,41 +205,214 @@ func NewLeftovers(logger logger, accessKeyId, secretAccessKey, sessionToken, reg
 		logger:       logger,
 		asyncDeleter: asyncDeleter,
 		resources: []resource{
-			elb.NewLoadBalancers(elbClient, logger),
-			elbv2.NewLoadBalancers(elbv2Client, logger),
-			elbv2.NewTargetGroups(elbv2Client, logger),
-
-			iam.NewInstanceProfiles(iamClient, logger),
-			iam.NewRoles(iamClient, logger, rolePolicies),
-			iam.NewUsers(iamClient, logger, userPolicies, accessKeys),
-			iam.NewPolicies(iamClient, logger),
-			iam.NewServerCertificates(iamClient, logger),
-
-			eks.NewClusters(eksClient, logger),
-
-			ec2.NewKeyPairs(ec2Client, logger),
-			ec2.NewInstances(ec2Client, logger, resourceTags),
-			ec2.NewSecurityGroups(ec2Client, logger, resourceTags),
-			ec2.NewTags(ec2Client, logger),
-			ec2.NewVolumes(ec2Client, logger),
-			ec2.NewNetworkInterfaces(ec2Client, logger),
-			ec2.NewNatGateways(ec2Client, logger),
-			ec2.NewVpcs(ec2Client, logger, routeTables, subnets, internetGateways, resourceTags),
-			ec2.NewImages(ec2Client, stsClient, logger, resourceTags),
-			ec2.NewAddresses(ec2Client, logger),
-			ec2.NewSnapshots(ec2Client, stsClient, logger),
-
-			s3.NewBuckets(s3Client, logger, bucketManager),
-
-			rds.NewDBInstances(rdsClient, logger),
-			rds.NewDBSubnetGroups(rdsClient, logger),
-			rds.NewDBClusters(rdsClient, logger),
-
-			kms.NewAliases(kmsClient, logger),
-			kms.NewKeys(kmsClient, logger),
-
-			route53.NewHostedZones(route53Client, logger, recordSets),
-			route53.NewHealthChecks(route53Client, logger),
+			inRegions(func(c regionClients) resource {
+				return cloudformation.NewStacks(c.cloudformation, c.logger, c.members)
+			}),
+
+			regional(func(c regionClients) resource {
+				return elb.NewLoadBalancers(c.elb, c.logger, c.elbListeners)
+			}),
+			regional(func(c regionClients) resource {
+				return elbv2.NewLoadBalancers(c.elbv2, c.logger, c.elbv2Listeners)
+			}),
+			regional(func(c regionClients) resource {
+				return elbv2.NewTargetGroups(c.elbv2, c.logger)
+			}),
+
+			iam.NewInstanceProfiles(iamClient, globalLogger),
+			iam.NewRoles(iamClient, globalLogger, rolePolicies),
+			iam.NewUsers(iamClient, globalLogger, userPolicies, accessKeys),
+			iam.NewPolicies(iamClient, globalLogger),
+			iam.NewServerCertificates(iamClient, globalLogger, globalListeners),
+			regional(func(c regionClients) resource {
+				return acm.NewCertificates(c.acm, c.logger, allListeners{c.elbListeners, c.elbv2Listeners})
+			}),
+
+			regional(func(c regionClients) resource {
+				return eks.NewClusters(c.eks, c.logger)
+			}),
+
+			regional(func(c regionClients) resource {
+				return ecs.NewClusters(c.ecs, c.logger)
+			}),
+			regional(func(c regionClients) resource {
+				return ecs.NewTaskDefinitions(c.ecs, c.logger)
+			}),
+			regional(func(c regionClients) resource {
+				return ecs.NewRepositories(c.ecr, c.logger)
+			}),
+
+			regional(func(c regionClients) resource {
+				return lambda.NewFunctions(c.lambda, c.logger)
+			}),
+			regional(func(c regionClients) resource {
+				return lambda.NewLayers(c.lambda, c.logger)
+			}),
+
+			regional(func(c regionClients) resource {
+				return cloudwatch.NewLogGroups(c.cloudwatchlogs, c.logger)
+			}),
+			regional(func(c regionClients) resource {
+				return cloudwatch.NewCompositeAlarms(c.cloudwatch, c.logger)
+			}),
+			regional(func(c regionClients) resource {
+				return cloudwatch.NewMetricAlarms(c.cloudwatch, c.logger)
+			}),
+			regional(func(c regionClients) resource {
+				return cloudwatch.NewDashboards(c.cloudwatch, c.logger)
+			}),
+
+			regional(func(c regionClients) resource {
+				return eventbridge.NewRules(c.eventbridge, c.logger)
+			}),
+			regional(func(c regionClients) resource {
+				return sns.NewTopics(c.sns, c.logger)
+			}),
+			regional(func(c regionClients) resource {
+				return sqs.NewQueues(c.sqs, c.logger)
+			}),
+
+			regional(func(c regionClients) resource {
+				return secretsmanager.NewSecrets(c.secretsmanager, c.logger, deleteArgs.ForceDeleteWithoutRecovery)
+			}),
+			regional(func(c regionClients) resource {
+				return ssm.NewParameters(c.ssm, c.logger)
+			}),
+
+			regional(func(c regionClients) resource {
+				return autoscaling.NewGroups(c.autoscaling, c.logger)
+			}),
+			regional(func(c regionClients) resource {
+				return autoscaling.NewLaunchConfigurations(c.autoscaling, c.logger)
+			}),
+			regional(func(c regionClients) resource {
+				return ec2.NewLaunchTemplates(c.ec2, c.logger)
+			}),
+
+			regional(func(c regionClients) resource {
+				return dynamodb.NewTables(c.dynamodb, c.logger, backup)
+			}),
+
+			regional(func(c regionClients) resource {
+				return elasticache.NewReplicationGroups(c.elasticache, c.logger, backup)
+			}),
+			regional(func(c regionClients) resource {
+				return elasticache.NewCacheClusters(c.elasticache, c.logger, backup)
+			}),
+			regional(func(c regionClients) resource {
+				return elasticache.NewSubnetGroups(c.elasticache, c.logger)
+			}),
+
+			regional(func(c regionClients) resource {
+				return redshift.NewClusters(c.redshift, c.logger, backup)
+			}),
+			regional(func(c regionClients) resource {
+				return redshift.NewClusterSubnetGroups(c.redshift, c.logger)
+			}),
+
+			regional(func(c regionClients) resource {
+				return efs.NewFileSystems(c.efs, c.logger)
+			}),
+
+			regional(func(c regionClients) resource {
+				return ec2.NewKeyPairs(c.ec2, c.logger)
+			}),
+			regional(func(c regionClients) resource {
+				return ec2.NewInstances(c.ec2, c.logger, c.resourceTags)
+			}),
+			regional(func(c regionClients) resource {
+				return ec2.NewSecurityGroups(c.ec2, c.logger, c.resourceTags)
+			}),
+			regional(func(c regionClients) resource {
+				return ec2.NewTags(c.ec2, c.logger)
+			}),
+			regional(func(c regionClients) resource {
+				return ec2.NewVolumes(c.ec2, c.logger, backup)
+			}),
+			regional(func(c regionClients) resource {
+				return ec2.NewNetworkInterfaces(c.ec2, c.logger)
+			}),
+			regional(func(c regionClients) resource {
+				return ec2.NewNatGateways(c.ec2, c.logger)
+			}),
+			regional(func(c regionClients) resource {
+				return ec2.NewTransitGatewayAttachments(c.ec2, c.logger)
+			}),
+			regional(func(c regionClients) resource {
+				return ec2.NewTransitGatewayRouteTables(c.ec2, c.logger)
+			}),
+			regional(func(c regionClients) resource {
+				return ec2.NewTransitGateways(c.ec2, c.logger,
+					ec2.NewTransitGatewayAttachments(c.ec2, c.logger),
+					ec2.NewTransitGatewayRouteTables(c.ec2, c.logger))
+			}),
+			regional(func(c regionClients) resource {
+				return ec2.NewVpcEndpoints(c.ec2, c.logger)
+			}),
+			regional(func(c regionClients) resource {
+				return ec2.NewVpcPeeringConnections(c.ec2, c.logger)
+			}),
+			regional(func(c regionClients) resource {
+				return ec2.NewEgressOnlyInternetGateways(c.ec2, c.logger)
+			}),
+			regional(func(c regionClients) resource {
+				return ec2.NewNetworkAcls(c.ec2, c.logger)
+			}),
+			regional(func(c regionClients) resource {
+				return ec2.NewVpnGateways(c.ec2, c.logger)
+			}),
+			regional(func(c regionClients) resource {
+				return ec2.NewCustomerGateways(c.ec2, c.logger)
+			}),
+			regional(func(c regionClients) resource {
+				routeTables := ec2.NewRouteTables(c.ec2, c.logger, c.resourceTags)
+				subnets := ec2.NewSubnets(c.ec2, c.logger, c.resourceTags)
+				internetGateways := ec2.NewInternetGateways(c.ec2, c.logger)
+				dependencies := ec2.VpcDependencies{
+					NatGateways:                ec2.NewNatGateways(c.ec2, c.logger),
+					Endpoints:                  ec2.NewVpcEndpoints(c.ec2, c.logger),
+					PeeringConnections:         ec2.NewVpcPeeringConnections(c.ec2, c.logger),
+					NetworkAcls:                ec2.NewNetworkAcls(c.ec2, c.logger),
+					EgressOnlyInternetGateways: ec2.NewEgressOnlyInternetGateways(c.ec2, c.logger),
+					VpnGateways:                ec2.NewVpnGateways(c.ec2, c.logger),
+					DhcpOptionsSets:            ec2.NewDhcpOptionsSets(c.ec2, c.logger),
+				}
+				return ec2.NewVpcs(c.ec2, c.logger, routeTables, subnets, internetGateways, dependencies, c.resourceTags)
+			}),
+			regional(func(c regionClients) resource {
+				return ec2.NewDhcpOptionsSets(c.ec2, c.logger)
+			}),
+			regional(func(c regionClients) resource {
+				return ec2.NewImages(c.ec2, c.sts, c.logger, c.resourceTags)
+			}),
+			regional(func(c regionClients) resource {
+				return ec2.NewAddresses(c.ec2, c.logger)
+			}),
+			regional(func(c regionClients) resource {
+				return ec2.NewSnapshots(c.ec2, c.sts, c.logger)
+			}),
+
+			s3.NewBuckets(s3Client, bucketClients, globalLogger, bucketManager, backup),
+
+			regional(func(c regionClients) resource {
+				return rds.NewDBInstances(c.rds, c.logger, backup)
+			}),
+			regional(func(c regionClients) resource {
+				return rds.NewDBSubnetGroups(c.rds, c.logger)
+			}),
+			regional(func(c regionClients) resource {
+				return rds.NewDBClusters(c.rds, c.logger, backup)
+			}),
+
+			regional(func(c regionClients) resource {
+				return kms.NewAliases(c.kms, c.logger)
+			}),
+			regional(func(c regionClients) resource {
+				return kms.NewKeys(c.kms, c.logger)
+			}),
+
+			route53.NewHostedZones(route53Client, globalLogger, recordSets),
+			route53.NewHealthChecks(route53Client, globalLogger),
 		},
 	}, nil
 }
@@ -151,7 +436,7 @@ func (l Leftovers) List(filter string) {
 	for _, r := range l.resources {
 		list, err := r.List(filter)
 		if err != nil {
-			l.logger.Println(err.Error())
+			l.logger.Errorln(err.Error())
 		}
 
 		all = append(all, list...)
@@ -166,38 +451,38 @@ func (l Leftovers) List(filter string) {
 // the provided filter in the resource's identifier, prompt
 // you to confirm deletion (if enabled), and delete those
 // that are selected.
-func (l Leftovers) Delete(filter string) error {
+func (l Leftovers) Delete(ctx context.Context, filter string) error {
 	deletables := [][]common.Deletable{}
 
 	for _, r := range l.resources {
 		list, err := r.List(filter)
 		if err != nil {
-			l.logger.Println(color.YellowString(err.Error()))
+			l.logger.Errorln(color.YellowString(err.Error()))
 		}
 
 		deletables = append(deletables, list)
 	}
 
-	return l.asyncDeleter.Run(deletables)
+	return l.asyncDeleter.Run(ctx, deletables)
 }
 
 // DeleteType will collect all resources of the provied type that contain
 // the provided filter in the resource's identifier, prompt
 // you to confirm deletion (if enabled), and delete those
 // that are selected.
-func (l Leftovers) DeleteType(filter, rType string) error {
+func (l Leftovers) DeleteType(ctx context.Context, filter, rType string) error {
 	deletables := [][]common.Deletable{}
 
 	for _, r := range l.resources {
 		if r.Type() == rType {
 			list, err := r.List(filter)
 			if err != nil {
-				l.logger.Println(color.YellowString(err.Error()))
+				l.logger.Errorln(color.YellowString(err.Error()))
 			}
 
 			deletables = append(deletables, list)
 		}
 	}
 
-	return l.asyncDeleter.Run(deletables)
+	return l.asyncDeleter.Run(ctx, deletables)
 }
@@ -0,0 +1,44 @@
+package aws_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+
+	"github.com/fatih/color"
+	"github.com/genevieve/leftovers/app"
+	"github.com/genevieve/leftovers/aws"
+	"github.com/genevieve/leftovers/common"
+	"github.com/genevieve/leftovers/recorder"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Leftovers", func() {
+	var (
+		stdout    *bytes.Buffer
+		leftovers aws.Leftovers
+	)
+
+	BeforeEach(func() {
+		color.NoColor = true
+
+		rec, err := recorder.New("testdata/fakecloud.json", recorder.ModeReplay)
+		Expect(err).NotTo(HaveOccurred())
+
+		stdout = bytes.NewBuffer([]byte{})
+		logger := app.NewLogger(stdout, os.Stdin, true)
+
+		leftovers, err = aws.NewLeftovers(logger, aws.AuthArgs{AccessKeyID: "access-key-id", SecretAccessKey: "secret-access-key"}, "us-east-1", aws.Endpoints{}, rec.Wrap, common.Backup{}, aws.DeleteArgs{})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("deletes the recorded resources", func() {
+		err := leftovers.DeleteType(context.Background(), "leftovers", "ec2-volume")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(stdout.String()).To(ContainSubstring("[EC2 Volume: vol-0leftovers (State:available) (Name:leftovers-replay)] Deleted!"))
+		Expect(stdout.String()).NotTo(ContainSubstring("No recorded response"))
+	})
+})
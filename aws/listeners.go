@@ -0,0 +1,77 @@
+package aws
+
+import "strings"
+
+// listeners are the load balancers that are being deleted and the
+// certificates that their listeners use. A certificate that is only
+// used by them is released once they are deleted.
+type listeners struct {
+	loadBalancers map[string]bool
+	certificates  map[string]bool
+}
+
+func newListeners() *listeners {
+	l := &listeners{}
+	l.Reset()
+	return l
+}
+
+func (l *listeners) Reset() {
+	l.loadBalancers = map[string]bool{}
+	l.certificates = map[string]bool{}
+}
+
+// Add adds a load balancer that is being deleted, by ARN or, for a classic
+// load balancer, by loadbalancer/<name>, and the certificates of its listeners.
+func (l *listeners) Add(loadBalancer string, certificates []string) {
+	l.loadBalancers[loadBalancerResource(loadBalancer)] = true
+
+	for _, c := range certificates {
+		l.certificates[c] = true
+	}
+}
+
+// Deleting returns true if the provided ARN is
+// the ARN of a load balancer that is being deleted.
+func (l *listeners) Deleting(arn string) bool {
+	return l.loadBalancers[loadBalancerResource(arn)]
+}
+
+// Releases returns true if the listeners of a load balancer
+// that is being deleted use the provided certificate.
+func (l *listeners) Releases(certificate string) bool {
+	return l.certificates[certificate]
+}
+
+// allListeners are the listeners of the classic and
+// application or network load balancers of one or more regions.
+type allListeners []*listeners
+
+func (a allListeners) Deleting(arn string) bool {
+	for _, l := range a {
+		if l.Deleting(arn) {
+			return true
+		}
+	}
+	return false
+}
+
+func (a allListeners) Releases(certificate string) bool {
+	for _, l := range a {
+		if l.Releases(certificate) {
+			return true
+		}
+	}
+	return false
+}
+
+// loadBalancerResource returns the resource of a load balancer ARN, which
+// is loadbalancer/<name> for classic load balancers, since their ARN is
+// not returned when they are described.
+func loadBalancerResource(arn string) string {
+	parts := strings.SplitN(arn, ":", 6)
+	if len(parts) < 6 {
+		return arn
+	}
+	return parts[5]
+}
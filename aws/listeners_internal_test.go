@@ -0,0 +1,44 @@
+package aws
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("listeners", func() {
+	var (
+		elbListeners   *listeners
+		elbv2Listeners *listeners
+		all            allListeners
+	)
+
+	BeforeEach(func() {
+		elbListeners = newListeners()
+		elbv2Listeners = newListeners()
+		all = allListeners{elbListeners, elbv2Listeners}
+
+		elbListeners.Add("loadbalancer/banana", []string{"arn:aws:iam::123456789012:server-certificate/banana"})
+		elbv2Listeners.Add("arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/kiwi/1234", []string{"arn:aws:acm:us-east-1:123456789012:certificate/kiwi"})
+	})
+
+	It("knows the load balancers that are being deleted by their ARN", func() {
+		Expect(all.Deleting("arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/banana")).To(BeTrue())
+		Expect(all.Deleting("arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/kiwi/1234")).To(BeTrue())
+		Expect(all.Deleting("arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/mango/5678")).To(BeFalse())
+		Expect(all.Deleting("arn:aws:cloudfront::123456789012:distribution/banana")).To(BeFalse())
+	})
+
+	It("knows the certificates that their listeners use", func() {
+		Expect(all.Releases("arn:aws:iam::123456789012:server-certificate/banana")).To(BeTrue())
+		Expect(all.Releases("arn:aws:acm:us-east-1:123456789012:certificate/kiwi")).To(BeTrue())
+		Expect(all.Releases("arn:aws:iam::123456789012:server-certificate/mango")).To(BeFalse())
+	})
+
+	It("forgets them when they are listed again", func() {
+		elbListeners.Reset()
+
+		Expect(all.Deleting("arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/banana")).To(BeFalse())
+		Expect(all.Releases("arn:aws:iam::123456789012:server-certificate/banana")).To(BeFalse())
+		Expect(all.Releases("arn:aws:acm:us-east-1:123456789012:certificate/kiwi")).To(BeTrue())
+	})
+})
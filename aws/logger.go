@@ -3,6 +3,9 @@ package aws
 type logger interface {
 	Printf(m string, a ...interface{})
 	Println(m string)
+	Errorln(m string)
+	Verbosef(m string, a ...interface{})
+	Debugf(m string, a ...interface{})
 	PromptWithDetails(resourceType, resourceName string) bool
 	NoConfirm()
 }
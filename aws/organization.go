@@ -0,0 +1,220 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	awslib "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	awsorganizations "github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/fatih/color"
+	"github.com/genevieve/leftovers/common"
+	multierror "github.com/hashicorp/go-multierror"
+)
+
+// defaultOrganizationRole is the role that AWS Organizations
+// creates in the accounts that it creates.
+const defaultOrganizationRole = "OrganizationAccountAccessRole"
+
+// OrganizationArgs select the member accounts of an AWS Organization to
+// sweep, and the role to assume in each of them. If accounts are included,
+// only those are swept. Excluded accounts are never swept.
+type OrganizationArgs struct {
+	Role    string
+	Include []string
+	Exclude []string
+}
+
+type organizationsClient interface {
+	DescribeOrganization(*awsorganizations.DescribeOrganizationInput) (*awsorganizations.DescribeOrganizationOutput, error)
+	ListAccountsPages(*awsorganizations.ListAccountsInput, func(*awsorganizations.ListAccountsOutput, bool) bool) error
+}
+
+type account struct {
+	id        string
+	name      string
+	leftovers Leftovers
+	err       error
+}
+
+type OrganizationLeftovers struct {
+	logger   logger
+	accounts []account
+}
+
+// NewOrganizationLeftovers returns a new OrganizationLeftovers that lists
+// resources, lists types, or deletes resources in every member account of
+// the AWS Organization of the provided credentials, like a Leftovers for
+// each account that is found with the role assumed in it. It returns an
+// error if the accounts cannot be listed, or if the management account,
+// which is never swept, is included.
+func NewOrganizationLeftovers(logger logger, authArgs AuthArgs, region string, endpoints Endpoints, transport common.WrapTransport, backup common.Backup, deleteArgs DeleteArgs, organizationArgs OrganizationArgs) (OrganizationLeftovers, error) {
+	base, regions, err := newBaseSession(authArgs, region, endpoints, transport)
+	if err != nil {
+		return OrganizationLeftovers{}, err
+	}
+
+	members, err := memberAccounts(awsorganizations.New(base), organizationArgs)
+	if err != nil {
+		return OrganizationLeftovers{}, err
+	}
+
+	role := organizationArgs.Role
+	if role == "" {
+		role = defaultOrganizationRole
+	}
+
+	var accounts []account
+	for _, member := range members {
+		a := account{
+			id:   *member.Id,
+			name: awslib.StringValue(member.Name),
+		}
+
+		partition := "aws"
+		if parsed, err := arn.Parse(awslib.StringValue(member.Arn)); err == nil {
+			partition = parsed.Partition
+		}
+		roleARN := fmt.Sprintf("arn:%s:iam::%s:role/%s", partition, a.id, role)
+
+		sess := assumeRole(base, roleARN, "", authArgs.SessionName)
+		if _, err := sess.Config.Credentials.Get(); err != nil {
+			a.err = fmt.Errorf("Assuming %s: %s", roleARN, err)
+		} else {
+			a.leftovers, a.err = newLeftovers(logger, sess, regions, backup, deleteArgs)
+		}
+
+		accounts = append(accounts, a)
+	}
+
+	return OrganizationLeftovers{
+		logger:   logger,
+		accounts: accounts,
+	}, nil
+}
+
+// memberAccounts returns the active member accounts of the organization
+// that are selected by the provided OrganizationArgs. The management
+// account is never swept, so it returns an error if it is included.
+func memberAccounts(client organizationsClient, organizationArgs OrganizationArgs) ([]*awsorganizations.Account, error) {
+	org, err := client.DescribeOrganization(&awsorganizations.DescribeOrganizationInput{})
+	if err != nil {
+		return nil, fmt.Errorf("Describing organization: %s", err)
+	}
+	management := awslib.StringValue(org.Organization.MasterAccountId)
+
+	if contains(organizationArgs.Include, management) {
+		return nil, fmt.Errorf("Account %s is the management account of the organization, which is only swept without --aws-organization.", management)
+	}
+
+	var members []*awsorganizations.Account
+	err = client.ListAccountsPages(&awsorganizations.ListAccountsInput{}, func(page *awsorganizations.ListAccountsOutput, lastPage bool) bool {
+		for _, a := range page.Accounts {
+			id := awslib.StringValue(a.Id)
+
+			if id == management || awslib.StringValue(a.Status) != awsorganizations.AccountStatusActive {
+				continue
+			}
+
+			if len(organizationArgs.Include) > 0 && !contains(organizationArgs.Include, id) {
+				continue
+			}
+
+			if contains(organizationArgs.Exclude, id) {
+				continue
+			}
+
+			members = append(members, a)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Listing accounts: %s", err)
+	}
+
+	return members, nil
+}
+
+// Types will print all the resource types that can
+// be deleted on this IaaS.
+func (o OrganizationLeftovers) Types() {
+	for _, a := range o.accounts {
+		if a.err == nil {
+			a.leftovers.Types()
+			return
+		}
+	}
+}
+
+// List will print all the resources that contain
+// the provided filter in the resource's identifier,
+// grouped by the account that they are in.
+func (o OrganizationLeftovers) List(filter string) {
+	o.logger.NoConfirm()
+
+	for _, a := range o.accounts {
+		o.logger.Println(a.header())
+
+		if a.err != nil {
+			o.logger.Errorln(color.YellowString(a.err.Error()))
+			continue
+		}
+
+		a.leftovers.List(filter)
+	}
+}
+
+// Delete will collect all resources that contain
+// the provided filter in the resource's identifier, prompt
+// you to confirm deletion (if enabled), and delete those
+// that are selected, one account at a time.
+func (o OrganizationLeftovers) Delete(ctx context.Context, filter string) error {
+	return o.each(func(l Leftovers) error {
+		return l.Delete(ctx, filter)
+	})
+}
+
+// DeleteType will collect all resources of the provied type that contain
+// the provided filter in the resource's identifier, prompt
+// you to confirm deletion (if enabled), and delete those
+// that are selected, one account at a time.
+func (o OrganizationLeftovers) DeleteType(ctx context.Context, filter, rType string) error {
+	return o.each(func(l Leftovers) error {
+		return l.DeleteType(ctx, filter, rType)
+	})
+}
+
+func (o OrganizationLeftovers) each(run func(l Leftovers) error) error {
+	var result *multierror.Error
+
+	for _, a := range o.accounts {
+		o.logger.Println(a.header())
+
+		err := a.err
+		if err == nil {
+			err = run(a.leftovers)
+		}
+
+		if err != nil {
+			if a.err != nil {
+				o.logger.Errorln(color.YellowString(err.Error()))
+			}
+			result = multierror.Append(result, fmt.Errorf("Account %s: %s", a.id, err))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+func (a account) header() string {
+	return color.BlueString("Account %s (%s):", a.id, a.name)
+}
+
+func contains(list []string, item string) bool {
+	for _, i := range list {
+		if i == item {
+			return true
+		}
+	}
+	return false
+}
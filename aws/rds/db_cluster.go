@@ -5,34 +5,49 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	awsrds "github.com/aws/aws-sdk-go/service/rds"
+	"github.com/genevieve/leftovers/common"
 )
 
 type DBCluster struct {
 	client     dbClustersClient
+	logger     logger
 	name       *string
 	identifier string
 	rtype      string
+	backup     bool
 }
 
-func NewDBCluster(client dbClustersClient, name *string) DBCluster {
+func NewDBCluster(client dbClustersClient, logger logger, name *string, backup bool) DBCluster {
 	return DBCluster{
 		client:     client,
+		logger:     logger,
 		name:       name,
 		identifier: *name,
 		rtype:      "RDS DB Cluster",
+		backup:     backup,
 	}
 }
 
 func (d DBCluster) Delete() error {
-	_, err := d.client.DeleteDBCluster(&awsrds.DeleteDBClusterInput{
+	input := &awsrds.DeleteDBClusterInput{
 		DBClusterIdentifier: d.name,
 		SkipFinalSnapshot:   aws.Bool(true),
-	})
+	}
+
+	if d.backup {
+		input.SkipFinalSnapshot = aws.Bool(false)
+		input.FinalDBSnapshotIdentifier = aws.String(common.BackupName(d.identifier, 255))
+	}
 
+	_, err := d.client.DeleteDBCluster(input)
 	if err != nil {
 		return fmt.Errorf("Delete: %s", err)
 	}
 
+	if d.backup {
+		d.logger.Printf("[%s: %s] Final snapshot: %s\n", d.rtype, d.identifier, *input.FinalDBSnapshotIdentifier)
+	}
+
 	return nil
 }
 
@@ -2,6 +2,7 @@ package rds_test
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/genevieve/leftovers/aws/rds"
@@ -15,16 +16,18 @@ var _ = Describe("DBCluster", func() {
 	var (
 		dbCluster    rds.DBCluster
 		client       *fakes.DBClustersClient
+		logger       *fakes.Logger
 		name         *string
 		skipSnapshot *bool
 	)
 
 	BeforeEach(func() {
 		client = &fakes.DBClustersClient{}
+		logger = &fakes.Logger{}
 		name = aws.String("the-name")
 		skipSnapshot = aws.Bool(true)
 
-		dbCluster = rds.NewDBCluster(client, name)
+		dbCluster = rds.NewDBCluster(client, logger, name, false)
 	})
 
 	Describe("Delete", func() {
@@ -35,6 +38,25 @@ var _ = Describe("DBCluster", func() {
 			Expect(client.DeleteDBClusterCall.CallCount).To(Equal(1))
 			Expect(client.DeleteDBClusterCall.Receives.Input.DBClusterIdentifier).To(Equal(name))
 			Expect(client.DeleteDBClusterCall.Receives.Input.SkipFinalSnapshot).To(Equal(skipSnapshot))
+			Expect(client.DeleteDBClusterCall.Receives.Input.FinalDBSnapshotIdentifier).To(BeNil())
+		})
+
+		Context("when backup is enabled", func() {
+			BeforeEach(func() {
+				dbCluster = rds.NewDBCluster(client, logger, name, true)
+			})
+
+			It("takes a final cluster snapshot and logs its name", func() {
+				err := dbCluster.Delete()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(client.DeleteDBClusterCall.Receives.Input.SkipFinalSnapshot).To(Equal(aws.Bool(false)))
+				Expect(*client.DeleteDBClusterCall.Receives.Input.FinalDBSnapshotIdentifier).To(HavePrefix("the-name-leftovers-"))
+
+				Expect(logger.PrintfCall.Messages).To(ConsistOf(
+					fmt.Sprintf("[RDS DB Cluster: the-name] Final snapshot: %s\n", *client.DeleteDBClusterCall.Receives.Input.FinalDBSnapshotIdentifier),
+				))
+			})
 		})
 
 		Context("when the client fails", func() {
@@ -9,31 +9,37 @@ import (
 )
 
 type dbClustersClient interface {
-	DescribeDBClusters(*awsrds.DescribeDBClustersInput) (*awsrds.DescribeDBClustersOutput, error)
+	DescribeDBClustersPages(*awsrds.DescribeDBClustersInput, func(*awsrds.DescribeDBClustersOutput, bool) bool) error
 	DeleteDBCluster(*awsrds.DeleteDBClusterInput) (*awsrds.DeleteDBClusterOutput, error)
 }
 
 type DBClusters struct {
 	client dbClustersClient
 	logger logger
+	backup common.Backup
 }
 
-func NewDBClusters(client dbClustersClient, logger logger) DBClusters {
+func NewDBClusters(client dbClustersClient, logger logger, backup common.Backup) DBClusters {
 	return DBClusters{
 		client: client,
 		logger: logger,
+		backup: backup,
 	}
 }
 
 func (d DBClusters) List(filter string) ([]common.Deletable, error) {
-	dbClusters, err := d.client.DescribeDBClusters(&awsrds.DescribeDBClustersInput{})
+	var clusters []*awsrds.DBCluster
+	err := d.client.DescribeDBClustersPages(&awsrds.DescribeDBClustersInput{}, func(page *awsrds.DescribeDBClustersOutput, lastPage bool) bool {
+		clusters = append(clusters, page.DBClusters...)
+		return true
+	})
 	if err != nil {
 		return nil, fmt.Errorf("Describing RDS DB Clusters: %s", err)
 	}
 
 	var resources []common.Deletable
-	for _, db := range dbClusters.DBClusters {
-		r := NewDBCluster(d.client, db.DBClusterIdentifier)
+	for _, db := range clusters {
+		r := NewDBCluster(d.client, d.logger, db.DBClusterIdentifier, d.backup.Enabled)
 
 		if *db.Status == "deleting" {
 			continue
@@ -7,6 +7,7 @@ import (
 	awsrds "github.com/aws/aws-sdk-go/service/rds"
 	"github.com/genevieve/leftovers/aws/rds"
 	"github.com/genevieve/leftovers/aws/rds/fakes"
+	"github.com/genevieve/leftovers/common"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -24,7 +25,7 @@ var _ = Describe("DBClusters", func() {
 		client = &fakes.DBClustersClient{}
 		logger = &fakes.Logger{}
 
-		dbClusters = rds.NewDBClusters(client, logger)
+		dbClusters = rds.NewDBClusters(client, logger, common.Backup{})
 	})
 
 	Describe("List", func() {
@@ -32,12 +33,12 @@ var _ = Describe("DBClusters", func() {
 
 		BeforeEach(func() {
 			logger.PromptWithDetailsCall.Returns.Proceed = true
-			client.DescribeDBClustersCall.Returns.Output = &awsrds.DescribeDBClustersOutput{
+			client.DescribeDBClustersPagesCall.Returns.Pages = []*awsrds.DescribeDBClustersOutput{{
 				DBClusters: []*awsrds.DBCluster{{
 					DBClusterIdentifier: aws.String("banana"),
 					Status:              aws.String("status"),
 				}},
-			}
+			}}
 			filter = "ban"
 		})
 
@@ -45,7 +46,7 @@ var _ = Describe("DBClusters", func() {
 			items, err := dbClusters.List(filter)
 			Expect(err).NotTo(HaveOccurred())
 
-			Expect(client.DescribeDBClustersCall.CallCount).To(Equal(1))
+			Expect(client.DescribeDBClustersPagesCall.CallCount).To(Equal(1))
 			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("RDS DB Cluster"))
 			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("banana"))
 
@@ -54,7 +55,7 @@ var _ = Describe("DBClusters", func() {
 
 		Context("when the client fails to list db clusters", func() {
 			BeforeEach(func() {
-				client.DescribeDBClustersCall.Returns.Error = errors.New("some error")
+				client.DescribeDBClustersPagesCall.Returns.Error = errors.New("some error")
 			})
 
 			It("returns the error", func() {
@@ -68,7 +69,7 @@ var _ = Describe("DBClusters", func() {
 				items, err := dbClusters.List("kiwi")
 				Expect(err).NotTo(HaveOccurred())
 
-				Expect(client.DescribeDBClustersCall.CallCount).To(Equal(1))
+				Expect(client.DescribeDBClustersPagesCall.CallCount).To(Equal(1))
 				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
 				Expect(items).To(HaveLen(0))
 			})
@@ -76,12 +77,12 @@ var _ = Describe("DBClusters", func() {
 
 		Context("when the db cluster is being deleted", func() {
 			BeforeEach(func() {
-				client.DescribeDBClustersCall.Returns.Output = &awsrds.DescribeDBClustersOutput{
+				client.DescribeDBClustersPagesCall.Returns.Pages = []*awsrds.DescribeDBClustersOutput{{
 					DBClusters: []*awsrds.DBCluster{{
 						DBClusterIdentifier: aws.String("banana"),
 						Status:              aws.String("deleting"),
 					}},
-				}
+				}}
 			})
 
 			It("does not return it in the list", func() {
@@ -5,34 +5,52 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	awsrds "github.com/aws/aws-sdk-go/service/rds"
+	"github.com/genevieve/leftovers/common"
 )
 
 type DBInstance struct {
 	client     dbInstancesClient
+	logger     logger
 	name       *string
 	identifier string
 	rtype      string
+	backup     bool
 }
 
-func NewDBInstance(client dbInstancesClient, name *string) DBInstance {
+// NewDBInstance returns a DBInstance. If backup is true and the instance
+// is not a member of a cluster, a final snapshot is taken on deletion.
+// Cluster members are backed up by their cluster's final snapshot.
+func NewDBInstance(client dbInstancesClient, logger logger, name, clusterName *string, backup bool) DBInstance {
 	return DBInstance{
 		client:     client,
+		logger:     logger,
 		name:       name,
 		identifier: *name,
 		rtype:      "RDS DB Instance",
+		backup:     backup && (clusterName == nil || *clusterName == ""),
 	}
 }
 
 func (d DBInstance) Delete() error {
-	_, err := d.client.DeleteDBInstance(&awsrds.DeleteDBInstanceInput{
+	input := &awsrds.DeleteDBInstanceInput{
 		DBInstanceIdentifier: d.name,
 		SkipFinalSnapshot:    aws.Bool(true),
-	})
+	}
+
+	if d.backup {
+		input.SkipFinalSnapshot = aws.Bool(false)
+		input.FinalDBSnapshotIdentifier = aws.String(common.BackupName(d.identifier, 255))
+	}
 
+	_, err := d.client.DeleteDBInstance(input)
 	if err != nil {
 		return fmt.Errorf("Delete: %s", err)
 	}
 
+	if d.backup {
+		d.logger.Printf("[%s: %s] Final snapshot: %s\n", d.rtype, d.identifier, *input.FinalDBSnapshotIdentifier)
+	}
+
 	return nil
 }
 
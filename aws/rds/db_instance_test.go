@@ -2,6 +2,7 @@ package rds_test
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/genevieve/leftovers/aws/rds"
@@ -15,16 +16,18 @@ var _ = Describe("DBInstance", func() {
 	var (
 		dbInstance   rds.DBInstance
 		client       *fakes.DBInstancesClient
+		logger       *fakes.Logger
 		name         *string
 		skipSnapshot *bool
 	)
 
 	BeforeEach(func() {
 		client = &fakes.DBInstancesClient{}
+		logger = &fakes.Logger{}
 		name = aws.String("the-name")
 		skipSnapshot = aws.Bool(true)
 
-		dbInstance = rds.NewDBInstance(client, name)
+		dbInstance = rds.NewDBInstance(client, logger, name, nil, false)
 	})
 
 	Describe("Delete", func() {
@@ -35,6 +38,40 @@ var _ = Describe("DBInstance", func() {
 			Expect(client.DeleteDBInstanceCall.CallCount).To(Equal(1))
 			Expect(client.DeleteDBInstanceCall.Receives.Input.DBInstanceIdentifier).To(Equal(name))
 			Expect(client.DeleteDBInstanceCall.Receives.Input.SkipFinalSnapshot).To(Equal(skipSnapshot))
+			Expect(client.DeleteDBInstanceCall.Receives.Input.FinalDBSnapshotIdentifier).To(BeNil())
+		})
+
+		Context("when backup is enabled", func() {
+			BeforeEach(func() {
+				dbInstance = rds.NewDBInstance(client, logger, name, nil, true)
+			})
+
+			It("takes a final snapshot and logs its name", func() {
+				err := dbInstance.Delete()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(client.DeleteDBInstanceCall.Receives.Input.SkipFinalSnapshot).To(Equal(aws.Bool(false)))
+				Expect(*client.DeleteDBInstanceCall.Receives.Input.FinalDBSnapshotIdentifier).To(HavePrefix("the-name-leftovers-"))
+
+				Expect(logger.PrintfCall.Messages).To(ConsistOf(
+					fmt.Sprintf("[RDS DB Instance: the-name] Final snapshot: %s\n", *client.DeleteDBInstanceCall.Receives.Input.FinalDBSnapshotIdentifier),
+				))
+			})
+
+			Context("when the instance is a member of a cluster", func() {
+				BeforeEach(func() {
+					dbInstance = rds.NewDBInstance(client, logger, name, aws.String("the-cluster"), true)
+				})
+
+				It("leaves the backup to the cluster", func() {
+					err := dbInstance.Delete()
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(client.DeleteDBInstanceCall.Receives.Input.SkipFinalSnapshot).To(Equal(skipSnapshot))
+					Expect(client.DeleteDBInstanceCall.Receives.Input.FinalDBSnapshotIdentifier).To(BeNil())
+					Expect(logger.PrintfCall.CallCount).To(Equal(0))
+				})
+			})
 		})
 
 		Context("when the client fails", func() {
@@ -9,35 +9,41 @@ import (
 )
 
 type dbInstancesClient interface {
-	DescribeDBInstances(*awsrds.DescribeDBInstancesInput) (*awsrds.DescribeDBInstancesOutput, error)
+	DescribeDBInstancesPages(*awsrds.DescribeDBInstancesInput, func(*awsrds.DescribeDBInstancesOutput, bool) bool) error
 	DeleteDBInstance(*awsrds.DeleteDBInstanceInput) (*awsrds.DeleteDBInstanceOutput, error)
 }
 
 type DBInstances struct {
 	client dbInstancesClient
 	logger logger
+	backup common.Backup
 }
 
-func NewDBInstances(client dbInstancesClient, logger logger) DBInstances {
+func NewDBInstances(client dbInstancesClient, logger logger, backup common.Backup) DBInstances {
 	return DBInstances{
 		client: client,
 		logger: logger,
+		backup: backup,
 	}
 }
 
 func (d DBInstances) List(filter string) ([]common.Deletable, error) {
-	dbInstances, err := d.client.DescribeDBInstances(&awsrds.DescribeDBInstancesInput{})
+	var dbInstances []*awsrds.DBInstance
+	err := d.client.DescribeDBInstancesPages(&awsrds.DescribeDBInstancesInput{}, func(page *awsrds.DescribeDBInstancesOutput, lastPage bool) bool {
+		dbInstances = append(dbInstances, page.DBInstances...)
+		return true
+	})
 	if err != nil {
 		return nil, fmt.Errorf("Describing RDS DB Instances: %s", err)
 	}
 
 	var resources []common.Deletable
-	for _, db := range dbInstances.DBInstances {
+	for _, db := range dbInstances {
 		if *db.DBInstanceStatus == "deleting" {
 			continue
 		}
 
-		r := NewDBInstance(d.client, db.DBInstanceIdentifier)
+		r := NewDBInstance(d.client, d.logger, db.DBInstanceIdentifier, db.DBClusterIdentifier, d.backup.Enabled)
 
 		if !strings.Contains(r.Name(), filter) {
 			continue
@@ -7,6 +7,7 @@ import (
 	awsrds "github.com/aws/aws-sdk-go/service/rds"
 	"github.com/genevieve/leftovers/aws/rds"
 	"github.com/genevieve/leftovers/aws/rds/fakes"
+	"github.com/genevieve/leftovers/common"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -24,7 +25,7 @@ var _ = Describe("DBInstances", func() {
 		client = &fakes.DBInstancesClient{}
 		logger = &fakes.Logger{}
 
-		dbInstances = rds.NewDBInstances(client, logger)
+		dbInstances = rds.NewDBInstances(client, logger, common.Backup{})
 	})
 
 	Describe("List", func() {
@@ -32,12 +33,12 @@ var _ = Describe("DBInstances", func() {
 
 		BeforeEach(func() {
 			logger.PromptWithDetailsCall.Returns.Proceed = true
-			client.DescribeDBInstancesCall.Returns.Output = &awsrds.DescribeDBInstancesOutput{
+			client.DescribeDBInstancesPagesCall.Returns.Pages = []*awsrds.DescribeDBInstancesOutput{{
 				DBInstances: []*awsrds.DBInstance{{
 					DBInstanceIdentifier: aws.String("banana"),
 					DBInstanceStatus:     aws.String("status"),
 				}},
-			}
+			}}
 			filter = "ban"
 		})
 
@@ -45,16 +46,38 @@ var _ = Describe("DBInstances", func() {
 			items, err := dbInstances.List(filter)
 			Expect(err).NotTo(HaveOccurred())
 
-			Expect(client.DescribeDBInstancesCall.CallCount).To(Equal(1))
+			Expect(client.DescribeDBInstancesPagesCall.CallCount).To(Equal(1))
 			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("RDS DB Instance"))
 			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("banana"))
 
 			Expect(items).To(HaveLen(1))
 		})
 
+		Context("when there is more than one page of db instances", func() {
+			BeforeEach(func() {
+				client.DescribeDBInstancesPagesCall.Returns.Pages = append(client.DescribeDBInstancesPagesCall.Returns.Pages, &awsrds.DescribeDBInstancesOutput{
+					DBInstances: []*awsrds.DBInstance{{
+						DBInstanceIdentifier: aws.String("bandana"),
+						DBInstanceStatus:     aws.String("status"),
+					}},
+				})
+			})
+
+			It("returns the db instances from every page", func() {
+				items, err := dbInstances.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(client.DescribeDBInstancesPagesCall.CallCount).To(Equal(1))
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(2))
+				Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("bandana"))
+
+				Expect(items).To(HaveLen(2))
+			})
+		})
+
 		Context("when the client fails to list db instances", func() {
 			BeforeEach(func() {
-				client.DescribeDBInstancesCall.Returns.Error = errors.New("some error")
+				client.DescribeDBInstancesPagesCall.Returns.Error = errors.New("some error")
 			})
 
 			It("returns the error", func() {
@@ -68,7 +91,7 @@ var _ = Describe("DBInstances", func() {
 				items, err := dbInstances.List("kiwi")
 				Expect(err).NotTo(HaveOccurred())
 
-				Expect(client.DescribeDBInstancesCall.CallCount).To(Equal(1))
+				Expect(client.DescribeDBInstancesPagesCall.CallCount).To(Equal(1))
 				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
 				Expect(items).To(HaveLen(0))
 			})
@@ -76,12 +99,12 @@ var _ = Describe("DBInstances", func() {
 
 		Context("when the db instance is being deleted", func() {
 			BeforeEach(func() {
-				client.DescribeDBInstancesCall.Returns.Output = &awsrds.DescribeDBInstancesOutput{
+				client.DescribeDBInstancesPagesCall.Returns.Pages = []*awsrds.DescribeDBInstancesOutput{{
 					DBInstances: []*awsrds.DBInstance{{
 						DBInstanceIdentifier: aws.String("banana"),
 						DBInstanceStatus:     aws.String("deleting"),
 					}},
-				}
+				}}
 			})
 
 			It("does not return it in the list", func() {
@@ -9,7 +9,7 @@ import (
 )
 
 type dbSubnetGroupsClient interface {
-	DescribeDBSubnetGroups(*awsrds.DescribeDBSubnetGroupsInput) (*awsrds.DescribeDBSubnetGroupsOutput, error)
+	DescribeDBSubnetGroupsPages(*awsrds.DescribeDBSubnetGroupsInput, func(*awsrds.DescribeDBSubnetGroupsOutput, bool) bool) error
 	DeleteDBSubnetGroup(*awsrds.DeleteDBSubnetGroupInput) (*awsrds.DeleteDBSubnetGroupOutput, error)
 }
 
@@ -26,13 +26,17 @@ func NewDBSubnetGroups(client dbSubnetGroupsClient, logger logger) DBSubnetGroup
 }
 
 func (d DBSubnetGroups) List(filter string) ([]common.Deletable, error) {
-	dbSubnetGroups, err := d.client.DescribeDBSubnetGroups(&awsrds.DescribeDBSubnetGroupsInput{})
+	var subnetGroups []*awsrds.DBSubnetGroup
+	err := d.client.DescribeDBSubnetGroupsPages(&awsrds.DescribeDBSubnetGroupsInput{}, func(page *awsrds.DescribeDBSubnetGroupsOutput, lastPage bool) bool {
+		subnetGroups = append(subnetGroups, page.DBSubnetGroups...)
+		return true
+	})
 	if err != nil {
 		return nil, fmt.Errorf("Describing RDS DB Subnet Groups: %s", err)
 	}
 
 	var resources []common.Deletable
-	for _, db := range dbSubnetGroups.DBSubnetGroups {
+	for _, db := range subnetGroups {
 		r := NewDBSubnetGroup(d.client, db.DBSubnetGroupName)
 
 		if !strings.Contains(r.Name(), filter) {
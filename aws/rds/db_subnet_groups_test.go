@@ -32,11 +32,11 @@ var _ = Describe("DBSubnetGroups", func() {
 
 		BeforeEach(func() {
 			logger.PromptWithDetailsCall.Returns.Proceed = true
-			client.DescribeDBSubnetGroupsCall.Returns.Output = &awsrds.DescribeDBSubnetGroupsOutput{
+			client.DescribeDBSubnetGroupsPagesCall.Returns.Pages = []*awsrds.DescribeDBSubnetGroupsOutput{{
 				DBSubnetGroups: []*awsrds.DBSubnetGroup{{
 					DBSubnetGroupName: aws.String("banana"),
 				}},
-			}
+			}}
 			filter = "ban"
 		})
 
@@ -44,7 +44,7 @@ var _ = Describe("DBSubnetGroups", func() {
 			items, err := dbSubnetGroups.List(filter)
 			Expect(err).NotTo(HaveOccurred())
 
-			Expect(client.DescribeDBSubnetGroupsCall.CallCount).To(Equal(1))
+			Expect(client.DescribeDBSubnetGroupsPagesCall.CallCount).To(Equal(1))
 			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("RDS DB Subnet Group"))
 			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("banana"))
 
@@ -53,7 +53,7 @@ var _ = Describe("DBSubnetGroups", func() {
 
 		Context("when the client fails to list db subnet groups", func() {
 			BeforeEach(func() {
-				client.DescribeDBSubnetGroupsCall.Returns.Error = errors.New("some error")
+				client.DescribeDBSubnetGroupsPagesCall.Returns.Error = errors.New("some error")
 			})
 
 			It("returns the error", func() {
@@ -67,7 +67,7 @@ var _ = Describe("DBSubnetGroups", func() {
 				items, err := dbSubnetGroups.List("kiwi")
 				Expect(err).NotTo(HaveOccurred())
 
-				Expect(client.DescribeDBSubnetGroupsCall.CallCount).To(Equal(1))
+				Expect(client.DescribeDBSubnetGroupsPagesCall.CallCount).To(Equal(1))
 				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
 				Expect(items).To(HaveLen(0))
 			})
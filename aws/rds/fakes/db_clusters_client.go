@@ -3,11 +3,14 @@ package fakes
 import awsrds "github.com/aws/aws-sdk-go/service/rds"
 
 type DBClustersClient struct {
-	DescribeDBClustersCall struct {
+	DescribeDBClustersPagesCall struct {
 		CallCount int
-		Returns   struct {
-			Output *awsrds.DescribeDBClustersOutput
-			Error  error
+		Receives  struct {
+			Input *awsrds.DescribeDBClustersInput
+		}
+		Returns struct {
+			Pages []*awsrds.DescribeDBClustersOutput
+			Error error
 		}
 	}
 	DeleteDBClusterCall struct {
@@ -29,8 +32,16 @@ func (d *DBClustersClient) DeleteDBCluster(input *awsrds.DeleteDBClusterInput) (
 	return d.DeleteDBClusterCall.Returns.Output, d.DeleteDBClusterCall.Returns.Error
 }
 
-func (d *DBClustersClient) DescribeDBClusters(input *awsrds.DescribeDBClustersInput) (*awsrds.DescribeDBClustersOutput, error) {
-	d.DescribeDBClustersCall.CallCount++
+func (d *DBClustersClient) DescribeDBClustersPages(input *awsrds.DescribeDBClustersInput, fn func(*awsrds.DescribeDBClustersOutput, bool) bool) error {
+	d.DescribeDBClustersPagesCall.CallCount++
+	d.DescribeDBClustersPagesCall.Receives.Input = input
+
+	pages := d.DescribeDBClustersPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
 
-	return d.DescribeDBClustersCall.Returns.Output, d.DescribeDBClustersCall.Returns.Error
+	return d.DescribeDBClustersPagesCall.Returns.Error
 }
@@ -3,11 +3,14 @@ package fakes
 import awsrds "github.com/aws/aws-sdk-go/service/rds"
 
 type DBInstancesClient struct {
-	DescribeDBInstancesCall struct {
+	DescribeDBInstancesPagesCall struct {
 		CallCount int
-		Returns   struct {
-			Output *awsrds.DescribeDBInstancesOutput
-			Error  error
+		Receives  struct {
+			Input *awsrds.DescribeDBInstancesInput
+		}
+		Returns struct {
+			Pages []*awsrds.DescribeDBInstancesOutput
+			Error error
 		}
 	}
 	DeleteDBInstanceCall struct {
@@ -29,8 +32,16 @@ func (d *DBInstancesClient) DeleteDBInstance(input *awsrds.DeleteDBInstanceInput
 	return d.DeleteDBInstanceCall.Returns.Output, d.DeleteDBInstanceCall.Returns.Error
 }
 
-func (d *DBInstancesClient) DescribeDBInstances(input *awsrds.DescribeDBInstancesInput) (*awsrds.DescribeDBInstancesOutput, error) {
-	d.DescribeDBInstancesCall.CallCount++
+func (d *DBInstancesClient) DescribeDBInstancesPages(input *awsrds.DescribeDBInstancesInput, fn func(*awsrds.DescribeDBInstancesOutput, bool) bool) error {
+	d.DescribeDBInstancesPagesCall.CallCount++
+	d.DescribeDBInstancesPagesCall.Receives.Input = input
+
+	pages := d.DescribeDBInstancesPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
 
-	return d.DescribeDBInstancesCall.Returns.Output, d.DescribeDBInstancesCall.Returns.Error
+	return d.DescribeDBInstancesPagesCall.Returns.Error
 }
@@ -3,11 +3,14 @@ package fakes
 import awsrds "github.com/aws/aws-sdk-go/service/rds"
 
 type DBSubnetGroupsClient struct {
-	DescribeDBSubnetGroupsCall struct {
+	DescribeDBSubnetGroupsPagesCall struct {
 		CallCount int
-		Returns   struct {
-			Output *awsrds.DescribeDBSubnetGroupsOutput
-			Error  error
+		Receives  struct {
+			Input *awsrds.DescribeDBSubnetGroupsInput
+		}
+		Returns struct {
+			Pages []*awsrds.DescribeDBSubnetGroupsOutput
+			Error error
 		}
 	}
 	DeleteDBSubnetGroupCall struct {
@@ -29,8 +32,16 @@ func (d *DBSubnetGroupsClient) DeleteDBSubnetGroup(input *awsrds.DeleteDBSubnetG
 	return d.DeleteDBSubnetGroupCall.Returns.Output, d.DeleteDBSubnetGroupCall.Returns.Error
 }
 
-func (d *DBSubnetGroupsClient) DescribeDBSubnetGroups(input *awsrds.DescribeDBSubnetGroupsInput) (*awsrds.DescribeDBSubnetGroupsOutput, error) {
-	d.DescribeDBSubnetGroupsCall.CallCount++
+func (d *DBSubnetGroupsClient) DescribeDBSubnetGroupsPages(input *awsrds.DescribeDBSubnetGroupsInput, fn func(*awsrds.DescribeDBSubnetGroupsOutput, bool) bool) error {
+	d.DescribeDBSubnetGroupsPagesCall.CallCount++
+	d.DescribeDBSubnetGroupsPagesCall.Receives.Input = input
+
+	pages := d.DescribeDBSubnetGroupsPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
 
-	return d.DescribeDBSubnetGroupsCall.Returns.Output, d.DescribeDBSubnetGroupsCall.Returns.Error
+	return d.DescribeDBSubnetGroupsPagesCall.Returns.Error
 }
@@ -1,5 +1,6 @@
 package rds
 
 type logger interface {
+	Printf(m string, a ...interface{})
 	PromptWithDetails(resourceType, resourceName string) bool
 }
@@ -0,0 +1,108 @@
+package redshift
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awsredshift "github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/genevieve/leftovers/common"
+)
+
+type Cluster struct {
+	client     clustersClient
+	logger     logger
+	id         *string
+	status     string
+	identifier string
+	rtype      string
+	backup     bool
+}
+
+func NewCluster(client clustersClient, logger logger, id *string, status string, tags []*awsredshift.Tag, backup bool) Cluster {
+	identifier := *id
+
+	var extra []string
+	for _, t := range tags {
+		extra = append(extra, fmt.Sprintf("%s:%s", *t.Key, *t.Value))
+	}
+
+	if len(extra) > 0 {
+		identifier = fmt.Sprintf("%s (%s)", *id, strings.Join(extra, ", "))
+	}
+
+	return Cluster{
+		client:     client,
+		logger:     logger,
+		id:         id,
+		status:     status,
+		identifier: identifier,
+		rtype:      "Redshift Cluster",
+		backup:     backup,
+	}
+}
+
+func (c Cluster) Delete() error {
+	return c.DeleteContext(context.Background())
+}
+
+// DeleteContext deletes the cluster, taking a final snapshot
+// if backup is true, and waits for it to be deleted.
+func (c Cluster) DeleteContext(ctx context.Context) error {
+	input := &awsredshift.DeleteClusterInput{
+		ClusterIdentifier:        c.id,
+		SkipFinalClusterSnapshot: aws.Bool(true),
+	}
+
+	if c.backup {
+		input.SkipFinalClusterSnapshot = aws.Bool(false)
+		input.FinalClusterSnapshotIdentifier = aws.String(common.BackupName(*c.id, 255))
+	}
+
+	_, err := c.client.DeleteCluster(input)
+	if err != nil {
+		return fmt.Errorf("Delete: %s", err)
+	}
+
+	if c.backup {
+		c.logger.Printf("[%s: %s] Final snapshot: %s\n", c.rtype, c.identifier, *input.FinalClusterSnapshotIdentifier)
+	}
+
+	poller := common.NewPoller(c.logger, clusterRefresh(c.client, c.id), []string{c.status, "final-snapshot", "deleting"}, []string{"deleted"})
+
+	_, err = poller.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("Waiting for deletion: %s", err)
+	}
+
+	return nil
+}
+
+func (c Cluster) Name() string {
+	return c.identifier
+}
+
+func (c Cluster) Type() string {
+	return c.rtype
+}
+
+func clusterRefresh(client clustersClient, id *string) common.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.DescribeClusters(&awsredshift.DescribeClustersInput{ClusterIdentifier: id})
+		if err != nil {
+			if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == awsredshift.ErrCodeClusterNotFoundFault {
+				return id, "deleted", nil
+			}
+			return nil, "", err
+		}
+
+		if len(resp.Clusters) == 0 {
+			return id, "deleted", nil
+		}
+
+		cluster := resp.Clusters[0]
+		return cluster, *cluster.ClusterStatus, nil
+	}
+}
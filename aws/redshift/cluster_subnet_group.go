@@ -0,0 +1,52 @@
+package redshift
+
+import (
+	"fmt"
+	"strings"
+
+	awsredshift "github.com/aws/aws-sdk-go/service/redshift"
+)
+
+type ClusterSubnetGroup struct {
+	client     clusterSubnetGroupsClient
+	name       *string
+	identifier string
+	rtype      string
+}
+
+func NewClusterSubnetGroup(client clusterSubnetGroupsClient, name *string, tags []*awsredshift.Tag) ClusterSubnetGroup {
+	identifier := *name
+
+	var extra []string
+	for _, t := range tags {
+		extra = append(extra, fmt.Sprintf("%s:%s", *t.Key, *t.Value))
+	}
+
+	if len(extra) > 0 {
+		identifier = fmt.Sprintf("%s (%s)", *name, strings.Join(extra, ", "))
+	}
+
+	return ClusterSubnetGroup{
+		client:     client,
+		name:       name,
+		identifier: identifier,
+		rtype:      "Redshift Cluster Subnet Group",
+	}
+}
+
+func (c ClusterSubnetGroup) Delete() error {
+	_, err := c.client.DeleteClusterSubnetGroup(&awsredshift.DeleteClusterSubnetGroupInput{ClusterSubnetGroupName: c.name})
+	if err != nil {
+		return fmt.Errorf("Delete: %s", err)
+	}
+
+	return nil
+}
+
+func (c ClusterSubnetGroup) Name() string {
+	return c.identifier
+}
+
+func (c ClusterSubnetGroup) Type() string {
+	return c.rtype
+}
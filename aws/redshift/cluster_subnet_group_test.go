@@ -0,0 +1,61 @@
+package redshift_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsredshift "github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/genevieve/leftovers/aws/redshift"
+	"github.com/genevieve/leftovers/aws/redshift/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ClusterSubnetGroup", func() {
+	var (
+		subnetGroup redshift.ClusterSubnetGroup
+		client      *fakes.ClusterSubnetGroupsClient
+		name        *string
+	)
+
+	BeforeEach(func() {
+		client = &fakes.ClusterSubnetGroupsClient{}
+		name = aws.String("the-subnet-group")
+		tags := []*awsredshift.Tag{{Key: aws.String("the-key"), Value: aws.String("the-value")}}
+
+		subnetGroup = redshift.NewClusterSubnetGroup(client, name, tags)
+	})
+
+	Describe("Delete", func() {
+		It("deletes the subnet group", func() {
+			err := subnetGroup.Delete()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DeleteClusterSubnetGroupCall.CallCount).To(Equal(1))
+			Expect(client.DeleteClusterSubnetGroupCall.Receives.Input.ClusterSubnetGroupName).To(Equal(name))
+		})
+
+		Context("when the client fails", func() {
+			BeforeEach(func() {
+				client.DeleteClusterSubnetGroupCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := subnetGroup.Delete()
+				Expect(err).To(MatchError("Delete: banana"))
+			})
+		})
+	})
+
+	Describe("Name", func() {
+		It("returns the identifier", func() {
+			Expect(subnetGroup.Name()).To(Equal("the-subnet-group (the-key:the-value)"))
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(subnetGroup.Type()).To(Equal("Redshift Cluster Subnet Group"))
+		})
+	})
+})
@@ -0,0 +1,63 @@
+package redshift
+
+import (
+	"fmt"
+	"strings"
+
+	awsredshift "github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/genevieve/leftovers/common"
+)
+
+type clusterSubnetGroupsClient interface {
+	DescribeClusterSubnetGroupsPages(*awsredshift.DescribeClusterSubnetGroupsInput, func(*awsredshift.DescribeClusterSubnetGroupsOutput, bool) bool) error
+	DeleteClusterSubnetGroup(*awsredshift.DeleteClusterSubnetGroupInput) (*awsredshift.DeleteClusterSubnetGroupOutput, error)
+}
+
+type ClusterSubnetGroups struct {
+	client clusterSubnetGroupsClient
+	logger logger
+}
+
+func NewClusterSubnetGroups(client clusterSubnetGroupsClient, logger logger) ClusterSubnetGroups {
+	return ClusterSubnetGroups{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (c ClusterSubnetGroups) List(filter string) ([]common.Deletable, error) {
+	var groups []*awsredshift.ClusterSubnetGroup
+	err := c.client.DescribeClusterSubnetGroupsPages(&awsredshift.DescribeClusterSubnetGroupsInput{}, func(page *awsredshift.DescribeClusterSubnetGroupsOutput, lastPage bool) bool {
+		groups = append(groups, page.ClusterSubnetGroups...)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Describe Redshift Cluster Subnet Groups: %s", err)
+	}
+
+	var resources []common.Deletable
+	for _, g := range groups {
+		if *g.ClusterSubnetGroupName == "default" {
+			continue
+		}
+
+		r := NewClusterSubnetGroup(c.client, g.ClusterSubnetGroupName, g.Tags)
+
+		if !strings.Contains(r.Name(), filter) {
+			continue
+		}
+
+		proceed := c.logger.PromptWithDetails(r.Type(), r.Name())
+		if !proceed {
+			continue
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func (c ClusterSubnetGroups) Type() string {
+	return "redshift-cluster-subnet-group"
+}
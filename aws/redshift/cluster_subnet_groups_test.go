@@ -0,0 +1,97 @@
+package redshift_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsredshift "github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/genevieve/leftovers/aws/redshift"
+	"github.com/genevieve/leftovers/aws/redshift/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ClusterSubnetGroups", func() {
+	var (
+		client *fakes.ClusterSubnetGroupsClient
+		logger *fakes.Logger
+
+		subnetGroups redshift.ClusterSubnetGroups
+	)
+
+	BeforeEach(func() {
+		client = &fakes.ClusterSubnetGroupsClient{}
+		logger = &fakes.Logger{}
+		logger.PromptWithDetailsCall.Returns.Proceed = true
+
+		subnetGroups = redshift.NewClusterSubnetGroups(client, logger)
+	})
+
+	Describe("List", func() {
+		var filter string
+
+		BeforeEach(func() {
+			client.DescribeClusterSubnetGroupsPagesCall.Returns.Pages = []*awsredshift.DescribeClusterSubnetGroupsOutput{{
+				ClusterSubnetGroups: []*awsredshift.ClusterSubnetGroup{
+					{ClusterSubnetGroupName: aws.String("default")},
+					{ClusterSubnetGroupName: aws.String("the-banana-subnet-group")},
+				},
+			}}
+			filter = ""
+		})
+
+		It("returns the subnet groups other than the default", func() {
+			items, err := subnetGroups.List(filter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DescribeClusterSubnetGroupsPagesCall.CallCount).To(Equal(1))
+
+			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("Redshift Cluster Subnet Group"))
+			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("the-banana-subnet-group"))
+
+			Expect(items).To(HaveLen(1))
+		})
+
+		Context("when the subnet group name does not contain the filter", func() {
+			It("does not return it to the list", func() {
+				items, err := subnetGroups.List("kiwi")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+
+		Context("when the client fails to describe subnet groups", func() {
+			BeforeEach(func() {
+				client.DescribeClusterSubnetGroupsPagesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := subnetGroups.List(filter)
+				Expect(err).To(MatchError("Describe Redshift Cluster Subnet Groups: some error"))
+			})
+		})
+
+		Context("when the user responds no to the prompt", func() {
+			BeforeEach(func() {
+				logger.PromptWithDetailsCall.Returns.Proceed = false
+			})
+
+			It("does not return it to the list", func() {
+				items, err := subnetGroups.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(subnetGroups.Type()).To(Equal("redshift-cluster-subnet-group"))
+		})
+	})
+})
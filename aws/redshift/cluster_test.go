@@ -0,0 +1,102 @@
+package redshift_test
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awsredshift "github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/genevieve/leftovers/aws/redshift"
+	"github.com/genevieve/leftovers/aws/redshift/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cluster", func() {
+	var (
+		cluster redshift.Cluster
+		client  *fakes.ClustersClient
+		logger  *fakes.Logger
+		id      *string
+		tags    []*awsredshift.Tag
+	)
+
+	BeforeEach(func() {
+		client = &fakes.ClustersClient{}
+		logger = &fakes.Logger{}
+		id = aws.String("the-cluster")
+		tags = []*awsredshift.Tag{{Key: aws.String("the-key"), Value: aws.String("the-value")}}
+
+		client.DescribeClustersCall.Returns.Error = awserr.New("ClusterNotFound", "", nil)
+
+		cluster = redshift.NewCluster(client, logger, id, "available", tags, false)
+	})
+
+	Describe("Delete", func() {
+		It("deletes the cluster without a final snapshot and waits for it to be deleted", func() {
+			err := cluster.Delete()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DeleteClusterCall.CallCount).To(Equal(1))
+			Expect(client.DeleteClusterCall.Receives.Input.ClusterIdentifier).To(Equal(id))
+			Expect(client.DeleteClusterCall.Receives.Input.SkipFinalClusterSnapshot).To(Equal(aws.Bool(true)))
+			Expect(client.DeleteClusterCall.Receives.Input.FinalClusterSnapshotIdentifier).To(BeNil())
+
+			Expect(client.DescribeClustersCall.CallCount).To(Equal(1))
+			Expect(client.DescribeClustersCall.Receives.Input.ClusterIdentifier).To(Equal(id))
+		})
+
+		Context("when backup is enabled", func() {
+			BeforeEach(func() {
+				cluster = redshift.NewCluster(client, logger, id, "available", tags, true)
+			})
+
+			It("takes a final snapshot and logs its name", func() {
+				err := cluster.Delete()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(client.DeleteClusterCall.Receives.Input.SkipFinalClusterSnapshot).To(Equal(aws.Bool(false)))
+				Expect(*client.DeleteClusterCall.Receives.Input.FinalClusterSnapshotIdentifier).To(HavePrefix("the-cluster-leftovers-"))
+
+				Expect(logger.PrintfCall.Messages).To(ContainElement(
+					fmt.Sprintf("[Redshift Cluster: the-cluster (the-key:the-value)] Final snapshot: %s\n", *client.DeleteClusterCall.Receives.Input.FinalClusterSnapshotIdentifier),
+				))
+			})
+		})
+
+		Context("when the client fails", func() {
+			BeforeEach(func() {
+				client.DeleteClusterCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := cluster.Delete()
+				Expect(err).To(MatchError("Delete: banana"))
+			})
+		})
+
+		Context("when the client fails while waiting", func() {
+			BeforeEach(func() {
+				client.DescribeClustersCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := cluster.Delete()
+				Expect(err).To(MatchError("Waiting for deletion: banana"))
+			})
+		})
+	})
+
+	Describe("Name", func() {
+		It("returns the identifier", func() {
+			Expect(cluster.Name()).To(Equal("the-cluster (the-key:the-value)"))
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(cluster.Type()).To(Equal("Redshift Cluster"))
+		})
+	})
+})
@@ -0,0 +1,66 @@
+package redshift
+
+import (
+	"fmt"
+	"strings"
+
+	awsredshift "github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/genevieve/leftovers/common"
+)
+
+type clustersClient interface {
+	DescribeClusters(*awsredshift.DescribeClustersInput) (*awsredshift.DescribeClustersOutput, error)
+	DescribeClustersPages(*awsredshift.DescribeClustersInput, func(*awsredshift.DescribeClustersOutput, bool) bool) error
+	DeleteCluster(*awsredshift.DeleteClusterInput) (*awsredshift.DeleteClusterOutput, error)
+}
+
+type Clusters struct {
+	client clustersClient
+	logger logger
+	backup common.Backup
+}
+
+func NewClusters(client clustersClient, logger logger, backup common.Backup) Clusters {
+	return Clusters{
+		client: client,
+		logger: logger,
+		backup: backup,
+	}
+}
+
+func (c Clusters) List(filter string) ([]common.Deletable, error) {
+	var clusters []*awsredshift.Cluster
+	err := c.client.DescribeClustersPages(&awsredshift.DescribeClustersInput{}, func(page *awsredshift.DescribeClustersOutput, lastPage bool) bool {
+		clusters = append(clusters, page.Clusters...)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Describe Redshift Clusters: %s", err)
+	}
+
+	var resources []common.Deletable
+	for _, cluster := range clusters {
+		if *cluster.ClusterStatus == "deleting" {
+			continue
+		}
+
+		r := NewCluster(c.client, c.logger, cluster.ClusterIdentifier, *cluster.ClusterStatus, cluster.Tags, c.backup.Enabled)
+
+		if !strings.Contains(r.Name(), filter) {
+			continue
+		}
+
+		proceed := c.logger.PromptWithDetails(r.Type(), r.Name())
+		if !proceed {
+			continue
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func (c Clusters) Type() string {
+	return "redshift-cluster"
+}
@@ -0,0 +1,102 @@
+package redshift_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsredshift "github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/genevieve/leftovers/aws/redshift"
+	"github.com/genevieve/leftovers/aws/redshift/fakes"
+	"github.com/genevieve/leftovers/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Clusters", func() {
+	var (
+		client *fakes.ClustersClient
+		logger *fakes.Logger
+
+		clusters redshift.Clusters
+	)
+
+	BeforeEach(func() {
+		client = &fakes.ClustersClient{}
+		logger = &fakes.Logger{}
+		logger.PromptWithDetailsCall.Returns.Proceed = true
+
+		clusters = redshift.NewClusters(client, logger, common.Backup{})
+	})
+
+	Describe("List", func() {
+		var filter string
+
+		BeforeEach(func() {
+			client.DescribeClustersPagesCall.Returns.Pages = []*awsredshift.DescribeClustersOutput{{
+				Clusters: []*awsredshift.Cluster{{
+					ClusterIdentifier: aws.String("the-cluster"),
+					ClusterStatus:     aws.String("available"),
+					Tags:              []*awsredshift.Tag{{Key: aws.String("env"), Value: aws.String("banana")}},
+				}, {
+					ClusterIdentifier: aws.String("the-deleting-banana-cluster"),
+					ClusterStatus:     aws.String("deleting"),
+				}},
+			}}
+			filter = "banana"
+		})
+
+		It("returns the clusters whose name or tags contain the filter", func() {
+			items, err := clusters.List(filter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DescribeClustersPagesCall.CallCount).To(Equal(1))
+
+			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("Redshift Cluster"))
+			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("the-cluster (env:banana)"))
+
+			Expect(items).To(HaveLen(1))
+		})
+
+		Context("when neither the name nor the tags contain the filter", func() {
+			It("does not return it to the list", func() {
+				items, err := clusters.List("kiwi")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+
+		Context("when the client fails to describe clusters", func() {
+			BeforeEach(func() {
+				client.DescribeClustersPagesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := clusters.List(filter)
+				Expect(err).To(MatchError("Describe Redshift Clusters: some error"))
+			})
+		})
+
+		Context("when the user responds no to the prompt", func() {
+			BeforeEach(func() {
+				logger.PromptWithDetailsCall.Returns.Proceed = false
+			})
+
+			It("does not return it to the list", func() {
+				items, err := clusters.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(clusters.Type()).To(Equal("redshift-cluster"))
+		})
+	})
+})
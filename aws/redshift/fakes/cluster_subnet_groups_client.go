@@ -0,0 +1,50 @@
+package fakes
+
+import (
+	awsredshift "github.com/aws/aws-sdk-go/service/redshift"
+)
+
+type ClusterSubnetGroupsClient struct {
+	DescribeClusterSubnetGroupsPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsredshift.DescribeClusterSubnetGroupsInput
+		}
+		Returns struct {
+			Pages []*awsredshift.DescribeClusterSubnetGroupsOutput
+			Error error
+		}
+	}
+
+	DeleteClusterSubnetGroupCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsredshift.DeleteClusterSubnetGroupInput
+		}
+		Returns struct {
+			Output *awsredshift.DeleteClusterSubnetGroupOutput
+			Error  error
+		}
+	}
+}
+
+func (c *ClusterSubnetGroupsClient) DescribeClusterSubnetGroupsPages(input *awsredshift.DescribeClusterSubnetGroupsInput, fn func(*awsredshift.DescribeClusterSubnetGroupsOutput, bool) bool) error {
+	c.DescribeClusterSubnetGroupsPagesCall.CallCount++
+	c.DescribeClusterSubnetGroupsPagesCall.Receives.Input = input
+
+	pages := c.DescribeClusterSubnetGroupsPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return c.DescribeClusterSubnetGroupsPagesCall.Returns.Error
+}
+
+func (c *ClusterSubnetGroupsClient) DeleteClusterSubnetGroup(input *awsredshift.DeleteClusterSubnetGroupInput) (*awsredshift.DeleteClusterSubnetGroupOutput, error) {
+	c.DeleteClusterSubnetGroupCall.CallCount++
+	c.DeleteClusterSubnetGroupCall.Receives.Input = input
+
+	return c.DeleteClusterSubnetGroupCall.Returns.Output, c.DeleteClusterSubnetGroupCall.Returns.Error
+}
@@ -0,0 +1,68 @@
+package fakes
+
+import (
+	awsredshift "github.com/aws/aws-sdk-go/service/redshift"
+)
+
+type ClustersClient struct {
+	DescribeClustersCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsredshift.DescribeClustersInput
+		}
+		Returns struct {
+			Output *awsredshift.DescribeClustersOutput
+			Error  error
+		}
+	}
+
+	DescribeClustersPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsredshift.DescribeClustersInput
+		}
+		Returns struct {
+			Pages []*awsredshift.DescribeClustersOutput
+			Error error
+		}
+	}
+
+	DeleteClusterCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsredshift.DeleteClusterInput
+		}
+		Returns struct {
+			Output *awsredshift.DeleteClusterOutput
+			Error  error
+		}
+	}
+}
+
+func (c *ClustersClient) DescribeClusters(input *awsredshift.DescribeClustersInput) (*awsredshift.DescribeClustersOutput, error) {
+	c.DescribeClustersCall.CallCount++
+	c.DescribeClustersCall.Receives.Input = input
+
+	return c.DescribeClustersCall.Returns.Output, c.DescribeClustersCall.Returns.Error
+}
+
+func (c *ClustersClient) DescribeClustersPages(input *awsredshift.DescribeClustersInput, fn func(*awsredshift.DescribeClustersOutput, bool) bool) error {
+	c.DescribeClustersPagesCall.CallCount++
+	c.DescribeClustersPagesCall.Receives.Input = input
+
+	pages := c.DescribeClustersPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return c.DescribeClustersPagesCall.Returns.Error
+}
+
+func (c *ClustersClient) DeleteCluster(input *awsredshift.DeleteClusterInput) (*awsredshift.DeleteClusterOutput, error) {
+	c.DeleteClusterCall.CallCount++
+	c.DeleteClusterCall.Receives.Input = input
+
+	return c.DeleteClusterCall.Returns.Output, c.DeleteClusterCall.Returns.Error
+}
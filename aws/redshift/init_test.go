@@ -0,0 +1,13 @@
+package redshift_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestRedshift(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "aws/redshift")
+}
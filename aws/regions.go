@@ -0,0 +1,218 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/client"
+	awsacm "github.com/aws/aws-sdk-go/service/acm"
+	awsautoscaling "github.com/aws/aws-sdk-go/service/autoscaling"
+	awscloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	awscloudwatch "github.com/aws/aws-sdk-go/service/cloudwatch"
+	awscloudwatchlogs "github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	awsdynamodb "github.com/aws/aws-sdk-go/service/dynamodb"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	awsecr "github.com/aws/aws-sdk-go/service/ecr"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	awsefs "github.com/aws/aws-sdk-go/service/efs"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	awselasticache "github.com/aws/aws-sdk-go/service/elasticache"
+	awselb "github.com/aws/aws-sdk-go/service/elb"
+	awselbv2 "github.com/aws/aws-sdk-go/service/elbv2"
+	awseventbridge "github.com/aws/aws-sdk-go/service/eventbridge"
+	awskms "github.com/aws/aws-sdk-go/service/kms"
+	awslambda "github.com/aws/aws-sdk-go/service/lambda"
+	awsrds "github.com/aws/aws-sdk-go/service/rds"
+	awsredshift "github.com/aws/aws-sdk-go/service/redshift"
+	awss3 "github.com/aws/aws-sdk-go/service/s3"
+	awssecretsmanager "github.com/aws/aws-sdk-go/service/secretsmanager"
+	awssns "github.com/aws/aws-sdk-go/service/sns"
+	awssqs "github.com/aws/aws-sdk-go/service/sqs"
+	awsssm "github.com/aws/aws-sdk-go/service/ssm"
+	awssts "github.com/aws/aws-sdk-go/service/sts"
+	"github.com/genevieve/leftovers/aws/cloudformation"
+	"github.com/genevieve/leftovers/aws/ec2"
+	"github.com/genevieve/leftovers/common"
+)
+
+// allRegions is the region that sweeps every region
+// that is enabled for the account.
+const allRegions = "all"
+
+// regionsRegion is the region that the enabled regions are described in
+// when the profile has no region, which is the default region of the
+// aws partition. GovCloud and China accounts have to configure a region
+// of their partition.
+const regionsRegion = "us-east-1"
+
+type regionsClient interface {
+	DescribeRegions(*awsec2.DescribeRegionsInput) (*awsec2.DescribeRegionsOutput, error)
+}
+
+// parseRegions splits the provided comma-separated list of regions.
+func parseRegions(region string) []string {
+	var regions []string
+	for _, r := range strings.Split(region, ",") {
+		r = strings.TrimSpace(r)
+		if r != "" {
+			regions = append(regions, r)
+		}
+	}
+	return regions
+}
+
+// enabledRegions returns the regions that are enabled for the account.
+func enabledRegions(client regionsClient) ([]string, error) {
+	resp, err := client.DescribeRegions(&awsec2.DescribeRegionsInput{})
+	if err != nil {
+		return nil, fmt.Errorf("Describing regions: %s", err)
+	}
+
+	var regions []string
+	for _, r := range resp.Regions {
+		regions = append(regions, *r.RegionName)
+	}
+
+	if len(regions) == 0 {
+		return nil, errors.New("No regions are enabled.")
+	}
+
+	return regions, nil
+}
+
+// regionClients are the clients of the regional services in one region,
+// the logger that their resources prompt with, the resources of the
+// CloudFormation stacks that are being deleted in the region, and the
+// listeners of the load balancers that are being deleted in the region.
+type regionClients struct {
+	logger         logger
+	members        *cloudformation.Members
+	elbListeners   *listeners
+	elbv2Listeners *listeners
+	acm            *awsacm.ACM
+	autoscaling    *awsautoscaling.AutoScaling
+	cloudformation *awscloudformation.CloudFormation
+	cloudwatch     *awscloudwatch.CloudWatch
+	cloudwatchlogs *awscloudwatchlogs.CloudWatchLogs
+	dynamodb       *awsdynamodb.DynamoDB
+	ec2            *awsec2.EC2
+	ecr            *awsecr.ECR
+	ecs            *awsecs.ECS
+	efs            *awsefs.EFS
+	eks            *awseks.EKS
+	elasticache    *awselasticache.ElastiCache
+	elb            *awselb.ELB
+	elbv2          *awselbv2.ELBV2
+	eventbridge    *awseventbridge.EventBridge
+	kms            *awskms.KMS
+	lambda         *awslambda.Lambda
+	rds            *awsrds.RDS
+	redshift       *awsredshift.Redshift
+	s3             *awss3.S3
+	secretsmanager *awssecretsmanager.SecretsManager
+	sns            *awssns.SNS
+	sqs            *awssqs.SQS
+	ssm            *awsssm.SSM
+	sts            *awssts.STS
+	resourceTags   ec2.ResourceTags
+}
+
+func newRegionClients(sess client.ConfigProvider, logger logger) regionClients {
+	ec2Client := awsec2.New(sess)
+
+	return regionClients{
+		logger:         logger,
+		members:        cloudformation.NewMembers(),
+		elbListeners:   newListeners(),
+		elbv2Listeners: newListeners(),
+		acm:            awsacm.New(sess),
+		autoscaling:    awsautoscaling.New(sess),
+		cloudformation: awscloudformation.New(sess),
+		cloudwatch:     awscloudwatch.New(sess),
+		cloudwatchlogs: awscloudwatchlogs.New(sess),
+		dynamodb:       awsdynamodb.New(sess),
+		ec2:            ec2Client,
+		ecr:            awsecr.New(sess),
+		ecs:            awsecs.New(sess),
+		efs:            awsefs.New(sess),
+		eks:            awseks.New(sess),
+		elasticache:    awselasticache.New(sess),
+		elb:            awselb.New(sess),
+		elbv2:          awselbv2.New(sess),
+		eventbridge:    awseventbridge.New(sess),
+		kms:            awskms.New(sess),
+		lambda:         awslambda.New(sess),
+		rds:            awsrds.New(sess),
+		redshift:       awsredshift.New(sess),
+		s3:             awss3.New(sess),
+		secretsmanager: awssecretsmanager.New(sess),
+		sns:            awssns.New(sess),
+		sqs:            awssqs.New(sess),
+		ssm:            awsssm.New(sess),
+		sts:            awssts.New(sess),
+		resourceTags:   ec2.NewResourceTags(ec2Client),
+	}
+}
+
+// regionalResource lists the resources of one type in every region
+// that is swept, and prefixes their names with their region.
+type regionalResource struct {
+	regions   []string
+	resources []resource
+}
+
+func (r regionalResource) List(filter string) ([]common.Deletable, error) {
+	var (
+		deletables []common.Deletable
+		errs       []string
+	)
+
+	for i, res := range r.resources {
+		list, err := res.List(filter)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("(%s) %s", r.regions[i], err))
+		}
+
+		for _, d := range list {
+			deletables = append(deletables, regionalDeletable{Deletable: d, region: r.regions[i]})
+		}
+	}
+
+	if len(errs) > 0 {
+		return deletables, errors.New(strings.Join(errs, "\n"))
+	}
+
+	return deletables, nil
+}
+
+func (r regionalResource) Type() string {
+	return r.resources[0].Type()
+}
+
+type regionalDeletable struct {
+	common.Deletable
+	region string
+}
+
+// DeleteContext deletes the deletable with the provided context,
+// since embedding it hides its DeleteContext.
+func (d regionalDeletable) DeleteContext(ctx context.Context) error {
+	return common.Delete(ctx, d.Deletable)
+}
+
+func (d regionalDeletable) Name() string {
+	return fmt.Sprintf("(%s) %s", d.region, d.Deletable.Name())
+}
+
+// regionalLogger prefixes the names of the resources it prompts
+// for with their region, to match their regionalDeletable.
+type regionalLogger struct {
+	logger
+	region string
+}
+
+func (l regionalLogger) PromptWithDetails(resourceType, resourceName string) bool {
+	return l.logger.PromptWithDetails(resourceType, fmt.Sprintf("(%s) %s", l.region, resourceName))
+}
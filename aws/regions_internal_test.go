@@ -0,0 +1,76 @@
+package aws
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"time"
+
+	"github.com/genevieve/leftovers/app"
+	"github.com/genevieve/leftovers/common"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("regionalLogger", func() {
+	var (
+		stdout *bytes.Buffer
+		logger regionalLogger
+	)
+
+	BeforeEach(func() {
+		stdout = &bytes.Buffer{}
+		logger = regionalLogger{logger: app.NewLogger(stdout, os.Stdin, true), region: "us-west-2"}
+	})
+
+	It("keeps polling progress quiet at the normal level", func() {
+		states := []string{"pending", "done"}
+		poller := common.NewPoller(logger, func() (interface{}, string, error) {
+			state := states[0]
+			states = states[1:]
+			return state, state, nil
+		}, []string{"pending"}, []string{"done"})
+		poller.Delay = time.Millisecond
+		poller.MinInterval = time.Millisecond
+		poller.MaxInterval = time.Millisecond
+
+		_, err := poller.Wait(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(stdout.String()).To(BeEmpty())
+	})
+})
+
+type waitingDeletable struct {
+	started chan bool
+}
+
+func (w waitingDeletable) Delete() error { return w.DeleteContext(context.Background()) }
+func (w waitingDeletable) Name() string  { return "the-resource" }
+func (w waitingDeletable) Type() string  { return "Waiting Resource" }
+
+func (w waitingDeletable) DeleteContext(ctx context.Context) error {
+	w.started <- true
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+var _ = Describe("regionalDeletable", func() {
+	It("deletes the deletable with the context, so that it can be cancelled", func() {
+		w := waitingDeletable{started: make(chan bool, 1)}
+		d := regionalDeletable{Deletable: w, region: "us-west-2"}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		errs := make(chan error, 1)
+		go func() { errs <- common.Delete(ctx, d) }()
+
+		Eventually(w.started).Should(Receive())
+		Consistently(errs).ShouldNot(Receive())
+
+		cancel()
+
+		Eventually(errs).Should(Receive(Equal(context.Canceled)))
+		Expect(d.Name()).To(Equal("(us-west-2) the-resource"))
+	})
+})
@@ -5,14 +5,14 @@ import (
 )
 
 type HealthChecksClient struct {
-	ListHealthChecksCall struct {
+	ListHealthChecksPagesCall struct {
 		CallCount int
 		Receives  struct {
 			Input *awsroute53.ListHealthChecksInput
 		}
 		Returns struct {
-			Output *awsroute53.ListHealthChecksOutput
-			Error  error
+			Pages []*awsroute53.ListHealthChecksOutput
+			Error error
 		}
 	}
 
@@ -28,11 +28,18 @@ type HealthChecksClient struct {
 	}
 }
 
-func (h *HealthChecksClient) ListHealthChecks(input *awsroute53.ListHealthChecksInput) (*awsroute53.ListHealthChecksOutput, error) {
-	h.ListHealthChecksCall.CallCount++
-	h.ListHealthChecksCall.Receives.Input = input
+func (h *HealthChecksClient) ListHealthChecksPages(input *awsroute53.ListHealthChecksInput, fn func(*awsroute53.ListHealthChecksOutput, bool) bool) error {
+	h.ListHealthChecksPagesCall.CallCount++
+	h.ListHealthChecksPagesCall.Receives.Input = input
+
+	pages := h.ListHealthChecksPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
 
-	return h.ListHealthChecksCall.Returns.Output, h.ListHealthChecksCall.Returns.Error
+	return h.ListHealthChecksPagesCall.Returns.Error
 }
 
 func (h *HealthChecksClient) DeleteHealthCheck(input *awsroute53.DeleteHealthCheckInput) (*awsroute53.DeleteHealthCheckOutput, error) {
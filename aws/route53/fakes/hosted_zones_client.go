@@ -5,14 +5,14 @@ import (
 )
 
 type HostedZonesClient struct {
-	ListHostedZonesCall struct {
+	ListHostedZonesPagesCall struct {
 		CallCount int
 		Receives  struct {
 			Input *awsroute53.ListHostedZonesInput
 		}
 		Returns struct {
-			Output *awsroute53.ListHostedZonesOutput
-			Error  error
+			Pages []*awsroute53.ListHostedZonesOutput
+			Error error
 		}
 	}
 
@@ -28,11 +28,18 @@ type HostedZonesClient struct {
 	}
 }
 
-func (h *HostedZonesClient) ListHostedZones(input *awsroute53.ListHostedZonesInput) (*awsroute53.ListHostedZonesOutput, error) {
-	h.ListHostedZonesCall.CallCount++
-	h.ListHostedZonesCall.Receives.Input = input
+func (h *HostedZonesClient) ListHostedZonesPages(input *awsroute53.ListHostedZonesInput, fn func(*awsroute53.ListHostedZonesOutput, bool) bool) error {
+	h.ListHostedZonesPagesCall.CallCount++
+	h.ListHostedZonesPagesCall.Receives.Input = input
+
+	pages := h.ListHostedZonesPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
 
-	return h.ListHostedZonesCall.Returns.Output, h.ListHostedZonesCall.Returns.Error
+	return h.ListHostedZonesPagesCall.Returns.Error
 }
 
 func (h *HostedZonesClient) DeleteHostedZone(input *awsroute53.DeleteHostedZoneInput) (*awsroute53.DeleteHostedZoneOutput, error) {
@@ -5,10 +5,15 @@ import (
 )
 
 type RecordSetsClient struct {
-	ListResourceRecordSetsCall struct {
+	ListResourceRecordSetsPagesCall struct {
 		CallCount int
-		Receives  []ListResourceRecordSetsCallReceive
-		Returns   []ListResourceRecordSetsCallReturn
+		Receives  struct {
+			Input *awsroute53.ListResourceRecordSetsInput
+		}
+		Returns struct {
+			Pages []*awsroute53.ListResourceRecordSetsOutput
+			Error error
+		}
 	}
 
 	ChangeResourceRecordSetsCall struct {
@@ -23,24 +28,18 @@ type RecordSetsClient struct {
 	}
 }
 
-type ListResourceRecordSetsCallReceive struct {
-	Input *awsroute53.ListResourceRecordSetsInput
-}
+func (r *RecordSetsClient) ListResourceRecordSetsPages(input *awsroute53.ListResourceRecordSetsInput, fn func(*awsroute53.ListResourceRecordSetsOutput, bool) bool) error {
+	r.ListResourceRecordSetsPagesCall.CallCount++
+	r.ListResourceRecordSetsPagesCall.Receives.Input = input
 
-type ListResourceRecordSetsCallReturn struct {
-	Output *awsroute53.ListResourceRecordSetsOutput
-	Error  error
-}
-
-func (r *RecordSetsClient) ListResourceRecordSets(input *awsroute53.ListResourceRecordSetsInput) (*awsroute53.ListResourceRecordSetsOutput, error) {
-	r.ListResourceRecordSetsCall.CallCount++
-	r.ListResourceRecordSetsCall.Receives = append(r.ListResourceRecordSetsCall.Receives, ListResourceRecordSetsCallReceive{Input: input})
-
-	if len(r.ListResourceRecordSetsCall.Returns) < r.ListResourceRecordSetsCall.CallCount {
-		return nil, nil
+	pages := r.ListResourceRecordSetsPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
 	}
 
-	return r.ListResourceRecordSetsCall.Returns[r.ListResourceRecordSetsCall.CallCount-1].Output, r.ListResourceRecordSetsCall.Returns[r.ListResourceRecordSetsCall.CallCount-1].Error
+	return r.ListResourceRecordSetsPagesCall.Returns.Error
 }
 
 func (r *RecordSetsClient) ChangeResourceRecordSets(input *awsroute53.ChangeResourceRecordSetsInput) (*awsroute53.ChangeResourceRecordSetsOutput, error) {
@@ -9,7 +9,7 @@ import (
 )
 
 type healthChecksClient interface {
-	ListHealthChecks(*awsroute53.ListHealthChecksInput) (*awsroute53.ListHealthChecksOutput, error)
+	ListHealthChecksPages(*awsroute53.ListHealthChecksInput, func(*awsroute53.ListHealthChecksOutput, bool) bool) error
 	DeleteHealthCheck(*awsroute53.DeleteHealthCheckInput) (*awsroute53.DeleteHealthCheckOutput, error)
 }
 
@@ -26,13 +26,17 @@ func NewHealthChecks(client healthChecksClient, logger logger) HealthChecks {
 }
 
 func (h HealthChecks) List(filter string) ([]common.Deletable, error) {
-	checks, err := h.client.ListHealthChecks(&awsroute53.ListHealthChecksInput{})
+	var healthChecks []*awsroute53.HealthCheck
+	err := h.client.ListHealthChecksPages(&awsroute53.ListHealthChecksInput{}, func(page *awsroute53.ListHealthChecksOutput, lastPage bool) bool {
+		healthChecks = append(healthChecks, page.HealthChecks...)
+		return true
+	})
 	if err != nil {
 		return nil, fmt.Errorf("List Route53 Health Checks: %s", err)
 	}
 
 	var resources []common.Deletable
-	for _, check := range checks.HealthChecks {
+	for _, check := range healthChecks {
 		r := NewHealthCheck(h.client, check.Id)
 
 		if !strings.Contains(r.Name(), filter) {
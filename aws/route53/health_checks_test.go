@@ -31,11 +31,11 @@ var _ = Describe("HealthChecks", func() {
 
 		BeforeEach(func() {
 			logger.PromptWithDetailsCall.Returns.Proceed = true
-			client.ListHealthChecksCall.Returns.Output = &awsroute53.ListHealthChecksOutput{
+			client.ListHealthChecksPagesCall.Returns.Pages = []*awsroute53.ListHealthChecksOutput{{
 				HealthChecks: []*awsroute53.HealthCheck{{
 					Id: aws.String("the-id"),
 				}},
-			}
+			}}
 			filter = "the"
 		})
 
@@ -43,7 +43,7 @@ var _ = Describe("HealthChecks", func() {
 			items, err := healthChecks.List(filter)
 			Expect(err).NotTo(HaveOccurred())
 
-			Expect(client.ListHealthChecksCall.CallCount).To(Equal(1))
+			Expect(client.ListHealthChecksPagesCall.CallCount).To(Equal(1))
 
 			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
 			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("Route53 Health Check"))
@@ -54,7 +54,7 @@ var _ = Describe("HealthChecks", func() {
 
 		Context("when the client fails to list health checks", func() {
 			BeforeEach(func() {
-				client.ListHealthChecksCall.Returns.Error = errors.New("some error")
+				client.ListHealthChecksPagesCall.Returns.Error = errors.New("some error")
 			})
 
 			It("returns the error", func() {
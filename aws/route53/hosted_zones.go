@@ -9,7 +9,7 @@ import (
 )
 
 type hostedZonesClient interface {
-	ListHostedZones(*awsroute53.ListHostedZonesInput) (*awsroute53.ListHostedZonesOutput, error)
+	ListHostedZonesPages(*awsroute53.ListHostedZonesInput, func(*awsroute53.ListHostedZonesOutput, bool) bool) error
 	DeleteHostedZone(*awsroute53.DeleteHostedZoneInput) (*awsroute53.DeleteHostedZoneOutput, error)
 }
 
@@ -33,13 +33,17 @@ func NewHostedZones(client hostedZonesClient, logger logger, recordSets recordSe
 }
 
 func (z HostedZones) List(filter string) ([]common.Deletable, error) {
-	zones, err := z.client.ListHostedZones(&awsroute53.ListHostedZonesInput{})
+	var zones []*awsroute53.HostedZone
+	err := z.client.ListHostedZonesPages(&awsroute53.ListHostedZonesInput{}, func(page *awsroute53.ListHostedZonesOutput, lastPage bool) bool {
+		zones = append(zones, page.HostedZones...)
+		return true
+	})
 	if err != nil {
 		return nil, fmt.Errorf("List Route53 Hosted Zones: %s", err)
 	}
 
 	var resources []common.Deletable
-	for _, zone := range zones.HostedZones {
+	for _, zone := range zones {
 		r := NewHostedZone(z.client, zone.Id, zone.Name, z.recordSets)
 
 		if !strings.Contains(r.Name(), filter) {
@@ -32,12 +32,12 @@ var _ = Describe("HostedZones", func() {
 
 		BeforeEach(func() {
 			logger.PromptWithDetailsCall.Returns.Proceed = true
-			client.ListHostedZonesCall.Returns.Output = &awsroute53.ListHostedZonesOutput{
+			client.ListHostedZonesPagesCall.Returns.Pages = []*awsroute53.ListHostedZonesOutput{{
 				HostedZones: []*awsroute53.HostedZone{{
 					Id:   aws.String("the-id"),
 					Name: aws.String("banana"),
 				}},
-			}
+			}}
 			filter = "ban"
 		})
 
@@ -45,7 +45,7 @@ var _ = Describe("HostedZones", func() {
 			items, err := hostedZones.List(filter)
 			Expect(err).NotTo(HaveOccurred())
 
-			Expect(client.ListHostedZonesCall.CallCount).To(Equal(1))
+			Expect(client.ListHostedZonesPagesCall.CallCount).To(Equal(1))
 
 			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
 			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("Route53 Hosted Zone"))
@@ -54,9 +54,31 @@ var _ = Describe("HostedZones", func() {
 			Expect(items).To(HaveLen(1))
 		})
 
+		Context("when there is more than one page of hosted zones", func() {
+			BeforeEach(func() {
+				client.ListHostedZonesPagesCall.Returns.Pages = append(client.ListHostedZonesPagesCall.Returns.Pages, &awsroute53.ListHostedZonesOutput{
+					HostedZones: []*awsroute53.HostedZone{{
+						Id:   aws.String("the-other-id"),
+						Name: aws.String("bandana"),
+					}},
+				})
+			})
+
+			It("returns the hosted zones from every page", func() {
+				items, err := hostedZones.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(client.ListHostedZonesPagesCall.CallCount).To(Equal(1))
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(2))
+				Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("bandana"))
+
+				Expect(items).To(HaveLen(2))
+			})
+		})
+
 		Context("when the client fails to list hosted zones", func() {
 			BeforeEach(func() {
-				client.ListHostedZonesCall.Returns.Error = errors.New("some error")
+				client.ListHostedZonesPagesCall.Returns.Error = errors.New("some error")
 			})
 
 			It("returns the error", func() {
@@ -9,7 +9,7 @@ import (
 )
 
 type recordSetsClient interface {
-	ListResourceRecordSets(*awsroute53.ListResourceRecordSetsInput) (*awsroute53.ListResourceRecordSetsOutput, error)
+	ListResourceRecordSetsPages(*awsroute53.ListResourceRecordSetsInput, func(*awsroute53.ListResourceRecordSetsOutput, bool) bool) error
 	ChangeResourceRecordSets(*awsroute53.ChangeResourceRecordSetsInput) (*awsroute53.ChangeResourceRecordSetsOutput, error)
 }
 
@@ -24,24 +24,15 @@ func NewRecordSets(client recordSetsClient) RecordSets {
 }
 
 func (r RecordSets) Get(hostedZoneId *string) ([]*awsroute53.ResourceRecordSet, error) {
-	var (
-		records    []*awsroute53.ResourceRecordSet
-		nextRecord *string
-	)
-
-	for isTruncated := true; isTruncated; {
-		output, err := r.client.ListResourceRecordSets(&awsroute53.ListResourceRecordSetsInput{
-			HostedZoneId:    hostedZoneId,
-			StartRecordName: nextRecord,
-		})
-		if err != nil {
-			return nil, fmt.Errorf("List Resource Record Sets: %s", err)
-		}
-
-		records = append(records, output.ResourceRecordSets...)
-
-		isTruncated = *output.IsTruncated
-		nextRecord = output.NextRecordName
+	var records []*awsroute53.ResourceRecordSet
+	err := r.client.ListResourceRecordSetsPages(&awsroute53.ListResourceRecordSetsInput{
+		HostedZoneId: hostedZoneId,
+	}, func(page *awsroute53.ListResourceRecordSetsOutput, lastPage bool) bool {
+		records = append(records, page.ResourceRecordSets...)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("List Resource Record Sets: %s", err)
 	}
 
 	return records, nil
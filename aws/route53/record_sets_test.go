@@ -31,15 +31,12 @@ var _ = Describe("RecordSets", func() {
 
 	Describe("Get", func() {
 		BeforeEach(func() {
-			client.ListResourceRecordSetsCall.Returns = []fakes.ListResourceRecordSetsCallReturn{{
-				Output: &awsroute53.ListResourceRecordSetsOutput{
-					ResourceRecordSets: []*awsroute53.ResourceRecordSet{{
-						Name: aws.String("the-name"),
-						Type: aws.String("something-else"),
-					}},
-					IsTruncated: aws.Bool(false),
+			client.ListResourceRecordSetsPagesCall.Returns.Pages = []*awsroute53.ListResourceRecordSetsOutput{{
+				ResourceRecordSets: []*awsroute53.ResourceRecordSet{{
+					Name: aws.String("the-name"),
+					Type: aws.String("something-else"),
 				}},
-			}
+			}}
 		})
 
 		It("gets the record sets", func() {
@@ -48,49 +45,39 @@ var _ = Describe("RecordSets", func() {
 
 			Expect(records).To(HaveLen(1))
 
-			Expect(client.ListResourceRecordSetsCall.CallCount).To(Equal(1))
-			Expect(client.ListResourceRecordSetsCall.Receives[0].Input.HostedZoneId).To(Equal(hostedZoneId))
+			Expect(client.ListResourceRecordSetsPagesCall.CallCount).To(Equal(1))
+			Expect(client.ListResourceRecordSetsPagesCall.Receives.Input.HostedZoneId).To(Equal(hostedZoneId))
 		})
 
 		Context("when there are pages of record sets", func() {
 			BeforeEach(func() {
-				client.ListResourceRecordSetsCall.Returns = []fakes.ListResourceRecordSetsCallReturn{
+				client.ListResourceRecordSetsPagesCall.Returns.Pages = []*awsroute53.ListResourceRecordSetsOutput{
 					{
-						Output: &awsroute53.ListResourceRecordSetsOutput{
-							ResourceRecordSets: []*awsroute53.ResourceRecordSet{{
-								Type: aws.String("something-else"),
-							}},
-							NextRecordName: aws.String("one-more-thing"),
-							IsTruncated:    aws.Bool(true),
-						},
+						ResourceRecordSets: []*awsroute53.ResourceRecordSet{{
+							Type: aws.String("something-else"),
+						}},
 					},
 					{
-						Output: &awsroute53.ListResourceRecordSetsOutput{
-							ResourceRecordSets: []*awsroute53.ResourceRecordSet{{
-								Type: aws.String("one-more-thing"),
-							}},
-							IsTruncated: aws.Bool(false),
-						},
+						ResourceRecordSets: []*awsroute53.ResourceRecordSet{{
+							Type: aws.String("one-more-thing"),
+						}},
 					},
 				}
 			})
 
-			It("loops over the list request", func() {
+			It("gets the record sets from every page", func() {
 				records, err := recordSets.Get(hostedZoneId)
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(records).To(HaveLen(2))
-
-				Expect(client.ListResourceRecordSetsCall.CallCount).To(Equal(2))
-				Expect(client.ListResourceRecordSetsCall.Receives[0].Input.HostedZoneId).To(Equal(hostedZoneId))
-				Expect(client.ListResourceRecordSetsCall.Receives[0].Input.StartRecordName).To(BeNil())
-				Expect(client.ListResourceRecordSetsCall.Receives[1].Input.StartRecordName).To(Equal(aws.String("one-more-thing")))
+				Expect(records[0].Type).To(Equal(aws.String("something-else")))
+				Expect(records[1].Type).To(Equal(aws.String("one-more-thing")))
 			})
 		})
 
 		Context("when the client fails to list resource record sets", func() {
 			BeforeEach(func() {
-				client.ListResourceRecordSetsCall.Returns = []fakes.ListResourceRecordSetsCallReturn{{Error: errors.New("banana")}}
+				client.ListResourceRecordSetsPagesCall.Returns.Error = errors.New("banana")
 			})
 
 			It("returns the error", func() {
@@ -1,29 +1,58 @@
 package s3
 
 import (
+	"errors"
 	"fmt"
+	"net/url"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	awss3 "github.com/aws/aws-sdk-go/service/s3"
+	"github.com/genevieve/leftovers/common"
+)
+
+const (
+	// maxCopySize is the size of the largest object that CopyObject copies.
+	maxCopySize = 5 * 1024 * 1024 * 1024
+	// copyPartSize is the size of the parts that larger objects are copied
+	// in, which keeps objects of up to 5 TB under the limit of 10,000 parts.
+	copyPartSize = 1024 * 1024 * 1024
 )
 
 type Bucket struct {
-	client     bucketsClient
+	client     BucketsClient
+	logger     logger
 	name       *string
 	identifier string
 	rtype      string
+	backup     common.Backup
 }
 
-func NewBucket(client bucketsClient, name *string) Bucket {
+func NewBucket(client BucketsClient, logger logger, name *string, backup common.Backup) Bucket {
 	return Bucket{
 		client:     client,
+		logger:     logger,
 		name:       name,
 		identifier: *name,
 		rtype:      "S3 Bucket",
+		backup:     backup,
 	}
 }
 
+// Delete copies the objects in the bucket to the backup bucket if
+// backup is enabled, then empties and deletes the bucket.
 func (b Bucket) Delete() error {
+	if b.backup.Enabled {
+		err := b.archive()
+		if err != nil {
+			return fmt.Errorf("Backup: %s", err)
+		}
+	}
+
+	return b.delete()
+}
+
+func (b Bucket) delete() error {
 	_, err := b.client.DeleteBucket(&awss3.DeleteBucketInput{
 		Bucket: b.name,
 	})
@@ -65,7 +94,7 @@ func (b Bucket) Delete() error {
 				return err
 			}
 
-			return b.Delete()
+			return b.delete()
 		}
 
 		return fmt.Errorf("Delete: %s", err)
@@ -74,6 +103,117 @@ func (b Bucket) Delete() error {
 	return nil
 }
 
+// archive copies the current version of every object in the bucket
+// under a new prefix in the backup bucket.
+func (b Bucket) archive() error {
+	if b.backup.Bucket == "" {
+		return errors.New("Missing backup bucket.")
+	}
+
+	prefix := common.BackupName(b.identifier, 512)
+
+	input := &awss3.ListObjectsV2Input{Bucket: b.name}
+	for {
+		resp, err := b.client.ListObjectsV2(input)
+		if err != nil {
+			return fmt.Errorf("List objects: %s", err)
+		}
+
+		for _, o := range resp.Contents {
+			err = b.copy(*o.Key, fmt.Sprintf("%s/%s", prefix, *o.Key), aws.Int64Value(o.Size))
+			if err != nil {
+				return fmt.Errorf("Copy object %s: %s", *o.Key, err)
+			}
+		}
+
+		if resp.NextContinuationToken == nil {
+			break
+		}
+
+		input.ContinuationToken = resp.NextContinuationToken
+	}
+
+	b.logger.Printf("[%s: %s] Archived to s3://%s/%s/\n", b.rtype, b.identifier, b.backup.Bucket, prefix)
+
+	return nil
+}
+
+// copy copies the object with the provided key and size to the
+// destination key in the backup bucket, in parts if it is too
+// large to be copied at once.
+func (b Bucket) copy(key, destination string, size int64) error {
+	source := aws.String(b.identifier + "/" + url.PathEscape(key))
+
+	if size <= maxCopySize {
+		_, err := b.client.CopyObject(&awss3.CopyObjectInput{
+			Bucket:     aws.String(b.backup.Bucket),
+			Key:        aws.String(destination),
+			CopySource: source,
+		})
+		return err
+	}
+
+	upload, err := b.client.CreateMultipartUpload(&awss3.CreateMultipartUploadInput{
+		Bucket: aws.String(b.backup.Bucket),
+		Key:    aws.String(destination),
+	})
+	if err != nil {
+		return err
+	}
+
+	var parts []*awss3.CompletedPart
+	for part, start := int64(1), int64(0); start < size; part, start = part+1, start+copyPartSize {
+		end := start + copyPartSize - 1
+		if end >= size {
+			end = size - 1
+		}
+
+		resp, err := b.client.UploadPartCopy(&awss3.UploadPartCopyInput{
+			Bucket:          aws.String(b.backup.Bucket),
+			Key:             aws.String(destination),
+			CopySource:      source,
+			CopySourceRange: aws.String(fmt.Sprintf("bytes=%d-%d", start, end)),
+			PartNumber:      aws.Int64(part),
+			UploadId:        upload.UploadId,
+		})
+		if err != nil {
+			b.abort(destination, upload.UploadId)
+			return err
+		}
+
+		parts = append(parts, &awss3.CompletedPart{
+			ETag:       resp.CopyPartResult.ETag,
+			PartNumber: aws.Int64(part),
+		})
+	}
+
+	_, err = b.client.CompleteMultipartUpload(&awss3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(b.backup.Bucket),
+		Key:             aws.String(destination),
+		UploadId:        upload.UploadId,
+		MultipartUpload: &awss3.CompletedMultipartUpload{Parts: parts},
+	})
+	if err != nil {
+		b.abort(destination, upload.UploadId)
+		return err
+	}
+
+	return nil
+}
+
+// abort aborts the multipart upload, so that the parts
+// that were copied are not kept in the backup bucket.
+func (b Bucket) abort(key string, uploadID *string) {
+	_, err := b.client.AbortMultipartUpload(&awss3.AbortMultipartUploadInput{
+		Bucket:   aws.String(b.backup.Bucket),
+		Key:      aws.String(key),
+		UploadId: uploadID,
+	})
+	if err != nil {
+		b.logger.Printf("[%s: %s] Abort upload of %s: %s\n", b.rtype, b.identifier, key, err)
+	}
+}
+
 func (b Bucket) Name() string {
 	return b.identifier
 }
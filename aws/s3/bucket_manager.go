@@ -2,26 +2,27 @@ package s3
 
 import (
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/aws/client"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 )
 
 type bucketManager interface {
-	IsInRegion(bucket string) bool
+	Region(bucket string) string
 }
 
 type BucketManager struct {
-	region string
+	sess client.ConfigProvider
 }
 
-func NewBucketManager(region string) BucketManager {
+func NewBucketManager(sess client.ConfigProvider) BucketManager {
 	return BucketManager{
-		region: region,
+		sess: sess,
 	}
 }
 
-func (u BucketManager) IsInRegion(bucket string) bool {
-	sess := session.Must(session.NewSession())
-	r, _ := s3manager.GetBucketRegion(aws.BackgroundContext(), sess, bucket, "us-west-1")
-	return u.region == r
+// Region returns the region that the bucket is in,
+// or an empty string if it cannot be found.
+func (u BucketManager) Region(bucket string) string {
+	r, _ := s3manager.GetBucketRegion(aws.BackgroundContext(), u.sess, bucket, "us-west-1")
+	return r
 }
@@ -2,10 +2,13 @@ package s3_test
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
+	awss3 "github.com/aws/aws-sdk-go/service/s3"
 	"github.com/genevieve/leftovers/aws/s3"
 	"github.com/genevieve/leftovers/aws/s3/fakes"
+	"github.com/genevieve/leftovers/common"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -15,14 +18,16 @@ var _ = Describe("Bucket", func() {
 	var (
 		bucket s3.Bucket
 		client *fakes.BucketsClient
+		logger *fakes.Logger
 		name   *string
 	)
 
 	BeforeEach(func() {
 		client = &fakes.BucketsClient{}
+		logger = &fakes.Logger{}
 		name = aws.String("the-name")
 
-		bucket = s3.NewBucket(client, name)
+		bucket = s3.NewBucket(client, logger, name, common.Backup{})
 	})
 
 	Describe("Delete", func() {
@@ -32,6 +37,116 @@ var _ = Describe("Bucket", func() {
 
 			Expect(client.DeleteBucketCall.CallCount).To(Equal(1))
 			Expect(client.DeleteBucketCall.Receives.Input.Bucket).To(Equal(name))
+
+			Expect(client.CopyObjectCall.CallCount).To(Equal(0))
+		})
+
+		Context("when backup is enabled", func() {
+			BeforeEach(func() {
+				client.ListObjectsV2Call.Returns.Output = &awss3.ListObjectsV2Output{
+					Contents: []*awss3.Object{{Key: aws.String("some/key")}},
+				}
+
+				bucket = s3.NewBucket(client, logger, name, common.Backup{Enabled: true, Bucket: "the-archive"})
+			})
+
+			It("copies the objects to the backup bucket before deleting the bucket", func() {
+				err := bucket.Delete()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(client.ListObjectsV2Call.Receives.Input.Bucket).To(Equal(name))
+				Expect(client.CopyObjectCall.CallCount).To(Equal(1))
+				Expect(client.CopyObjectCall.Receives.Input.Bucket).To(Equal(aws.String("the-archive")))
+				Expect(*client.CopyObjectCall.Receives.Input.Key).To(MatchRegexp(`^the-name-leftovers-\d+/some/key$`))
+				Expect(client.CopyObjectCall.Receives.Input.CopySource).To(Equal(aws.String("the-name/some%2Fkey")))
+
+				Expect(logger.PrintfCall.Messages[0]).To(HavePrefix("[S3 Bucket: the-name] Archived to s3://the-archive/the-name-leftovers-"))
+				Expect(client.DeleteBucketCall.CallCount).To(Equal(1))
+			})
+
+			Context("when there is no backup bucket", func() {
+				BeforeEach(func() {
+					bucket = s3.NewBucket(client, logger, name, common.Backup{Enabled: true})
+				})
+
+				It("does not delete the bucket", func() {
+					err := bucket.Delete()
+					Expect(err).To(MatchError("Backup: Missing backup bucket."))
+
+					Expect(client.DeleteBucketCall.CallCount).To(Equal(0))
+				})
+			})
+
+			Context("when an object is larger than 5 GB", func() {
+				var ranges []string
+
+				BeforeEach(func() {
+					client.ListObjectsV2Call.Returns.Output = &awss3.ListObjectsV2Output{
+						Contents: []*awss3.Object{{Key: aws.String("some/key"), Size: aws.Int64(5*1024*1024*1024 + 1)}},
+					}
+					client.CreateMultipartUploadCall.Returns.Output = &awss3.CreateMultipartUploadOutput{UploadId: aws.String("the-upload")}
+
+					ranges = nil
+					client.UploadPartCopyCall.Stub = func(input *awss3.UploadPartCopyInput) (*awss3.UploadPartCopyOutput, error) {
+						ranges = append(ranges, *input.CopySourceRange)
+						return &awss3.UploadPartCopyOutput{CopyPartResult: &awss3.CopyPartResult{ETag: aws.String(fmt.Sprintf("etag-%d", *input.PartNumber))}}, nil
+					}
+				})
+
+				It("copies it in parts", func() {
+					err := bucket.Delete()
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(client.CopyObjectCall.CallCount).To(Equal(0))
+
+					Expect(client.CreateMultipartUploadCall.Receives.Input.Bucket).To(Equal(aws.String("the-archive")))
+					Expect(*client.CreateMultipartUploadCall.Receives.Input.Key).To(MatchRegexp(`^the-name-leftovers-\d+/some/key$`))
+
+					Expect(client.UploadPartCopyCall.CallCount).To(Equal(6))
+					Expect(client.UploadPartCopyCall.Receives.Input.CopySource).To(Equal(aws.String("the-name/some%2Fkey")))
+					Expect(client.UploadPartCopyCall.Receives.Input.UploadId).To(Equal(aws.String("the-upload")))
+					Expect(ranges[0]).To(Equal("bytes=0-1073741823"))
+					Expect(ranges[5]).To(Equal("bytes=5368709120-5368709120"))
+
+					parts := client.CompleteMultipartUploadCall.Receives.Input.MultipartUpload.Parts
+					Expect(parts).To(HaveLen(6))
+					Expect(parts[5].ETag).To(Equal(aws.String("etag-6")))
+					Expect(parts[5].PartNumber).To(Equal(aws.Int64(6)))
+
+					Expect(client.AbortMultipartUploadCall.CallCount).To(Equal(0))
+					Expect(client.DeleteBucketCall.CallCount).To(Equal(1))
+				})
+
+				Context("when the client fails to copy a part", func() {
+					BeforeEach(func() {
+						client.UploadPartCopyCall.Stub = nil
+						client.UploadPartCopyCall.Returns.Error = errors.New("banana")
+					})
+
+					It("aborts the upload and does not delete the bucket", func() {
+						err := bucket.Delete()
+						Expect(err).To(MatchError("Backup: Copy object some/key: banana"))
+
+						Expect(client.AbortMultipartUploadCall.CallCount).To(Equal(1))
+						Expect(client.AbortMultipartUploadCall.Receives.Input.UploadId).To(Equal(aws.String("the-upload")))
+						Expect(client.CompleteMultipartUploadCall.CallCount).To(Equal(0))
+						Expect(client.DeleteBucketCall.CallCount).To(Equal(0))
+					})
+				})
+			})
+
+			Context("when the client fails to copy an object", func() {
+				BeforeEach(func() {
+					client.CopyObjectCall.Returns.Error = errors.New("banana")
+				})
+
+				It("does not delete the bucket", func() {
+					err := bucket.Delete()
+					Expect(err).To(MatchError("Backup: Copy object some/key: banana"))
+
+					Expect(client.DeleteBucketCall.CallCount).To(Equal(0))
+				})
+			})
 		})
 
 		Context("the client fails", func() {
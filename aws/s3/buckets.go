@@ -8,25 +8,41 @@ import (
 	"github.com/genevieve/leftovers/common"
 )
 
-type bucketsClient interface {
+// BucketsClient lists and deletes S3 buckets. Buckets are
+// deleted with the client of the region that they are in.
+type BucketsClient interface {
 	ListBuckets(*awss3.ListBucketsInput) (*awss3.ListBucketsOutput, error)
 	DeleteBucket(*awss3.DeleteBucketInput) (*awss3.DeleteBucketOutput, error)
 
 	ListObjectVersions(*awss3.ListObjectVersionsInput) (*awss3.ListObjectVersionsOutput, error)
 	DeleteObjects(*awss3.DeleteObjectsInput) (*awss3.DeleteObjectsOutput, error)
+
+	ListObjectsV2(*awss3.ListObjectsV2Input) (*awss3.ListObjectsV2Output, error)
+	CopyObject(*awss3.CopyObjectInput) (*awss3.CopyObjectOutput, error)
+
+	CreateMultipartUpload(*awss3.CreateMultipartUploadInput) (*awss3.CreateMultipartUploadOutput, error)
+	UploadPartCopy(*awss3.UploadPartCopyInput) (*awss3.UploadPartCopyOutput, error)
+	CompleteMultipartUpload(*awss3.CompleteMultipartUploadInput) (*awss3.CompleteMultipartUploadOutput, error)
+	AbortMultipartUpload(*awss3.AbortMultipartUploadInput) (*awss3.AbortMultipartUploadOutput, error)
 }
 
 type Buckets struct {
-	client  bucketsClient
+	client  BucketsClient
+	clients map[string]BucketsClient
 	logger  logger
 	manager bucketManager
+	backup  common.Backup
 }
 
-func NewBuckets(client bucketsClient, logger logger, manager bucketManager) Buckets {
+// NewBuckets returns the S3 buckets that are listed with the provided
+// client and are in one of the regions of the provided clients.
+func NewBuckets(client BucketsClient, clients map[string]BucketsClient, logger logger, manager bucketManager, backup common.Backup) Buckets {
 	return Buckets{
 		client:  client,
+		clients: clients,
 		logger:  logger,
 		manager: manager,
+		backup:  backup,
 	}
 }
 
@@ -38,16 +54,23 @@ func (b Buckets) List(filter string) ([]common.Deletable, error) {
 
 	var resources []common.Deletable
 	for _, bucket := range buckets.Buckets {
-		r := NewBucket(b.client, bucket.Name)
+		name := *bucket.Name
+
+		if !strings.Contains(name, filter) {
+			continue
+		}
 
-		if !strings.Contains(r.Name(), filter) {
+		if name == b.backup.Bucket {
 			continue
 		}
 
-		if !b.manager.IsInRegion(r.Name()) {
+		client, ok := b.clients[b.manager.Region(name)]
+		if !ok {
 			continue
 		}
 
+		r := NewBucket(client, b.logger, bucket.Name, b.backup)
+
 		proceed := b.logger.PromptWithDetails(r.Type(), r.Name())
 		if !proceed {
 			continue
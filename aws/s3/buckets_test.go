@@ -7,6 +7,7 @@ import (
 	awss3 "github.com/aws/aws-sdk-go/service/s3"
 	"github.com/genevieve/leftovers/aws/s3"
 	"github.com/genevieve/leftovers/aws/s3/fakes"
+	"github.com/genevieve/leftovers/common"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -14,6 +15,7 @@ import (
 var _ = Describe("Buckets", func() {
 	var (
 		client  *fakes.BucketsClient
+		clients map[string]s3.BucketsClient
 		logger  *fakes.Logger
 		manager *fakes.BucketManager
 
@@ -24,8 +26,9 @@ var _ = Describe("Buckets", func() {
 		client = &fakes.BucketsClient{}
 		logger = &fakes.Logger{}
 		manager = &fakes.BucketManager{}
+		clients = map[string]s3.BucketsClient{"region-1": client}
 
-		buckets = s3.NewBuckets(client, logger, manager)
+		buckets = s3.NewBuckets(client, clients, logger, manager, common.Backup{})
 	})
 
 	Describe("List", func() {
@@ -38,7 +41,7 @@ var _ = Describe("Buckets", func() {
 					Name: aws.String("banana"),
 				}},
 			}
-			manager.IsInRegionCall.Returns.Output = true
+			manager.RegionCall.Returns.Region = "region-1"
 			filter = "ban"
 		})
 
@@ -47,8 +50,8 @@ var _ = Describe("Buckets", func() {
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(client.ListBucketsCall.CallCount).To(Equal(1))
-			Expect(manager.IsInRegionCall.CallCount).To(Equal(1))
-			Expect(manager.IsInRegionCall.Receives.Bucket).To(Equal("banana"))
+			Expect(manager.RegionCall.CallCount).To(Equal(1))
+			Expect(manager.RegionCall.Receives.Bucket).To(Equal("banana"))
 
 			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("S3 Bucket"))
 			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("banana"))
@@ -72,16 +75,38 @@ var _ = Describe("Buckets", func() {
 				items, err := buckets.List("kiwi")
 				Expect(err).NotTo(HaveOccurred())
 
-				Expect(manager.IsInRegionCall.CallCount).To(Equal(0))
+				Expect(manager.RegionCall.CallCount).To(Equal(0))
 				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
 
 				Expect(items).To(HaveLen(0))
 			})
 		})
 
-		Context("when the bucket isn't in the region configured", func() {
+		Context("when the bucket is in another of the regions configured", func() {
+			var otherClient *fakes.BucketsClient
+
+			BeforeEach(func() {
+				otherClient = &fakes.BucketsClient{}
+				clients["region-2"] = otherClient
+				manager.RegionCall.Returns.Region = "region-2"
+			})
+
+			It("returns it to be deleted with the client of its region", func() {
+				items, err := buckets.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(items).To(HaveLen(1))
+
+				err = items[0].Delete()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(otherClient.DeleteBucketCall.CallCount).To(Equal(1))
+				Expect(client.DeleteBucketCall.CallCount).To(Equal(0))
+			})
+		})
+
+		Context("when the bucket isn't in the regions configured", func() {
 			BeforeEach(func() {
-				manager.IsInRegionCall.Returns.Output = false
+				manager.RegionCall.Returns.Region = "region-2"
 			})
 
 			It("does not return it in the list", func() {
@@ -92,6 +117,20 @@ var _ = Describe("Buckets", func() {
 			})
 		})
 
+		Context("when the bucket is the backup bucket", func() {
+			BeforeEach(func() {
+				buckets = s3.NewBuckets(client, clients, logger, manager, common.Backup{Enabled: true, Bucket: "banana"})
+			})
+
+			It("does not return it in the list", func() {
+				items, err := buckets.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+
 		Context("when the user responds no to the prompt", func() {
 			BeforeEach(func() {
 				logger.PromptWithDetailsCall.Returns.Proceed = false
@@ -1,20 +1,20 @@
 package fakes
 
 type BucketManager struct {
-	IsInRegionCall struct {
+	RegionCall struct {
 		CallCount int
 		Receives  struct {
 			Bucket string
 		}
 		Returns struct {
-			Output bool
+			Region string
 		}
 	}
 }
 
-func (b *BucketManager) IsInRegion(bucket string) bool {
-	b.IsInRegionCall.CallCount++
-	b.IsInRegionCall.Receives.Bucket = bucket
+func (b *BucketManager) Region(bucket string) string {
+	b.RegionCall.CallCount++
+	b.RegionCall.Receives.Bucket = bucket
 
-	return b.IsInRegionCall.Returns.Output
+	return b.RegionCall.Returns.Region
 }
@@ -46,6 +46,73 @@ type BucketsClient struct {
 			Error  error
 		}
 	}
+
+	ListObjectsV2Call struct {
+		CallCount int
+		Receives  struct {
+			Input *awss3.ListObjectsV2Input
+		}
+		Returns struct {
+			Output *awss3.ListObjectsV2Output
+			Error  error
+		}
+	}
+
+	CopyObjectCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awss3.CopyObjectInput
+		}
+		Returns struct {
+			Output *awss3.CopyObjectOutput
+			Error  error
+		}
+	}
+
+	CreateMultipartUploadCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awss3.CreateMultipartUploadInput
+		}
+		Returns struct {
+			Output *awss3.CreateMultipartUploadOutput
+			Error  error
+		}
+	}
+
+	UploadPartCopyCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awss3.UploadPartCopyInput
+		}
+		Returns struct {
+			Output *awss3.UploadPartCopyOutput
+			Error  error
+		}
+		Stub func(*awss3.UploadPartCopyInput) (*awss3.UploadPartCopyOutput, error)
+	}
+
+	CompleteMultipartUploadCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awss3.CompleteMultipartUploadInput
+		}
+		Returns struct {
+			Output *awss3.CompleteMultipartUploadOutput
+			Error  error
+		}
+	}
+
+	AbortMultipartUploadCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awss3.AbortMultipartUploadInput
+		}
+		Returns struct {
+			Output *awss3.AbortMultipartUploadOutput
+			Error  error
+		}
+	}
 }
 
 func (i *BucketsClient) ListBuckets(input *awss3.ListBucketsInput) (*awss3.ListBucketsOutput, error) {
@@ -75,3 +142,49 @@ func (i *BucketsClient) DeleteObjects(input *awss3.DeleteObjectsInput) (*awss3.D
 
 	return i.DeleteObjectsCall.Returns.Output, i.DeleteObjectsCall.Returns.Error
 }
+
+func (i *BucketsClient) ListObjectsV2(input *awss3.ListObjectsV2Input) (*awss3.ListObjectsV2Output, error) {
+	i.ListObjectsV2Call.CallCount++
+	i.ListObjectsV2Call.Receives.Input = input
+
+	return i.ListObjectsV2Call.Returns.Output, i.ListObjectsV2Call.Returns.Error
+}
+
+func (i *BucketsClient) CopyObject(input *awss3.CopyObjectInput) (*awss3.CopyObjectOutput, error) {
+	i.CopyObjectCall.CallCount++
+	i.CopyObjectCall.Receives.Input = input
+
+	return i.CopyObjectCall.Returns.Output, i.CopyObjectCall.Returns.Error
+}
+
+func (i *BucketsClient) CreateMultipartUpload(input *awss3.CreateMultipartUploadInput) (*awss3.CreateMultipartUploadOutput, error) {
+	i.CreateMultipartUploadCall.CallCount++
+	i.CreateMultipartUploadCall.Receives.Input = input
+
+	return i.CreateMultipartUploadCall.Returns.Output, i.CreateMultipartUploadCall.Returns.Error
+}
+
+func (i *BucketsClient) UploadPartCopy(input *awss3.UploadPartCopyInput) (*awss3.UploadPartCopyOutput, error) {
+	i.UploadPartCopyCall.CallCount++
+	i.UploadPartCopyCall.Receives.Input = input
+
+	if i.UploadPartCopyCall.Stub != nil {
+		return i.UploadPartCopyCall.Stub(input)
+	}
+
+	return i.UploadPartCopyCall.Returns.Output, i.UploadPartCopyCall.Returns.Error
+}
+
+func (i *BucketsClient) CompleteMultipartUpload(input *awss3.CompleteMultipartUploadInput) (*awss3.CompleteMultipartUploadOutput, error) {
+	i.CompleteMultipartUploadCall.CallCount++
+	i.CompleteMultipartUploadCall.Receives.Input = input
+
+	return i.CompleteMultipartUploadCall.Returns.Output, i.CompleteMultipartUploadCall.Returns.Error
+}
+
+func (i *BucketsClient) AbortMultipartUpload(input *awss3.AbortMultipartUploadInput) (*awss3.AbortMultipartUploadOutput, error) {
+	i.AbortMultipartUploadCall.CallCount++
+	i.AbortMultipartUploadCall.Receives.Input = input
+
+	return i.AbortMultipartUploadCall.Returns.Output, i.AbortMultipartUploadCall.Returns.Error
+}
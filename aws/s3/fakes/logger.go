@@ -1,6 +1,17 @@
 package fakes
 
+import "fmt"
+
 type Logger struct {
+	PrintfCall struct {
+		CallCount int
+		Receives  struct {
+			Message   string
+			Arguments []interface{}
+		}
+		Messages []string
+	}
+
 	PromptWithDetailsCall struct {
 		CallCount int
 		Receives  struct {
@@ -13,6 +24,14 @@ type Logger struct {
 	}
 }
 
+func (l *Logger) Printf(message string, a ...interface{}) {
+	l.PrintfCall.CallCount++
+	l.PrintfCall.Receives.Message = message
+	l.PrintfCall.Receives.Arguments = a
+
+	l.PrintfCall.Messages = append(l.PrintfCall.Messages, fmt.Sprintf(message, a...))
+}
+
 func (l *Logger) PromptWithDetails(resourceType, resourceName string) bool {
 	l.PromptWithDetailsCall.CallCount++
 	l.PromptWithDetailsCall.Receives.Type = resourceType
@@ -0,0 +1,50 @@
+package fakes
+
+import (
+	awssecretsmanager "github.com/aws/aws-sdk-go/service/secretsmanager"
+)
+
+type SecretsClient struct {
+	ListSecretsPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awssecretsmanager.ListSecretsInput
+		}
+		Returns struct {
+			Pages []*awssecretsmanager.ListSecretsOutput
+			Error error
+		}
+	}
+
+	DeleteSecretCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awssecretsmanager.DeleteSecretInput
+		}
+		Returns struct {
+			Output *awssecretsmanager.DeleteSecretOutput
+			Error  error
+		}
+	}
+}
+
+func (s *SecretsClient) ListSecretsPages(input *awssecretsmanager.ListSecretsInput, fn func(*awssecretsmanager.ListSecretsOutput, bool) bool) error {
+	s.ListSecretsPagesCall.CallCount++
+	s.ListSecretsPagesCall.Receives.Input = input
+
+	pages := s.ListSecretsPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return s.ListSecretsPagesCall.Returns.Error
+}
+
+func (s *SecretsClient) DeleteSecret(input *awssecretsmanager.DeleteSecretInput) (*awssecretsmanager.DeleteSecretOutput, error) {
+	s.DeleteSecretCall.CallCount++
+	s.DeleteSecretCall.Receives.Input = input
+
+	return s.DeleteSecretCall.Returns.Output, s.DeleteSecretCall.Returns.Error
+}
@@ -0,0 +1,13 @@
+package secretsmanager_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestSecretsManager(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "aws/secretsmanager")
+}
@@ -0,0 +1,60 @@
+package secretsmanager
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awssecretsmanager "github.com/aws/aws-sdk-go/service/secretsmanager"
+)
+
+type Secret struct {
+	client                     secretsClient
+	arn                        *string
+	identifier                 string
+	rtype                      string
+	forceDeleteWithoutRecovery bool
+}
+
+func NewSecret(client secretsClient, arn, name *string, tags []*awssecretsmanager.Tag, forceDeleteWithoutRecovery bool) Secret {
+	identifier := *name
+
+	var extra []string
+	for _, t := range tags {
+		extra = append(extra, fmt.Sprintf("%s:%s", *t.Key, *t.Value))
+	}
+
+	if len(extra) > 0 {
+		identifier = fmt.Sprintf("%s (%s)", *name, strings.Join(extra, ", "))
+	}
+
+	return Secret{
+		client:                     client,
+		arn:                        arn,
+		identifier:                 identifier,
+		rtype:                      "Secrets Manager Secret",
+		forceDeleteWithoutRecovery: forceDeleteWithoutRecovery,
+	}
+}
+
+// Delete schedules the secret for deletion after the default recovery
+// window, or deletes it immediately if forceDeleteWithoutRecovery is true.
+func (s Secret) Delete() error {
+	_, err := s.client.DeleteSecret(&awssecretsmanager.DeleteSecretInput{
+		SecretId:                   s.arn,
+		ForceDeleteWithoutRecovery: aws.Bool(s.forceDeleteWithoutRecovery),
+	})
+	if err != nil {
+		return fmt.Errorf("Delete: %s", err)
+	}
+
+	return nil
+}
+
+func (s Secret) Name() string {
+	return s.identifier
+}
+
+func (s Secret) Type() string {
+	return s.rtype
+}
@@ -0,0 +1,78 @@
+package secretsmanager_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awssecretsmanager "github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/genevieve/leftovers/aws/secretsmanager"
+	"github.com/genevieve/leftovers/aws/secretsmanager/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Secret", func() {
+	var (
+		secret secretsmanager.Secret
+		client *fakes.SecretsClient
+		arn    *string
+		name   *string
+		tags   []*awssecretsmanager.Tag
+	)
+
+	BeforeEach(func() {
+		client = &fakes.SecretsClient{}
+		arn = aws.String("the-arn")
+		name = aws.String("the-name")
+		tags = []*awssecretsmanager.Tag{{Key: aws.String("the-key"), Value: aws.String("the-value")}}
+
+		secret = secretsmanager.NewSecret(client, arn, name, tags, false)
+	})
+
+	Describe("Delete", func() {
+		It("schedules the secret for deletion", func() {
+			err := secret.Delete()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DeleteSecretCall.CallCount).To(Equal(1))
+			Expect(client.DeleteSecretCall.Receives.Input.SecretId).To(Equal(arn))
+			Expect(client.DeleteSecretCall.Receives.Input.ForceDeleteWithoutRecovery).To(Equal(aws.Bool(false)))
+		})
+
+		Context("when force delete without recovery is true", func() {
+			BeforeEach(func() {
+				secret = secretsmanager.NewSecret(client, arn, name, tags, true)
+			})
+
+			It("deletes the secret without a recovery window", func() {
+				err := secret.Delete()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(client.DeleteSecretCall.Receives.Input.ForceDeleteWithoutRecovery).To(Equal(aws.Bool(true)))
+			})
+		})
+
+		Context("when the client fails", func() {
+			BeforeEach(func() {
+				client.DeleteSecretCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := secret.Delete()
+				Expect(err).To(MatchError("Delete: banana"))
+			})
+		})
+	})
+
+	Describe("Name", func() {
+		It("returns the identifier", func() {
+			Expect(secret.Name()).To(Equal("the-name (the-key:the-value)"))
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(secret.Type()).To(Equal("Secrets Manager Secret"))
+		})
+	})
+})
@@ -0,0 +1,69 @@
+package secretsmanager
+
+import (
+	"fmt"
+	"strings"
+
+	awssecretsmanager "github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/genevieve/leftovers/common"
+)
+
+type secretsClient interface {
+	ListSecretsPages(*awssecretsmanager.ListSecretsInput, func(*awssecretsmanager.ListSecretsOutput, bool) bool) error
+	DeleteSecret(*awssecretsmanager.DeleteSecretInput) (*awssecretsmanager.DeleteSecretOutput, error)
+}
+
+type Secrets struct {
+	client                     secretsClient
+	logger                     logger
+	forceDeleteWithoutRecovery bool
+}
+
+func NewSecrets(client secretsClient, logger logger, forceDeleteWithoutRecovery bool) Secrets {
+	return Secrets{
+		client:                     client,
+		logger:                     logger,
+		forceDeleteWithoutRecovery: forceDeleteWithoutRecovery,
+	}
+}
+
+// List returns the secrets whose names start with the filter, ignoring a
+// leading slash so that paths like /env/db-password match env. Secrets that
+// are scheduled for deletion are not listed, and secrets that are managed by
+// another service, like RDS, are skipped since they are deleted with it.
+func (s Secrets) List(filter string) ([]common.Deletable, error) {
+	var secrets []*awssecretsmanager.SecretListEntry
+	err := s.client.ListSecretsPages(&awssecretsmanager.ListSecretsInput{}, func(page *awssecretsmanager.ListSecretsOutput, lastPage bool) bool {
+		secrets = append(secrets, page.SecretList...)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("List Secrets Manager Secrets: %s", err)
+	}
+
+	var resources []common.Deletable
+	for _, secret := range secrets {
+		if secret.DeletedDate != nil || secret.OwningService != nil {
+			continue
+		}
+
+		if !strings.HasPrefix(strings.TrimPrefix(*secret.Name, "/"), strings.TrimPrefix(filter, "/")) {
+			continue
+		}
+
+		r := NewSecret(s.client, secret.ARN, secret.Name, secret.Tags, s.forceDeleteWithoutRecovery)
+
+		proceed := s.logger.PromptWithDetails(r.Type(), r.Name())
+		if !proceed {
+			continue
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func (s Secrets) Type() string {
+	return "secretsmanager-secret"
+}
@@ -0,0 +1,113 @@
+package secretsmanager_test
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awssecretsmanager "github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/genevieve/leftovers/aws/secretsmanager"
+	"github.com/genevieve/leftovers/aws/secretsmanager/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Secrets", func() {
+	var (
+		client *fakes.SecretsClient
+		logger *fakes.Logger
+
+		secrets secretsmanager.Secrets
+	)
+
+	BeforeEach(func() {
+		client = &fakes.SecretsClient{}
+		logger = &fakes.Logger{}
+		logger.PromptWithDetailsCall.Returns.Proceed = true
+
+		secrets = secretsmanager.NewSecrets(client, logger, true)
+	})
+
+	Describe("List", func() {
+		var filter string
+
+		BeforeEach(func() {
+			client.ListSecretsPagesCall.Returns.Pages = []*awssecretsmanager.ListSecretsOutput{{
+				SecretList: []*awssecretsmanager.SecretListEntry{{
+					ARN:  aws.String("arn:aws:secretsmanager:us-east-1:123:secret:banana-password"),
+					Name: aws.String("banana-password"),
+				}, {
+					ARN:  aws.String("arn:aws:secretsmanager:us-east-1:123:secret:/banana/db"),
+					Name: aws.String("/banana/db"),
+					Tags: []*awssecretsmanager.Tag{{Key: aws.String("the-key"), Value: aws.String("the-value")}},
+				}, {
+					ARN:  aws.String("arn:aws:secretsmanager:us-east-1:123:secret:kiwi-banana"),
+					Name: aws.String("kiwi-banana"),
+				}, {
+					ARN:         aws.String("arn:aws:secretsmanager:us-east-1:123:secret:banana-deleted"),
+					Name:        aws.String("banana-deleted"),
+					DeletedDate: aws.Time(time.Now()),
+				}, {
+					ARN:           aws.String("arn:aws:secretsmanager:us-east-1:123:secret:banana-rds"),
+					Name:          aws.String("banana-rds"),
+					OwningService: aws.String("rds"),
+				}},
+			}}
+			filter = "banana"
+		})
+
+		It("returns the secrets whose names or paths start with the filter", func() {
+			items, err := secrets.List(filter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.ListSecretsPagesCall.CallCount).To(Equal(1))
+
+			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(2))
+			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("Secrets Manager Secret"))
+			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("/banana/db (the-key:the-value)"))
+
+			Expect(items).To(HaveLen(2))
+		})
+
+		Context("when the filter is a path", func() {
+			It("returns the secrets under it", func() {
+				items, err := secrets.List("/banana/")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(items).To(HaveLen(1))
+				Expect(items[0].Name()).To(Equal("/banana/db (the-key:the-value)"))
+			})
+		})
+
+		Context("when the client fails to list secrets", func() {
+			BeforeEach(func() {
+				client.ListSecretsPagesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := secrets.List(filter)
+				Expect(err).To(MatchError("List Secrets Manager Secrets: some error"))
+			})
+		})
+
+		Context("when the user responds no to the prompt", func() {
+			BeforeEach(func() {
+				logger.PromptWithDetailsCall.Returns.Proceed = false
+			})
+
+			It("does not return it to the list", func() {
+				items, err := secrets.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(2))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(secrets.Type()).To(Equal("secretsmanager-secret"))
+		})
+	})
+})
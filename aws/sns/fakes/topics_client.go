@@ -0,0 +1,111 @@
+package fakes
+
+import (
+	awssns "github.com/aws/aws-sdk-go/service/sns"
+)
+
+type TopicsClient struct {
+	ListTopicsPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awssns.ListTopicsInput
+		}
+		Returns struct {
+			Pages []*awssns.ListTopicsOutput
+			Error error
+		}
+	}
+
+	ListTagsForResourceCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awssns.ListTagsForResourceInput
+		}
+		Returns struct {
+			Output *awssns.ListTagsForResourceOutput
+			Error  error
+		}
+	}
+
+	ListSubscriptionsByTopicPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awssns.ListSubscriptionsByTopicInput
+		}
+		Returns struct {
+			Pages []*awssns.ListSubscriptionsByTopicOutput
+			Error error
+		}
+	}
+
+	UnsubscribeCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awssns.UnsubscribeInput
+		}
+		Returns struct {
+			Output *awssns.UnsubscribeOutput
+			Error  error
+		}
+	}
+
+	DeleteTopicCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awssns.DeleteTopicInput
+		}
+		Returns struct {
+			Output *awssns.DeleteTopicOutput
+			Error  error
+		}
+	}
+}
+
+func (t *TopicsClient) ListTopicsPages(input *awssns.ListTopicsInput, fn func(*awssns.ListTopicsOutput, bool) bool) error {
+	t.ListTopicsPagesCall.CallCount++
+	t.ListTopicsPagesCall.Receives.Input = input
+
+	pages := t.ListTopicsPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return t.ListTopicsPagesCall.Returns.Error
+}
+
+func (t *TopicsClient) ListTagsForResource(input *awssns.ListTagsForResourceInput) (*awssns.ListTagsForResourceOutput, error) {
+	t.ListTagsForResourceCall.CallCount++
+	t.ListTagsForResourceCall.Receives.Input = input
+
+	return t.ListTagsForResourceCall.Returns.Output, t.ListTagsForResourceCall.Returns.Error
+}
+
+func (t *TopicsClient) ListSubscriptionsByTopicPages(input *awssns.ListSubscriptionsByTopicInput, fn func(*awssns.ListSubscriptionsByTopicOutput, bool) bool) error {
+	t.ListSubscriptionsByTopicPagesCall.CallCount++
+	t.ListSubscriptionsByTopicPagesCall.Receives.Input = input
+
+	pages := t.ListSubscriptionsByTopicPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return t.ListSubscriptionsByTopicPagesCall.Returns.Error
+}
+
+func (t *TopicsClient) Unsubscribe(input *awssns.UnsubscribeInput) (*awssns.UnsubscribeOutput, error) {
+	t.UnsubscribeCall.CallCount++
+	t.UnsubscribeCall.Receives.Input = input
+
+	return t.UnsubscribeCall.Returns.Output, t.UnsubscribeCall.Returns.Error
+}
+
+func (t *TopicsClient) DeleteTopic(input *awssns.DeleteTopicInput) (*awssns.DeleteTopicOutput, error) {
+	t.DeleteTopicCall.CallCount++
+	t.DeleteTopicCall.Receives.Input = input
+
+	return t.DeleteTopicCall.Returns.Output, t.DeleteTopicCall.Returns.Error
+}
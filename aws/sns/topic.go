@@ -0,0 +1,80 @@
+package sns
+
+import (
+	"fmt"
+	"strings"
+
+	awssns "github.com/aws/aws-sdk-go/service/sns"
+)
+
+type Topic struct {
+	client     topicsClient
+	logger     logger
+	arn        *string
+	identifier string
+	rtype      string
+}
+
+// NewTopic names the topic after the last segment of its arn,
+// which is the name that it was created with.
+func NewTopic(client topicsClient, logger logger, arn *string, tags []*awssns.Tag) Topic {
+	identifier := (*arn)[strings.LastIndex(*arn, ":")+1:]
+
+	var extra []string
+	for _, t := range tags {
+		extra = append(extra, fmt.Sprintf("%s:%s", *t.Key, *t.Value))
+	}
+
+	if len(extra) > 0 {
+		identifier = fmt.Sprintf("%s (%s)", identifier, strings.Join(extra, ", "))
+	}
+
+	return Topic{
+		client:     client,
+		logger:     logger,
+		arn:        arn,
+		identifier: identifier,
+		rtype:      "SNS Topic",
+	}
+}
+
+// Delete removes the confirmed subscriptions to the topic,
+// which would otherwise outlive it, and deletes the topic.
+func (t Topic) Delete() error {
+	var subscriptions []*awssns.Subscription
+	err := t.client.ListSubscriptionsByTopicPages(&awssns.ListSubscriptionsByTopicInput{TopicArn: t.arn}, func(page *awssns.ListSubscriptionsByTopicOutput, lastPage bool) bool {
+		subscriptions = append(subscriptions, page.Subscriptions...)
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("List subscriptions: %s", err)
+	}
+
+	for _, s := range subscriptions {
+		if !strings.HasPrefix(*s.SubscriptionArn, "arn:") {
+			continue
+		}
+
+		_, err = t.client.Unsubscribe(&awssns.UnsubscribeInput{SubscriptionArn: s.SubscriptionArn})
+		if err != nil {
+			return fmt.Errorf("Unsubscribe %s: %s", *s.SubscriptionArn, err)
+		}
+
+		t.logger.Printf("[%s: %s] Deleted subscription %s \n", t.rtype, t.identifier, *s.SubscriptionArn)
+	}
+
+	_, err = t.client.DeleteTopic(&awssns.DeleteTopicInput{TopicArn: t.arn})
+	if err != nil {
+		return fmt.Errorf("Delete: %s", err)
+	}
+
+	return nil
+}
+
+func (t Topic) Name() string {
+	return t.identifier
+}
+
+func (t Topic) Type() string {
+	return t.rtype
+}
@@ -0,0 +1,102 @@
+package sns_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awssns "github.com/aws/aws-sdk-go/service/sns"
+	"github.com/genevieve/leftovers/aws/sns"
+	"github.com/genevieve/leftovers/aws/sns/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Topic", func() {
+	var (
+		topic  sns.Topic
+		client *fakes.TopicsClient
+		logger *fakes.Logger
+		arn    *string
+	)
+
+	BeforeEach(func() {
+		client = &fakes.TopicsClient{}
+		logger = &fakes.Logger{}
+		arn = aws.String("arn:aws:sns:us-east-1:123456789012:the-topic")
+		tags := []*awssns.Tag{{Key: aws.String("the-key"), Value: aws.String("the-value")}}
+
+		topic = sns.NewTopic(client, logger, arn, tags)
+	})
+
+	Describe("Delete", func() {
+		BeforeEach(func() {
+			client.ListSubscriptionsByTopicPagesCall.Returns.Pages = []*awssns.ListSubscriptionsByTopicOutput{{
+				Subscriptions: []*awssns.Subscription{{
+					SubscriptionArn: aws.String("arn:aws:sns:us-east-1:123456789012:the-topic:the-subscription"),
+				}, {
+					SubscriptionArn: aws.String("PendingConfirmation"),
+				}},
+			}}
+		})
+
+		It("removes the confirmed subscriptions and deletes the topic", func() {
+			err := topic.Delete()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.ListSubscriptionsByTopicPagesCall.Receives.Input.TopicArn).To(Equal(arn))
+
+			Expect(client.UnsubscribeCall.CallCount).To(Equal(1))
+			Expect(client.UnsubscribeCall.Receives.Input.SubscriptionArn).To(Equal(aws.String("arn:aws:sns:us-east-1:123456789012:the-topic:the-subscription")))
+
+			Expect(client.DeleteTopicCall.CallCount).To(Equal(1))
+			Expect(client.DeleteTopicCall.Receives.Input.TopicArn).To(Equal(arn))
+		})
+
+		Context("when the client fails to list subscriptions", func() {
+			BeforeEach(func() {
+				client.ListSubscriptionsByTopicPagesCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := topic.Delete()
+				Expect(err).To(MatchError("List subscriptions: banana"))
+			})
+		})
+
+		Context("when the client fails to unsubscribe", func() {
+			BeforeEach(func() {
+				client.UnsubscribeCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := topic.Delete()
+				Expect(err).To(MatchError("Unsubscribe arn:aws:sns:us-east-1:123456789012:the-topic:the-subscription: banana"))
+
+				Expect(client.DeleteTopicCall.CallCount).To(Equal(0))
+			})
+		})
+
+		Context("when the client fails", func() {
+			BeforeEach(func() {
+				client.DeleteTopicCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := topic.Delete()
+				Expect(err).To(MatchError("Delete: banana"))
+			})
+		})
+	})
+
+	Describe("Name", func() {
+		It("returns the topic name and tags", func() {
+			Expect(topic.Name()).To(Equal("the-topic (the-key:the-value)"))
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(topic.Type()).To(Equal("SNS Topic"))
+		})
+	})
+})
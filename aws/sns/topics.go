@@ -0,0 +1,72 @@
+package sns
+
+import (
+	"fmt"
+	"strings"
+
+	awssns "github.com/aws/aws-sdk-go/service/sns"
+	"github.com/genevieve/leftovers/common"
+)
+
+type topicsClient interface {
+	ListTopicsPages(*awssns.ListTopicsInput, func(*awssns.ListTopicsOutput, bool) bool) error
+	ListTagsForResource(*awssns.ListTagsForResourceInput) (*awssns.ListTagsForResourceOutput, error)
+	ListSubscriptionsByTopicPages(*awssns.ListSubscriptionsByTopicInput, func(*awssns.ListSubscriptionsByTopicOutput, bool) bool) error
+	Unsubscribe(*awssns.UnsubscribeInput) (*awssns.UnsubscribeOutput, error)
+	DeleteTopic(*awssns.DeleteTopicInput) (*awssns.DeleteTopicOutput, error)
+}
+
+type logger interface {
+	Printf(m string, a ...interface{})
+	PromptWithDetails(resourceType, resourceName string) bool
+}
+
+type Topics struct {
+	client topicsClient
+	logger logger
+}
+
+func NewTopics(client topicsClient, logger logger) Topics {
+	return Topics{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (t Topics) List(filter string) ([]common.Deletable, error) {
+	var topics []*awssns.Topic
+	err := t.client.ListTopicsPages(&awssns.ListTopicsInput{}, func(page *awssns.ListTopicsOutput, lastPage bool) bool {
+		topics = append(topics, page.Topics...)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("List SNS Topics: %s", err)
+	}
+
+	var resources []common.Deletable
+	for _, topic := range topics {
+		tags, err := t.client.ListTagsForResource(&awssns.ListTagsForResourceInput{ResourceArn: topic.TopicArn})
+		if err != nil {
+			return nil, fmt.Errorf("List tags for SNS Topic %s: %s", *topic.TopicArn, err)
+		}
+
+		r := NewTopic(t.client, t.logger, topic.TopicArn, tags.Tags)
+
+		if !strings.Contains(r.Name(), filter) {
+			continue
+		}
+
+		proceed := t.logger.PromptWithDetails(r.Type(), r.Name())
+		if !proceed {
+			continue
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func (t Topics) Type() string {
+	return "sns-topic"
+}
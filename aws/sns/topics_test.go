@@ -0,0 +1,109 @@
+package sns_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awssns "github.com/aws/aws-sdk-go/service/sns"
+	"github.com/genevieve/leftovers/aws/sns"
+	"github.com/genevieve/leftovers/aws/sns/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Topics", func() {
+	var (
+		client *fakes.TopicsClient
+		logger *fakes.Logger
+
+		topics sns.Topics
+	)
+
+	BeforeEach(func() {
+		client = &fakes.TopicsClient{}
+		logger = &fakes.Logger{}
+		logger.PromptWithDetailsCall.Returns.Proceed = true
+
+		topics = sns.NewTopics(client, logger)
+	})
+
+	Describe("List", func() {
+		var filter string
+
+		BeforeEach(func() {
+			client.ListTopicsPagesCall.Returns.Pages = []*awssns.ListTopicsOutput{{
+				Topics: []*awssns.Topic{{TopicArn: aws.String("arn:aws:sns:us-east-1:123456789012:the-topic")}},
+			}}
+			client.ListTagsForResourceCall.Returns.Output = &awssns.ListTagsForResourceOutput{
+				Tags: []*awssns.Tag{{Key: aws.String("env"), Value: aws.String("banana")}},
+			}
+			filter = "banana"
+		})
+
+		It("returns the topics whose name or tags contain the filter", func() {
+			items, err := topics.List(filter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.ListTopicsPagesCall.CallCount).To(Equal(1))
+			Expect(client.ListTagsForResourceCall.Receives.Input.ResourceArn).To(Equal(aws.String("arn:aws:sns:us-east-1:123456789012:the-topic")))
+
+			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("SNS Topic"))
+			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("the-topic (env:banana)"))
+
+			Expect(items).To(HaveLen(1))
+		})
+
+		Context("when neither the name nor the tags contain the filter", func() {
+			It("does not return it to the list", func() {
+				items, err := topics.List("mango")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+
+		Context("when the client fails to list topics", func() {
+			BeforeEach(func() {
+				client.ListTopicsPagesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := topics.List(filter)
+				Expect(err).To(MatchError("List SNS Topics: some error"))
+			})
+		})
+
+		Context("when the client fails to list tags", func() {
+			BeforeEach(func() {
+				client.ListTagsForResourceCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := topics.List(filter)
+				Expect(err).To(MatchError("List tags for SNS Topic arn:aws:sns:us-east-1:123456789012:the-topic: some error"))
+			})
+		})
+
+		Context("when the user responds no to the prompt", func() {
+			BeforeEach(func() {
+				logger.PromptWithDetailsCall.Returns.Proceed = false
+			})
+
+			It("does not return it to the list", func() {
+				items, err := topics.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(topics.Type()).To(Equal("sns-topic"))
+		})
+	})
+})
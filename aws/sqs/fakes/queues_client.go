@@ -0,0 +1,68 @@
+package fakes
+
+import (
+	awssqs "github.com/aws/aws-sdk-go/service/sqs"
+)
+
+type QueuesClient struct {
+	ListQueuesPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awssqs.ListQueuesInput
+		}
+		Returns struct {
+			Pages []*awssqs.ListQueuesOutput
+			Error error
+		}
+	}
+
+	ListQueueTagsCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awssqs.ListQueueTagsInput
+		}
+		Returns struct {
+			Output *awssqs.ListQueueTagsOutput
+			Error  error
+		}
+	}
+
+	DeleteQueueCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awssqs.DeleteQueueInput
+		}
+		Returns struct {
+			Output *awssqs.DeleteQueueOutput
+			Error  error
+		}
+	}
+}
+
+func (q *QueuesClient) ListQueuesPages(input *awssqs.ListQueuesInput, fn func(*awssqs.ListQueuesOutput, bool) bool) error {
+	q.ListQueuesPagesCall.CallCount++
+	q.ListQueuesPagesCall.Receives.Input = input
+
+	pages := q.ListQueuesPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return q.ListQueuesPagesCall.Returns.Error
+}
+
+func (q *QueuesClient) ListQueueTags(input *awssqs.ListQueueTagsInput) (*awssqs.ListQueueTagsOutput, error) {
+	q.ListQueueTagsCall.CallCount++
+	q.ListQueueTagsCall.Receives.Input = input
+
+	return q.ListQueueTagsCall.Returns.Output, q.ListQueueTagsCall.Returns.Error
+}
+
+func (q *QueuesClient) DeleteQueue(input *awssqs.DeleteQueueInput) (*awssqs.DeleteQueueOutput, error) {
+	q.DeleteQueueCall.CallCount++
+	q.DeleteQueueCall.Receives.Input = input
+
+	return q.DeleteQueueCall.Returns.Output, q.DeleteQueueCall.Returns.Error
+}
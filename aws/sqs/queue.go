@@ -0,0 +1,62 @@
+package sqs
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	awssqs "github.com/aws/aws-sdk-go/service/sqs"
+)
+
+type Queue struct {
+	client     queuesClient
+	url        *string
+	identifier string
+	rtype      string
+}
+
+// NewQueue names the queue after the last segment of its url,
+// which is the name that it was created with.
+func NewQueue(client queuesClient, url *string, tags map[string]*string) Queue {
+	name := (*url)[strings.LastIndex(*url, "/")+1:]
+	identifier := name
+
+	var keys []string
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var extra []string
+	for _, k := range keys {
+		extra = append(extra, fmt.Sprintf("%s:%s", k, *tags[k]))
+	}
+
+	if len(extra) > 0 {
+		identifier = fmt.Sprintf("%s (%s)", name, strings.Join(extra, ", "))
+	}
+
+	return Queue{
+		client:     client,
+		url:        url,
+		identifier: identifier,
+		rtype:      "SQS Queue",
+	}
+}
+
+func (q Queue) Delete() error {
+	_, err := q.client.DeleteQueue(&awssqs.DeleteQueueInput{QueueUrl: q.url})
+	if err != nil {
+		return fmt.Errorf("Delete: %s", err)
+	}
+
+	return nil
+}
+
+func (q Queue) Name() string {
+	return q.identifier
+}
+
+func (q Queue) Type() string {
+	return q.rtype
+}
@@ -0,0 +1,59 @@
+package sqs_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/genevieve/leftovers/aws/sqs"
+	"github.com/genevieve/leftovers/aws/sqs/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Queue", func() {
+	var (
+		queue  sqs.Queue
+		client *fakes.QueuesClient
+		url    *string
+	)
+
+	BeforeEach(func() {
+		client = &fakes.QueuesClient{}
+		url = aws.String("https://sqs.us-east-1.amazonaws.com/123456789012/the-queue")
+
+		queue = sqs.NewQueue(client, url, map[string]*string{"the-key": aws.String("the-value")})
+	})
+
+	Describe("Delete", func() {
+		It("deletes the queue", func() {
+			err := queue.Delete()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DeleteQueueCall.CallCount).To(Equal(1))
+			Expect(client.DeleteQueueCall.Receives.Input.QueueUrl).To(Equal(url))
+		})
+
+		Context("when the client fails", func() {
+			BeforeEach(func() {
+				client.DeleteQueueCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := queue.Delete()
+				Expect(err).To(MatchError("Delete: banana"))
+			})
+		})
+	})
+
+	Describe("Name", func() {
+		It("returns the queue name and tags", func() {
+			Expect(queue.Name()).To(Equal("the-queue (the-key:the-value)"))
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(queue.Type()).To(Equal("SQS Queue"))
+		})
+	})
+})
@@ -0,0 +1,70 @@
+package sqs
+
+import (
+	"fmt"
+	"strings"
+
+	awssqs "github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/genevieve/leftovers/common"
+)
+
+type queuesClient interface {
+	ListQueuesPages(*awssqs.ListQueuesInput, func(*awssqs.ListQueuesOutput, bool) bool) error
+	ListQueueTags(*awssqs.ListQueueTagsInput) (*awssqs.ListQueueTagsOutput, error)
+	DeleteQueue(*awssqs.DeleteQueueInput) (*awssqs.DeleteQueueOutput, error)
+}
+
+type logger interface {
+	Printf(m string, a ...interface{})
+	PromptWithDetails(resourceType, resourceName string) bool
+}
+
+type Queues struct {
+	client queuesClient
+	logger logger
+}
+
+func NewQueues(client queuesClient, logger logger) Queues {
+	return Queues{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (q Queues) List(filter string) ([]common.Deletable, error) {
+	var urls []*string
+	err := q.client.ListQueuesPages(&awssqs.ListQueuesInput{}, func(page *awssqs.ListQueuesOutput, lastPage bool) bool {
+		urls = append(urls, page.QueueUrls...)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("List SQS Queues: %s", err)
+	}
+
+	var resources []common.Deletable
+	for _, url := range urls {
+		tags, err := q.client.ListQueueTags(&awssqs.ListQueueTagsInput{QueueUrl: url})
+		if err != nil {
+			return nil, fmt.Errorf("List tags for SQS Queue %s: %s", *url, err)
+		}
+
+		r := NewQueue(q.client, url, tags.Tags)
+
+		if !strings.Contains(r.Name(), filter) {
+			continue
+		}
+
+		proceed := q.logger.PromptWithDetails(r.Type(), r.Name())
+		if !proceed {
+			continue
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func (q Queues) Type() string {
+	return "sqs-queue"
+}
@@ -0,0 +1,112 @@
+package sqs_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awssqs "github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/genevieve/leftovers/aws/sqs"
+	"github.com/genevieve/leftovers/aws/sqs/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Queues", func() {
+	var (
+		client *fakes.QueuesClient
+		logger *fakes.Logger
+
+		queues sqs.Queues
+	)
+
+	BeforeEach(func() {
+		client = &fakes.QueuesClient{}
+		logger = &fakes.Logger{}
+		logger.PromptWithDetailsCall.Returns.Proceed = true
+
+		queues = sqs.NewQueues(client, logger)
+	})
+
+	Describe("List", func() {
+		var filter string
+
+		BeforeEach(func() {
+			client.ListQueuesPagesCall.Returns.Pages = []*awssqs.ListQueuesOutput{{
+				QueueUrls: []*string{aws.String("https://sqs.us-east-1.amazonaws.com/123456789012/the-queue")},
+			}, {
+				QueueUrls: []*string{aws.String("https://sqs.us-east-1.amazonaws.com/123456789012/the-other-queue")},
+			}}
+			client.ListQueueTagsCall.Returns.Output = &awssqs.ListQueueTagsOutput{
+				Tags: map[string]*string{"env": aws.String("banana"), "app": aws.String("kiwi")},
+			}
+			filter = "banana"
+		})
+
+		It("returns the queues from every page whose name or tags contain the filter", func() {
+			items, err := queues.List(filter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.ListQueuesPagesCall.CallCount).To(Equal(1))
+			Expect(client.ListQueueTagsCall.CallCount).To(Equal(2))
+			Expect(client.ListQueueTagsCall.Receives.Input.QueueUrl).To(Equal(aws.String("https://sqs.us-east-1.amazonaws.com/123456789012/the-other-queue")))
+
+			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(2))
+			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("SQS Queue"))
+			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("the-other-queue (app:kiwi, env:banana)"))
+
+			Expect(items).To(HaveLen(2))
+		})
+
+		Context("when neither the name nor the tags contain the filter", func() {
+			It("does not return it to the list", func() {
+				items, err := queues.List("mango")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+
+		Context("when the client fails to list queues", func() {
+			BeforeEach(func() {
+				client.ListQueuesPagesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := queues.List(filter)
+				Expect(err).To(MatchError("List SQS Queues: some error"))
+			})
+		})
+
+		Context("when the client fails to list tags", func() {
+			BeforeEach(func() {
+				client.ListQueueTagsCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := queues.List(filter)
+				Expect(err).To(MatchError("List tags for SQS Queue https://sqs.us-east-1.amazonaws.com/123456789012/the-queue: some error"))
+			})
+		})
+
+		Context("when the user responds no to the prompt", func() {
+			BeforeEach(func() {
+				logger.PromptWithDetailsCall.Returns.Proceed = false
+			})
+
+			It("does not return it to the list", func() {
+				items, err := queues.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(2))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(queues.Type()).To(Equal("sqs-queue"))
+		})
+	})
+})
@@ -0,0 +1,50 @@
+package fakes
+
+import (
+	awsssm "github.com/aws/aws-sdk-go/service/ssm"
+)
+
+type ParametersClient struct {
+	DescribeParametersPagesCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsssm.DescribeParametersInput
+		}
+		Returns struct {
+			Pages []*awsssm.DescribeParametersOutput
+			Error error
+		}
+	}
+
+	DeleteParametersCall struct {
+		CallCount int
+		Receives  struct {
+			Input *awsssm.DeleteParametersInput
+		}
+		Returns struct {
+			Output *awsssm.DeleteParametersOutput
+			Error  error
+		}
+	}
+}
+
+func (p *ParametersClient) DescribeParametersPages(input *awsssm.DescribeParametersInput, fn func(*awsssm.DescribeParametersOutput, bool) bool) error {
+	p.DescribeParametersPagesCall.CallCount++
+	p.DescribeParametersPagesCall.Receives.Input = input
+
+	pages := p.DescribeParametersPagesCall.Returns.Pages
+	for n, page := range pages {
+		if !fn(page, n == len(pages)-1) {
+			break
+		}
+	}
+
+	return p.DescribeParametersPagesCall.Returns.Error
+}
+
+func (p *ParametersClient) DeleteParameters(input *awsssm.DeleteParametersInput) (*awsssm.DeleteParametersOutput, error) {
+	p.DeleteParametersCall.CallCount++
+	p.DeleteParametersCall.Receives.Input = input
+
+	return p.DeleteParametersCall.Returns.Output, p.DeleteParametersCall.Returns.Error
+}
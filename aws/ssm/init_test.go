@@ -0,0 +1,13 @@
+package ssm_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestSSM(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "aws/ssm")
+}
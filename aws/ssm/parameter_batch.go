@@ -0,0 +1,49 @@
+package ssm
+
+import (
+	"fmt"
+	"strings"
+
+	awsssm "github.com/aws/aws-sdk-go/service/ssm"
+)
+
+// ParameterBatch deletes up to ten parameters with one request.
+type ParameterBatch struct {
+	client     parametersClient
+	names      []*string
+	identifier string
+	rtype      string
+}
+
+func NewParameterBatch(client parametersClient, names []*string) ParameterBatch {
+	var identifiers []string
+	for _, n := range names {
+		identifiers = append(identifiers, *n)
+	}
+
+	return ParameterBatch{
+		client:     client,
+		names:      names,
+		identifier: strings.Join(identifiers, ", "),
+		rtype:      "SSM Parameter",
+	}
+}
+
+// Delete deletes the parameters of the batch. Parameters that no longer
+// exist are reported as invalid by AWS and are ignored.
+func (p ParameterBatch) Delete() error {
+	_, err := p.client.DeleteParameters(&awsssm.DeleteParametersInput{Names: p.names})
+	if err != nil {
+		return fmt.Errorf("Delete: %s", err)
+	}
+
+	return nil
+}
+
+func (p ParameterBatch) Name() string {
+	return p.identifier
+}
+
+func (p ParameterBatch) Type() string {
+	return p.rtype
+}
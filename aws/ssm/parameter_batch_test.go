@@ -0,0 +1,59 @@
+package ssm_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/genevieve/leftovers/aws/ssm"
+	"github.com/genevieve/leftovers/aws/ssm/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ParameterBatch", func() {
+	var (
+		batch  ssm.ParameterBatch
+		client *fakes.ParametersClient
+		names  []*string
+	)
+
+	BeforeEach(func() {
+		client = &fakes.ParametersClient{}
+		names = aws.StringSlice([]string{"/banana/db", "/banana/password"})
+
+		batch = ssm.NewParameterBatch(client, names)
+	})
+
+	Describe("Delete", func() {
+		It("deletes the parameters", func() {
+			err := batch.Delete()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DeleteParametersCall.CallCount).To(Equal(1))
+			Expect(client.DeleteParametersCall.Receives.Input.Names).To(Equal(names))
+		})
+
+		Context("when the client fails", func() {
+			BeforeEach(func() {
+				client.DeleteParametersCall.Returns.Error = errors.New("banana")
+			})
+
+			It("returns the error", func() {
+				err := batch.Delete()
+				Expect(err).To(MatchError("Delete: banana"))
+			})
+		})
+	})
+
+	Describe("Name", func() {
+		It("returns the names of the parameters", func() {
+			Expect(batch.Name()).To(Equal("/banana/db, /banana/password"))
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(batch.Type()).To(Equal("SSM Parameter"))
+		})
+	})
+})
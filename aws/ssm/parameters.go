@@ -0,0 +1,77 @@
+package ssm
+
+import (
+	"fmt"
+	"strings"
+
+	awsssm "github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/genevieve/leftovers/common"
+)
+
+// batchSize is the most parameters that DeleteParameters accepts.
+const batchSize = 10
+
+type parametersClient interface {
+	DescribeParametersPages(*awsssm.DescribeParametersInput, func(*awsssm.DescribeParametersOutput, bool) bool) error
+	DeleteParameters(*awsssm.DeleteParametersInput) (*awsssm.DeleteParametersOutput, error)
+}
+
+type Parameters struct {
+	client parametersClient
+	logger logger
+}
+
+func NewParameters(client parametersClient, logger logger) Parameters {
+	return Parameters{
+		client: client,
+		logger: logger,
+	}
+}
+
+// List prompts for each parameter whose name starts with the filter,
+// ignoring a leading slash so that paths like /env/db-password match env,
+// and returns the parameters to delete in batches of up to ten. Each
+// parameter is prompted for by its own name before it is batched, so a
+// parameter that the logger skips, like one that belongs to a
+// CloudFormation stack being deleted, is never part of a batch.
+func (p Parameters) List(filter string) ([]common.Deletable, error) {
+	var parameters []*awsssm.ParameterMetadata
+	err := p.client.DescribeParametersPages(&awsssm.DescribeParametersInput{}, func(page *awsssm.DescribeParametersOutput, lastPage bool) bool {
+		parameters = append(parameters, page.Parameters...)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Describe SSM Parameters: %s", err)
+	}
+
+	var names []*string
+	for _, parameter := range parameters {
+		if !strings.HasPrefix(strings.TrimPrefix(*parameter.Name, "/"), strings.TrimPrefix(filter, "/")) {
+			continue
+		}
+
+		proceed := p.logger.PromptWithDetails("SSM Parameter", *parameter.Name)
+		if !proceed {
+			continue
+		}
+
+		names = append(names, parameter.Name)
+	}
+
+	var resources []common.Deletable
+	for len(names) > 0 {
+		n := batchSize
+		if len(names) < n {
+			n = len(names)
+		}
+
+		resources = append(resources, NewParameterBatch(p.client, names[:n]))
+		names = names[n:]
+	}
+
+	return resources, nil
+}
+
+func (p Parameters) Type() string {
+	return "ssm-parameter"
+}
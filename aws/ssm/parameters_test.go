@@ -0,0 +1,109 @@
+package ssm_test
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsssm "github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/genevieve/leftovers/aws/ssm"
+	"github.com/genevieve/leftovers/aws/ssm/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Parameters", func() {
+	var (
+		client *fakes.ParametersClient
+		logger *fakes.Logger
+
+		parameters ssm.Parameters
+	)
+
+	BeforeEach(func() {
+		client = &fakes.ParametersClient{}
+		logger = &fakes.Logger{}
+		logger.PromptWithDetailsCall.Returns.Proceed = true
+
+		parameters = ssm.NewParameters(client, logger)
+	})
+
+	Describe("List", func() {
+		var filter string
+
+		BeforeEach(func() {
+			client.DescribeParametersPagesCall.Returns.Pages = []*awsssm.DescribeParametersOutput{{
+				Parameters: []*awsssm.ParameterMetadata{
+					{Name: aws.String("banana-password")},
+					{Name: aws.String("/banana/db")},
+					{Name: aws.String("/kiwi/banana")},
+				},
+			}}
+			filter = "banana"
+		})
+
+		It("returns the parameters whose names or paths start with the filter", func() {
+			items, err := parameters.List(filter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DescribeParametersPagesCall.CallCount).To(Equal(1))
+
+			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(2))
+			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("SSM Parameter"))
+			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("/banana/db"))
+
+			Expect(items).To(HaveLen(1))
+			Expect(items[0].Name()).To(Equal("banana-password, /banana/db"))
+		})
+
+		Context("when there are more than ten parameters", func() {
+			BeforeEach(func() {
+				var page []*awsssm.ParameterMetadata
+				for i := 0; i < 23; i++ {
+					page = append(page, &awsssm.ParameterMetadata{Name: aws.String(fmt.Sprintf("/banana/%d", i))})
+				}
+				client.DescribeParametersPagesCall.Returns.Pages = []*awsssm.DescribeParametersOutput{{Parameters: page}}
+			})
+
+			It("returns them in batches of ten", func() {
+				items, err := parameters.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(23))
+				Expect(items).To(HaveLen(3))
+				Expect(items[2].Name()).To(Equal("/banana/20, /banana/21, /banana/22"))
+			})
+		})
+
+		Context("when the client fails to describe parameters", func() {
+			BeforeEach(func() {
+				client.DescribeParametersPagesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := parameters.List(filter)
+				Expect(err).To(MatchError("Describe SSM Parameters: some error"))
+			})
+		})
+
+		Context("when the user responds no to the prompt", func() {
+			BeforeEach(func() {
+				logger.PromptWithDetailsCall.Returns.Proceed = false
+			})
+
+			It("does not return it to the list", func() {
+				items, err := parameters.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(2))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(parameters.Type()).To(Equal("ssm-parameter"))
+		})
+	})
+})
@@ -0,0 +1,21 @@
+package aws
+
+import "github.com/genevieve/leftovers/aws/cloudformation"
+
+// stackOwnedLogger does not prompt for the resources that belong to a
+// CloudFormation stack that is being deleted, in any of the provided
+// members, since they are deleted with their stack.
+type stackOwnedLogger struct {
+	logger
+	members []*cloudformation.Members
+}
+
+func (l stackOwnedLogger) PromptWithDetails(resourceType, resourceName string) bool {
+	for _, m := range l.members {
+		if m.Owns(resourceName) {
+			return false
+		}
+	}
+
+	return l.logger.PromptWithDetails(resourceType, resourceName)
+}
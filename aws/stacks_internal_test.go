@@ -0,0 +1,89 @@
+package aws
+
+import (
+	"bytes"
+	"os"
+
+	awslib "github.com/aws/aws-sdk-go/aws"
+	awscloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	awsssm "github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/genevieve/leftovers/app"
+	"github.com/genevieve/leftovers/aws/cloudformation"
+	"github.com/genevieve/leftovers/aws/cloudformation/fakes"
+	"github.com/genevieve/leftovers/aws/ssm"
+	ssmfakes "github.com/genevieve/leftovers/aws/ssm/fakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("stackOwnedLogger", func() {
+	var (
+		stdout *bytes.Buffer
+		logger stackOwnedLogger
+	)
+
+	BeforeEach(func() {
+		client := &fakes.StacksClient{}
+		client.DescribeStacksPagesCall.Returns.Pages = []*awscloudformation.DescribeStacksOutput{{
+			Stacks: []*awscloudformation.Stack{{
+				StackId:     awslib.String("the-stack-id"),
+				StackName:   awslib.String("the-stack"),
+				StackStatus: awslib.String("CREATE_COMPLETE"),
+			}},
+		}}
+		client.ListStackResourcesPagesCall.Returns.Pages = []*awscloudformation.ListStackResourcesOutput{{
+			StackResourceSummaries: []*awscloudformation.StackResourceSummary{{
+				PhysicalResourceId: awslib.String("i-12345"),
+				ResourceType:       awslib.String("AWS::EC2::Instance"),
+			}, {
+				PhysicalResourceId: awslib.String("/banana/password"),
+				ResourceType:       awslib.String("AWS::SSM::Parameter"),
+			}},
+		}}
+
+		stacksLogger := &fakes.Logger{}
+		stacksLogger.PromptWithDetailsCall.Returns.Proceed = true
+
+		members := cloudformation.NewMembers()
+		_, err := cloudformation.NewStacks(client, stacksLogger, members).List("")
+		Expect(err).NotTo(HaveOccurred())
+
+		stdout = &bytes.Buffer{}
+		logger = stackOwnedLogger{logger: app.NewLogger(stdout, os.Stdin, false), members: []*cloudformation.Members{members}}
+	})
+
+	It("does not prompt for the resources of the stacks being deleted", func() {
+		Expect(logger.PromptWithDetails("EC2 Instance", "i-12345 (Name:banana)")).To(BeFalse())
+		Expect(logger.PromptWithDetails("EC2 Volume", "vol-12345 (aws:cloudformation:stack-name:the-stack)")).To(BeFalse())
+
+		Expect(stdout.String()).To(BeEmpty())
+	})
+
+	It("prompts for the other resources", func() {
+		logger.logger = app.NewLogger(stdout, bytes.NewBufferString("y\n"), false)
+
+		Expect(logger.PromptWithDetails("EC2 Instance", "i-67890")).To(BeTrue())
+
+		Expect(stdout.String()).To(ContainSubstring("[EC2 Instance: i-67890] Delete? (y/N): "))
+	})
+
+	It("skips the SSM parameters of the stacks being deleted before they are batched", func() {
+		logger.logger.NoConfirm()
+
+		client := &ssmfakes.ParametersClient{}
+		client.DescribeParametersPagesCall.Returns.Pages = []*awsssm.DescribeParametersOutput{{
+			Parameters: []*awsssm.ParameterMetadata{
+				{Name: awslib.String("/banana/db")},
+				{Name: awslib.String("/banana/password")},
+				{Name: awslib.String("/banana/user")},
+			},
+		}}
+
+		list, err := ssm.NewParameters(client, logger).List("banana")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(list).To(HaveLen(1))
+		Expect(list[0].Name()).To(Equal("/banana/db, /banana/user"))
+	})
+})
@@ -0,0 +1,47 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+)
+
+type Disk struct {
+	client     resourcesClient
+	id         string
+	apiVersion string
+	identifier string
+}
+
+// NewDisk returns the Azure managed disk with the provided id, which
+// is deleted with the provided api-version of the Microsoft.Compute provider.
+func NewDisk(client resourcesClient, id, name *string, tags *map[string]*string, apiVersion string) Disk {
+	return Disk{
+		client:     client,
+		id:         *id,
+		apiVersion: apiVersion,
+		identifier: withBOSHTags(*name, tags),
+	}
+}
+
+func (d Disk) Delete() error {
+	return d.DeleteContext(context.Background())
+}
+
+// DeleteContext deletes the managed disk. Disks that are still
+// attached to a virtual machine cannot be deleted.
+func (d Disk) DeleteContext(ctx context.Context) error {
+	err := d.client.DeleteResource(ctx, d.id, d.apiVersion)
+	if err != nil {
+		return fmt.Errorf("Delete: %s", err)
+	}
+
+	return nil
+}
+
+func (d Disk) Name() string {
+	return d.identifier
+}
+
+func (d Disk) Type() string {
+	return "Disk"
+}
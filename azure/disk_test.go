@@ -0,0 +1,79 @@
+package azure_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/genevieve/leftovers/azure"
+	"github.com/genevieve/leftovers/azure/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Disk", func() {
+	var (
+		client *fakes.ResourcesClient
+		id     string
+		name   string
+		tags   map[string]*string
+
+		disk azure.Disk
+	)
+
+	BeforeEach(func() {
+		client = &fakes.ResourcesClient{}
+		id = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/disks/disk-banana"
+		name = "disk-banana"
+		director := "bosh-banana"
+		deployment := "cf"
+		tags = map[string]*string{"deployment": &deployment, "director": &director}
+
+		disk = azure.NewDisk(client, &id, &name, &tags, "2017-03-30")
+	})
+
+	Describe("Delete", func() {
+		It("deletes the disk", func() {
+			err := disk.Delete()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DeleteResourceCall.CallCount).To(Equal(1))
+			Expect(client.DeleteResourceCall.Receives.Id).To(Equal(id))
+			Expect(client.DeleteResourceCall.Receives.ApiVersion).To(Equal("2017-03-30"))
+		})
+
+		Context("when client fails to delete the disk", func() {
+			BeforeEach(func() {
+				client.DeleteResourceCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				err := disk.Delete()
+				Expect(err).To(MatchError("Delete: some error"))
+			})
+		})
+	})
+
+	Describe("DeleteContext", func() {
+		It("deletes the disk with the context", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			err := disk.DeleteContext(ctx)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DeleteResourceCall.Receives.Context).To(Equal(ctx))
+		})
+	})
+
+	Describe("Name", func() {
+		It("includes the bosh director and deployment tags", func() {
+			Expect(disk.Name()).To(Equal("disk-banana (director:bosh-banana, deployment:cf)"))
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(disk.Type()).To(Equal("Disk"))
+		})
+	})
+})
@@ -0,0 +1,60 @@
+package azure
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/genevieve/leftovers/common"
+)
+
+const disksType = "Microsoft.Compute/disks"
+
+type Disks struct {
+	client resourcesClient
+	logger logger
+}
+
+func NewDisks(client resourcesClient, logger logger) Disks {
+	return Disks{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (d Disks) List(filter string) ([]common.Deletable, error) {
+	disks, err := d.client.ListResources(disksType)
+	if err != nil {
+		return nil, fmt.Errorf("Listing Disks: %s", err)
+	}
+
+	if len(disks) == 0 {
+		return nil, nil
+	}
+
+	apiVersion, err := d.client.APIVersion(disksType)
+	if err != nil {
+		return nil, fmt.Errorf("Getting api version: %s", err)
+	}
+
+	var resources []common.Deletable
+	for _, disk := range disks {
+		r := NewDisk(d.client, disk.ID, disk.Name, disk.Tags, apiVersion)
+
+		if !strings.Contains(r.Name(), filter) {
+			continue
+		}
+
+		proceed := d.logger.PromptWithDetails(r.Type(), r.Name())
+		if !proceed {
+			continue
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func (d Disks) Type() string {
+	return "disk"
+}
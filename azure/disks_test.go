@@ -0,0 +1,100 @@
+package azure_test
+
+import (
+	"errors"
+
+	"github.com/Azure/azure-sdk-for-go/arm/resources/resources"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/genevieve/leftovers/azure"
+	"github.com/genevieve/leftovers/azure/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Disks", func() {
+	var (
+		client *fakes.ResourcesClient
+		logger *fakes.Logger
+		filter string
+
+		disks azure.Disks
+	)
+
+	BeforeEach(func() {
+		client = &fakes.ResourcesClient{}
+		logger = &fakes.Logger{}
+		filter = "banana"
+
+		disks = azure.NewDisks(client, logger)
+	})
+
+	Describe("List", func() {
+		BeforeEach(func() {
+			logger.PromptWithDetailsCall.Returns.Proceed = true
+			client.APIVersionCall.Returns.APIVersion = "2017-03-30"
+			client.ListResourcesCall.Returns.Output = []resources.GenericResource{{
+				ID:   aws.String("some-id"),
+				Name: aws.String("banana-disk"),
+			}}
+		})
+
+		It("returns a list of disks to delete", func() {
+			items, err := disks.List(filter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.ListResourcesCall.CallCount).To(Equal(1))
+			Expect(client.ListResourcesCall.Receives.ResourceType).To(Equal("Microsoft.Compute/disks"))
+			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("Disk"))
+			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("banana-disk"))
+
+			Expect(client.APIVersionCall.Receives.ResourceType).To(Equal("Microsoft.Compute/disks"))
+
+			Expect(items).To(HaveLen(1))
+		})
+
+		Context("when the client fails to get the api version", func() {
+			BeforeEach(func() {
+				client.APIVersionCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := disks.List(filter)
+				Expect(err).To(MatchError("Getting api version: some error"))
+			})
+		})
+
+		Context("when client fails to list disks", func() {
+			BeforeEach(func() {
+				client.ListResourcesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := disks.List(filter)
+				Expect(err).To(MatchError("Listing Disks: some error"))
+			})
+		})
+
+		Context("when the user responds no to the prompt", func() {
+			BeforeEach(func() {
+				logger.PromptWithDetailsCall.Returns.Proceed = false
+			})
+
+			It("does not return it in the list", func() {
+				items, err := disks.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(items).To(HaveLen(0))
+			})
+		})
+
+		Context("when the disk name does not contain the filter", func() {
+			It("does not return it in the list", func() {
+				items, err := disks.List("grape")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,80 @@
+package azure
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	azurelib "github.com/Azure/go-autorest/autorest/azure"
+)
+
+// metadataAPIVersion is the api-version of the Resource Manager
+// metadata endpoint that Azure Stack describes its endpoints with.
+const metadataAPIVersion = "2015-01-01"
+
+// NewEnvironment returns the Azure cloud with the provided name, like
+// AzureUSGovernmentCloud or USGovernment, AzureChinaCloud or China, or
+// the Azure Stack cloud whose Resource Manager is at the provided URL.
+// It returns the public cloud if no name is provided.
+func NewEnvironment(name string) (azurelib.Environment, error) {
+	if name == "" {
+		return azurelib.PublicCloud, nil
+	}
+
+	if strings.HasPrefix(name, "https://") || strings.HasPrefix(name, "http://") {
+		return environmentFromMetadata(name)
+	}
+
+	if !strings.HasPrefix(strings.ToLower(name), "azure") {
+		name = fmt.Sprintf("Azure%sCloud", name)
+	}
+
+	env, err := azurelib.EnvironmentFromName(name)
+	if err != nil {
+		return azurelib.Environment{}, fmt.Errorf("Unknown Azure environment %s.", name)
+	}
+
+	return env, nil
+}
+
+func environmentFromMetadata(resourceManager string) (azurelib.Environment, error) {
+	resourceManager = fmt.Sprintf("%s/", strings.TrimSuffix(resourceManager, "/"))
+
+	resp, err := http.Get(fmt.Sprintf("%smetadata/endpoints?api-version=%s", resourceManager, metadataAPIVersion))
+	if err != nil {
+		return azurelib.Environment{}, fmt.Errorf("Getting Azure Stack metadata: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return azurelib.Environment{}, fmt.Errorf("Getting Azure Stack metadata: %s", resp.Status)
+	}
+
+	var metadata struct {
+		GalleryEndpoint string `json:"galleryEndpoint"`
+		GraphEndpoint   string `json:"graphEndpoint"`
+		PortalEndpoint  string `json:"portalEndpoint"`
+		Authentication  struct {
+			LoginEndpoint string   `json:"loginEndpoint"`
+			Audiences     []string `json:"audiences"`
+		} `json:"authentication"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&metadata); err != nil {
+		return azurelib.Environment{}, fmt.Errorf("Decoding Azure Stack metadata: %s", err)
+	}
+
+	if len(metadata.Authentication.Audiences) == 0 {
+		return azurelib.Environment{}, fmt.Errorf("Azure Stack metadata has no token audiences.")
+	}
+
+	return azurelib.Environment{
+		Name:                      "AzureStackCloud",
+		ManagementPortalURL:       metadata.PortalEndpoint,
+		ServiceManagementEndpoint: metadata.Authentication.Audiences[0],
+		ResourceManagerEndpoint:   resourceManager,
+		ActiveDirectoryEndpoint:   metadata.Authentication.LoginEndpoint,
+		GalleryEndpoint:           metadata.GalleryEndpoint,
+		GraphEndpoint:             metadata.GraphEndpoint,
+	}, nil
+}
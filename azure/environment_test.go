@@ -0,0 +1,88 @@
+package azure_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	azurelib "github.com/Azure/go-autorest/autorest/azure"
+	"github.com/genevieve/leftovers/azure"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NewEnvironment", func() {
+	It("returns the public cloud by default", func() {
+		env, err := azure.NewEnvironment("")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(env).To(Equal(azurelib.PublicCloud))
+	})
+
+	It("returns the cloud with the provided name", func() {
+		env, err := azure.NewEnvironment("AzureUSGovernmentCloud")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(env).To(Equal(azurelib.USGovernmentCloud))
+
+		env, err = azure.NewEnvironment("China")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(env).To(Equal(azurelib.ChinaCloud))
+	})
+
+	Context("when the name is unknown", func() {
+		It("returns an error", func() {
+			_, err := azure.NewEnvironment("Mars")
+			Expect(err).To(MatchError("Unknown Azure environment AzureMarsCloud."))
+		})
+	})
+
+	Context("when a Resource Manager URL is provided", func() {
+		var (
+			server *httptest.Server
+			query  string
+		)
+
+		BeforeEach(func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/metadata/endpoints" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+
+				query = r.URL.RawQuery
+				fmt.Fprint(w, `{
+					"galleryEndpoint": "https://gallery.local/",
+					"graphEndpoint": "https://graph.local/",
+					"portalEndpoint": "https://portal.local/",
+					"authentication": {
+						"loginEndpoint": "https://login.local/adfs",
+						"audiences": ["https://management.adfs.local/banana"]
+					}
+				}`)
+			}))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("returns the Azure Stack cloud from its metadata", func() {
+			env, err := azure.NewEnvironment(server.URL)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(query).To(Equal("api-version=2015-01-01"))
+			Expect(env.Name).To(Equal("AzureStackCloud"))
+			Expect(env.ResourceManagerEndpoint).To(Equal(server.URL + "/"))
+			Expect(env.ActiveDirectoryEndpoint).To(Equal("https://login.local/adfs"))
+			Expect(env.ServiceManagementEndpoint).To(Equal("https://management.adfs.local/banana"))
+			Expect(env.GraphEndpoint).To(Equal("https://graph.local/"))
+		})
+
+		Context("when the metadata cannot be retrieved", func() {
+			It("returns an error", func() {
+				_, err := azure.NewEnvironment(server.URL + "/missing")
+				Expect(err).To(MatchError("Getting Azure Stack metadata: 404 Not Found"))
+			})
+		})
+	})
+})
@@ -18,6 +18,13 @@ type Logger struct {
 		Messages []string
 	}
 
+	ErrorlnCall struct {
+		Receives struct {
+			Message string
+		}
+		Messages []string
+	}
+
 	PromptWithDetailsCall struct {
 		CallCount int
 		Receives  struct {
@@ -51,4 +58,10 @@ func (l *Logger) Println(message string) {
 	l.PrintfCall.Messages = append(l.PrintfCall.Messages, message)
 }
 
+func (l *Logger) Errorln(message string) {
+	l.ErrorlnCall.Receives.Message = message
+
+	l.ErrorlnCall.Messages = append(l.ErrorlnCall.Messages, message)
+}
+
 func (l *Logger) NoConfirm() {}
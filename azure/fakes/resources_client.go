@@ -0,0 +1,66 @@
+package fakes
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/arm/resources/resources"
+)
+
+type ResourcesClient struct {
+	ListResourcesCall struct {
+		CallCount int
+		Receives  struct {
+			ResourceType string
+		}
+		Returns struct {
+			Output []resources.GenericResource
+			Error  error
+		}
+	}
+
+	APIVersionCall struct {
+		CallCount int
+		Receives  struct {
+			ResourceType string
+		}
+		Returns struct {
+			APIVersion string
+			Error      error
+		}
+	}
+
+	DeleteResourceCall struct {
+		CallCount int
+		Receives  struct {
+			Context    context.Context
+			Id         string
+			ApiVersion string
+		}
+		Returns struct {
+			Error error
+		}
+	}
+}
+
+func (r *ResourcesClient) ListResources(resourceType string) ([]resources.GenericResource, error) {
+	r.ListResourcesCall.CallCount++
+	r.ListResourcesCall.Receives.ResourceType = resourceType
+
+	return r.ListResourcesCall.Returns.Output, r.ListResourcesCall.Returns.Error
+}
+
+func (r *ResourcesClient) APIVersion(resourceType string) (string, error) {
+	r.APIVersionCall.CallCount++
+	r.APIVersionCall.Receives.ResourceType = resourceType
+
+	return r.APIVersionCall.Returns.APIVersion, r.APIVersionCall.Returns.Error
+}
+
+func (r *ResourcesClient) DeleteResource(ctx context.Context, id, apiVersion string) error {
+	r.DeleteResourceCall.CallCount++
+	r.DeleteResourceCall.Receives.Context = ctx
+	r.DeleteResourceCall.Receives.Id = id
+	r.DeleteResourceCall.Receives.ApiVersion = apiVersion
+
+	return r.DeleteResourceCall.Returns.Error
+}
@@ -1,6 +1,9 @@
 package azure
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 type Group struct {
 	client     groupsClient
@@ -15,14 +18,23 @@ func NewGroup(client groupsClient, name *string) Group {
 	}
 }
 
-// Delete deletes an Azure resource group and all other Azure
-// resources in the resource group.
 func (g Group) Delete() error {
-	_, errChan := g.client.Delete(g.identifier, nil)
+	return g.DeleteContext(context.Background())
+}
 
-	err := <-errChan
-	if err != nil {
-		return fmt.Errorf("Delete: %s", err)
+// DeleteContext deletes an Azure resource group and all other Azure
+// resources in the resource group. The client stops polling for the
+// deletion to complete when the context is done.
+func (g Group) DeleteContext(ctx context.Context) error {
+	_, errChan := g.client.Delete(g.identifier, ctx.Done())
+
+	select {
+	case err := <-errChan:
+		if err != nil {
+			return fmt.Errorf("Delete: %s", err)
+		}
+	case <-ctx.Done():
+		return ctx.Err()
 	}
 
 	return nil
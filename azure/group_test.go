@@ -1,6 +1,7 @@
 package azure_test
 
 import (
+	"context"
 	"errors"
 
 	"github.com/genevieve/leftovers/azure"
@@ -53,6 +54,28 @@ var _ = Describe("Group", func() {
 		})
 	})
 
+	Describe("DeleteContext", func() {
+		var (
+			ctx    context.Context
+			cancel context.CancelFunc
+		)
+
+		BeforeEach(func() {
+			ctx, cancel = context.WithCancel(context.Background())
+			client.DeleteCall.Returns.Error = make(chan error)
+		})
+
+		It("passes the done channel of the context to the client", func() {
+			cancel()
+
+			err := group.DeleteContext(ctx)
+			Expect(err).To(Equal(context.Canceled))
+
+			Expect(client.DeleteCall.CallCount).To(Equal(1))
+			Expect(client.DeleteCall.Receives.Channel).To(Equal(ctx.Done()))
+		})
+	})
+
 	Describe("Type", func() {
 		It("returns the type", func() {
 			Expect(group.Type()).To(Equal("Resource Group"))
@@ -1,13 +1,13 @@
 package azure
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/arm/resources/resources"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/adal"
-	azurelib "github.com/Azure/go-autorest/autorest/azure"
 	"github.com/fatih/color"
 	"github.com/genevieve/leftovers/common"
 	multierror "github.com/hashicorp/go-multierror"
@@ -19,20 +19,25 @@ type resource interface {
 }
 
 type Leftovers struct {
-	logger   logger
-	resource resource
+	logger    logger
+	resources []resource
 }
 
 // List will print all of the resources that match the provided filter.
 func (l Leftovers) List(filter string) {
 	l.logger.NoConfirm()
 
-	list, err := l.resource.List(filter)
-	if err != nil {
-		l.logger.Println(color.YellowString(err.Error()))
+	var all []common.Deletable
+	for _, r := range l.resources {
+		list, err := r.List(filter)
+		if err != nil {
+			l.logger.Errorln(color.YellowString(err.Error()))
+		}
+
+		all = append(all, list...)
 	}
 
-	for _, r := range list {
+	for _, r := range all {
 		l.logger.Println(fmt.Sprintf("[%s: %s]", r.Type(), r.Name()))
 	}
 }
@@ -40,33 +45,60 @@ func (l Leftovers) List(filter string) {
 // Types will print all the resource types that can
 // be deleted on this IaaS.
 func (l Leftovers) Types() {
-	l.logger.Println(l.resource.Type())
+	for _, r := range l.resources {
+		l.logger.Println(r.Type())
+	}
 }
 
 // Delete will collect all resources that contain
 // the provided filter in the resource's identifier, prompt
 // you to confirm deletion (if enabled), and delete those
 // that are selected.
-func (l Leftovers) Delete(filter string) error {
+func (l Leftovers) Delete(ctx context.Context, filter string) error {
+	return l.delete(ctx, filter, "")
+}
+
+// DeleteType will collect all resources of the provied type that contain
+// the provided filter in the resource's identifier, prompt
+// you to confirm deletion (if enabled), and delete those
+// that are selected.
+func (l Leftovers) DeleteType(ctx context.Context, filter, rType string) error {
+	return l.delete(ctx, filter, rType)
+}
+
+func (l Leftovers) delete(ctx context.Context, filter, rType string) error {
 	var (
 		deletables []common.Deletable
 		result     *multierror.Error
 	)
 
-	deletables, err := l.resource.List(filter)
-	if err != nil {
-		l.logger.Println(color.YellowString(err.Error()))
+	for _, r := range l.resources {
+		if rType != "" && r.Type() != rType {
+			continue
+		}
+
+		list, err := r.List(filter)
+		if err != nil {
+			l.logger.Errorln(color.YellowString(err.Error()))
+		}
+
+		deletables = append(deletables, list...)
 	}
 
 	for _, d := range deletables {
+		if ctx.Err() != nil {
+			result = multierror.Append(result, ctx.Err())
+			break
+		}
+
 		l.logger.Println(fmt.Sprintf("[%s: %s] Deleting...", d.Type(), d.Name()))
 
-		err := d.Delete()
+		err := common.Delete(ctx, d)
 		if err != nil {
 			err = fmt.Errorf("[%s: %s] %s", d.Type(), d.Name(), color.YellowString(err.Error()))
 			result = multierror.Append(result, err)
 
-			l.logger.Println(err.Error())
+			l.logger.Errorln(err.Error())
 		} else {
 			l.logger.Println(fmt.Sprintf("[%s: %s] %s", d.Type(), d.Name(), color.GreenString("Deleted!")))
 		}
@@ -75,18 +107,14 @@ func (l Leftovers) Delete(filter string) error {
 	return result.ErrorOrNil()
 }
 
-// DeleteType will collect all resources of the provied type that contain
-// the provided filter in the resource's identifier, prompt
-// you to confirm deletion (if enabled), and delete those
-// that are selected.
-func (l Leftovers) DeleteType(filter, rType string) error {
-	return l.Delete(filter)
-}
-
 // NewLeftovers returns a new Leftovers for Azure that can be used to list resources,
 // list types, or delete resources for the provided account. It returns an error
-// if the credentials provided are invalid.
-func NewLeftovers(logger logger, clientId, clientSecret, subscriptionId, tenantId string) (Leftovers, error) {
+// if the credentials provided are invalid. It uses the public cloud unless
+// the name of another cloud, or the URL of an Azure Stack Resource Manager,
+// is provided as the environment. Virtual machines and disks are otherwise
+// deleted with their resource groups, so they are only swept on their own
+// when bosh is true, to select the VMs and disks of a BOSH director or deployment.
+func NewLeftovers(logger logger, clientId, clientSecret, subscriptionId, tenantId, environment string, bosh bool) (Leftovers, error) {
 	if clientId == "" {
 		return Leftovers{}, errors.New("Missing client id.")
 	}
@@ -103,21 +131,39 @@ func NewLeftovers(logger logger, clientId, clientSecret, subscriptionId, tenantI
 		return Leftovers{}, errors.New("Missing tenant id.")
 	}
 
-	oauthConfig, err := adal.NewOAuthConfig(azurelib.PublicCloud.ActiveDirectoryEndpoint, tenantId)
+	env, err := NewEnvironment(environment)
+	if err != nil {
+		return Leftovers{}, err
+	}
+
+	oauthConfig, err := adal.NewOAuthConfig(env.ActiveDirectoryEndpoint, tenantId)
 	if err != nil {
 		return Leftovers{}, fmt.Errorf("Creating oauth config: %s\n", err)
 	}
 
-	servicePrincipalToken, err := adal.NewServicePrincipalToken(*oauthConfig, clientId, clientSecret, azurelib.PublicCloud.ResourceManagerEndpoint)
+	servicePrincipalToken, err := adal.NewServicePrincipalToken(*oauthConfig, clientId, clientSecret, env.ServiceManagementEndpoint)
 	if err != nil {
 		return Leftovers{}, fmt.Errorf("Creating service principal token: %s\n", err)
 	}
 
-	gc := resources.NewGroupsClient(subscriptionId)
+	gc := resources.NewGroupsClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionId)
 	gc.ManagementClient.Authorizer = autorest.NewBearerAuthorizer(servicePrincipalToken)
 
+	rc := resources.NewGroupClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionId)
+	rc.ManagementClient.Authorizer = autorest.NewBearerAuthorizer(servicePrincipalToken)
+
+	pc := resources.NewProvidersClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionId)
+	pc.ManagementClient.Authorizer = autorest.NewBearerAuthorizer(servicePrincipalToken)
+
+	var list []resource
+	if bosh {
+		client := NewResourcesClient(rc, pc)
+		list = append(list, NewVirtualMachines(client, logger), NewDisks(client, logger))
+	}
+	list = append(list, NewGroups(gc, logger))
+
 	return Leftovers{
-		logger:   logger,
-		resource: NewGroups(gc, logger),
+		logger:    logger,
+		resources: list,
 	}, nil
 }
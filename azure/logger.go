@@ -4,5 +4,6 @@ type logger interface {
 	Printf(message string, args ...interface{})
 	PromptWithDetails(resourceType, resourceName string) bool
 	Println(message string)
+	Errorln(message string)
 	NoConfirm()
 }
@@ -0,0 +1,111 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/arm/resources/resources"
+	"github.com/Azure/go-autorest/autorest"
+)
+
+type ResourcesClient struct {
+	client    resources.GroupClient
+	providers resources.ProvidersClient
+}
+
+func NewResourcesClient(client resources.GroupClient, providers resources.ProvidersClient) ResourcesClient {
+	return ResourcesClient{
+		client:    client,
+		providers: providers,
+	}
+}
+
+// APIVersion returns the latest stable api-version that the resource
+// provider accepts for the provided type, like Microsoft.Compute/disks.
+// Resources have to be deleted with it, since the generic resources
+// client uses its own api-version, which resource providers reject.
+func (r ResourcesClient) APIVersion(resourceType string) (string, error) {
+	parts := strings.SplitN(resourceType, "/", 2)
+	if len(parts) != 2 {
+		return "", fmt.Errorf("Invalid resource type %s", resourceType)
+	}
+
+	provider, err := r.providers.Get(parts[0], "")
+	if err != nil {
+		return "", err
+	}
+
+	if provider.ResourceTypes != nil {
+		for _, t := range *provider.ResourceTypes {
+			if t.ResourceType == nil || !strings.EqualFold(*t.ResourceType, parts[1]) || t.APIVersions == nil {
+				continue
+			}
+
+			// Versions are listed from the newest to the oldest.
+			for _, v := range *t.APIVersions {
+				if !strings.HasSuffix(v, "-preview") {
+					return v, nil
+				}
+			}
+		}
+	}
+
+	return "", fmt.Errorf("No api version for %s", resourceType)
+}
+
+// ListResources returns every resource of the provided
+// type, like Microsoft.Compute/virtualMachines, in the subscription.
+func (r ResourcesClient) ListResources(resourceType string) ([]resources.GenericResource, error) {
+	filter := fmt.Sprintf("resourceType eq '%s'", resourceType)
+
+	result, err := r.client.List(filter, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []resources.GenericResource
+	for {
+		if result.Value != nil {
+			list = append(list, *result.Value...)
+		}
+
+		if result.NextLink == nil || *result.NextLink == "" {
+			break
+		}
+
+		result, err = r.client.ListNextResults(result)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return list, nil
+}
+
+// DeleteResource deletes the resource with the provided id and
+// waits for the deletion to complete, unless the context is done first.
+func (r ResourcesClient) DeleteResource(ctx context.Context, id, apiVersion string) error {
+	req, err := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(r.client.BaseURI),
+		autorest.WithPathParameters("/{resourceId}", map[string]interface{}{"resourceId": id}),
+		autorest.WithQueryParameters(map[string]interface{}{"api-version": apiVersion}),
+	).Prepare(&http.Request{})
+	if err != nil {
+		return err
+	}
+	req.Cancel = ctx.Done()
+
+	resp, err := r.client.DeleteByIDSender(req)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.client.DeleteByIDResponder(resp)
+	if err != nil && ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
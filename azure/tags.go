@@ -0,0 +1,18 @@
+package azure
+
+import "github.com/genevieve/leftovers/bosh"
+
+// withBOSHTags appends the director and deployment tags
+// that BOSH sets on resources to the provided name.
+func withBOSHTags(name string, tags *map[string]*string) string {
+	values := map[string]string{}
+	if tags != nil {
+		for key, value := range *tags {
+			if value != nil {
+				values[key] = *value
+			}
+		}
+	}
+
+	return bosh.WithMetadata(name, values)
+}
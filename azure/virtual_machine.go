@@ -0,0 +1,47 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+)
+
+type VirtualMachine struct {
+	client     resourcesClient
+	id         string
+	apiVersion string
+	identifier string
+}
+
+// NewVirtualMachine returns the Azure virtual machine with the provided id, which
+// is deleted with the provided api-version of the Microsoft.Compute provider.
+func NewVirtualMachine(client resourcesClient, id, name *string, tags *map[string]*string, apiVersion string) VirtualMachine {
+	return VirtualMachine{
+		client:     client,
+		id:         *id,
+		apiVersion: apiVersion,
+		identifier: withBOSHTags(*name, tags),
+	}
+}
+
+func (v VirtualMachine) Delete() error {
+	return v.DeleteContext(context.Background())
+}
+
+// DeleteContext deletes the virtual machine and waits for it to be
+// deleted, which releases its managed disks.
+func (v VirtualMachine) DeleteContext(ctx context.Context) error {
+	err := v.client.DeleteResource(ctx, v.id, v.apiVersion)
+	if err != nil {
+		return fmt.Errorf("Delete: %s", err)
+	}
+
+	return nil
+}
+
+func (v VirtualMachine) Name() string {
+	return v.identifier
+}
+
+func (v VirtualMachine) Type() string {
+	return "Virtual Machine"
+}
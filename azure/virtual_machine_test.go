@@ -0,0 +1,79 @@
+package azure_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/genevieve/leftovers/azure"
+	"github.com/genevieve/leftovers/azure/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("VirtualMachine", func() {
+	var (
+		client *fakes.ResourcesClient
+		id     string
+		name   string
+		tags   map[string]*string
+
+		vm azure.VirtualMachine
+	)
+
+	BeforeEach(func() {
+		client = &fakes.ResourcesClient{}
+		id = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines/vm-banana"
+		name = "vm-banana"
+		director := "bosh-banana"
+		deployment := "cf"
+		tags = map[string]*string{"deployment": &deployment, "director": &director}
+
+		vm = azure.NewVirtualMachine(client, &id, &name, &tags, "2017-03-30")
+	})
+
+	Describe("Delete", func() {
+		It("deletes the virtual machine", func() {
+			err := vm.Delete()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DeleteResourceCall.CallCount).To(Equal(1))
+			Expect(client.DeleteResourceCall.Receives.Id).To(Equal(id))
+			Expect(client.DeleteResourceCall.Receives.ApiVersion).To(Equal("2017-03-30"))
+		})
+
+		Context("when client fails to delete the virtual machine", func() {
+			BeforeEach(func() {
+				client.DeleteResourceCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				err := vm.Delete()
+				Expect(err).To(MatchError("Delete: some error"))
+			})
+		})
+	})
+
+	Describe("DeleteContext", func() {
+		It("deletes the virtual machine with the context", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			err := vm.DeleteContext(ctx)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.DeleteResourceCall.Receives.Context).To(Equal(ctx))
+		})
+	})
+
+	Describe("Name", func() {
+		It("includes the bosh director and deployment tags", func() {
+			Expect(vm.Name()).To(Equal("vm-banana (director:bosh-banana, deployment:cf)"))
+		})
+	})
+
+	Describe("Type", func() {
+		It("returns the type", func() {
+			Expect(vm.Type()).To(Equal("Virtual Machine"))
+		})
+	})
+})
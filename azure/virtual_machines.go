@@ -0,0 +1,68 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/arm/resources/resources"
+	"github.com/genevieve/leftovers/common"
+)
+
+type resourcesClient interface {
+	ListResources(resourceType string) ([]resources.GenericResource, error)
+	APIVersion(resourceType string) (string, error)
+	DeleteResource(ctx context.Context, id, apiVersion string) error
+}
+
+const virtualMachinesType = "Microsoft.Compute/virtualMachines"
+
+type VirtualMachines struct {
+	client resourcesClient
+	logger logger
+}
+
+func NewVirtualMachines(client resourcesClient, logger logger) VirtualMachines {
+	return VirtualMachines{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (v VirtualMachines) List(filter string) ([]common.Deletable, error) {
+	vms, err := v.client.ListResources(virtualMachinesType)
+	if err != nil {
+		return nil, fmt.Errorf("Listing Virtual Machines: %s", err)
+	}
+
+	if len(vms) == 0 {
+		return nil, nil
+	}
+
+	apiVersion, err := v.client.APIVersion(virtualMachinesType)
+	if err != nil {
+		return nil, fmt.Errorf("Getting api version: %s", err)
+	}
+
+	var resources []common.Deletable
+	for _, vm := range vms {
+		r := NewVirtualMachine(v.client, vm.ID, vm.Name, vm.Tags, apiVersion)
+
+		if !strings.Contains(r.Name(), filter) {
+			continue
+		}
+
+		proceed := v.logger.PromptWithDetails(r.Type(), r.Name())
+		if !proceed {
+			continue
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func (v VirtualMachines) Type() string {
+	return "virtual-machine"
+}
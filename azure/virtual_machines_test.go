@@ -0,0 +1,100 @@
+package azure_test
+
+import (
+	"errors"
+
+	"github.com/Azure/azure-sdk-for-go/arm/resources/resources"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/genevieve/leftovers/azure"
+	"github.com/genevieve/leftovers/azure/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("VirtualMachines", func() {
+	var (
+		client *fakes.ResourcesClient
+		logger *fakes.Logger
+		filter string
+
+		vms azure.VirtualMachines
+	)
+
+	BeforeEach(func() {
+		client = &fakes.ResourcesClient{}
+		logger = &fakes.Logger{}
+		filter = "banana"
+
+		vms = azure.NewVirtualMachines(client, logger)
+	})
+
+	Describe("List", func() {
+		BeforeEach(func() {
+			logger.PromptWithDetailsCall.Returns.Proceed = true
+			client.APIVersionCall.Returns.APIVersion = "2017-03-30"
+			client.ListResourcesCall.Returns.Output = []resources.GenericResource{{
+				ID:   aws.String("some-id"),
+				Name: aws.String("banana-vm"),
+			}}
+		})
+
+		It("returns a list of virtual machines to delete", func() {
+			items, err := vms.List(filter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.ListResourcesCall.CallCount).To(Equal(1))
+			Expect(client.ListResourcesCall.Receives.ResourceType).To(Equal("Microsoft.Compute/virtualMachines"))
+			Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(1))
+			Expect(logger.PromptWithDetailsCall.Receives.Type).To(Equal("Virtual Machine"))
+			Expect(logger.PromptWithDetailsCall.Receives.Name).To(Equal("banana-vm"))
+
+			Expect(client.APIVersionCall.Receives.ResourceType).To(Equal("Microsoft.Compute/virtualMachines"))
+
+			Expect(items).To(HaveLen(1))
+		})
+
+		Context("when the client fails to get the api version", func() {
+			BeforeEach(func() {
+				client.APIVersionCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := vms.List(filter)
+				Expect(err).To(MatchError("Getting api version: some error"))
+			})
+		})
+
+		Context("when client fails to list virtual machines", func() {
+			BeforeEach(func() {
+				client.ListResourcesCall.Returns.Error = errors.New("some error")
+			})
+
+			It("returns the error", func() {
+				_, err := vms.List(filter)
+				Expect(err).To(MatchError("Listing Virtual Machines: some error"))
+			})
+		})
+
+		Context("when the user responds no to the prompt", func() {
+			BeforeEach(func() {
+				logger.PromptWithDetailsCall.Returns.Proceed = false
+			})
+
+			It("does not return it in the list", func() {
+				items, err := vms.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(items).To(HaveLen(0))
+			})
+		})
+
+		Context("when the virtual machine name does not contain the filter", func() {
+			It("does not return it in the list", func() {
+				items, err := vms.List("grape")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
+				Expect(items).To(HaveLen(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package bbl_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestBBL(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "bbl")
+}
@@ -0,0 +1,155 @@
+package bbl
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/genevieve/leftovers/tfstate"
+)
+
+// State is the environment identity that bbl records in bbl-state.json.
+// Credentials are only present if the version of bbl that wrote the
+// state stores them.
+type State struct {
+	IAAS  string `json:"iaas"`
+	EnvID string `json:"envID"`
+
+	AWS struct {
+		AccessKeyID     string `json:"accessKeyId"`
+		SecretAccessKey string `json:"secretAccessKey"`
+		Region          string `json:"region"`
+	} `json:"aws"`
+
+	Azure struct {
+		ClientID       string `json:"clientId"`
+		ClientSecret   string `json:"clientSecret"`
+		Location       string `json:"location"`
+		SubscriptionID string `json:"subscriptionId"`
+		TenantID       string `json:"tenantId"`
+	} `json:"azure"`
+
+	GCP struct {
+		ServiceAccountKey string `json:"serviceAccountKey"`
+		ProjectID         string `json:"projectId"`
+		Zone              string `json:"zone"`
+		Region            string `json:"region"`
+	} `json:"gcp"`
+
+	VSphere struct {
+		VCenterUser     string `json:"vcenterUser"`
+		VCenterPassword string `json:"vcenterPassword"`
+		VCenterIP       string `json:"vcenterIP"`
+		VCenterDC       string `json:"vcenterDC"`
+	} `json:"vsphere"`
+
+	OpenStack struct {
+		AuthURL  string `json:"authURL"`
+		Username string `json:"username"`
+		Password string `json:"password"`
+		Domain   string `json:"domain"`
+		Project  string `json:"project"`
+		Region   string `json:"region"`
+	} `json:"openstack"`
+
+	BOSH struct {
+		DirectorName string `json:"directorName"`
+	} `json:"bosh"`
+
+	// TFState is the terraform state that bbl kept in
+	// bbl-state.json before it moved it to the vars directory.
+	TFState string `json:"tfState"`
+
+	terraform tfstate.State
+}
+
+// Load reads bbl-state.json and the terraform state from the provided
+// bbl state directory. A relative GCP service account key path is
+// resolved against the state directory.
+func Load(dir string) (State, error) {
+	contents, err := ioutil.ReadFile(filepath.Join(dir, "bbl-state.json"))
+	if err != nil {
+		return State{}, fmt.Errorf("Reading bbl state: %s", err)
+	}
+
+	var state State
+	err = json.Unmarshal(contents, &state)
+	if err != nil {
+		return State{}, fmt.Errorf("Parsing bbl state: %s", err)
+	}
+
+	if state.EnvID == "" {
+		return State{}, errors.New("Missing env id in bbl state.")
+	}
+
+	key := state.GCP.ServiceAccountKey
+	if key != "" && !strings.HasPrefix(strings.TrimSpace(key), "{") && !filepath.IsAbs(key) && key[0] != '~' {
+		state.GCP.ServiceAccountKey = filepath.Join(dir, key)
+	}
+
+	state.terraform, err = loadTerraform(dir, state.TFState)
+	if err != nil {
+		return State{}, err
+	}
+
+	return state, nil
+}
+
+// Contains returns true if the resource is in the terraform state of the
+// environment, or if its name contains the env id, the BOSH director name
+// or the value of a terraform output as a whole word. Hyphens are part of
+// words, so that the env id "banana" selects "banana" and "vpc-123
+// (Name:banana)", but not resources from the environments "bananas" or
+// "banana-2".
+func (s State) Contains(resourceType, resourceName string) bool {
+	if s.terraform.Contains(resourceType, resourceName) {
+		return true
+	}
+
+	names := append([]string{s.EnvID, s.BOSH.DirectorName}, s.terraform.Outputs()...)
+	for _, name := range names {
+		if name != "" && containsWord(resourceName, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// loadTerraform returns the terraform state that bbl keeps in
+// vars/terraform.tfstate, or in bbl-state.json before bbl 6.
+func loadTerraform(dir, tfState string) (tfstate.State, error) {
+	path := filepath.Join(dir, "vars", "terraform.tfstate")
+	if _, err := os.Stat(path); err == nil {
+		return tfstate.Load(path)
+	}
+
+	if tfState != "" {
+		return tfstate.Parse([]byte(tfState))
+	}
+
+	return tfstate.State{}, nil
+}
+
+func containsWord(s, word string) bool {
+	for i := 0; i <= len(s)-len(word); i++ {
+		if !strings.HasPrefix(s[i:], word) {
+			continue
+		}
+
+		end := i + len(word)
+		if (i == 0 || !isWordChar(s[i-1])) && (end == len(s) || !isWordChar(s[end])) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isWordChar(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') || c == '-' || c == '_' || c == '.'
+}
@@ -0,0 +1,192 @@
+package bbl_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/genevieve/leftovers/bbl"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("State", func() {
+	Describe("Load", func() {
+		var dir string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "bbl-state")
+			Expect(err).NotTo(HaveOccurred())
+
+			err = ioutil.WriteFile(filepath.Join(dir, "bbl-state.json"), []byte(`{
+				"version": 14,
+				"iaas": "gcp",
+				"envID": "banana",
+				"gcp": {"serviceAccountKey": "vars/key.json", "zone": "us-west1-a", "region": "us-west1"},
+				"bosh": {"directorName": "bosh-banana"}
+			}`), os.ModePerm)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(dir)
+		})
+
+		It("reads the environment identity from bbl-state.json", func() {
+			state, err := bbl.Load(dir)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(state.IAAS).To(Equal("gcp"))
+			Expect(state.EnvID).To(Equal("banana"))
+			Expect(state.GCP.Region).To(Equal("us-west1"))
+			Expect(state.GCP.Zone).To(Equal("us-west1-a"))
+			Expect(state.BOSH.DirectorName).To(Equal("bosh-banana"))
+		})
+
+		It("resolves a relative service account key path against the state directory", func() {
+			state, err := bbl.Load(dir)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(state.GCP.ServiceAccountKey).To(Equal(filepath.Join(dir, "vars/key.json")))
+		})
+
+		Context("when bbl-state.json does not exist", func() {
+			It("returns an error", func() {
+				_, err := bbl.Load("/does/not/exist")
+				Expect(err).To(MatchError(ContainSubstring("Reading bbl state:")))
+			})
+		})
+
+		Context("when bbl-state.json has no env id", func() {
+			BeforeEach(func() {
+				err := ioutil.WriteFile(filepath.Join(dir, "bbl-state.json"), []byte(`{"iaas": "aws"}`), os.ModePerm)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns an error", func() {
+				_, err := bbl.Load(dir)
+				Expect(err).To(MatchError("Missing env id in bbl state."))
+			})
+		})
+	})
+
+	Describe("Contains", func() {
+		var (
+			dir   string
+			state bbl.State
+		)
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "bbl-state")
+			Expect(err).NotTo(HaveOccurred())
+
+			err = ioutil.WriteFile(filepath.Join(dir, "bbl-state.json"), []byte(`{
+				"iaas": "aws",
+				"envID": "banana",
+				"bosh": {"directorName": "bosh-director"}
+			}`), os.ModePerm)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = os.MkdirAll(filepath.Join(dir, "vars"), os.ModePerm)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = ioutil.WriteFile(filepath.Join(dir, "vars", "terraform.tfstate"), []byte(`{
+				"version": 4,
+				"outputs": {
+					"internal_security_group": {"type": "string", "value": "sg-123"},
+					"nat_eip": {"type": "string", "value": "eipalloc-123"}
+				},
+				"resources": [{
+					"mode": "managed",
+					"type": "aws_vpc",
+					"instances": [{"attributes": {"id": "vpc-123"}}]
+				}, {
+					"mode": "managed",
+					"type": "aws_iam_role",
+					"instances": [{"attributes": {"id": "banana-bosh-role", "name": "banana-bosh-role"}}]
+				}]
+			}`), os.ModePerm)
+			Expect(err).NotTo(HaveOccurred())
+
+			state, err = bbl.Load(dir)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(dir)
+		})
+
+		It("returns true for the resources in the terraform state", func() {
+			Expect(state.Contains("EC2 VPC", "vpc-123 (Name:banana-vpc)")).To(BeTrue())
+			Expect(state.Contains("IAM Role", "banana-bosh-role")).To(BeTrue())
+		})
+
+		It("returns true for names that contain a terraform output", func() {
+			Expect(state.Contains("EC2 Security Group", "sg-123 (Name:banana-internal-security-group)")).To(BeTrue())
+			Expect(state.Contains("EC2 Address", "eipalloc-123")).To(BeTrue())
+		})
+
+		It("returns true for names that contain the env id as a word", func() {
+			Expect(state.Contains("EC2 Key Pair", "banana")).To(BeTrue())
+			Expect(state.Contains("EC2 Volume", "vol-123 (Name:banana)")).To(BeTrue())
+		})
+
+		It("returns true for names that contain the director name", func() {
+			Expect(state.Contains("EC2 Instance", "i-123 (director:bosh-director, deployment:cf)")).To(BeTrue())
+		})
+
+		It("returns false for unknown names", func() {
+			Expect(state.Contains("EC2 VPC", "vpc-456 (Name:kiwi-vpc)")).To(BeFalse())
+			Expect(state.Contains("IAM Role", "kiwi-bosh-role")).To(BeFalse())
+			Expect(state.Contains("EC2 Security Group", "sg-1234")).To(BeFalse())
+		})
+
+		It("returns false for resources of another type in the terraform state", func() {
+			Expect(state.Contains("EC2 Subnet", "vpc-123")).To(BeFalse())
+		})
+
+		It("returns false for extra names that are not in the state", func() {
+			Expect(state.Contains("EC2 VPC", "vpc-456 (Name:banana-vpc)")).To(BeFalse())
+			Expect(state.Contains("IAM Role", "banana-other-role")).To(BeFalse())
+			Expect(state.Contains("EC2 Instance", "banana-2-jumpbox")).To(BeFalse())
+			Expect(state.Contains("EC2 VPC", "bananas-vpc")).To(BeFalse())
+			Expect(state.Contains("EC2 VPC", "bigbanana")).To(BeFalse())
+			Expect(state.Contains("Route53 Hosted Zone", "banana.example.com")).To(BeFalse())
+		})
+
+		Context("when bbl-state.json has the terraform state", func() {
+			BeforeEach(func() {
+				err := os.RemoveAll(filepath.Join(dir, "vars"))
+				Expect(err).NotTo(HaveOccurred())
+
+				err = ioutil.WriteFile(filepath.Join(dir, "bbl-state.json"), []byte(`{
+					"iaas": "aws",
+					"envID": "banana",
+					"tfState": "{\"version\": 3, \"modules\": [{\"resources\": {\"aws_vpc.vpc\": {\"type\": \"aws_vpc\", \"primary\": {\"id\": \"vpc-789\"}}}}]}"
+				}`), os.ModePerm)
+				Expect(err).NotTo(HaveOccurred())
+
+				state, err = bbl.Load(dir)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns true for the resources in it", func() {
+				Expect(state.Contains("EC2 VPC", "vpc-789")).To(BeTrue())
+				Expect(state.Contains("EC2 VPC", "vpc-123")).To(BeFalse())
+			})
+		})
+
+		Context("when the terraform state cannot be parsed", func() {
+			It("returns an error", func() {
+				err := ioutil.WriteFile(filepath.Join(dir, "vars", "terraform.tfstate"), []byte(`%%%`), os.ModePerm)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = bbl.Load(dir)
+				Expect(err).To(MatchError(ContainSubstring("Parsing terraform state:")))
+			})
+		})
+	})
+})
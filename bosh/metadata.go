@@ -0,0 +1,34 @@
+package bosh
+
+import (
+	"fmt"
+	"strings"
+)
+
+// keys are the metadata that BOSH tags instances and disks with,
+// in the order in which leftovers includes them in names.
+var keys = []string{"director", "deployment"}
+
+// Metadata returns the director and deployment in the provided tags,
+// labels or custom attributes as the key:value pairs that the
+// Selector matches.
+func Metadata(values map[string]string) []string {
+	pairs := []string{}
+	for _, key := range keys {
+		if value, ok := values[key]; ok {
+			pairs = append(pairs, fmt.Sprintf("%s:%s", key, value))
+		}
+	}
+	return pairs
+}
+
+// WithMetadata appends the director and deployment in the provided
+// tags, labels or custom attributes to the provided name.
+func WithMetadata(name string, values map[string]string) string {
+	pairs := Metadata(values)
+	if len(pairs) == 0 {
+		return name
+	}
+
+	return fmt.Sprintf("%s (%s)", name, strings.Join(pairs, ", "))
+}
@@ -0,0 +1,36 @@
+package bosh_test
+
+import (
+	"github.com/genevieve/leftovers/bosh"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Metadata", func() {
+	It("returns the director and deployment as key:value pairs", func() {
+		pairs := bosh.Metadata(map[string]string{"deployment": "cf", "job": "router", "director": "bosh-banana"})
+		Expect(pairs).To(Equal([]string{"director:bosh-banana", "deployment:cf"}))
+	})
+
+	It("returns no pairs without bosh metadata", func() {
+		Expect(bosh.Metadata(map[string]string{"job": "router"})).To(BeEmpty())
+		Expect(bosh.Metadata(nil)).To(BeEmpty())
+	})
+})
+
+var _ = Describe("WithMetadata", func() {
+	It("appends the director and deployment to the name", func() {
+		name := bosh.WithMetadata("vm-123", map[string]string{"director": "bosh-banana", "deployment": "cf"})
+		Expect(name).To(Equal("vm-123 (director:bosh-banana, deployment:cf)"))
+	})
+
+	It("returns names that the selector contains", func() {
+		name := bosh.WithMetadata("vm-123", map[string]string{"director": "bosh-banana", "deployment": "cf"})
+		Expect(bosh.NewSelector("bosh-banana", "cf").Contains("Virtual Machine", name)).To(BeTrue())
+	})
+
+	It("returns the name without bosh metadata", func() {
+		Expect(bosh.WithMetadata("vm-123", map[string]string{"job": "router"})).To(Equal("vm-123"))
+	})
+})
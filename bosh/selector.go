@@ -0,0 +1,59 @@
+package bosh
+
+import "strings"
+
+// resourceTypes are the types of the resources that BOSH creates
+// for instances and their persistent disks, and tags with metadata.
+var resourceTypes = map[string]bool{
+	"EC2 Instance":     true,
+	"EC2 Volume":       true,
+	"Compute Instance": true,
+	"Disk":             true,
+	"Volume":           true,
+	"Virtual Machine":  true,
+}
+
+// Selector selects BOSH instances and persistent disks by the director
+// and deployment metadata that BOSH tags them with. Leftovers includes
+// this metadata in the name of these resources as key:value pairs.
+type Selector struct {
+	director   string
+	deployment string
+}
+
+// NewSelector returns a Selector for the provided director and deployment.
+// An empty director or deployment matches any value.
+func NewSelector(director, deployment string) Selector {
+	return Selector{
+		director:   director,
+		deployment: deployment,
+	}
+}
+
+// Contains returns true if the resource is a BOSH instance or disk
+// with metadata matching the director and deployment.
+func (s Selector) Contains(resourceType, resourceName string) bool {
+	if !resourceTypes[resourceType] {
+		return false
+	}
+
+	metadata := map[string]string{}
+	for _, field := range strings.FieldsFunc(resourceName, func(r rune) bool { return r == '(' || r == ')' || r == ',' }) {
+		parts := strings.SplitN(strings.TrimSpace(field), ":", 2)
+		if len(parts) == 2 {
+			metadata[parts[0]] = parts[1]
+		}
+	}
+
+	for key, value := range map[string]string{"director": s.director, "deployment": s.deployment} {
+		if _, ok := metadata[key]; !ok {
+			return false
+		}
+
+		if value != "" && metadata[key] != value {
+			return false
+		}
+	}
+
+	return true
+}
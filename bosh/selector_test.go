@@ -0,0 +1,49 @@
+package bosh_test
+
+import (
+	"github.com/genevieve/leftovers/bosh"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Selector", func() {
+	var selector bosh.Selector
+
+	BeforeEach(func() {
+		selector = bosh.NewSelector("bosh-banana", "cf")
+	})
+
+	Describe("Contains", func() {
+		It("selects instances and disks with matching metadata", func() {
+			Expect(selector.Contains("EC2 Instance", "i-123 (director:bosh-banana, deployment:cf, job:router)")).To(BeTrue())
+			Expect(selector.Contains("EC2 Volume", "vol-123 (State:available) (director:bosh-banana,deployment:cf)")).To(BeTrue())
+			Expect(selector.Contains("Compute Instance", "vm-123 (p-bosh, director:bosh-banana, deployment:cf)")).To(BeTrue())
+			Expect(selector.Contains("Volume", "some-name some-id (director:bosh-banana, deployment:cf)")).To(BeTrue())
+			Expect(selector.Contains("Virtual Machine", "vm-123 (director:bosh-banana, deployment:cf)")).To(BeTrue())
+		})
+
+		It("does not select resources of other types", func() {
+			Expect(selector.Contains("EC2 Security Group", "sg (director:bosh-banana, deployment:cf)")).To(BeFalse())
+		})
+
+		It("does not select resources with other metadata", func() {
+			Expect(selector.Contains("EC2 Instance", "i-123 (director:bosh-banana, deployment:cf-2)")).To(BeFalse())
+			Expect(selector.Contains("EC2 Instance", "i-123 (director:bosh-banana-2, deployment:cf)")).To(BeFalse())
+			Expect(selector.Contains("EC2 Instance", "i-123 (director:bosh-banana)")).To(BeFalse())
+			Expect(selector.Contains("EC2 Instance", "i-123 (Name:bosh-banana-cf)")).To(BeFalse())
+		})
+
+		Context("when only the director is provided", func() {
+			BeforeEach(func() {
+				selector = bosh.NewSelector("bosh-banana", "")
+			})
+
+			It("selects instances and disks in any deployment", func() {
+				Expect(selector.Contains("Disk", "disk-123 (director:bosh-banana, deployment:cf)")).To(BeTrue())
+				Expect(selector.Contains("Disk", "disk-123 (director:bosh-banana, deployment:concourse)")).To(BeTrue())
+				Expect(selector.Contains("Disk", "disk-123 (director:bosh-kiwi, deployment:cf)")).To(BeFalse())
+			})
+		})
+	})
+})
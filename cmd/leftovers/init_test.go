@@ -0,0 +1,48 @@
+package main_test
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestLeftovers(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "leftovers")
+}
+
+var (
+	binaryDir  string
+	pathToMain string
+)
+
+var _ = BeforeSuite(func() {
+	var err error
+	binaryDir, err = ioutil.TempDir("", "leftovers")
+	Expect(err).NotTo(HaveOccurred())
+
+	pathToMain = filepath.Join(binaryDir, "leftovers")
+
+	output, err := exec.Command("go", "build", "-o", pathToMain, ".").CombinedOutput()
+	Expect(err).NotTo(HaveOccurred(), string(output))
+})
+
+var _ = AfterSuite(func() {
+	os.RemoveAll(binaryDir)
+})
+
+// leftovers runs the binary with the provided arguments, without
+// any BBL_ or cloud environment variables, and returns its output.
+func leftovers(args ...string) (string, error) {
+	cmd := exec.Command(pathToMain, args...)
+	cmd.Env = []string{"PATH=" + os.Getenv("PATH"), "HOME=" + binaryDir}
+
+	output, err := cmd.CombinedOutput()
+	return string(output), err
+}
@@ -1,18 +1,27 @@
 package main
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
+	"os/signal"
+	"strings"
+	"syscall"
 
 	"github.com/fatih/color"
 	"github.com/genevieve/leftovers/app"
 	"github.com/genevieve/leftovers/aws"
 	"github.com/genevieve/leftovers/azure"
+	"github.com/genevieve/leftovers/bbl"
+	"github.com/genevieve/leftovers/bosh"
+	"github.com/genevieve/leftovers/common"
 	"github.com/genevieve/leftovers/gcp"
 	"github.com/genevieve/leftovers/nsxt"
 	"github.com/genevieve/leftovers/openstack"
+	"github.com/genevieve/leftovers/tfstate"
 	"github.com/genevieve/leftovers/vsphere"
 	flags "github.com/jessevdk/go-flags"
 )
@@ -26,10 +35,29 @@ type opts struct {
 	Filter    string `short:"f"  long:"filter"                      description:"Filtering resources by an environment name."`
 	Type      string `short:"t"  long:"type"                        description:"Type of resource to delete."`
 
+	Quiet   bool   `short:"q" long:"quiet"    description:"Only print prompts and errors, or the resources with --dry-run."`
+	Verbose bool   `long:"verbose"            description:"Also print progress, like waiting for resources to be deleted."`
+	Debug   bool   `long:"debug"              description:"Also print API requests (AWS, GCP, Azure and OpenStack)."`
+	NoColor bool   `long:"no-color"           description:"Print without colors. Colors are off when output is not a terminal."`
+	JSON    bool   `long:"json"               description:"Print each message as a line of JSON."`
+	LogFile string `long:"log-file"           description:"Also write all messages, without colors, to this file."`
+
+	Backup       bool   `long:"backup"                  description:"Snapshot or archive stateful resources (AWS and GCP) before deleting them."`
+	BackupBucket string `long:"backup-bucket"           description:"Bucket to archive bucket contents and database exports to when backing up."`
+
+	ForceDeleteWithoutRecovery bool `long:"force-delete-without-recovery" description:"Delete AWS Secrets Manager secrets immediately instead of after a recovery window."`
+
+	TFState        string `long:"tfstate"         description:"Only delete resources managed by this Terraform state file (AWS, GCP, Azure and OpenStack)."`
+	ExcludeTFState bool   `long:"exclude-tfstate" description:"Delete resources that are not managed by the --tfstate file instead."`
+	BBLStateDir    string `long:"bbl-state-dir"   env:"BBL_STATE_DIRECTORY" description:"Read the IaaS, credentials and filter from this bbl state directory."`
+
+	BOSHDirector   string `long:"bosh-director"   description:"Only delete VMs and disks that BOSH tagged with this director name."`
+	BOSHDeployment string `long:"bosh-deployment" description:"Only delete VMs and disks that BOSH tagged with this deployment name."`
+
 	AWSAccessKeyID       string `long:"aws-access-key-id"        env:"BBL_AWS_ACCESS_KEY_ID"        description:"AWS access key id."`
 	AWSSecretAccessKey   string `long:"aws-secret-access-key"    env:"BBL_AWS_SECRET_ACCESS_KEY"    description:"AWS secret access key."`
 	AWSSessionToken      string `long:"aws-session-token"        env:"BBL_AWS_SESSION_TOKEN"        description:"AWS session token."`
-	AWSRegion            string `long:"aws-region"               env:"BBL_AWS_REGION"               description:"AWS region."`
+	AWSRegion            string `long:"aws-region"               env:"BBL_AWS_REGION"               description:"AWS region, comma-separated regions, or all."`
 	AzureClientID        string `long:"azure-client-id"          env:"BBL_AZURE_CLIENT_ID"          description:"Azure client id."`
 	AzureClientSecret    string `long:"azure-client-secret"      env:"BBL_AZURE_CLIENT_SECRET"      description:"Azure client secret."`
 	AzureTenantID        string `long:"azure-tenant-id"          env:"BBL_AZURE_TENANT_ID"          description:"Azure tenant id."`
@@ -48,11 +76,38 @@ type opts struct {
 	OpenstackDomain      string `long:"openstack-domain-name"    env:"BBL_OPENSTACK_DOMAIN"         description:"Openstack domain name."`
 	OpenstackTenant      string `long:"openstack-project-name"   env:"BBL_OPENSTACK_PROJECT"        description:"Openstack project name."`
 	OpenstackRegion      string `long:"openstack-region-name"    env:"BBL_OPENSTACK_REGION"         description:"Openstack region name."`
+
+	AWSEndpoint         string            `long:"aws-endpoint"         description:"Send AWS requests to this endpoint instead, ie. for LocalStack."`
+	AWSServiceEndpoints map[string]string `long:"aws-service-endpoint" description:"Send requests for one AWS service to an endpoint instead, ie. s3:http://localhost:4566. Can be repeated."`
+	AzureEnvironment    string            `long:"azure-environment"    description:"Azure cloud, ie. USGovernment or China, or the Resource Manager URL of an Azure Stack."`
+	GCPEndpoint         string            `long:"gcp-endpoint"         description:"Send GCP requests to this endpoint instead, ie. for testing."`
+	GCPServiceEndpoints map[string]string `long:"gcp-service-endpoint" description:"Send requests for one GCP API to a base URL instead, ie. storage:http://localhost:4443/storage/v1/. Can be repeated."`
+	NSXTBasePath        string            `long:"nsxt-base-path"       description:"NSX-T API base path. Defaults to /api/v1."`
+
+	AWSProfile         string `long:"aws-profile"           description:"AWS shared config profile to find credentials in when no access key is provided."`
+	AWSAssumeRoleARN   string `long:"aws-assume-role-arn"   description:"AWS role to assume with the credentials, ie. in a sandbox account."`
+	AWSExternalID      string `long:"aws-external-id"       description:"External id to assume the AWS role with."`
+	AWSRoleSessionName string `long:"aws-role-session-name" description:"Session name to assume the AWS role with. Defaults to leftovers."`
+
+	AWSOrganization     bool     `long:"aws-organization"      description:"Sweep every member account of the AWS Organization of the credentials instead."`
+	AWSOrganizationRole string   `long:"aws-organization-role" description:"Role to assume in each member account. Defaults to OrganizationAccountAccessRole."`
+	AWSIncludeAccounts  []string `long:"aws-include-account"   description:"Only sweep this member account. Can be repeated."`
+	AWSExcludeAccounts  []string `long:"aws-exclude-account"   description:"Do not sweep this member account. Can be repeated."`
+}
+
+type logger interface {
+	Printf(message string, a ...interface{})
+	Println(message string)
+	Errorln(message string)
+	Verbosef(message string, a ...interface{})
+	Debugf(message string, a ...interface{})
+	PromptWithDetails(resourceType, resourceName string) bool
+	NoConfirm()
 }
 
 type leftovers interface {
-	Delete(filter string) error
-	DeleteType(filter, rType string) error
+	Delete(ctx context.Context, filter string) error
+	DeleteType(ctx context.Context, filter, rType string) error
 	List(filter string)
 	Types()
 }
@@ -88,34 +143,142 @@ func main() {
 		return
 	}
 
-	logger := app.NewLogger(os.Stdout, os.Stdin, o.NoConfirm)
+	var bblState *bbl.State
+	if o.BBLStateDir != "" {
+		state, err := bbl.Load(o.BBLStateDir)
+		if err != nil {
+			log.Fatalf("\n\n%s\n", err)
+		}
+
+		o = useBBLState(o, state)
+		bblState = &state
+	}
+
+	if o.Quiet && (o.Verbose || o.Debug) {
+		log.Fatalf("--quiet cannot be used with --verbose or --debug.")
+	}
+
+	if o.NoColor {
+		color.NoColor = true
+	}
+
+	appLogger := app.NewLogger(os.Stdout, os.Stdin, o.NoConfirm)
+	appLogger.SetJSON(o.JSON)
+
+	// fatalf prints the error and exits. With --json, the error
+	// is printed as a line of JSON like every other message.
+	fatalf := func(format string, a ...interface{}) {
+		if o.JSON {
+			appLogger.Errorln(strings.TrimSpace(fmt.Sprintf(format, a...)))
+			os.Exit(1)
+		}
+		log.Fatalf(format, a...)
+	}
+
+	switch {
+	case o.Debug:
+		appLogger.SetLevel(app.LevelDebug)
+	case o.Verbose:
+		appLogger.SetLevel(app.LevelVerbose)
+	case o.Quiet && !o.DryRun && command != "types":
+		// Listing resources or types prints them regardless of --quiet.
+		appLogger.SetLevel(app.LevelQuiet)
+	}
+
+	if o.LogFile != "" {
+		file, err := os.OpenFile(o.LogFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+		if err != nil {
+			fatalf("\n\n%s\n", err)
+		}
+		defer file.Close()
+
+		appLogger.SetLogFile(file)
+	}
+
+	if o.Debug {
+		http.DefaultTransport = app.NewTracingTransport(http.DefaultTransport, appLogger)
+	}
+
+	var logger logger = appLogger
+
+	if o.TFState != "" {
+		if o.IAAS != AWS && o.IAAS != GCP && o.IAAS != Azure && o.IAAS != Openstack {
+			fatalf("--tfstate is not supported for %s.", o.IAAS)
+		}
+
+		state, err := tfstate.Load(o.TFState)
+		if err != nil {
+			fatalf("\n\n%s\n", err)
+		}
+
+		logger = app.NewSelectiveLogger(logger, state, o.ExcludeTFState)
+	}
+
+	if bblState != nil && o.Filter == "" {
+		if o.IAAS == VSphere {
+			o.Filter = bblState.EnvID
+		}
+
+		logger = app.NewSelectiveLogger(logger, *bblState, false)
+	}
+
+	if o.BOSHDirector != "" || o.BOSHDeployment != "" {
+		if o.IAAS == NSXT {
+			fatalf("--bosh-director and --bosh-deployment are not supported for %s.", o.IAAS)
+		}
+
+		logger = app.NewSelectiveLogger(logger, bosh.NewSelector(o.BOSHDirector, o.BOSHDeployment), false)
+	}
+
+	backup := common.Backup{Enabled: o.Backup, Bucket: o.BackupBucket}
 
 	var l leftovers
 
 	switch o.IAAS {
 	case AWS:
 		o = useOtherEnvVars(o, AWS)
-		l, err = aws.NewLeftovers(logger, o.AWSAccessKeyID, o.AWSSecretAccessKey, o.AWSSessionToken, o.AWSRegion)
+		endpoints := aws.Endpoints{Default: o.AWSEndpoint, Services: o.AWSServiceEndpoints}
+		authArgs := aws.AuthArgs{
+			AccessKeyID:     o.AWSAccessKeyID,
+			SecretAccessKey: o.AWSSecretAccessKey,
+			SessionToken:    o.AWSSessionToken,
+			Profile:         o.AWSProfile,
+			RoleARN:         o.AWSAssumeRoleARN,
+			ExternalID:      o.AWSExternalID,
+			SessionName:     o.AWSRoleSessionName,
+		}
+		deleteArgs := aws.DeleteArgs{ForceDeleteWithoutRecovery: o.ForceDeleteWithoutRecovery}
+		if o.AWSOrganization {
+			organizationArgs := aws.OrganizationArgs{
+				Role:    o.AWSOrganizationRole,
+				Include: o.AWSIncludeAccounts,
+				Exclude: o.AWSExcludeAccounts,
+			}
+			l, err = aws.NewOrganizationLeftovers(logger, authArgs, o.AWSRegion, endpoints, nil, backup, deleteArgs, organizationArgs)
+		} else {
+			l, err = aws.NewLeftovers(logger, authArgs, o.AWSRegion, endpoints, nil, backup, deleteArgs)
+		}
 	case Azure:
 		o = useOtherEnvVars(o, Azure)
-		l, err = azure.NewLeftovers(logger, o.AzureClientID, o.AzureClientSecret, o.AzureSubscriptionID, o.AzureTenantID)
+		l, err = azure.NewLeftovers(logger, o.AzureClientID, o.AzureClientSecret, o.AzureSubscriptionID, o.AzureTenantID, o.AzureEnvironment, o.BOSHDirector != "" || o.BOSHDeployment != "")
 	case GCP:
 		o = useOtherEnvVars(o, GCP)
-		l, err = gcp.NewLeftovers(logger, o.GCPServiceAccountKey)
+		endpoints := gcp.Endpoints{Default: o.GCPEndpoint, Services: o.GCPServiceEndpoints}
+		l, err = gcp.NewLeftovers(logger, o.GCPServiceAccountKey, endpoints, nil, backup)
 	case NSXT:
 		o = useOtherEnvVars(o, NSXT)
-		l, err = nsxt.NewLeftovers(logger, o.NSXTManagerHost, o.NSXTUser, o.NSXTPassword)
+		l, err = nsxt.NewLeftovers(logger, o.NSXTManagerHost, o.NSXTUser, o.NSXTPassword, o.NSXTBasePath, nil)
 	case VSphere:
 		if o.Filter == "" {
-			log.Fatalf("--filter is required for vSphere.")
+			fatalf("--filter is required for vSphere.")
 		}
 		if o.NoConfirm {
-			log.Fatalf("--no-confirm is not supported for vSphere.")
+			fatalf("--no-confirm is not supported for vSphere.")
 		}
-		l, err = vsphere.NewLeftovers(logger, o.VSphereIP, o.VSphereUser, o.VSpherePassword, o.VSphereDC)
+		l, err = vsphere.NewLeftovers(logger, o.VSphereIP, o.VSphereUser, o.VSpherePassword, o.VSphereDC, nil)
 	case Openstack:
 		if o.Filter != "" {
-			log.Fatalf("--filter is not supported for OpenStack")
+			fatalf("--filter is not supported for OpenStack")
 		}
 		l, err = openstack.NewLeftovers(logger, openstack.AuthArgs{
 			AuthURL:    o.OpenstackAuthUrl,
@@ -124,13 +287,13 @@ func main() {
 			Domain:     o.OpenstackDomain,
 			TenantName: o.OpenstackTenant,
 			Region:     o.OpenstackRegion,
-		})
+		}, nil)
 	default:
 		err = errors.New("Missing or unsupported BBL_IAAS.")
 	}
 
 	if err != nil {
-		log.Fatalf("\n\n%s\n", err)
+		fatalf("\n\n%s\n", err)
 	}
 
 	if command == "types" {
@@ -143,20 +306,50 @@ func main() {
 		return
 	}
 
+	ctx, cancel := interruptible(logger)
+	defer cancel()
+
 	if o.Type != "" {
-		err = l.DeleteType(o.Filter, o.Type)
+		err = l.DeleteType(ctx, o.Filter, o.Type)
 	} else {
-		err = l.Delete(o.Filter)
+		err = l.Delete(ctx, o.Filter)
 	}
 	if err != nil {
-		log.Fatalf("\n\n%s\n", err)
+		fatalf("\n\n%s\n", err)
 	}
 
-	if !o.DryRun {
-		log.Println(fmt.Sprintf("Try %s to list remaining resources!", fmt.Sprintf(color.BlueString("leftovers --filter %s --dry-run"), o.Filter)))
+	if !o.DryRun && !o.Quiet {
+		try := fmt.Sprintf("Try %s to list remaining resources!", fmt.Sprintf(color.BlueString("leftovers --filter %s --dry-run"), o.Filter))
+		if o.JSON {
+			appLogger.Println(try)
+		} else {
+			log.Println(try)
+		}
 	}
 }
 
+// interruptible returns a context that is cancelled on the first SIGINT or
+// SIGTERM, so that deletions stop waiting and no more are started. Another
+// signal exits immediately.
+func interruptible(logger logger) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+
+	go func() {
+		select {
+		case <-signals:
+			logger.Println(color.YellowString("Interrupted, stopping. Interrupt again to exit immediately."))
+			cancel()
+		case <-ctx.Done():
+		}
+		signal.Stop(signals)
+	}()
+
+	return ctx, cancel
+}
+
 func useOtherEnvVars(o opts, iaas string) opts {
 	switch iaas {
 	case AWS:
@@ -185,6 +378,9 @@ func useOtherEnvVars(o opts, iaas string) opts {
 		if o.AzureTenantID == "" {
 			o.AzureTenantID = os.Getenv("ARM_TENANT_ID")
 		}
+		if o.AzureEnvironment == "" {
+			o.AzureEnvironment = os.Getenv("ARM_ENVIRONMENT")
+		}
 	case GCP:
 		if o.GCPServiceAccountKey == "" {
 			o.GCPServiceAccountKey = os.Getenv("GOOGLE_CREDENTIALS")
@@ -216,3 +412,40 @@ func useOtherEnvVars(o opts, iaas string) opts {
 
 	return o
 }
+
+// useBBLState fills any IaaS, region and credential options that were not
+// provided as flags or BBL_ environment variables from the bbl state.
+func useBBLState(o opts, s bbl.State) opts {
+	fill := func(option *string, value string) {
+		if *option == "" {
+			*option = value
+		}
+	}
+
+	fill(&o.IAAS, s.IAAS)
+
+	fill(&o.AWSAccessKeyID, s.AWS.AccessKeyID)
+	fill(&o.AWSSecretAccessKey, s.AWS.SecretAccessKey)
+	fill(&o.AWSRegion, s.AWS.Region)
+
+	fill(&o.AzureClientID, s.Azure.ClientID)
+	fill(&o.AzureClientSecret, s.Azure.ClientSecret)
+	fill(&o.AzureSubscriptionID, s.Azure.SubscriptionID)
+	fill(&o.AzureTenantID, s.Azure.TenantID)
+
+	fill(&o.GCPServiceAccountKey, s.GCP.ServiceAccountKey)
+
+	fill(&o.VSphereIP, s.VSphere.VCenterIP)
+	fill(&o.VSphereUser, s.VSphere.VCenterUser)
+	fill(&o.VSpherePassword, s.VSphere.VCenterPassword)
+	fill(&o.VSphereDC, s.VSphere.VCenterDC)
+
+	fill(&o.OpenstackAuthUrl, s.OpenStack.AuthURL)
+	fill(&o.OpenstackUsername, s.OpenStack.Username)
+	fill(&o.OpenstackPassword, s.OpenStack.Password)
+	fill(&o.OpenstackDomain, s.OpenStack.Domain)
+	fill(&o.OpenstackTenant, s.OpenStack.Project)
+	fill(&o.OpenstackRegion, s.OpenStack.Region)
+
+	return o
+}
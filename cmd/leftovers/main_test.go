@@ -0,0 +1,325 @@
+package main_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/genevieve/leftovers/fakecloud"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("leftovers", func() {
+	Describe("aws", func() {
+		var server *fakecloud.AWS
+
+		BeforeEach(func() {
+			server = fakecloud.NewAWS("us-east-1")
+			server.AddVolume("vol-banana", map[string]string{"Name": "banana"})
+			server.AddBucket("banana-bucket")
+			server.AddBucket("kiwi-bucket")
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("deletes the resources that match the filter", func() {
+			output, err := leftovers("--iaas", "aws", "--no-confirm", "--filter", "banana",
+				"--aws-access-key-id", "some-key", "--aws-secret-access-key", "some-secret",
+				"--aws-region", "us-east-1", "--aws-endpoint", server.URL())
+			Expect(err).NotTo(HaveOccurred(), output)
+
+			Expect(output).To(ContainSubstring("[EC2 Volume: vol-banana (State:available) (Name:banana)] Deleted!"))
+			Expect(output).To(ContainSubstring("[S3 Bucket: banana-bucket] Deleted!"))
+			Expect(output).NotTo(ContainSubstring("kiwi"))
+
+			Expect(server.Volumes()).To(BeEmpty())
+			Expect(server.Buckets()).To(ConsistOf("kiwi-bucket"))
+		})
+
+		Context("when a bbl state directory is provided", func() {
+			var stateDir string
+
+			BeforeEach(func() {
+				server.AddBucket("banana-other-bucket")
+
+				stateDir = filepath.Join(binaryDir, "banana-env")
+				err := os.MkdirAll(filepath.Join(stateDir, "vars"), os.ModePerm)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = ioutil.WriteFile(filepath.Join(stateDir, "bbl-state.json"), []byte(`{
+					"iaas": "aws",
+					"envID": "banana",
+					"aws": {"accessKeyId": "some-key", "secretAccessKey": "some-secret", "region": "us-east-1"}
+				}`), os.ModePerm)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = ioutil.WriteFile(filepath.Join(stateDir, "vars", "terraform.tfstate"), []byte(`{
+					"version": 4,
+					"resources": [{
+						"mode": "managed",
+						"type": "aws_s3_bucket",
+						"instances": [{"attributes": {"id": "banana-bucket", "bucket": "banana-bucket"}}]
+					}]
+				}`), os.ModePerm)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				os.RemoveAll(stateDir)
+			})
+
+			It("reads the iaas and credentials from it and only deletes the resources of the environment", func() {
+				output, err := leftovers("--bbl-state-dir", stateDir, "--no-confirm", "--aws-endpoint", server.URL())
+				Expect(err).NotTo(HaveOccurred(), output)
+
+				Expect(output).To(ContainSubstring("[EC2 Volume: vol-banana (State:available) (Name:banana)] Deleted!"))
+				Expect(output).To(ContainSubstring("[S3 Bucket: banana-bucket] Deleted!"))
+				Expect(output).NotTo(ContainSubstring("banana-other-bucket"))
+
+				Expect(server.Volumes()).To(BeEmpty())
+				Expect(server.Buckets()).To(ConsistOf("banana-other-bucket", "kiwi-bucket"))
+			})
+
+			Context("when a filter is provided", func() {
+				It("deletes the resources that match the filter instead", func() {
+					output, err := leftovers("--bbl-state-dir", stateDir, "--no-confirm", "--filter", "banana", "--aws-endpoint", server.URL())
+					Expect(err).NotTo(HaveOccurred(), output)
+
+					Expect(output).To(ContainSubstring("[S3 Bucket: banana-bucket] Deleted!"))
+					Expect(output).To(ContainSubstring("[S3 Bucket: banana-other-bucket] Deleted!"))
+
+					Expect(server.Buckets()).To(ConsistOf("kiwi-bucket"))
+				})
+			})
+
+			Context("when the flags are provided", func() {
+				It("uses them instead of the bbl state", func() {
+					output, err := leftovers("--bbl-state-dir", stateDir, "--dry-run", "--iaas", "gcp")
+					Expect(err).To(HaveOccurred())
+
+					Expect(output).To(ContainSubstring("Missing service account key path."))
+				})
+			})
+		})
+
+		Context("when there are iam roles, users and policies", func() {
+			BeforeEach(func() {
+				server.AddPolicy("banana-policy")
+				server.AddPolicy("kiwi-policy")
+				server.AddRole("banana-role", "banana-policy")
+				server.AddRolePolicy("banana-role", "banana-inline")
+				server.AddRole("kiwi-role", "kiwi-policy")
+				server.AddUser("banana-user", "banana-policy")
+				server.AddAccessKey("banana-user", "AKIABANANABANANA")
+			})
+
+			It("detaches and deletes their policies and access keys before deleting them", func() {
+				output, err := leftovers("--iaas", "aws", "--no-confirm", "--filter", "banana",
+					"--aws-access-key-id", "some-key", "--aws-secret-access-key", "some-secret",
+					"--aws-region", "us-east-1", "--aws-endpoint", server.URL())
+				Expect(err).NotTo(HaveOccurred(), output)
+
+				Expect(output).To(ContainSubstring("[IAM Role: banana-role] Deleted!"))
+				Expect(output).To(ContainSubstring("[IAM User: banana-user] Deleted access key AKIABANANABANANA"))
+				Expect(output).To(ContainSubstring("[IAM User: banana-user] Deleted!"))
+				Expect(output).To(ContainSubstring("[IAM Policy: banana-policy] Deleted!"))
+				Expect(output).NotTo(ContainSubstring("kiwi"))
+
+				Expect(server.Roles()).To(ConsistOf("kiwi-role"))
+				Expect(server.Users()).To(BeEmpty())
+				Expect(server.Policies()).To(ConsistOf("kiwi-policy"))
+			})
+		})
+
+		It("prints every message as a line of json", func() {
+			output, err := leftovers("--iaas", "aws", "--no-confirm", "--json", "--filter", "banana",
+				"--aws-access-key-id", "some-key", "--aws-secret-access-key", "some-secret",
+				"--aws-region", "us-east-1", "--aws-endpoint", server.URL())
+			Expect(err).NotTo(HaveOccurred(), output)
+
+			lines := strings.Split(strings.TrimSpace(output), "\n")
+			Expect(lines).To(ContainElement(MatchRegexp(`"message":"\[S3 Bucket: banana-bucket\] Deleted!"`)))
+			Expect(lines[len(lines)-1]).To(ContainSubstring("to list remaining resources!"))
+			for _, line := range lines {
+				Expect(line).To(MatchRegexp(`^{"time":"[^"]+","level":"[a-z]+","message":".*"}$`))
+			}
+		})
+
+		Context("when leftovers fails with --json", func() {
+			It("prints the error as a line of json", func() {
+				output, err := leftovers("--iaas", "aws", "--json", "--aws-access-key-id", "some-key")
+				Expect(err).To(HaveOccurred())
+
+				Expect(strings.TrimSpace(output)).To(MatchRegexp(`^{"time":"[^"]+","level":"error","message":"Missing secret access key."}$`))
+			})
+		})
+
+		It("lists the resources without deleting them", func() {
+			output, err := leftovers("--iaas", "aws", "--dry-run", "--filter", "banana",
+				"--aws-access-key-id", "some-key", "--aws-secret-access-key", "some-secret",
+				"--aws-region", "us-east-1", "--aws-endpoint", server.URL())
+			Expect(err).NotTo(HaveOccurred(), output)
+
+			Expect(output).To(ContainSubstring("[S3 Bucket: banana-bucket]"))
+			Expect(server.Buckets()).To(ConsistOf("banana-bucket", "kiwi-bucket"))
+		})
+
+		Context("when every region is swept", func() {
+			BeforeEach(func() {
+				server.Close()
+
+				server = fakecloud.NewAWS("us-east-1", "us-west-2")
+				server.AddRegionalVolume("us-east-1", "vol-banana", map[string]string{"Name": "banana"})
+				server.AddRegionalVolume("us-west-2", "vol-banana-west", map[string]string{"Name": "banana"})
+				server.AddRegionalBucket("us-west-2", "banana-west-bucket")
+				server.AddRegionalBucket("eu-west-1", "banana-eu-bucket")
+			})
+
+			It("deletes the resources in each region and prefixes them with their region", func() {
+				output, err := leftovers("--iaas", "aws", "--no-confirm", "--filter", "banana",
+					"--aws-access-key-id", "some-key", "--aws-secret-access-key", "some-secret",
+					"--aws-region", "all", "--aws-endpoint", server.URL())
+				Expect(err).NotTo(HaveOccurred(), output)
+
+				Expect(output).To(ContainSubstring("[EC2 Volume: (us-east-1) vol-banana (State:available) (Name:banana)] Deleted!"))
+				Expect(output).To(ContainSubstring("[EC2 Volume: (us-west-2) vol-banana-west (State:available) (Name:banana)] Deleted!"))
+				Expect(output).To(ContainSubstring("[S3 Bucket: banana-west-bucket] Deleted!"))
+				Expect(output).NotTo(ContainSubstring("banana-eu-bucket"))
+
+				Expect(server.Volumes()).To(BeEmpty())
+				Expect(server.Buckets()).To(ConsistOf("banana-eu-bucket"))
+			})
+
+			It("only sweeps the regions in the list", func() {
+				output, err := leftovers("--iaas", "aws", "--dry-run", "--filter", "banana",
+					"--aws-access-key-id", "some-key", "--aws-secret-access-key", "some-secret",
+					"--aws-region", "us-west-2,eu-west-1", "--aws-endpoint", server.URL())
+				Expect(err).NotTo(HaveOccurred(), output)
+
+				Expect(output).To(ContainSubstring("[EC2 Volume: (us-west-2) vol-banana-west (State:available) (Name:banana)]"))
+				Expect(output).To(ContainSubstring("[S3 Bucket: banana-west-bucket]"))
+				Expect(output).To(ContainSubstring("[S3 Bucket: banana-eu-bucket]"))
+				Expect(output).NotTo(ContainSubstring("(us-east-1)"))
+			})
+		})
+		Context("when every account of the organization is swept", func() {
+			BeforeEach(func() {
+				server.AddAccount("111111111111", "sandbox-a")
+				server.AddAccount("222222222222", "sandbox-b")
+				server.AddAccount("333333333333", "sandbox-c")
+				server.AddAccountVolume("111111111111", "us-east-1", "vol-banana-a", map[string]string{"Name": "banana"})
+				server.AddAccountVolume("222222222222", "us-east-1", "vol-banana-b", map[string]string{"Name": "banana"})
+				server.AddAccountVolume("333333333333", "us-east-1", "vol-banana-c", map[string]string{"Name": "banana"})
+			})
+
+			It("deletes the resources in each member account and groups them by account", func() {
+				output, err := leftovers("--iaas", "aws", "--no-confirm", "--filter", "banana",
+					"--aws-access-key-id", "some-key", "--aws-secret-access-key", "some-secret",
+					"--aws-region", "us-east-1", "--aws-endpoint", server.URL(),
+					"--aws-organization", "--aws-exclude-account", "333333333333")
+				Expect(err).NotTo(HaveOccurred(), output)
+
+				Expect(output).To(MatchRegexp(`(?s)Account 111111111111 \(sandbox-a\):.*vol-banana-a.*Deleted!.*Account 222222222222 \(sandbox-b\):.*vol-banana-b.*Deleted!`))
+				Expect(output).NotTo(ContainSubstring("333333333333"))
+				Expect(output).NotTo(ContainSubstring("vol-banana (State"))
+
+				Expect(server.Volumes()).To(ConsistOf("vol-banana", "vol-banana-c"))
+			})
+
+			It("only sweeps the included accounts", func() {
+				output, err := leftovers("--iaas", "aws", "--dry-run", "--filter", "banana",
+					"--aws-access-key-id", "some-key", "--aws-secret-access-key", "some-secret",
+					"--aws-region", "us-east-1", "--aws-endpoint", server.URL(),
+					"--aws-organization", "--aws-include-account", "222222222222")
+				Expect(err).NotTo(HaveOccurred(), output)
+
+				Expect(output).To(ContainSubstring("Account 222222222222 (sandbox-b):"))
+				Expect(output).To(ContainSubstring("[EC2 Volume: vol-banana-b (State:available) (Name:banana)]"))
+				Expect(output).NotTo(ContainSubstring("sandbox-a"))
+			})
+
+			It("rejects the management account in the included accounts", func() {
+				output, err := leftovers("--iaas", "aws", "--dry-run", "--filter", "banana",
+					"--aws-access-key-id", "some-key", "--aws-secret-access-key", "some-secret",
+					"--aws-region", "us-east-1", "--aws-endpoint", server.URL(),
+					"--aws-organization", "--aws-include-account", "222222222222", "--aws-include-account", "123456789012")
+				Expect(err).To(HaveOccurred())
+
+				Expect(output).To(ContainSubstring("Account 123456789012 is the management account of the organization, which is only swept without --aws-organization."))
+				Expect(output).NotTo(ContainSubstring("vol-banana"))
+			})
+		})
+	})
+
+	Describe("gcp", func() {
+		var (
+			server  *fakecloud.GCP
+			keyPath string
+		)
+
+		BeforeEach(func() {
+			server = fakecloud.NewGCP("some-project", "us-east1", "us-east1-b")
+			server.AddDisk("banana-disk")
+			server.AddDisk("kiwi-disk")
+			server.AddBucket("banana-bucket")
+
+			key, err := server.Key()
+			Expect(err).NotTo(HaveOccurred())
+
+			keyPath = filepath.Join(binaryDir, "key.json")
+			err = ioutil.WriteFile(keyPath, key, 0600)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("deletes the resources that match the filter", func() {
+			output, err := leftovers("--iaas", "gcp", "--no-confirm", "--filter", "banana",
+				"--gcp-service-account-key", keyPath, "--gcp-endpoint", server.URL())
+			Expect(err).NotTo(HaveOccurred(), output)
+
+			Expect(output).To(ContainSubstring("[Disk: banana-disk] Deleted!"))
+			Expect(output).To(ContainSubstring("[Storage Bucket: banana-bucket] Deleted!"))
+
+			Expect(server.Disks()).To(ConsistOf("kiwi-disk"))
+			Expect(server.Buckets()).To(BeEmpty())
+		})
+	})
+
+	Describe("openstack", func() {
+		var server *fakecloud.OpenStack
+
+		BeforeEach(func() {
+			server = fakecloud.NewOpenStack("some-region")
+			server.AddServer("server-id", "banana-vm")
+			server.AddVolume("volume-id", "banana-volume")
+			server.AddImage("image-id", "banana-image")
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("deletes all of the resources", func() {
+			output, err := leftovers("--iaas", "openstack", "--no-confirm",
+				"--openstack-auth-url", server.AuthURL(), "--openstack-username", "some-user",
+				"--openstack-password", "some-password", "--openstack-domain-name", "some-domain",
+				"--openstack-project-name", "some-project", "--openstack-region-name", "some-region")
+			Expect(err).NotTo(HaveOccurred(), output)
+
+			Expect(output).To(ContainSubstring("[Compute Instance: banana-vm server-id] Deleted!"))
+			Expect(output).To(ContainSubstring("[Volume: banana-volume volume-id] Deleted!"))
+
+			Expect(server.Servers()).To(BeEmpty())
+			Expect(server.Volumes()).To(BeEmpty())
+			Expect(server.Images()).To(BeEmpty())
+		})
+	})
+})
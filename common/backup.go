@@ -0,0 +1,30 @@
+package common
+
+import (
+	"fmt"
+	"time"
+)
+
+// Backup describes whether stateful resources should be backed up
+// before they are deleted, and the bucket that the contents of
+// buckets and database exports should be archived to.
+type Backup struct {
+	Enabled bool
+	Bucket  string
+}
+
+// BackupName returns a name for the backup of the provided resource
+// that is unique to this second and no longer than max characters.
+func BackupName(name string, max int) string {
+	suffix := fmt.Sprintf("-leftovers-%s", time.Now().UTC().Format("20060102150405"))
+
+	if len(name)+len(suffix) > max {
+		name = name[:max-len(suffix)]
+	}
+
+	for len(name) > 0 && name[len(name)-1] == '-' {
+		name = name[:len(name)-1]
+	}
+
+	return fmt.Sprintf("%s%s", name, suffix)
+}
@@ -1,7 +1,31 @@
 package common
 
+import "context"
+
 type Deletable interface {
 	Delete() error
 	Name() string
 	Type() string
 }
+
+// ContextDeletable is implemented by deletables that wait for their
+// deletion to complete. DeleteContext stops waiting when the context is
+// done, like when leftovers is interrupted, and returns the context's error.
+type ContextDeletable interface {
+	Deletable
+	DeleteContext(ctx context.Context) error
+}
+
+// Delete deletes the deletable with the provided context if it waits for
+// its deletion to complete. It does not start deleting if the context is done.
+func Delete(ctx context.Context, d Deletable) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if c, ok := d.(ContextDeletable); ok {
+		return c.DeleteContext(ctx)
+	}
+
+	return d.Delete()
+}
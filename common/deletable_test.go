@@ -0,0 +1,72 @@
+package common_test
+
+import (
+	"context"
+
+	"github.com/genevieve/leftovers/common"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type deletable struct {
+	deleted *int
+}
+
+func (d deletable) Delete() error { *d.deleted++; return nil }
+func (d deletable) Name() string  { return "banana" }
+func (d deletable) Type() string  { return "Banana" }
+
+type contextDeletable struct {
+	deletable
+	ctx *context.Context
+}
+
+func (d contextDeletable) DeleteContext(ctx context.Context) error {
+	*d.ctx = ctx
+	return nil
+}
+
+var _ = Describe("Delete", func() {
+	var (
+		deleted int
+		ctx     context.Context
+	)
+
+	BeforeEach(func() {
+		deleted = 0
+		ctx = nil
+	})
+
+	It("deletes the deletable", func() {
+		err := common.Delete(context.Background(), deletable{deleted: &deleted})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(deleted).To(Equal(1))
+	})
+
+	Context("when the deletable waits for its deletion", func() {
+		It("passes it the context", func() {
+			parent := context.WithValue(context.Background(), "key", "value")
+
+			err := common.Delete(parent, contextDeletable{deletable: deletable{deleted: &deleted}, ctx: &ctx})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(ctx).To(Equal(parent))
+			Expect(deleted).To(Equal(0))
+		})
+	})
+
+	Context("when the context is done", func() {
+		It("does not delete and returns the context's error", func() {
+			cancelled, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			err := common.Delete(cancelled, contextDeletable{deletable: deletable{deleted: &deleted}, ctx: &ctx})
+			Expect(err).To(Equal(context.Canceled))
+
+			Expect(ctx).To(BeNil())
+			Expect(deleted).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,22 @@
+package fakes
+
+import "fmt"
+
+type Logger struct {
+	PrintfCall struct {
+		CallCount int
+		Receives  struct {
+			Message   string
+			Arguments []interface{}
+		}
+		Messages []string
+	}
+}
+
+func (l *Logger) Printf(message string, a ...interface{}) {
+	l.PrintfCall.CallCount++
+	l.PrintfCall.Receives.Message = message
+	l.PrintfCall.Receives.Arguments = a
+
+	l.PrintfCall.Messages = append(l.PrintfCall.Messages, fmt.Sprintf(message, a...))
+}
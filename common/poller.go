@@ -0,0 +1,184 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+)
+
+type logger interface {
+	Printf(m string, a ...interface{})
+}
+
+// verboseLogger is implemented by loggers that only print
+// progress, like waiting between refreshes, when asked to.
+type verboseLogger interface {
+	Verbosef(m string, a ...interface{})
+}
+
+// StateRefreshFunc returns the resource being waited on and its current
+// state. It returns a nil result if the resource could not be found.
+type StateRefreshFunc func() (result interface{}, state string, err error)
+
+// Poller refreshes the state of a resource until it reaches one of the
+// target states, backing off exponentially with jitter between refreshes.
+type Poller struct {
+	logger  logger
+	refresh StateRefreshFunc
+	pending []string
+	target  []string
+
+	// Delay is how long to wait before the first refresh.
+	Delay time.Duration
+	// MinInterval and MaxInterval bound the wait between refreshes.
+	MinInterval time.Duration
+	MaxInterval time.Duration
+	// Timeout is used when the context passed to Wait has no deadline.
+	Timeout time.Duration
+	// NotFoundChecks is how many refreshes in a row may not find
+	// the resource before Wait returns a NotFoundError.
+	NotFoundChecks int
+}
+
+func NewPoller(logger logger, refresh StateRefreshFunc, pending, target []string) Poller {
+	return Poller{
+		logger:         logger,
+		refresh:        refresh,
+		pending:        pending,
+		target:         target,
+		Delay:          2 * time.Second,
+		MinInterval:    2 * time.Second,
+		MaxInterval:    10 * time.Second,
+		Timeout:        10 * time.Minute,
+		NotFoundChecks: 20,
+	}
+}
+
+// TimeoutError is returned when the resource does not reach a target
+// state before the context deadline or the poller's timeout.
+type TimeoutError struct {
+	Target    []string
+	LastState string
+}
+
+func (e *TimeoutError) Error() string {
+	if e.LastState != "" {
+		return fmt.Sprintf("Timeout waiting for state to be %s, last state was %s.", strings.Join(e.Target, ", "), e.LastState)
+	}
+	return fmt.Sprintf("Timeout waiting for state to be %s.", strings.Join(e.Target, ", "))
+}
+
+// NotFoundError is returned when the resource could not be found
+// for more than the poller's NotFoundChecks refreshes in a row.
+type NotFoundError struct {
+	Checks int
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("Resource not found after %d checks.", e.Checks)
+}
+
+// UnexpectedStateError is returned when the resource reaches
+// a state that is neither pending nor a target.
+type UnexpectedStateError struct {
+	State    string
+	Expected []string
+}
+
+func (e *UnexpectedStateError) Error() string {
+	return fmt.Sprintf("Unexpected state %s, wanted one of: %s", e.State, strings.Join(e.Expected, ", "))
+}
+
+// Wait refreshes the resource until it reaches a target state and
+// returns the last result. It stops early if the refresh fails, the
+// resource reaches an unexpected state, or the context is done.
+func (p Poller) Wait(ctx context.Context) (interface{}, error) {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.Timeout)
+		defer cancel()
+	}
+
+	var (
+		lastState   string
+		notFound    int
+		interval    = p.MinInterval
+		wait        = p.Delay
+		expectation = append(append([]string{}, p.pending...), p.target...)
+	)
+
+	for {
+		select {
+		case <-ctx.Done():
+			if ctx.Err() == context.DeadlineExceeded {
+				return nil, &TimeoutError{Target: p.target, LastState: lastState}
+			}
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+
+		result, state, err := p.refresh()
+		if err != nil {
+			return nil, err
+		}
+
+		if result == nil {
+			notFound++
+			if notFound > p.NotFoundChecks {
+				return nil, &NotFoundError{Checks: notFound}
+			}
+		} else {
+			notFound = 0
+			lastState = state
+
+			if contains(p.target, state) {
+				return result, nil
+			}
+
+			if !contains(p.pending, state) {
+				return nil, &UnexpectedStateError{State: state, Expected: expectation}
+			}
+		}
+
+		wait = jitter(interval)
+		if lastState != "" {
+			p.progress("Waiting %s before next try (state: %s).\n", wait.Round(time.Millisecond), lastState)
+		} else {
+			p.progress("Waiting %s before next try.\n", wait.Round(time.Millisecond))
+		}
+
+		interval *= 2
+		if interval > p.MaxInterval {
+			interval = p.MaxInterval
+		}
+	}
+}
+
+func (p Poller) progress(message string, a ...interface{}) {
+	if v, ok := p.logger.(verboseLogger); ok {
+		v.Verbosef(message, a...)
+		return
+	}
+	p.logger.Printf(message, a...)
+}
+
+// jitter returns a random duration between half
+// of the provided interval and the full interval.
+func jitter(interval time.Duration) time.Duration {
+	half := int64(interval / 2)
+	if half <= 0 {
+		return interval
+	}
+	return time.Duration(half + rand.Int63n(half+1))
+}
+
+func contains(states []string, state string) bool {
+	for _, s := range states {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,126 @@
+package common_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/genevieve/leftovers/common"
+	"github.com/genevieve/leftovers/common/fakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Poller", func() {
+	var (
+		logger  *fakes.Logger
+		states  []string
+		refresh common.StateRefreshFunc
+		calls   int
+
+		poller common.Poller
+	)
+
+	newPoller := func() common.Poller {
+		p := common.NewPoller(logger, refresh, []string{"pending"}, []string{"done"})
+		p.Delay = time.Millisecond
+		p.MinInterval = time.Millisecond
+		p.MaxInterval = 4 * time.Millisecond
+		p.NotFoundChecks = 2
+		return p
+	}
+
+	BeforeEach(func() {
+		logger = &fakes.Logger{}
+		calls = 0
+		states = []string{"pending", "pending", "done"}
+		refresh = func() (interface{}, string, error) {
+			state := states[calls]
+			calls++
+			if state == "" {
+				return nil, "", nil
+			}
+			return state, state, nil
+		}
+
+		poller = newPoller()
+	})
+
+	Describe("Wait", func() {
+		It("refreshes until the target state and reports progress", func() {
+			result, err := poller.Wait(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal("done"))
+
+			Expect(calls).To(Equal(3))
+			Expect(logger.PrintfCall.CallCount).To(Equal(2))
+			Expect(logger.PrintfCall.Messages[0]).To(HaveSuffix("before next try (state: pending).\n"))
+		})
+
+		Context("when the refresh fails", func() {
+			BeforeEach(func() {
+				refresh = func() (interface{}, string, error) {
+					return nil, "", errors.New("banana")
+				}
+				poller = newPoller()
+			})
+
+			It("returns the error", func() {
+				_, err := poller.Wait(context.Background())
+				Expect(err).To(MatchError("banana"))
+			})
+		})
+
+		Context("when the resource reaches an unexpected state", func() {
+			BeforeEach(func() {
+				states = []string{"pending", "failed"}
+			})
+
+			It("returns an unexpected state error", func() {
+				_, err := poller.Wait(context.Background())
+				Expect(err).To(BeAssignableToTypeOf(&common.UnexpectedStateError{}))
+				Expect(err).To(MatchError("Unexpected state failed, wanted one of: pending, done"))
+			})
+		})
+
+		Context("when the resource is not found", func() {
+			BeforeEach(func() {
+				states = []string{"", "", "", "done"}
+			})
+
+			It("returns a not found error", func() {
+				_, err := poller.Wait(context.Background())
+				Expect(err).To(BeAssignableToTypeOf(&common.NotFoundError{}))
+				Expect(calls).To(Equal(3))
+			})
+		})
+
+		Context("when the deadline is exceeded", func() {
+			BeforeEach(func() {
+				refresh = func() (interface{}, string, error) {
+					return "pending", "pending", nil
+				}
+				poller = newPoller()
+				poller.Timeout = 20 * time.Millisecond
+			})
+
+			It("returns a timeout error", func() {
+				_, err := poller.Wait(context.Background())
+				Expect(err).To(BeAssignableToTypeOf(&common.TimeoutError{}))
+				Expect(err).To(MatchError("Timeout waiting for state to be done, last state was pending."))
+			})
+		})
+
+		Context("when the context is cancelled", func() {
+			It("returns the context error", func() {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+
+				_, err := poller.Wait(ctx)
+				Expect(err).To(Equal(context.Canceled))
+				Expect(calls).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,16 @@
+package common
+
+import "net/http"
+
+// WrapTransport wraps the transport that a provider's clients send
+// their requests with, ie. to record or replay them.
+type WrapTransport func(base http.RoundTripper) http.RoundTripper
+
+// Wrap returns the base transport wrapped, or the
+// base transport itself if there is nothing to wrap it with.
+func (w WrapTransport) Wrap(base http.RoundTripper) http.RoundTripper {
+	if w == nil {
+		return base
+	}
+	return w(base)
+}
@@ -0,0 +1,324 @@
+package fakecloud
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+var (
+	credentialScope = regexp.MustCompile(`Credential=([^/]+)/[^/]+/([^/]+)/([^/]+)/aws4_request`)
+	roleAccount     = regexp.MustCompile(`^arn:aws:iam::(\d+):role/`)
+)
+
+// managementAccount is the account of the credentials that the
+// server is sent requests with, and of its organization.
+const managementAccount = "123456789012"
+
+// accountKeyPrefix prefixes the access keys of the roles that are
+// assumed in member accounts, which are followed by the account id.
+const accountKeyPrefix = "account-"
+
+// AWS is an in-memory stand-in for the AWS APIs that leftovers calls.
+// It stores EC2 volumes by account and region, S3 buckets by region,
+// and IAM roles, users and policies by account, in an organization of
+// member accounts. Every other list returns no resources.
+type AWS struct {
+	server   *httptest.Server
+	mutex    *sync.Mutex
+	regions  []string
+	accounts map[string]string
+
+	volumes map[string]map[string]map[string]string
+	buckets map[string]string
+	iam     map[string]*iamState
+}
+
+// NewAWS starts an AWS server for the provided regions,
+// which are the regions that are enabled for the account.
+func NewAWS(regions ...string) *AWS {
+	a := &AWS{
+		mutex:    &sync.Mutex{},
+		regions:  regions,
+		accounts: map[string]string{},
+		volumes:  map[string]map[string]map[string]string{},
+		buckets:  map[string]string{},
+		iam:      map[string]*iamState{},
+	}
+	a.server = httptest.NewServer(http.HandlerFunc(a.serve))
+
+	return a
+}
+
+func (a *AWS) URL() string {
+	return a.server.URL
+}
+
+func (a *AWS) Close() {
+	a.server.Close()
+}
+
+// Transport returns a transport that sends every request to the server,
+// whichever host it is addressed to, ie. to answer the requests that a
+// replayed cassette has no response for.
+func (a *AWS) Transport() http.RoundTripper {
+	return redirect(a.server.URL)
+}
+
+// AddVolume stores an available EC2 volume with the provided tags
+// in the first region.
+func (a *AWS) AddVolume(id string, tags map[string]string) {
+	a.AddRegionalVolume(a.regions[0], id, tags)
+}
+
+// AddRegionalVolume stores an available EC2 volume with the
+// provided tags in the provided region.
+func (a *AWS) AddRegionalVolume(region, id string, tags map[string]string) {
+	a.AddAccountVolume(managementAccount, region, id, tags)
+}
+
+// AddAccount adds a member account with the provided id and name
+// to the organization.
+func (a *AWS) AddAccount(id, name string) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	a.accounts[id] = name
+}
+
+// AddAccountVolume stores an available EC2 volume with the provided
+// tags in the provided region of the provided account.
+func (a *AWS) AddAccountVolume(account, region, id string, tags map[string]string) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	scope := volumeScope(account, region)
+	if a.volumes[scope] == nil {
+		a.volumes[scope] = map[string]map[string]string{}
+	}
+	a.volumes[scope][id] = tags
+}
+
+// AddBucket stores an S3 bucket in the first region.
+func (a *AWS) AddBucket(name string) {
+	a.AddRegionalBucket(a.regions[0], name)
+}
+
+// AddRegionalBucket stores an S3 bucket in the provided region.
+func (a *AWS) AddRegionalBucket(region, name string) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	a.buckets[name] = region
+}
+
+// Volumes returns the ids of the stored EC2 volumes
+// in every region of every account.
+func (a *AWS) Volumes() []string {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	var ids []string
+	for _, volumes := range a.volumes {
+		ids = append(ids, keys(volumes)...)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// Buckets returns the names of the stored S3 buckets.
+func (a *AWS) Buckets() []string {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	return keys(a.buckets)
+}
+
+// serve routes each request by the access key, region and service in the
+// credential scope of its signature. Requests without one are anonymous
+// S3 requests.
+func (a *AWS) serve(w http.ResponseWriter, r *http.Request) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	r.ParseForm()
+
+	account, region, service := managementAccount, a.regions[0], "s3"
+	if m := credentialScope.FindStringSubmatch(r.Header.Get("Authorization")); m != nil {
+		region, service = m[2], m[3]
+		if strings.HasPrefix(m[1], accountKeyPrefix) {
+			account = strings.TrimPrefix(m[1], accountKeyPrefix)
+		}
+	}
+
+	switch service {
+	case "ec2":
+		a.ec2(w, r.Form.Get("Action"), a.volumes[volumeScope(account, region)], r)
+	case "s3":
+		a.s3(w, region, r)
+	case "sts":
+		a.sts(w, r.Form.Get("Action"), account, r)
+	case "organizations":
+		a.organizations(w, r.Header.Get("X-Amz-Target"))
+	case "iam":
+		a.iamAction(w, r.Form.Get("Action"), account, r)
+	case "kms", "eks", "ecs", "ecr", "lambda", "logs", "events", "sqs", "dynamodb", "elasticfilesystem", "secretsmanager", "ssm", "acm":
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, "{}")
+	case "route53":
+		fmt.Fprint(w, "<Response><IsTruncated>false</IsTruncated></Response>")
+	default:
+		query(w, r.Form.Get("Action"), "<IsTruncated>false</IsTruncated>")
+	}
+}
+
+func (a *AWS) ec2(w http.ResponseWriter, action string, volumes map[string]map[string]string, r *http.Request) {
+	var body string
+
+	switch action {
+	case "DescribeRegions":
+		body = "<regionInfo>"
+		for _, name := range a.regions {
+			body += fmt.Sprintf("<item><regionName>%s</regionName></item>", escape(name))
+		}
+		body += "</regionInfo>"
+	case "DescribeVolumes":
+		body = "<volumeSet>"
+		for _, id := range keys(volumes) {
+			body += fmt.Sprintf("<item><volumeId>%s</volumeId><status>available</status><tagSet>", escape(id))
+			for _, k := range keys(volumes[id]) {
+				body += fmt.Sprintf("<item><key>%s</key><value>%s</value></item>", escape(k), escape(volumes[id][k]))
+			}
+			body += "</tagSet></item>"
+		}
+		body += "</volumeSet>"
+	case "DeleteVolume":
+		id := r.Form.Get("VolumeId")
+		if _, ok := volumes[id]; !ok {
+			awsError(w, "InvalidVolume.NotFound", id)
+			return
+		}
+		delete(volumes, id)
+		body = "<return>true</return>"
+	}
+
+	fmt.Fprintf(w, `<%sResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">%s</%sResponse>`, action, body, action)
+}
+
+func (a *AWS) s3(w http.ResponseWriter, region string, r *http.Request) {
+	bucket := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 2)[0]
+
+	if bucket == "" {
+		body := "<ListAllMyBucketsResult><Buckets>"
+		for _, name := range keys(a.buckets) {
+			body += fmt.Sprintf("<Bucket><Name>%s</Name></Bucket>", escape(name))
+		}
+		body += "</Buckets></ListAllMyBucketsResult>"
+		fmt.Fprint(w, body)
+		return
+	}
+
+	bucketRegion, ok := a.buckets[bucket]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, "<Error><Code>NoSuchBucket</Code><Message>%s</Message></Error>", escape(bucket))
+		return
+	}
+
+	if r.Method != http.MethodHead && region != bucketRegion {
+		w.WriteHeader(http.StatusMovedPermanently)
+		fmt.Fprintf(w, "<Error><Code>PermanentRedirect</Code><Message>%s</Message></Error>", escape(bucket))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodHead:
+		w.Header().Set("X-Amz-Bucket-Region", bucketRegion)
+	case http.MethodDelete:
+		delete(a.buckets, bucket)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		fmt.Fprint(w, "<Response><IsTruncated>false</IsTruncated></Response>")
+	}
+}
+
+func (a *AWS) sts(w http.ResponseWriter, action, account string, r *http.Request) {
+	switch action {
+	case "AssumeRole":
+		m := roleAccount.FindStringSubmatch(r.Form.Get("RoleArn"))
+		if m == nil || a.accounts[m[1]] == "" {
+			awsError(w, "AccessDenied", r.Form.Get("RoleArn"))
+			return
+		}
+
+		query(w, action, fmt.Sprintf(`<Credentials><AccessKeyId>%s%s</AccessKeyId><SecretAccessKey>secret</SecretAccessKey>
+			<SessionToken>token</SessionToken><Expiration>2100-01-01T00:00:00Z</Expiration></Credentials>`, accountKeyPrefix, m[1]))
+	default:
+		query(w, action, fmt.Sprintf("<Account>%s</Account><Arn>arn:aws:iam::%s:user/leftovers</Arn>", account, account))
+	}
+}
+
+// organizations answers for an organization of the management
+// account and the member accounts that were added.
+func (a *AWS) organizations(w http.ResponseWriter, target string) {
+	w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+
+	switch strings.TrimPrefix(target, "AWSOrganizationsV20161128.") {
+	case "DescribeOrganization":
+		fmt.Fprintf(w, `{"Organization": {"Id": "o-leftovers", "MasterAccountId": %q}}`, managementAccount)
+	case "ListAccounts":
+		accounts := []string{fmt.Sprintf(`{"Id": %q, "Name": "management", "Status": "ACTIVE", "Arn": "arn:aws:organizations::%s:account/o-leftovers/%s"}`,
+			managementAccount, managementAccount, managementAccount)}
+		for _, id := range keys(a.accounts) {
+			accounts = append(accounts, fmt.Sprintf(`{"Id": %q, "Name": %q, "Status": "ACTIVE", "Arn": "arn:aws:organizations::%s:account/o-leftovers/%s"}`,
+				id, a.accounts[id], managementAccount, id))
+		}
+		fmt.Fprintf(w, `{"Accounts": [%s]}`, strings.Join(accounts, ", "))
+	default:
+		fmt.Fprint(w, "{}")
+	}
+}
+
+func volumeScope(account, region string) string {
+	return account + "/" + region
+}
+
+// query writes a response in the format of the AWS query protocol,
+// which wraps the result of the action in its response.
+func query(w http.ResponseWriter, action, result string) {
+	fmt.Fprintf(w, "<%sResponse><%sResult>%s</%sResult></%sResponse>", action, action, result, action, action)
+}
+
+func awsError(w http.ResponseWriter, code, message string) {
+	w.WriteHeader(http.StatusBadRequest)
+	fmt.Fprintf(w, "<Response><Errors><Error><Code>%s</Code><Message>%s</Message></Error></Errors></Response>", code, escape(message))
+}
+
+func keys(m interface{}) []string {
+	var list []string
+	switch m := m.(type) {
+	case map[string]bool:
+		for k := range m {
+			list = append(list, k)
+		}
+	case map[string]string:
+		for k := range m {
+			list = append(list, k)
+		}
+	case map[string]map[string]string:
+		for k := range m {
+			list = append(list, k)
+		}
+	case map[string]*iamPrincipal:
+		for k := range m {
+			list = append(list, k)
+		}
+	case map[string]*iamPolicy:
+		for k := range m {
+			list = append(list, k)
+		}
+	}
+	sort.Strings(list)
+	return list
+}
@@ -0,0 +1,294 @@
+package fakecloud
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// iamState is the IAM roles, users and managed policies of an account.
+// Like IAM, it refuses to delete a role or user that still has policies
+// or access keys, and a policy that is still attached or has versions
+// other than its default version.
+type iamState struct {
+	roles    map[string]*iamPrincipal
+	users    map[string]*iamPrincipal
+	policies map[string]*iamPolicy
+}
+
+// iamPrincipal is a role or a user, with its inline policies, the
+// arns of the managed policies attached to it, and its access keys.
+type iamPrincipal struct {
+	inline     map[string]bool
+	attached   map[string]bool
+	accessKeys map[string]bool
+}
+
+type iamPolicy struct {
+	name     string
+	versions map[string]bool
+}
+
+func newIAMState() *iamState {
+	return &iamState{
+		roles:    map[string]*iamPrincipal{},
+		users:    map[string]*iamPrincipal{},
+		policies: map[string]*iamPolicy{},
+	}
+}
+
+func newIAMPrincipal() *iamPrincipal {
+	return &iamPrincipal{
+		inline:     map[string]bool{},
+		attached:   map[string]bool{},
+		accessKeys: map[string]bool{},
+	}
+}
+
+// AddPolicy stores a customer managed IAM policy with a default
+// version and an older version that has to be deleted first.
+func (a *AWS) AddPolicy(name string) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.iamState(managementAccount).policies[policyArn(managementAccount, name)] = &iamPolicy{
+		name:     name,
+		versions: map[string]bool{"v1": false, "v2": true},
+	}
+}
+
+// AddRole stores an IAM role with the provided managed
+// policies, which have to be added first, attached to it.
+func (a *AWS) AddRole(name string, policies ...string) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	role := newIAMPrincipal()
+	for _, p := range policies {
+		role.attached[policyArn(managementAccount, p)] = true
+	}
+	a.iamState(managementAccount).roles[name] = role
+}
+
+// AddRolePolicy stores an inline policy of the provided role.
+func (a *AWS) AddRolePolicy(role, name string) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	a.iamState(managementAccount).roles[role].inline[name] = true
+}
+
+// AddUser stores an IAM user with the provided managed
+// policies, which have to be added first, attached to it.
+func (a *AWS) AddUser(name string, policies ...string) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	user := newIAMPrincipal()
+	for _, p := range policies {
+		user.attached[policyArn(managementAccount, p)] = true
+	}
+	a.iamState(managementAccount).users[name] = user
+}
+
+// AddUserPolicy stores an inline policy of the provided user.
+func (a *AWS) AddUserPolicy(user, name string) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	a.iamState(managementAccount).users[user].inline[name] = true
+}
+
+// AddAccessKey stores an access key of the provided user.
+func (a *AWS) AddAccessKey(user, id string) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	a.iamState(managementAccount).users[user].accessKeys[id] = true
+}
+
+// Roles returns the names of the stored IAM roles.
+func (a *AWS) Roles() []string {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	return keys(a.iamState(managementAccount).roles)
+}
+
+// Users returns the names of the stored IAM users.
+func (a *AWS) Users() []string {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	return keys(a.iamState(managementAccount).users)
+}
+
+// Policies returns the names of the stored IAM policies.
+func (a *AWS) Policies() []string {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	var names []string
+	for _, p := range a.iamState(managementAccount).policies {
+		names = append(names, p.name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// iamState is not threadsafe.
+func (a *AWS) iamState(account string) *iamState {
+	if a.iam[account] == nil {
+		a.iam[account] = newIAMState()
+	}
+	return a.iam[account]
+}
+
+func (a *AWS) iamAction(w http.ResponseWriter, action, account string, r *http.Request) {
+	state := a.iamState(account)
+
+	var (
+		principals map[string]*iamPrincipal
+		name       string
+	)
+	switch {
+	case r.Form.Get("RoleName") != "":
+		principals, name = state.roles, r.Form.Get("RoleName")
+	case r.Form.Get("UserName") != "":
+		principals, name = state.users, r.Form.Get("UserName")
+	}
+
+	principal := principals[name]
+	if principals != nil && principal == nil {
+		queryError(w, http.StatusNotFound, "NoSuchEntity", fmt.Sprintf("The role or user with name %s cannot be found.", name))
+		return
+	}
+
+	var body string
+
+	switch action {
+	case "ListRoles":
+		body = "<Roles>"
+		for _, n := range keys(state.roles) {
+			body += fmt.Sprintf("<member><RoleName>%s</RoleName><RoleId>%s</RoleId><Arn>arn:aws:iam::%s:role/%s</Arn><Path>/</Path></member>",
+				escape(n), escape(n), account, escape(n))
+		}
+		body += "</Roles>"
+	case "ListUsers":
+		body = "<Users>"
+		for _, n := range keys(state.users) {
+			body += fmt.Sprintf("<member><UserName>%s</UserName><UserId>%s</UserId><Arn>arn:aws:iam::%s:user/%s</Arn><Path>/</Path></member>",
+				escape(n), escape(n), account, escape(n))
+		}
+		body += "</Users>"
+	case "ListPolicies":
+		body = "<Policies>"
+		for _, arn := range keys(state.policies) {
+			p := state.policies[arn]
+			body += fmt.Sprintf("<member><PolicyName>%s</PolicyName><Arn>%s</Arn><AttachmentCount>%d</AttachmentCount></member>",
+				escape(p.name), escape(arn), state.attachments(arn))
+		}
+		body += "</Policies>"
+	case "ListPolicyVersions":
+		p, ok := state.policies[r.Form.Get("PolicyArn")]
+		if !ok {
+			queryError(w, http.StatusNotFound, "NoSuchEntity", r.Form.Get("PolicyArn"))
+			return
+		}
+		body = "<Versions>"
+		for _, v := range keys(p.versions) {
+			body += fmt.Sprintf("<member><VersionId>%s</VersionId><IsDefaultVersion>%t</IsDefaultVersion></member>", v, p.versions[v])
+		}
+		body += "</Versions>"
+	case "ListAttachedRolePolicies", "ListAttachedUserPolicies":
+		body = "<AttachedPolicies>"
+		for _, arn := range keys(principal.attached) {
+			body += fmt.Sprintf("<member><PolicyName>%s</PolicyName><PolicyArn>%s</PolicyArn></member>",
+				escape(arn[strings.LastIndex(arn, "/")+1:]), escape(arn))
+		}
+		body += "</AttachedPolicies>"
+	case "ListRolePolicies", "ListUserPolicies":
+		body = "<PolicyNames>"
+		for _, n := range keys(principal.inline) {
+			body += fmt.Sprintf("<member>%s</member>", escape(n))
+		}
+		body += "</PolicyNames>"
+	case "ListAccessKeys":
+		body = "<AccessKeyMetadata>"
+		for _, id := range keys(principal.accessKeys) {
+			body += fmt.Sprintf("<member><AccessKeyId>%s</AccessKeyId><UserName>%s</UserName><Status>Active</Status></member>", escape(id), escape(name))
+		}
+		body += "</AccessKeyMetadata>"
+	case "DetachRolePolicy", "DetachUserPolicy":
+		if !principal.attached[r.Form.Get("PolicyArn")] {
+			queryError(w, http.StatusNotFound, "NoSuchEntity", r.Form.Get("PolicyArn"))
+			return
+		}
+		delete(principal.attached, r.Form.Get("PolicyArn"))
+	case "DeleteRolePolicy", "DeleteUserPolicy":
+		if !principal.inline[r.Form.Get("PolicyName")] {
+			queryError(w, http.StatusNotFound, "NoSuchEntity", r.Form.Get("PolicyName"))
+			return
+		}
+		delete(principal.inline, r.Form.Get("PolicyName"))
+	case "DeleteAccessKey":
+		if !principal.accessKeys[r.Form.Get("AccessKeyId")] {
+			queryError(w, http.StatusNotFound, "NoSuchEntity", r.Form.Get("AccessKeyId"))
+			return
+		}
+		delete(principal.accessKeys, r.Form.Get("AccessKeyId"))
+	case "DeleteRole", "DeleteUser":
+		if len(principal.inline) > 0 || len(principal.attached) > 0 || len(principal.accessKeys) > 0 {
+			queryError(w, http.StatusConflict, "DeleteConflict", "Cannot delete entity, must remove policies and access keys first.")
+			return
+		}
+		delete(principals, name)
+	case "DeletePolicyVersion":
+		p, ok := state.policies[r.Form.Get("PolicyArn")]
+		if !ok {
+			queryError(w, http.StatusNotFound, "NoSuchEntity", r.Form.Get("PolicyArn"))
+			return
+		}
+		if p.versions[r.Form.Get("VersionId")] {
+			queryError(w, http.StatusConflict, "DeleteConflict", "Cannot delete the default version of a policy.")
+			return
+		}
+		delete(p.versions, r.Form.Get("VersionId"))
+	case "DeletePolicy":
+		arn := r.Form.Get("PolicyArn")
+		p, ok := state.policies[arn]
+		if !ok {
+			queryError(w, http.StatusNotFound, "NoSuchEntity", arn)
+			return
+		}
+		if state.attachments(arn) > 0 || len(p.versions) > 1 {
+			queryError(w, http.StatusConflict, "DeleteConflict", "Cannot delete a policy attached to entities or with more than one version.")
+			return
+		}
+		delete(state.policies, arn)
+	}
+
+	query(w, action, body+"<IsTruncated>false</IsTruncated>")
+}
+
+// attachments returns the number of roles and users
+// that the policy with the provided arn is attached to.
+func (s *iamState) attachments(arn string) int {
+	count := 0
+	for _, principals := range []map[string]*iamPrincipal{s.roles, s.users} {
+		for _, p := range principals {
+			if p.attached[arn] {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+func policyArn(account, name string) string {
+	return fmt.Sprintf("arn:aws:iam::%s:policy/%s", account, name)
+}
+
+// queryError writes an error in the format of the AWS query protocol.
+func queryError(w http.ResponseWriter, status int, code, message string) {
+	w.WriteHeader(status)
+	fmt.Fprintf(w, "<ErrorResponse><Error><Type>Sender</Type><Code>%s</Code><Message>%s</Message></Error></ErrorResponse>", code, escape(message))
+}
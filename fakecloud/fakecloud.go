@@ -0,0 +1,32 @@
+// Package fakecloud provides in-memory stand-ins for the cloud APIs that
+// leftovers calls, so that the binary can be tested without credentials.
+package fakecloud
+
+import (
+	"bytes"
+	"encoding/xml"
+	"net/http"
+	"net/url"
+)
+
+func escape(s string) string {
+	var b bytes.Buffer
+	xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
+
+// redirect sends every request to the server at the provided URL
+// instead of the host that it is addressed to.
+type redirect string
+
+func (r redirect) RoundTrip(req *http.Request) (*http.Response, error) {
+	u, err := url.Parse(string(r))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.Clone(req.Context())
+	req.URL.Scheme, req.URL.Host, req.Host = u.Scheme, u.Host, ""
+
+	return http.DefaultTransport.RoundTrip(req)
+}
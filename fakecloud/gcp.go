@@ -0,0 +1,174 @@
+package fakecloud
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+)
+
+// GCP is an in-memory stand-in for the Google APIs that leftovers calls,
+// including the OAuth token endpoint. It stores compute disks in a single
+// zone and storage buckets. Every other list returns no resources.
+type GCP struct {
+	server  *httptest.Server
+	mutex   *sync.Mutex
+	project string
+	region  string
+	zone    string
+
+	disks   map[string]bool
+	buckets map[string]bool
+}
+
+// NewGCP starts a GCP server for the provided project, with one region and zone.
+func NewGCP(project, region, zone string) *GCP {
+	g := &GCP{
+		mutex:   &sync.Mutex{},
+		project: project,
+		region:  region,
+		zone:    zone,
+		disks:   map[string]bool{},
+		buckets: map[string]bool{},
+	}
+	g.server = httptest.NewServer(http.HandlerFunc(g.serve))
+
+	return g
+}
+
+func (g *GCP) URL() string {
+	return g.server.URL
+}
+
+func (g *GCP) Close() {
+	g.server.Close()
+}
+
+// Transport returns a transport that sends every request to the server,
+// whichever host it is addressed to, ie. to answer the requests that a
+// replayed cassette has no response for.
+func (g *GCP) Transport() http.RoundTripper {
+	return redirect(g.server.URL)
+}
+
+// Key returns a service account key for the server's project
+// that exchanges tokens with the server instead of Google.
+func (g *GCP) Key() ([]byte, error) {
+	private, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+
+	block := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(private)})
+
+	return json.Marshal(map[string]string{
+		"type":           "service_account",
+		"project_id":     g.project,
+		"private_key_id": "leftovers",
+		"private_key":    string(block),
+		"client_email":   fmt.Sprintf("leftovers@%s.iam.gserviceaccount.com", g.project),
+		"token_uri":      fmt.Sprintf("%s/token", g.server.URL),
+	})
+}
+
+// AddDisk stores a compute disk in the server's zone.
+func (g *GCP) AddDisk(name string) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	g.disks[name] = true
+}
+
+// AddBucket stores an empty storage bucket.
+func (g *GCP) AddBucket(name string) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	g.buckets[name] = true
+}
+
+// Disks returns the names of the stored compute disks.
+func (g *GCP) Disks() []string {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	return keys(g.disks)
+}
+
+// Buckets returns the names of the stored storage buckets.
+func (g *GCP) Buckets() []string {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	return keys(g.buckets)
+}
+
+func (g *GCP) serve(w http.ResponseWriter, r *http.Request) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+
+	compute := fmt.Sprintf("/compute/v1/projects/%s/", g.project)
+	zone := fmt.Sprintf("%s%szones/%s", g.server.URL, compute, g.zone)
+
+	path := r.URL.Path
+	switch {
+	case path == "/token":
+		writeJSON(w, map[string]interface{}{"access_token": "leftovers", "token_type": "Bearer", "expires_in": 3600})
+
+	case path == compute+"regions":
+		writeJSON(w, items(map[string]string{"name": g.region, "selfLink": fmt.Sprintf("%s%sregions/%s", g.server.URL, compute, g.region)}))
+	case path == compute+"zones":
+		writeJSON(w, items(map[string]string{"name": g.zone, "selfLink": zone}))
+	case path == compute+"zones/"+g.zone+"/disks":
+		var disks []interface{}
+		for _, name := range keys(g.disks) {
+			disks = append(disks, map[string]string{"name": name, "zone": zone})
+		}
+		writeJSON(w, items(disks...))
+	case strings.HasPrefix(path, compute+"zones/"+g.zone+"/disks/") && r.Method == http.MethodDelete:
+		name := strings.TrimPrefix(path, compute+"zones/"+g.zone+"/disks/")
+		if !g.disks[name] {
+			googleError(w, http.StatusNotFound, name)
+			return
+		}
+		delete(g.disks, name)
+		writeJSON(w, map[string]string{"name": "operation-" + name, "status": "DONE", "zone": zone})
+	case strings.HasPrefix(path, compute+"zones/"+g.zone+"/operations/"):
+		writeJSON(w, map[string]string{"name": strings.TrimPrefix(path, compute+"zones/"+g.zone+"/operations/"), "status": "DONE", "zone": zone})
+
+	case path == "/storage/v1/b":
+		var buckets []interface{}
+		for _, name := range keys(g.buckets) {
+			buckets = append(buckets, map[string]string{"name": name})
+		}
+		writeJSON(w, items(buckets...))
+	case strings.HasPrefix(path, "/storage/v1/b/") && r.Method == http.MethodDelete:
+		name := strings.TrimPrefix(path, "/storage/v1/b/")
+		if !g.buckets[name] {
+			googleError(w, http.StatusNotFound, name)
+			return
+		}
+		delete(g.buckets, name)
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		fmt.Fprint(w, "{}")
+	}
+}
+
+func items(list ...interface{}) map[string]interface{} {
+	return map[string]interface{}{"items": list}
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	json.NewEncoder(w).Encode(v)
+}
+
+func googleError(w http.ResponseWriter, code int, message string) {
+	w.WriteHeader(code)
+	writeJSON(w, map[string]interface{}{"error": map[string]interface{}{"code": code, "message": message}})
+}
@@ -0,0 +1,167 @@
+package fakecloud
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+)
+
+// OpenStack is an in-memory stand-in for the keystone v3, nova, cinder
+// and glance APIs that leftovers calls. Its service catalog points every
+// service back at this server.
+type OpenStack struct {
+	server *httptest.Server
+	mutex  *sync.Mutex
+	region string
+
+	servers map[string]string
+	volumes map[string]string
+	images  map[string]string
+}
+
+// NewOpenStack starts an OpenStack server with a catalog for the provided region.
+func NewOpenStack(region string) *OpenStack {
+	o := &OpenStack{
+		mutex:   &sync.Mutex{},
+		region:  region,
+		servers: map[string]string{},
+		volumes: map[string]string{},
+		images:  map[string]string{},
+	}
+	o.server = httptest.NewServer(http.HandlerFunc(o.serve))
+
+	return o
+}
+
+// AuthURL returns the keystone v3 endpoint of the server.
+func (o *OpenStack) AuthURL() string {
+	return fmt.Sprintf("%s/v3/", o.server.URL)
+}
+
+func (o *OpenStack) Close() {
+	o.server.Close()
+}
+
+// Transport returns a transport that sends every request to the server,
+// whichever host it is addressed to, ie. to answer the requests that a
+// replayed cassette has no response for.
+func (o *OpenStack) Transport() http.RoundTripper {
+	return redirect(o.server.URL)
+}
+
+// AddServer stores a compute instance with the provided id and name.
+func (o *OpenStack) AddServer(id, name string) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	o.servers[id] = name
+}
+
+// AddVolume stores a block storage volume with the provided id and name.
+func (o *OpenStack) AddVolume(id, name string) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	o.volumes[id] = name
+}
+
+// AddImage stores an image with the provided id and name.
+func (o *OpenStack) AddImage(id, name string) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	o.images[id] = name
+}
+
+// Servers returns the ids of the stored compute instances.
+func (o *OpenStack) Servers() []string {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	return keys(o.servers)
+}
+
+// Volumes returns the ids of the stored volumes.
+func (o *OpenStack) Volumes() []string {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	return keys(o.volumes)
+}
+
+// Images returns the ids of the stored images.
+func (o *OpenStack) Images() []string {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	return keys(o.images)
+}
+
+func (o *OpenStack) serve(w http.ResponseWriter, r *http.Request) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+
+	path := r.URL.Path
+	switch {
+	case path == "/v3/auth/tokens" && r.Method == http.MethodPost:
+		w.Header().Set("X-Subject-Token", "leftovers")
+		w.WriteHeader(http.StatusCreated)
+		writeJSON(w, map[string]interface{}{
+			"token": map[string]interface{}{
+				"expires_at": "2099-01-01T00:00:00.000000Z",
+				"catalog": []interface{}{
+					o.catalogEntry("compute", "/compute/"),
+					o.catalogEntry("volumev3", "/volume/"),
+					o.catalogEntry("image", "/image/"),
+				},
+			},
+		})
+
+	case path == "/compute/servers/detail":
+		writeJSON(w, map[string]interface{}{"servers": o.list(o.servers)})
+	case strings.HasPrefix(path, "/compute/servers/") && r.Method == http.MethodDelete:
+		o.delete(w, o.servers, strings.TrimPrefix(path, "/compute/servers/"))
+
+	case path == "/volume/volumes/detail":
+		writeJSON(w, map[string]interface{}{"volumes": o.list(o.volumes)})
+	case strings.HasPrefix(path, "/volume/volumes/") && r.Method == http.MethodDelete:
+		o.delete(w, o.volumes, strings.TrimPrefix(path, "/volume/volumes/"))
+
+	case path == "/image/v2/images":
+		writeJSON(w, map[string]interface{}{"images": o.list(o.images)})
+	case strings.HasPrefix(path, "/image/v2/images/") && r.Method == http.MethodDelete:
+		o.delete(w, o.images, strings.TrimPrefix(path, "/image/v2/images/"))
+
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		writeJSON(w, map[string]interface{}{"error": map[string]interface{}{"code": http.StatusNotFound, "message": path}})
+	}
+}
+
+func (o *OpenStack) catalogEntry(serviceType, path string) map[string]interface{} {
+	return map[string]interface{}{
+		"type": serviceType,
+		"endpoints": []interface{}{map[string]string{
+			"interface": "public",
+			"region":    o.region,
+			"region_id": o.region,
+			"url":       o.server.URL + path,
+		}},
+	}
+}
+
+func (o *OpenStack) list(resources map[string]string) []interface{} {
+	list := []interface{}{}
+	for _, id := range keys(resources) {
+		list = append(list, map[string]interface{}{"id": id, "name": resources[id], "metadata": map[string]string{}})
+	}
+	return list
+}
+
+func (o *OpenStack) delete(w http.ResponseWriter, resources map[string]string, id string) {
+	if _, ok := resources[id]; !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	delete(resources, id)
+	w.WriteHeader(http.StatusNoContent)
+}
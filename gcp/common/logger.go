@@ -1,5 +0,0 @@
-package common
-
-type logger interface {
-	Printf(m string, a ...interface{})
-}
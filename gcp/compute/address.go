@@ -1,6 +1,9 @@
 package compute
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 type Address struct {
 	client      addressesClient
@@ -24,7 +27,11 @@ func NewAddress(client addressesClient, name, region string, users int) Address
 }
 
 func (a Address) Delete() error {
-	err := a.client.DeleteAddress(a.region, a.name)
+	return a.DeleteContext(context.Background())
+}
+
+func (a Address) DeleteContext(ctx context.Context) error {
+	err := a.client.DeleteAddress(ctx, a.region, a.name)
 	if err != nil {
 		return fmt.Errorf("Delete: %s", err)
 	}
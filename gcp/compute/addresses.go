@@ -1,6 +1,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -10,7 +11,7 @@ import (
 
 type addressesClient interface {
 	ListAddresses(region string) ([]*gcpcompute.Address, error)
-	DeleteAddress(region, address string) error
+	DeleteAddress(ctx context.Context, region, address string) error
 }
 
 type Addresses struct {
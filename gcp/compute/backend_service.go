@@ -1,6 +1,9 @@
 package compute
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 type BackendService struct {
 	client backendServicesClient
@@ -15,7 +18,11 @@ func NewBackendService(client backendServicesClient, name string) BackendService
 }
 
 func (b BackendService) Delete() error {
-	err := b.client.DeleteBackendService(b.name)
+	return b.DeleteContext(context.Background())
+}
+
+func (b BackendService) DeleteContext(ctx context.Context) error {
+	err := b.client.DeleteBackendService(ctx, b.name)
 	if err != nil {
 		return fmt.Errorf("Delete: %s", err)
 	}
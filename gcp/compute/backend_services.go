@@ -1,6 +1,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -10,7 +11,7 @@ import (
 
 type backendServicesClient interface {
 	ListBackendServices() ([]*gcpcompute.BackendService, error)
-	DeleteBackendService(backendService string) error
+	DeleteBackendService(ctx context.Context, backendService string) error
 }
 
 type BackendServices struct {
@@ -1,6 +1,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -100,8 +101,8 @@ func (c client) ListAddresses(region string) ([]*gcpcompute.Address, error) {
 	return list, nil
 }
 
-func (c client) DeleteAddress(region, address string) error {
-	return c.wait(c.addresses.Delete(c.project, region, address))
+func (c client) DeleteAddress(ctx context.Context, region, address string) error {
+	return c.wait(ctx, c.addresses.Delete(c.project, region, address))
 }
 
 func (c client) ListGlobalAddresses() ([]*gcpcompute.Address, error) {
@@ -127,8 +128,8 @@ func (c client) ListGlobalAddresses() ([]*gcpcompute.Address, error) {
 	return list, nil
 }
 
-func (c client) DeleteGlobalAddress(address string) error {
-	return c.wait(c.globalAddresses.Delete(c.project, address))
+func (c client) DeleteGlobalAddress(ctx context.Context, address string) error {
+	return c.wait(ctx, c.globalAddresses.Delete(c.project, address))
 }
 
 func (c client) ListBackendServices() ([]*gcpcompute.BackendService, error) {
@@ -154,8 +155,8 @@ func (c client) ListBackendServices() ([]*gcpcompute.BackendService, error) {
 	return list, nil
 }
 
-func (c client) DeleteBackendService(backendService string) error {
-	return c.wait(c.backendServices.Delete(c.project, backendService))
+func (c client) DeleteBackendService(ctx context.Context, backendService string) error {
+	return c.wait(ctx, c.backendServices.Delete(c.project, backendService))
 }
 
 // ListDisks returns the full list of disks.
@@ -182,8 +183,12 @@ func (c client) ListDisks(zone string) ([]*gcpcompute.Disk, error) {
 	return list, nil
 }
 
-func (c client) DeleteDisk(zone, disk string) error {
-	return c.wait(c.disks.Delete(c.project, zone, disk))
+func (c client) DeleteDisk(ctx context.Context, zone, disk string) error {
+	return c.wait(ctx, c.disks.Delete(c.project, zone, disk))
+}
+
+func (c client) CreateDiskSnapshot(ctx context.Context, zone, disk, snapshot string) error {
+	return c.wait(ctx, c.disks.CreateSnapshot(c.project, zone, disk, &gcpcompute.Snapshot{Name: snapshot}))
 }
 
 // ListImages returns the full list of images.
@@ -210,8 +215,8 @@ func (c client) ListImages() ([]*gcpcompute.Image, error) {
 	return list, nil
 }
 
-func (c client) DeleteImage(image string) error {
-	return c.wait(c.images.Delete(c.project, image))
+func (c client) DeleteImage(ctx context.Context, image string) error {
+	return c.wait(ctx, c.images.Delete(c.project, image))
 }
 
 func (c client) ListInstances(zone string) ([]*gcpcompute.Instance, error) {
@@ -237,8 +242,8 @@ func (c client) ListInstances(zone string) ([]*gcpcompute.Instance, error) {
 	return list, nil
 }
 
-func (c client) DeleteInstance(zone, instance string) error {
-	return c.wait(c.instances.Delete(c.project, zone, instance))
+func (c client) DeleteInstance(ctx context.Context, zone, instance string) error {
+	return c.wait(ctx, c.instances.Delete(c.project, zone, instance))
 }
 
 func (c client) ListInstanceTemplates() ([]*gcpcompute.InstanceTemplate, error) {
@@ -264,8 +269,8 @@ func (c client) ListInstanceTemplates() ([]*gcpcompute.InstanceTemplate, error)
 	return list, nil
 }
 
-func (c client) DeleteInstanceTemplate(instanceTemplate string) error {
-	return c.wait(c.instanceTemplates.Delete(c.project, instanceTemplate))
+func (c client) DeleteInstanceTemplate(ctx context.Context, instanceTemplate string) error {
+	return c.wait(ctx, c.instanceTemplates.Delete(c.project, instanceTemplate))
 }
 
 func (c client) ListInstanceGroups(zone string) ([]*gcpcompute.InstanceGroup, error) {
@@ -291,8 +296,8 @@ func (c client) ListInstanceGroups(zone string) ([]*gcpcompute.InstanceGroup, er
 	return list, nil
 }
 
-func (c client) DeleteInstanceGroup(zone, instanceGroup string) error {
-	return c.wait(c.instanceGroups.Delete(c.project, zone, instanceGroup))
+func (c client) DeleteInstanceGroup(ctx context.Context, zone, instanceGroup string) error {
+	return c.wait(ctx, c.instanceGroups.Delete(c.project, zone, instanceGroup))
 }
 
 func (c client) ListInstanceGroupManagers(zone string) ([]*gcpcompute.InstanceGroupManager, error) {
@@ -318,8 +323,8 @@ func (c client) ListInstanceGroupManagers(zone string) ([]*gcpcompute.InstanceGr
 	return list, nil
 }
 
-func (c client) DeleteInstanceGroupManager(zone, instanceGroupManager string) error {
-	return c.wait(c.instanceGroupManagers.Delete(c.project, zone, instanceGroupManager))
+func (c client) DeleteInstanceGroupManager(ctx context.Context, zone, instanceGroupManager string) error {
+	return c.wait(ctx, c.instanceGroupManagers.Delete(c.project, zone, instanceGroupManager))
 }
 
 func (c client) ListGlobalHealthChecks() ([]*gcpcompute.HealthCheck, error) {
@@ -345,8 +350,8 @@ func (c client) ListGlobalHealthChecks() ([]*gcpcompute.HealthCheck, error) {
 	return list, nil
 }
 
-func (c client) DeleteGlobalHealthCheck(globalHealthCheck string) error {
-	return c.wait(c.globalHealthChecks.Delete(c.project, globalHealthCheck))
+func (c client) DeleteGlobalHealthCheck(ctx context.Context, globalHealthCheck string) error {
+	return c.wait(ctx, c.globalHealthChecks.Delete(c.project, globalHealthCheck))
 }
 
 func (c client) ListHttpHealthChecks() ([]*gcpcompute.HttpHealthCheck, error) {
@@ -372,8 +377,8 @@ func (c client) ListHttpHealthChecks() ([]*gcpcompute.HttpHealthCheck, error) {
 	return list, nil
 }
 
-func (c client) DeleteHttpHealthCheck(httpHealthCheck string) error {
-	return c.wait(c.httpHealthChecks.Delete(c.project, httpHealthCheck))
+func (c client) DeleteHttpHealthCheck(ctx context.Context, httpHealthCheck string) error {
+	return c.wait(ctx, c.httpHealthChecks.Delete(c.project, httpHealthCheck))
 }
 
 func (c client) ListHttpsHealthChecks() ([]*gcpcompute.HttpsHealthCheck, error) {
@@ -399,8 +404,8 @@ func (c client) ListHttpsHealthChecks() ([]*gcpcompute.HttpsHealthCheck, error)
 	return list, nil
 }
 
-func (c client) DeleteHttpsHealthCheck(httpsHealthCheck string) error {
-	return c.wait(c.httpsHealthChecks.Delete(c.project, httpsHealthCheck))
+func (c client) DeleteHttpsHealthCheck(ctx context.Context, httpsHealthCheck string) error {
+	return c.wait(ctx, c.httpsHealthChecks.Delete(c.project, httpsHealthCheck))
 }
 
 func (c client) ListFirewalls() ([]*gcpcompute.Firewall, error) {
@@ -426,8 +431,8 @@ func (c client) ListFirewalls() ([]*gcpcompute.Firewall, error) {
 	return list, nil
 }
 
-func (c client) DeleteFirewall(firewall string) error {
-	return c.wait(c.firewalls.Delete(c.project, firewall))
+func (c client) DeleteFirewall(ctx context.Context, firewall string) error {
+	return c.wait(ctx, c.firewalls.Delete(c.project, firewall))
 }
 
 func (c client) ListGlobalForwardingRules() ([]*gcpcompute.ForwardingRule, error) {
@@ -453,8 +458,8 @@ func (c client) ListGlobalForwardingRules() ([]*gcpcompute.ForwardingRule, error
 	return list, nil
 }
 
-func (c client) DeleteGlobalForwardingRule(globalForwardingRule string) error {
-	return c.wait(c.globalForwardingRules.Delete(c.project, globalForwardingRule))
+func (c client) DeleteGlobalForwardingRule(ctx context.Context, globalForwardingRule string) error {
+	return c.wait(ctx, c.globalForwardingRules.Delete(c.project, globalForwardingRule))
 }
 
 func (c client) ListForwardingRules(region string) ([]*gcpcompute.ForwardingRule, error) {
@@ -480,8 +485,8 @@ func (c client) ListForwardingRules(region string) ([]*gcpcompute.ForwardingRule
 	return list, nil
 }
 
-func (c client) DeleteForwardingRule(region, forwardingRule string) error {
-	return c.wait(c.forwardingRules.Delete(c.project, region, forwardingRule))
+func (c client) DeleteForwardingRule(ctx context.Context, region, forwardingRule string) error {
+	return c.wait(ctx, c.forwardingRules.Delete(c.project, region, forwardingRule))
 }
 
 func (c client) ListRoutes() ([]*gcpcompute.Route, error) {
@@ -507,8 +512,8 @@ func (c client) ListRoutes() ([]*gcpcompute.Route, error) {
 	return list, nil
 }
 
-func (c client) DeleteRoute(route string) error {
-	return c.wait(c.routes.Delete(c.project, route))
+func (c client) DeleteRoute(ctx context.Context, route string) error {
+	return c.wait(ctx, c.routes.Delete(c.project, route))
 }
 
 func (c client) ListNetworks() ([]*gcpcompute.Network, error) {
@@ -534,8 +539,8 @@ func (c client) ListNetworks() ([]*gcpcompute.Network, error) {
 	return list, nil
 }
 
-func (c client) DeleteNetwork(network string) error {
-	return c.wait(c.networks.Delete(c.project, network))
+func (c client) DeleteNetwork(ctx context.Context, network string) error {
+	return c.wait(ctx, c.networks.Delete(c.project, network))
 }
 
 func (c client) ListSubnetworks(region string) ([]*gcpcompute.Subnetwork, error) {
@@ -561,8 +566,8 @@ func (c client) ListSubnetworks(region string) ([]*gcpcompute.Subnetwork, error)
 	return list, nil
 }
 
-func (c client) DeleteSubnetwork(region, subnetwork string) error {
-	return c.wait(c.subnetworks.Delete(c.project, region, subnetwork))
+func (c client) DeleteSubnetwork(ctx context.Context, region, subnetwork string) error {
+	return c.wait(ctx, c.subnetworks.Delete(c.project, region, subnetwork))
 }
 
 func (c client) ListSslCertificates() ([]*gcpcompute.SslCertificate, error) {
@@ -588,32 +593,32 @@ func (c client) ListSslCertificates() ([]*gcpcompute.SslCertificate, error) {
 	return list, nil
 }
 
-func (c client) DeleteSslCertificate(certificate string) error {
-	return c.wait(c.sslCertificates.Delete(c.project, certificate))
+func (c client) DeleteSslCertificate(ctx context.Context, certificate string) error {
+	return c.wait(ctx, c.sslCertificates.Delete(c.project, certificate))
 }
 
 func (c client) ListTargetHttpProxies() (*gcpcompute.TargetHttpProxyList, error) {
 	return c.targetHttpProxies.List(c.project).Do()
 }
 
-func (c client) DeleteTargetHttpProxy(targetHttpProxy string) error {
-	return c.wait(c.targetHttpProxies.Delete(c.project, targetHttpProxy))
+func (c client) DeleteTargetHttpProxy(ctx context.Context, targetHttpProxy string) error {
+	return c.wait(ctx, c.targetHttpProxies.Delete(c.project, targetHttpProxy))
 }
 
 func (c client) ListTargetHttpsProxies() (*gcpcompute.TargetHttpsProxyList, error) {
 	return c.targetHttpsProxies.List(c.project).Do()
 }
 
-func (c client) DeleteTargetHttpsProxy(targetHttpsProxy string) error {
-	return c.wait(c.targetHttpsProxies.Delete(c.project, targetHttpsProxy))
+func (c client) DeleteTargetHttpsProxy(ctx context.Context, targetHttpsProxy string) error {
+	return c.wait(ctx, c.targetHttpsProxies.Delete(c.project, targetHttpsProxy))
 }
 
 func (c client) ListTargetPools(region string) (*gcpcompute.TargetPoolList, error) {
 	return c.targetPools.List(c.project, region).Do()
 }
 
-func (c client) DeleteTargetPool(region string, targetPool string) error {
-	return c.wait(c.targetPools.Delete(c.project, region, targetPool))
+func (c client) DeleteTargetPool(ctx context.Context, region string, targetPool string) error {
+	return c.wait(ctx, c.targetPools.Delete(c.project, region, targetPool))
 }
 
 func (c client) ListTargetVpnGateways(region string) ([]*gcpcompute.TargetVpnGateway, error) {
@@ -639,16 +644,16 @@ func (c client) ListTargetVpnGateways(region string) ([]*gcpcompute.TargetVpnGat
 	return list, nil
 }
 
-func (c client) DeleteTargetVpnGateway(region, targetVpnGateway string) error {
-	return c.wait(c.targetVpnGateways.Delete(c.project, region, targetVpnGateway))
+func (c client) DeleteTargetVpnGateway(ctx context.Context, region, targetVpnGateway string) error {
+	return c.wait(ctx, c.targetVpnGateways.Delete(c.project, region, targetVpnGateway))
 }
 
 func (c client) ListUrlMaps() (*gcpcompute.UrlMapList, error) {
 	return c.urlMaps.List(c.project).Do()
 }
 
-func (c client) DeleteUrlMap(urlMap string) error {
-	return c.wait(c.urlMaps.Delete(c.project, urlMap))
+func (c client) DeleteUrlMap(ctx context.Context, urlMap string) error {
+	return c.wait(ctx, c.urlMaps.Delete(c.project, urlMap))
 }
 
 func (c client) ListVpnTunnels(region string) ([]*gcpcompute.VpnTunnel, error) {
@@ -674,8 +679,8 @@ func (c client) ListVpnTunnels(region string) ([]*gcpcompute.VpnTunnel, error) {
 	return list, nil
 }
 
-func (c client) DeleteVpnTunnel(region, vpnTunnel string) error {
-	return c.wait(c.vpnTunnels.Delete(c.project, region, vpnTunnel))
+func (c client) DeleteVpnTunnel(ctx context.Context, region, vpnTunnel string) error {
+	return c.wait(ctx, c.vpnTunnels.Delete(c.project, region, vpnTunnel))
 }
 
 func (c client) ListRegions() (map[string]string, error) {
@@ -710,7 +715,7 @@ type request interface {
 	Do(...googleapi.CallOption) (*gcpcompute.Operation, error)
 }
 
-func (c client) wait(request request) error {
+func (c client) wait(ctx context.Context, request request) error {
 	op, err := request.Do()
 	if err != nil {
 		if gerr, ok := err.(*googleapi.Error); ok {
@@ -723,5 +728,5 @@ func (c client) wait(request request) error {
 
 	waiter := NewOperationWaiter(op, c.service, c.project, c.logger)
 
-	return waiter.Wait()
+	return waiter.Wait(ctx)
 }
@@ -1,23 +1,60 @@
 package compute
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/genevieve/leftovers/bosh"
+	"github.com/genevieve/leftovers/common"
+)
 
 type Disk struct {
-	client disksClient
-	name   string
-	zone   string
+	client      disksClient
+	logger      logger
+	name        string
+	clearerName string
+	zone        string
+	backup      bool
 }
 
-func NewDisk(client disksClient, name, zone string) Disk {
+func NewDisk(client disksClient, logger logger, name, zone string, labels map[string]string, backup bool) Disk {
+	clearerName := name
+
+	extra := bosh.Metadata(labels)
+	if len(extra) > 0 {
+		clearerName = fmt.Sprintf("%s (%s)", name, strings.Join(extra, ", "))
+	}
+
 	return Disk{
-		client: client,
-		name:   name,
-		zone:   zone,
+		client:      client,
+		logger:      logger,
+		name:        name,
+		clearerName: clearerName,
+		zone:        zone,
+		backup:      backup,
 	}
 }
 
 func (d Disk) Delete() error {
-	err := d.client.DeleteDisk(d.zone, d.name)
+	return d.DeleteContext(context.Background())
+}
+
+// DeleteContext snapshots the disk if backup is enabled,
+// then deletes the disk.
+func (d Disk) DeleteContext(ctx context.Context) error {
+	if d.backup {
+		snapshot := common.BackupName(d.name, 63)
+
+		err := d.client.CreateDiskSnapshot(ctx, d.zone, d.name, snapshot)
+		if err != nil {
+			return fmt.Errorf("Backup: %s", err)
+		}
+
+		d.logger.Printf("[%s: %s] Snapshot: %s\n", d.Type(), d.name, snapshot)
+	}
+
+	err := d.client.DeleteDisk(ctx, d.zone, d.name)
 	if err != nil {
 		return fmt.Errorf("Delete: %s", err)
 	}
@@ -26,7 +63,7 @@ func (d Disk) Delete() error {
 }
 
 func (d Disk) Name() string {
-	return d.name
+	return d.clearerName
 }
 
 func (d Disk) Type() string {
@@ -2,6 +2,7 @@ package compute_test
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/genevieve/leftovers/gcp/compute"
 	"github.com/genevieve/leftovers/gcp/compute/fakes"
@@ -13,18 +14,22 @@ import (
 var _ = Describe("Disk", func() {
 	var (
 		client *fakes.DisksClient
+		logger *fakes.Logger
 		name   string
 		zone   string
+		labels map[string]string
 
 		disk compute.Disk
 	)
 
 	BeforeEach(func() {
 		client = &fakes.DisksClient{}
+		logger = &fakes.Logger{}
 		name = "banana"
 		zone = "zone"
+		labels = map[string]string{}
 
-		disk = compute.NewDisk(client, name, zone)
+		disk = compute.NewDisk(client, logger, name, zone, labels, false)
 	})
 
 	Describe("Delete", func() {
@@ -35,6 +40,42 @@ var _ = Describe("Disk", func() {
 			Expect(client.DeleteDiskCall.CallCount).To(Equal(1))
 			Expect(client.DeleteDiskCall.Receives.Disk).To(Equal(name))
 			Expect(client.DeleteDiskCall.Receives.Zone).To(Equal(zone))
+
+			Expect(client.CreateDiskSnapshotCall.CallCount).To(Equal(0))
+		})
+
+		Context("when backup is enabled", func() {
+			BeforeEach(func() {
+				disk = compute.NewDisk(client, logger, name, zone, labels, true)
+			})
+
+			It("snapshots the disk before deleting it", func() {
+				err := disk.Delete()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(client.CreateDiskSnapshotCall.CallCount).To(Equal(1))
+				Expect(client.CreateDiskSnapshotCall.Receives.Zone).To(Equal(zone))
+				Expect(client.CreateDiskSnapshotCall.Receives.Disk).To(Equal(name))
+				Expect(client.CreateDiskSnapshotCall.Receives.Snapshot).To(HavePrefix("banana-leftovers-"))
+
+				Expect(logger.PrintfCall.Messages).To(ConsistOf(
+					fmt.Sprintf("[Disk: banana] Snapshot: %s\n", client.CreateDiskSnapshotCall.Receives.Snapshot),
+				))
+				Expect(client.DeleteDiskCall.CallCount).To(Equal(1))
+			})
+
+			Context("when the client fails to snapshot the disk", func() {
+				BeforeEach(func() {
+					client.CreateDiskSnapshotCall.Returns.Error = errors.New("the-error")
+				})
+
+				It("does not delete the disk", func() {
+					err := disk.Delete()
+					Expect(err).To(MatchError("Backup: the-error"))
+
+					Expect(client.DeleteDiskCall.CallCount).To(Equal(0))
+				})
+			})
 		})
 
 		Context("when the client fails to delete", func() {
@@ -53,6 +94,17 @@ var _ = Describe("Disk", func() {
 		It("returns the name", func() {
 			Expect(disk.Name()).To(Equal(name))
 		})
+
+		Context("when the disk has bosh labels", func() {
+			BeforeEach(func() {
+				labels = map[string]string{"deployment": "cf", "director": "bosh-banana", "job": "diego-cell"}
+				disk = compute.NewDisk(client, logger, name, zone, labels, false)
+			})
+
+			It("includes the director and deployment", func() {
+				Expect(disk.Name()).To(Equal("banana (director:bosh-banana, deployment:cf)"))
+			})
+		})
 	})
 
 	Describe("Type", func() {
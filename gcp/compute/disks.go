@@ -1,6 +1,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -10,20 +11,23 @@ import (
 
 type disksClient interface {
 	ListDisks(zone string) ([]*gcpcompute.Disk, error)
-	DeleteDisk(zone, disk string) error
+	DeleteDisk(ctx context.Context, zone, disk string) error
+	CreateDiskSnapshot(ctx context.Context, zone, disk, snapshot string) error
 }
 
 type Disks struct {
 	client disksClient
 	logger logger
 	zones  map[string]string
+	backup common.Backup
 }
 
-func NewDisks(client disksClient, logger logger, zones map[string]string) Disks {
+func NewDisks(client disksClient, logger logger, zones map[string]string, backup common.Backup) Disks {
 	return Disks{
 		client: client,
 		logger: logger,
 		zones:  zones,
+		backup: backup,
 	}
 }
 
@@ -40,7 +44,7 @@ func (d Disks) List(filter string) ([]common.Deletable, error) {
 
 	var resources []common.Deletable
 	for _, disk := range disks {
-		resource := NewDisk(d.client, disk.Name, d.zones[disk.Zone])
+		resource := NewDisk(d.client, d.logger, disk.Name, d.zones[disk.Zone], disk.Labels, d.backup.Enabled)
 
 		if !strings.Contains(resource.Name(), filter) {
 			continue
@@ -3,6 +3,7 @@ package compute_test
 import (
 	"errors"
 
+	"github.com/genevieve/leftovers/common"
 	"github.com/genevieve/leftovers/gcp/compute"
 	"github.com/genevieve/leftovers/gcp/compute/fakes"
 	. "github.com/onsi/ginkgo"
@@ -24,7 +25,7 @@ var _ = Describe("Disks", func() {
 		logger = &fakes.Logger{}
 		zones = map[string]string{"https://zone-1": "zone-1"}
 
-		disks = compute.NewDisks(client, logger, zones)
+		disks = compute.NewDisks(client, logger, zones, common.Backup{})
 	})
 
 	Describe("List", func() {
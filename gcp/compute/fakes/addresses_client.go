@@ -1,5 +1,7 @@
 package fakes
 
+import "context"
+
 import gcpcompute "google.golang.org/api/compute/v1"
 
 type AddressesClient struct {
@@ -33,7 +35,7 @@ func (a *AddressesClient) ListAddresses(region string) ([]*gcpcompute.Address, e
 	return a.ListAddressesCall.Returns.Output, a.ListAddressesCall.Returns.Error
 }
 
-func (a *AddressesClient) DeleteAddress(region, address string) error {
+func (a *AddressesClient) DeleteAddress(ctx context.Context, region, address string) error {
 	a.DeleteAddressCall.CallCount++
 	a.DeleteAddressCall.Receives.Address = address
 	a.DeleteAddressCall.Receives.Region = region
@@ -1,5 +1,7 @@
 package fakes
 
+import "context"
+
 import gcpcompute "google.golang.org/api/compute/v1"
 
 type BackendServicesClient struct {
@@ -28,7 +30,7 @@ func (n *BackendServicesClient) ListBackendServices() ([]*gcpcompute.BackendServ
 	return n.ListBackendServicesCall.Returns.Output, n.ListBackendServicesCall.Returns.Error
 }
 
-func (n *BackendServicesClient) DeleteBackendService(backendService string) error {
+func (n *BackendServicesClient) DeleteBackendService(ctx context.Context, backendService string) error {
 	n.DeleteBackendServiceCall.CallCount++
 	n.DeleteBackendServiceCall.Receives.BackendService = backendService
 
@@ -1,5 +1,7 @@
 package fakes
 
+import "context"
+
 import gcpcompute "google.golang.org/api/compute/v1"
 
 type DisksClient struct {
@@ -24,6 +26,18 @@ type DisksClient struct {
 			Error error
 		}
 	}
+
+	CreateDiskSnapshotCall struct {
+		CallCount int
+		Receives  struct {
+			Zone     string
+			Disk     string
+			Snapshot string
+		}
+		Returns struct {
+			Error error
+		}
+	}
 }
 
 func (n *DisksClient) ListDisks(zone string) ([]*gcpcompute.Disk, error) {
@@ -33,10 +47,19 @@ func (n *DisksClient) ListDisks(zone string) ([]*gcpcompute.Disk, error) {
 	return n.ListDisksCall.Returns.Output, n.ListDisksCall.Returns.Error
 }
 
-func (n *DisksClient) DeleteDisk(zone, disk string) error {
+func (n *DisksClient) DeleteDisk(ctx context.Context, zone, disk string) error {
 	n.DeleteDiskCall.CallCount++
 	n.DeleteDiskCall.Receives.Zone = zone
 	n.DeleteDiskCall.Receives.Disk = disk
 
 	return n.DeleteDiskCall.Returns.Error
 }
+
+func (n *DisksClient) CreateDiskSnapshot(ctx context.Context, zone, disk, snapshot string) error {
+	n.CreateDiskSnapshotCall.CallCount++
+	n.CreateDiskSnapshotCall.Receives.Zone = zone
+	n.CreateDiskSnapshotCall.Receives.Disk = disk
+	n.CreateDiskSnapshotCall.Receives.Snapshot = snapshot
+
+	return n.CreateDiskSnapshotCall.Returns.Error
+}
@@ -1,5 +1,7 @@
 package fakes
 
+import "context"
+
 import gcpcompute "google.golang.org/api/compute/v1"
 
 type FirewallsClient struct {
@@ -28,7 +30,7 @@ func (c *FirewallsClient) ListFirewalls() ([]*gcpcompute.Firewall, error) {
 	return c.ListFirewallsCall.Returns.Output, c.ListFirewallsCall.Returns.Error
 }
 
-func (c *FirewallsClient) DeleteFirewall(firewall string) error {
+func (c *FirewallsClient) DeleteFirewall(ctx context.Context, firewall string) error {
 	c.DeleteFirewallCall.CallCount++
 	c.DeleteFirewallCall.Receives.Firewall = firewall
 
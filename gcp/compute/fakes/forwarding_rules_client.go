@@ -1,5 +1,7 @@
 package fakes
 
+import "context"
+
 import gcpcompute "google.golang.org/api/compute/v1"
 
 type ForwardingRulesClient struct {
@@ -33,7 +35,7 @@ func (n *ForwardingRulesClient) ListForwardingRules(region string) ([]*gcpcomput
 	return n.ListForwardingRulesCall.Returns.Output, n.ListForwardingRulesCall.Returns.Error
 }
 
-func (n *ForwardingRulesClient) DeleteForwardingRule(region, forwardingRule string) error {
+func (n *ForwardingRulesClient) DeleteForwardingRule(ctx context.Context, region, forwardingRule string) error {
 	n.DeleteForwardingRuleCall.CallCount++
 	n.DeleteForwardingRuleCall.Receives.ForwardingRule = forwardingRule
 	n.DeleteForwardingRuleCall.Receives.Region = region
@@ -1,5 +1,7 @@
 package fakes
 
+import "context"
+
 import gcpcompute "google.golang.org/api/compute/v1"
 
 type GlobalAddressesClient struct {
@@ -28,7 +30,7 @@ func (a *GlobalAddressesClient) ListGlobalAddresses() ([]*gcpcompute.Address, er
 	return a.ListGlobalAddressesCall.Returns.Output, a.ListGlobalAddressesCall.Returns.Error
 }
 
-func (a *GlobalAddressesClient) DeleteGlobalAddress(address string) error {
+func (a *GlobalAddressesClient) DeleteGlobalAddress(ctx context.Context, address string) error {
 	a.DeleteGlobalAddressCall.CallCount++
 	a.DeleteGlobalAddressCall.Receives.Address = address
 
@@ -1,5 +1,7 @@
 package fakes
 
+import "context"
+
 import gcpcompute "google.golang.org/api/compute/v1"
 
 type GlobalForwardingRulesClient struct {
@@ -28,7 +30,7 @@ func (n *GlobalForwardingRulesClient) ListGlobalForwardingRules() ([]*gcpcompute
 	return n.ListGlobalForwardingRulesCall.Returns.Output, n.ListGlobalForwardingRulesCall.Returns.Error
 }
 
-func (n *GlobalForwardingRulesClient) DeleteGlobalForwardingRule(globalForwardingRule string) error {
+func (n *GlobalForwardingRulesClient) DeleteGlobalForwardingRule(ctx context.Context, globalForwardingRule string) error {
 	n.DeleteGlobalForwardingRuleCall.CallCount++
 	n.DeleteGlobalForwardingRuleCall.Receives.GlobalForwardingRule = globalForwardingRule
 
@@ -1,5 +1,7 @@
 package fakes
 
+import "context"
+
 import gcpcompute "google.golang.org/api/compute/v1"
 
 type GlobalHealthChecksClient struct {
@@ -28,7 +30,7 @@ func (n *GlobalHealthChecksClient) ListGlobalHealthChecks() ([]*gcpcompute.Healt
 	return n.ListGlobalHealthChecksCall.Returns.Output, n.ListGlobalHealthChecksCall.Returns.Error
 }
 
-func (n *GlobalHealthChecksClient) DeleteGlobalHealthCheck(globalHealthCheck string) error {
+func (n *GlobalHealthChecksClient) DeleteGlobalHealthCheck(ctx context.Context, globalHealthCheck string) error {
 	n.DeleteGlobalHealthCheckCall.CallCount++
 	n.DeleteGlobalHealthCheckCall.Receives.GlobalHealthCheck = globalHealthCheck
 
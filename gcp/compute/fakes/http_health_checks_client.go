@@ -1,5 +1,7 @@
 package fakes
 
+import "context"
+
 import gcpcompute "google.golang.org/api/compute/v1"
 
 type HttpHealthChecksClient struct {
@@ -28,7 +30,7 @@ func (n *HttpHealthChecksClient) ListHttpHealthChecks() ([]*gcpcompute.HttpHealt
 	return n.ListHttpHealthChecksCall.Returns.Output, n.ListHttpHealthChecksCall.Returns.Error
 }
 
-func (n *HttpHealthChecksClient) DeleteHttpHealthCheck(httpHealthCheck string) error {
+func (n *HttpHealthChecksClient) DeleteHttpHealthCheck(ctx context.Context, httpHealthCheck string) error {
 	n.DeleteHttpHealthCheckCall.CallCount++
 	n.DeleteHttpHealthCheckCall.Receives.HttpHealthCheck = httpHealthCheck
 
@@ -1,5 +1,7 @@
 package fakes
 
+import "context"
+
 import gcpcompute "google.golang.org/api/compute/v1"
 
 type HttpsHealthChecksClient struct {
@@ -28,7 +30,7 @@ func (n *HttpsHealthChecksClient) ListHttpsHealthChecks() ([]*gcpcompute.HttpsHe
 	return n.ListHttpsHealthChecksCall.Returns.Output, n.ListHttpsHealthChecksCall.Returns.Error
 }
 
-func (n *HttpsHealthChecksClient) DeleteHttpsHealthCheck(httpsHealthCheck string) error {
+func (n *HttpsHealthChecksClient) DeleteHttpsHealthCheck(ctx context.Context, httpsHealthCheck string) error {
 	n.DeleteHttpsHealthCheckCall.CallCount++
 	n.DeleteHttpsHealthCheckCall.Receives.HttpsHealthCheck = httpsHealthCheck
 
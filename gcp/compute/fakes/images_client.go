@@ -1,5 +1,7 @@
 package fakes
 
+import "context"
+
 import gcpcompute "google.golang.org/api/compute/v1"
 
 type ImagesClient struct {
@@ -28,7 +30,7 @@ func (n *ImagesClient) ListImages() ([]*gcpcompute.Image, error) {
 	return n.ListImagesCall.Returns.Output, n.ListImagesCall.Returns.Error
 }
 
-func (n *ImagesClient) DeleteImage(image string) error {
+func (n *ImagesClient) DeleteImage(ctx context.Context, image string) error {
 	n.DeleteImageCall.CallCount++
 	n.DeleteImageCall.Receives.Image = image
 
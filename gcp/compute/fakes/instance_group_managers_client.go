@@ -1,5 +1,7 @@
 package fakes
 
+import "context"
+
 import gcpcompute "google.golang.org/api/compute/v1"
 
 type InstanceGroupManagersClient struct {
@@ -33,7 +35,7 @@ func (n *InstanceGroupManagersClient) ListInstanceGroupManagers(zone string) ([]
 	return n.ListInstanceGroupManagersCall.Returns.Output, n.ListInstanceGroupManagersCall.Returns.Error
 }
 
-func (n *InstanceGroupManagersClient) DeleteInstanceGroupManager(zone, instanceGroupManager string) error {
+func (n *InstanceGroupManagersClient) DeleteInstanceGroupManager(ctx context.Context, zone, instanceGroupManager string) error {
 	n.DeleteInstanceGroupManagerCall.CallCount++
 	n.DeleteInstanceGroupManagerCall.Receives.Zone = zone
 	n.DeleteInstanceGroupManagerCall.Receives.InstanceGroupManager = instanceGroupManager
@@ -1,5 +1,7 @@
 package fakes
 
+import "context"
+
 import gcpcompute "google.golang.org/api/compute/v1"
 
 type InstanceGroupsClient struct {
@@ -33,7 +35,7 @@ func (n *InstanceGroupsClient) ListInstanceGroups(zone string) ([]*gcpcompute.In
 	return n.ListInstanceGroupsCall.Returns.Output, n.ListInstanceGroupsCall.Returns.Error
 }
 
-func (n *InstanceGroupsClient) DeleteInstanceGroup(zone, instanceGroup string) error {
+func (n *InstanceGroupsClient) DeleteInstanceGroup(ctx context.Context, zone, instanceGroup string) error {
 	n.DeleteInstanceGroupCall.CallCount++
 	n.DeleteInstanceGroupCall.Receives.Zone = zone
 	n.DeleteInstanceGroupCall.Receives.InstanceGroup = instanceGroup
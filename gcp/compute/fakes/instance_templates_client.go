@@ -1,5 +1,7 @@
 package fakes
 
+import "context"
+
 import gcpcompute "google.golang.org/api/compute/v1"
 
 type InstanceTemplatesClient struct {
@@ -28,7 +30,7 @@ func (n *InstanceTemplatesClient) ListInstanceTemplates() ([]*gcpcompute.Instanc
 	return n.ListInstanceTemplatesCall.Returns.Output, n.ListInstanceTemplatesCall.Returns.Error
 }
 
-func (n *InstanceTemplatesClient) DeleteInstanceTemplate(instanceTemplate string) error {
+func (n *InstanceTemplatesClient) DeleteInstanceTemplate(ctx context.Context, instanceTemplate string) error {
 	n.DeleteInstanceTemplateCall.CallCount++
 	n.DeleteInstanceTemplateCall.Receives.InstanceTemplate = instanceTemplate
 
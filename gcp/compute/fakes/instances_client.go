@@ -1,5 +1,7 @@
 package fakes
 
+import "context"
+
 import gcpcompute "google.golang.org/api/compute/v1"
 
 type InstancesClient struct {
@@ -33,7 +35,7 @@ func (n *InstancesClient) ListInstances(zone string) ([]*gcpcompute.Instance, er
 	return n.ListInstancesCall.Returns.Output, n.ListInstancesCall.Returns.Error
 }
 
-func (n *InstancesClient) DeleteInstance(zone, instance string) error {
+func (n *InstancesClient) DeleteInstance(ctx context.Context, zone, instance string) error {
 	n.DeleteInstanceCall.CallCount++
 	n.DeleteInstanceCall.Receives.Zone = zone
 	n.DeleteInstanceCall.Receives.Instance = instance
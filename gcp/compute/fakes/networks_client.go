@@ -1,5 +1,7 @@
 package fakes
 
+import "context"
+
 import compute "google.golang.org/api/compute/v1"
 
 type NetworksClient struct {
@@ -28,7 +30,7 @@ func (n *NetworksClient) ListNetworks() ([]*compute.Network, error) {
 	return n.ListNetworksCall.Returns.Output, n.ListNetworksCall.Returns.Error
 }
 
-func (n *NetworksClient) DeleteNetwork(network string) error {
+func (n *NetworksClient) DeleteNetwork(ctx context.Context, network string) error {
 	n.DeleteNetworkCall.CallCount++
 	n.DeleteNetworkCall.Receives.Network = network
 
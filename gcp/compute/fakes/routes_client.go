@@ -1,5 +1,7 @@
 package fakes
 
+import "context"
+
 import gcpcompute "google.golang.org/api/compute/v1"
 
 type RoutesClient struct {
@@ -28,7 +30,7 @@ func (n *RoutesClient) ListRoutes() ([]*gcpcompute.Route, error) {
 	return n.ListRoutesCall.Returns.Output, n.ListRoutesCall.Returns.Error
 }
 
-func (n *RoutesClient) DeleteRoute(route string) error {
+func (n *RoutesClient) DeleteRoute(ctx context.Context, route string) error {
 	n.DeleteRouteCall.CallCount++
 	n.DeleteRouteCall.Receives.Route = route
 
@@ -1,5 +1,7 @@
 package fakes
 
+import "context"
+
 import gcpcompute "google.golang.org/api/compute/v1"
 
 type SslCertificatesClient struct {
@@ -28,7 +30,7 @@ func (n *SslCertificatesClient) ListSslCertificates() ([]*gcpcompute.SslCertific
 	return n.ListSslCertificatesCall.Returns.Output, n.ListSslCertificatesCall.Returns.Error
 }
 
-func (n *SslCertificatesClient) DeleteSslCertificate(sslCertificate string) error {
+func (n *SslCertificatesClient) DeleteSslCertificate(ctx context.Context, sslCertificate string) error {
 	n.DeleteSslCertificateCall.CallCount++
 	n.DeleteSslCertificateCall.Receives.SslCertificate = sslCertificate
 
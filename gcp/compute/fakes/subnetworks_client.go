@@ -1,5 +1,7 @@
 package fakes
 
+import "context"
+
 import compute "google.golang.org/api/compute/v1"
 
 type SubnetworksClient struct {
@@ -33,7 +35,7 @@ func (n *SubnetworksClient) ListSubnetworks(region string) ([]*compute.Subnetwor
 	return n.ListSubnetworksCall.Returns.Output, n.ListSubnetworksCall.Returns.Error
 }
 
-func (n *SubnetworksClient) DeleteSubnetwork(region, subnetwork string) error {
+func (n *SubnetworksClient) DeleteSubnetwork(ctx context.Context, region, subnetwork string) error {
 	n.DeleteSubnetworkCall.CallCount++
 	n.DeleteSubnetworkCall.Receives.Region = region
 	n.DeleteSubnetworkCall.Receives.Subnetwork = subnetwork
@@ -1,5 +1,7 @@
 package fakes
 
+import "context"
+
 import gcpcompute "google.golang.org/api/compute/v1"
 
 type TargetHttpProxiesClient struct {
@@ -28,7 +30,7 @@ func (t *TargetHttpProxiesClient) ListTargetHttpProxies() (*gcpcompute.TargetHtt
 	return t.ListTargetHttpProxiesCall.Returns.Output, t.ListTargetHttpProxiesCall.Returns.Error
 }
 
-func (t *TargetHttpProxiesClient) DeleteTargetHttpProxy(targetHttpProxy string) error {
+func (t *TargetHttpProxiesClient) DeleteTargetHttpProxy(ctx context.Context, targetHttpProxy string) error {
 	t.DeleteTargetHttpProxyCall.CallCount++
 	t.DeleteTargetHttpProxyCall.Receives.TargetHttpProxy = targetHttpProxy
 
@@ -1,5 +1,7 @@
 package fakes
 
+import "context"
+
 import gcpcompute "google.golang.org/api/compute/v1"
 
 type TargetHttpsProxiesClient struct {
@@ -28,7 +30,7 @@ func (t *TargetHttpsProxiesClient) ListTargetHttpsProxies() (*gcpcompute.TargetH
 	return t.ListTargetHttpsProxiesCall.Returns.Output, t.ListTargetHttpsProxiesCall.Returns.Error
 }
 
-func (t *TargetHttpsProxiesClient) DeleteTargetHttpsProxy(targetHttpsProxy string) error {
+func (t *TargetHttpsProxiesClient) DeleteTargetHttpsProxy(ctx context.Context, targetHttpsProxy string) error {
 	t.DeleteTargetHttpsProxyCall.CallCount++
 	t.DeleteTargetHttpsProxyCall.Receives.TargetHttpsProxy = targetHttpsProxy
 
@@ -1,5 +1,7 @@
 package fakes
 
+import "context"
+
 import gcpcompute "google.golang.org/api/compute/v1"
 
 type TargetPoolsClient struct {
@@ -33,7 +35,7 @@ func (n *TargetPoolsClient) ListTargetPools(region string) (*gcpcompute.TargetPo
 	return n.ListTargetPoolsCall.Returns.Output, n.ListTargetPoolsCall.Returns.Error
 }
 
-func (n *TargetPoolsClient) DeleteTargetPool(region, targetPool string) error {
+func (n *TargetPoolsClient) DeleteTargetPool(ctx context.Context, region, targetPool string) error {
 	n.DeleteTargetPoolCall.CallCount++
 	n.DeleteTargetPoolCall.Receives.Region = region
 	n.DeleteTargetPoolCall.Receives.TargetPool = targetPool
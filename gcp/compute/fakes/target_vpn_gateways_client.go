@@ -1,5 +1,7 @@
 package fakes
 
+import "context"
+
 import gcpcompute "google.golang.org/api/compute/v1"
 
 type TargetVpnGatewaysClient struct {
@@ -33,7 +35,7 @@ func (u *TargetVpnGatewaysClient) ListTargetVpnGateways(region string) ([]*gcpco
 	return u.ListTargetVpnGatewaysCall.Returns.Output, u.ListTargetVpnGatewaysCall.Returns.Error
 }
 
-func (u *TargetVpnGatewaysClient) DeleteTargetVpnGateway(region, targetVpnGateway string) error {
+func (u *TargetVpnGatewaysClient) DeleteTargetVpnGateway(ctx context.Context, region, targetVpnGateway string) error {
 	u.DeleteTargetVpnGatewayCall.CallCount++
 	u.DeleteTargetVpnGatewayCall.Receives.Region = region
 	u.DeleteTargetVpnGatewayCall.Receives.TargetVpnGateway = targetVpnGateway
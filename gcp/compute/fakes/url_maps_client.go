@@ -1,5 +1,7 @@
 package fakes
 
+import "context"
+
 import gcpcompute "google.golang.org/api/compute/v1"
 
 type UrlMapsClient struct {
@@ -28,7 +30,7 @@ func (u *UrlMapsClient) ListUrlMaps() (*gcpcompute.UrlMapList, error) {
 	return u.ListUrlMapsCall.Returns.Output, u.ListUrlMapsCall.Returns.Error
 }
 
-func (u *UrlMapsClient) DeleteUrlMap(urlMap string) error {
+func (u *UrlMapsClient) DeleteUrlMap(ctx context.Context, urlMap string) error {
 	u.DeleteUrlMapCall.CallCount++
 	u.DeleteUrlMapCall.Receives.UrlMap = urlMap
 
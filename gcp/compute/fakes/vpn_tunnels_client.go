@@ -1,5 +1,7 @@
 package fakes
 
+import "context"
+
 import gcpcompute "google.golang.org/api/compute/v1"
 
 type VpnTunnelsClient struct {
@@ -33,7 +35,7 @@ func (u *VpnTunnelsClient) ListVpnTunnels(region string) ([]*gcpcompute.VpnTunne
 	return u.ListVpnTunnelsCall.Returns.Output, u.ListVpnTunnelsCall.Returns.Error
 }
 
-func (u *VpnTunnelsClient) DeleteVpnTunnel(region, vpnTunnel string) error {
+func (u *VpnTunnelsClient) DeleteVpnTunnel(ctx context.Context, region, vpnTunnel string) error {
 	u.DeleteVpnTunnelCall.CallCount++
 	u.DeleteVpnTunnelCall.Receives.Region = region
 	u.DeleteVpnTunnelCall.Receives.VpnTunnel = vpnTunnel
@@ -1,6 +1,9 @@
 package compute
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 type Firewall struct {
 	client firewallsClient
@@ -15,7 +18,11 @@ func NewFirewall(client firewallsClient, name string) Firewall {
 }
 
 func (f Firewall) Delete() error {
-	err := f.client.DeleteFirewall(f.name)
+	return f.DeleteContext(context.Background())
+}
+
+func (f Firewall) DeleteContext(ctx context.Context) error {
+	err := f.client.DeleteFirewall(ctx, f.name)
 
 	if err != nil {
 		return fmt.Errorf("Delete: %s", err)
@@ -1,6 +1,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -10,7 +11,7 @@ import (
 
 type firewallsClient interface {
 	ListFirewalls() ([]*gcpcompute.Firewall, error)
-	DeleteFirewall(firewall string) error
+	DeleteFirewall(ctx context.Context, firewall string) error
 }
 
 type Firewalls struct {
@@ -1,6 +1,9 @@
 package compute
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 type ForwardingRule struct {
 	client forwardingRulesClient
@@ -17,7 +20,11 @@ func NewForwardingRule(client forwardingRulesClient, name, region string) Forwar
 }
 
 func (f ForwardingRule) Delete() error {
-	err := f.client.DeleteForwardingRule(f.region, f.name)
+	return f.DeleteContext(context.Background())
+}
+
+func (f ForwardingRule) DeleteContext(ctx context.Context) error {
+	err := f.client.DeleteForwardingRule(ctx, f.region, f.name)
 	if err != nil {
 		return fmt.Errorf("Delete: %s", err)
 	}
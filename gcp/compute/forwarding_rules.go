@@ -1,6 +1,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -10,7 +11,7 @@ import (
 
 type forwardingRulesClient interface {
 	ListForwardingRules(region string) ([]*gcpcompute.ForwardingRule, error)
-	DeleteForwardingRule(region, rule string) error
+	DeleteForwardingRule(ctx context.Context, region, rule string) error
 }
 
 type ForwardingRules struct {
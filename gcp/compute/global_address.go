@@ -1,6 +1,9 @@
 package compute
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 type GlobalAddress struct {
 	client globalAddressesClient
@@ -15,7 +18,11 @@ func NewGlobalAddress(client globalAddressesClient, name string) GlobalAddress {
 }
 
 func (g GlobalAddress) Delete() error {
-	err := g.client.DeleteGlobalAddress(g.name)
+	return g.DeleteContext(context.Background())
+}
+
+func (g GlobalAddress) DeleteContext(ctx context.Context) error {
+	err := g.client.DeleteGlobalAddress(ctx, g.name)
 
 	if err != nil {
 		return fmt.Errorf("Delete: %s", err)
@@ -1,6 +1,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -10,7 +11,7 @@ import (
 
 type globalAddressesClient interface {
 	ListGlobalAddresses() ([]*gcpcompute.Address, error)
-	DeleteGlobalAddress(address string) error
+	DeleteGlobalAddress(ctx context.Context, address string) error
 }
 
 type GlobalAddresses struct {
@@ -1,6 +1,9 @@
 package compute
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 type GlobalForwardingRule struct {
 	client globalForwardingRulesClient
@@ -15,7 +18,11 @@ func NewGlobalForwardingRule(client globalForwardingRulesClient, name string) Gl
 }
 
 func (g GlobalForwardingRule) Delete() error {
-	err := g.client.DeleteGlobalForwardingRule(g.name)
+	return g.DeleteContext(context.Background())
+}
+
+func (g GlobalForwardingRule) DeleteContext(ctx context.Context) error {
+	err := g.client.DeleteGlobalForwardingRule(ctx, g.name)
 
 	if err != nil {
 		return fmt.Errorf("Delete: %s", err)
@@ -1,6 +1,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -10,7 +11,7 @@ import (
 
 type globalForwardingRulesClient interface {
 	ListGlobalForwardingRules() ([]*gcpcompute.ForwardingRule, error)
-	DeleteGlobalForwardingRule(rule string) error
+	DeleteGlobalForwardingRule(ctx context.Context, rule string) error
 }
 
 type GlobalForwardingRules struct {
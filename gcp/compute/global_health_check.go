@@ -1,6 +1,9 @@
 package compute
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 type GlobalHealthCheck struct {
 	client globalHealthChecksClient
@@ -15,7 +18,11 @@ func NewGlobalHealthCheck(client globalHealthChecksClient, name string) GlobalHe
 }
 
 func (g GlobalHealthCheck) Delete() error {
-	err := g.client.DeleteGlobalHealthCheck(g.name)
+	return g.DeleteContext(context.Background())
+}
+
+func (g GlobalHealthCheck) DeleteContext(ctx context.Context) error {
+	err := g.client.DeleteGlobalHealthCheck(ctx, g.name)
 
 	if err != nil {
 		return fmt.Errorf("Delete: %s", err)
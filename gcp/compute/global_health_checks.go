@@ -1,6 +1,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -10,7 +11,7 @@ import (
 
 type globalHealthChecksClient interface {
 	ListGlobalHealthChecks() ([]*gcpcompute.HealthCheck, error)
-	DeleteGlobalHealthCheck(globalHealthCheck string) error
+	DeleteGlobalHealthCheck(ctx context.Context, globalHealthCheck string) error
 }
 
 type GlobalHealthChecks struct {
@@ -1,6 +1,9 @@
 package compute
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 type HttpHealthCheck struct {
 	client httpHealthChecksClient
@@ -15,7 +18,11 @@ func NewHttpHealthCheck(client httpHealthChecksClient, name string) HttpHealthCh
 }
 
 func (h HttpHealthCheck) Delete() error {
-	err := h.client.DeleteHttpHealthCheck(h.name)
+	return h.DeleteContext(context.Background())
+}
+
+func (h HttpHealthCheck) DeleteContext(ctx context.Context) error {
+	err := h.client.DeleteHttpHealthCheck(ctx, h.name)
 
 	if err != nil {
 		return fmt.Errorf("Delete: %s", err)
@@ -1,6 +1,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -10,7 +11,7 @@ import (
 
 type httpHealthChecksClient interface {
 	ListHttpHealthChecks() ([]*gcpcompute.HttpHealthCheck, error)
-	DeleteHttpHealthCheck(httpHealthCheck string) error
+	DeleteHttpHealthCheck(ctx context.Context, httpHealthCheck string) error
 }
 
 type HttpHealthChecks struct {
@@ -1,6 +1,9 @@
 package compute
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 type HttpsHealthCheck struct {
 	client httpsHealthChecksClient
@@ -15,7 +18,11 @@ func NewHttpsHealthCheck(client httpsHealthChecksClient, name string) HttpsHealt
 }
 
 func (h HttpsHealthCheck) Delete() error {
-	err := h.client.DeleteHttpsHealthCheck(h.name)
+	return h.DeleteContext(context.Background())
+}
+
+func (h HttpsHealthCheck) DeleteContext(ctx context.Context) error {
+	err := h.client.DeleteHttpsHealthCheck(ctx, h.name)
 
 	if err != nil {
 		return fmt.Errorf("Delete: %s", err)
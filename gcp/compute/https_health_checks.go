@@ -1,6 +1,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -10,7 +11,7 @@ import (
 
 type httpsHealthChecksClient interface {
 	ListHttpsHealthChecks() ([]*gcpcompute.HttpsHealthCheck, error)
-	DeleteHttpsHealthCheck(httpsHealthCheck string) error
+	DeleteHttpsHealthCheck(ctx context.Context, httpsHealthCheck string) error
 }
 
 type HttpsHealthChecks struct {
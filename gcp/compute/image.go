@@ -1,6 +1,9 @@
 package compute
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 type Image struct {
 	client imagesClient
@@ -15,7 +18,11 @@ func NewImage(client imagesClient, name string) Image {
 }
 
 func (i Image) Delete() error {
-	err := i.client.DeleteImage(i.name)
+	return i.DeleteContext(context.Background())
+}
+
+func (i Image) DeleteContext(ctx context.Context) error {
+	err := i.client.DeleteImage(ctx, i.name)
 
 	if err != nil {
 		return fmt.Errorf("Delete: %s", err)
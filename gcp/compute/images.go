@@ -1,6 +1,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -10,7 +11,7 @@ import (
 
 type imagesClient interface {
 	ListImages() ([]*gcpcompute.Image, error)
-	DeleteImage(image string) error
+	DeleteImage(ctx context.Context, image string) error
 }
 
 type Images struct {
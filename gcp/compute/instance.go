@@ -1,9 +1,11 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/genevieve/leftovers/bosh"
 	gcpcompute "google.golang.org/api/compute/v1"
 )
 
@@ -14,7 +16,7 @@ type Instance struct {
 	zone        string
 }
 
-func NewInstance(client instancesClient, name, zone string, tags *gcpcompute.Tags) Instance {
+func NewInstance(client instancesClient, name, zone string, tags *gcpcompute.Tags, labels map[string]string) Instance {
 	clearerName := name
 
 	extra := []string{}
@@ -24,6 +26,8 @@ func NewInstance(client instancesClient, name, zone string, tags *gcpcompute.Tag
 		}
 	}
 
+	extra = append(extra, bosh.Metadata(labels)...)
+
 	if len(extra) > 0 {
 		clearerName = fmt.Sprintf("%s (%s)", name, strings.Join(extra, ", "))
 	}
@@ -37,7 +41,11 @@ func NewInstance(client instancesClient, name, zone string, tags *gcpcompute.Tag
 }
 
 func (i Instance) Delete() error {
-	err := i.client.DeleteInstance(i.zone, i.name)
+	return i.DeleteContext(context.Background())
+}
+
+func (i Instance) DeleteContext(ctx context.Context) error {
+	err := i.client.DeleteInstance(ctx, i.zone, i.name)
 
 	if err != nil {
 		return fmt.Errorf("Delete: %s", err)
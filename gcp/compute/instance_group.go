@@ -1,6 +1,9 @@
 package compute
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 type InstanceGroup struct {
 	client instanceGroupsClient
@@ -17,7 +20,11 @@ func NewInstanceGroup(client instanceGroupsClient, name, zone string) InstanceGr
 }
 
 func (i InstanceGroup) Delete() error {
-	err := i.client.DeleteInstanceGroup(i.zone, i.name)
+	return i.DeleteContext(context.Background())
+}
+
+func (i InstanceGroup) DeleteContext(ctx context.Context) error {
+	err := i.client.DeleteInstanceGroup(ctx, i.zone, i.name)
 
 	if err != nil {
 		return fmt.Errorf("Delete: %s", err)
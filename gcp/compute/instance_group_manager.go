@@ -1,6 +1,9 @@
 package compute
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 type InstanceGroupManager struct {
 	client instanceGroupManagersClient
@@ -17,7 +20,11 @@ func NewInstanceGroupManager(client instanceGroupManagersClient, name, zone stri
 }
 
 func (i InstanceGroupManager) Delete() error {
-	err := i.client.DeleteInstanceGroupManager(i.zone, i.name)
+	return i.DeleteContext(context.Background())
+}
+
+func (i InstanceGroupManager) DeleteContext(ctx context.Context) error {
+	err := i.client.DeleteInstanceGroupManager(ctx, i.zone, i.name)
 
 	if err != nil {
 		return fmt.Errorf("Delete: %s", err)
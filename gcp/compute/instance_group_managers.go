@@ -1,6 +1,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -10,7 +11,7 @@ import (
 
 type instanceGroupManagersClient interface {
 	ListInstanceGroupManagers(zone string) ([]*gcpcompute.InstanceGroupManager, error)
-	DeleteInstanceGroupManager(zone, instanceGroupManager string) error
+	DeleteInstanceGroupManager(ctx context.Context, zone, instanceGroupManager string) error
 }
 
 type InstanceGroupManagers struct {
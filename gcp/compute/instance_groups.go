@@ -1,6 +1,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -10,7 +11,7 @@ import (
 
 type instanceGroupsClient interface {
 	ListInstanceGroups(zone string) ([]*gcpcompute.InstanceGroup, error)
-	DeleteInstanceGroup(zone, instanceGroup string) error
+	DeleteInstanceGroup(ctx context.Context, zone, instanceGroup string) error
 }
 
 type InstanceGroups struct {
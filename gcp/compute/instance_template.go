@@ -1,6 +1,9 @@
 package compute
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 type InstanceTemplate struct {
 	client instanceTemplatesClient
@@ -15,7 +18,11 @@ func NewInstanceTemplate(client instanceTemplatesClient, name string) InstanceTe
 }
 
 func (i InstanceTemplate) Delete() error {
-	err := i.client.DeleteInstanceTemplate(i.name)
+	return i.DeleteContext(context.Background())
+}
+
+func (i InstanceTemplate) DeleteContext(ctx context.Context) error {
+	err := i.client.DeleteInstanceTemplate(ctx, i.name)
 
 	if err != nil {
 		return fmt.Errorf("Delete: %s", err)
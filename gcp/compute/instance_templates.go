@@ -1,6 +1,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -10,7 +11,7 @@ import (
 
 type instanceTemplatesClient interface {
 	ListInstanceTemplates() ([]*gcpcompute.InstanceTemplate, error)
-	DeleteInstanceTemplate(template string) error
+	DeleteInstanceTemplate(ctx context.Context, template string) error
 }
 
 type InstanceTemplates struct {
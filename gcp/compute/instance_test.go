@@ -17,6 +17,7 @@ var _ = Describe("Instance", func() {
 		name   string
 		zone   string
 		tags   *gcpcompute.Tags
+		labels map[string]string
 
 		instance compute.Instance
 	)
@@ -26,8 +27,9 @@ var _ = Describe("Instance", func() {
 		name = "banana"
 		zone = "zone"
 		tags = &gcpcompute.Tags{Items: []string{"tag-1"}}
+		labels = map[string]string{}
 
-		instance = compute.NewInstance(client, name, zone, tags)
+		instance = compute.NewInstance(client, name, zone, tags, labels)
 	})
 
 	Describe("Delete", func() {
@@ -56,6 +58,17 @@ var _ = Describe("Instance", func() {
 		It("returns the name", func() {
 			Expect(instance.Name()).To(Equal("banana (tag-1)"))
 		})
+
+		Context("when the instance has bosh labels", func() {
+			BeforeEach(func() {
+				labels = map[string]string{"director": "bosh-banana", "deployment": "cf", "index": "0"}
+				instance = compute.NewInstance(client, name, zone, tags, labels)
+			})
+
+			It("includes the director and deployment", func() {
+				Expect(instance.Name()).To(Equal("banana (tag-1, director:bosh-banana, deployment:cf)"))
+			})
+		})
 	})
 
 	Describe("Type", func() {
@@ -1,6 +1,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -10,7 +11,7 @@ import (
 
 type instancesClient interface {
 	ListInstances(zone string) ([]*gcpcompute.Instance, error)
-	DeleteInstance(zone, instance string) error
+	DeleteInstance(ctx context.Context, zone, instance string) error
 }
 
 type Instances struct {
@@ -40,7 +41,7 @@ func (i Instances) List(filter string) ([]common.Deletable, error) {
 
 	var resources []common.Deletable
 	for _, instance := range instances {
-		resource := NewInstance(i.client, instance.Name, i.zones[instance.Zone], instance.Tags)
+		resource := NewInstance(i.client, instance.Name, i.zones[instance.Zone], instance.Tags, instance.Labels)
 
 		if !strings.Contains(resource.Name(), filter) {
 			continue
@@ -1,6 +1,9 @@
 package compute
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 type Network struct {
 	client networksClient
@@ -15,7 +18,11 @@ func NewNetwork(client networksClient, name string) Network {
 }
 
 func (n Network) Delete() error {
-	err := n.client.DeleteNetwork(n.name)
+	return n.DeleteContext(context.Background())
+}
+
+func (n Network) DeleteContext(ctx context.Context) error {
+	err := n.client.DeleteNetwork(ctx, n.name)
 
 	if err != nil {
 		return fmt.Errorf("Delete: %s", err)
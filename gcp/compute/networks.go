@@ -1,6 +1,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -10,7 +11,7 @@ import (
 
 type networksClient interface {
 	ListNetworks() ([]*gcp.Network, error)
-	DeleteNetwork(network string) error
+	DeleteNetwork(ctx context.Context, network string) error
 }
 
 type Networks struct {
@@ -1,10 +1,11 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
-	"github.com/genevieve/leftovers/gcp/common"
+	"github.com/genevieve/leftovers/common"
 
 	gcpcompute "google.golang.org/api/compute/v1"
 )
@@ -25,10 +26,10 @@ func NewOperationWaiter(op *gcpcompute.Operation, service *gcpcompute.Service, p
 	}
 }
 
-func (w *operationWaiter) Wait() error {
-	state := common.NewState(w.logger, w.refreshFunc())
+func (w *operationWaiter) Wait(ctx context.Context) error {
+	poller := common.NewPoller(w.logger, w.refreshFunc(), []string{"PENDING", "RUNNING"}, []string{"DONE"})
 
-	raw, err := state.Wait()
+	raw, err := poller.Wait(ctx)
 	if err != nil {
 		return fmt.Errorf("Waiting for operation to complete: %s", err)
 	}
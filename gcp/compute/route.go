@@ -1,6 +1,9 @@
 package compute
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 type Route struct {
 	client routesClient
@@ -15,7 +18,11 @@ func NewRoute(client routesClient, name string) Route {
 }
 
 func (r Route) Delete() error {
-	err := r.client.DeleteRoute(r.name)
+	return r.DeleteContext(context.Background())
+}
+
+func (r Route) DeleteContext(ctx context.Context) error {
+	err := r.client.DeleteRoute(ctx, r.name)
 
 	if err != nil {
 		return fmt.Errorf("Delete: %s", err)
@@ -1,6 +1,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -10,7 +11,7 @@ import (
 
 type routesClient interface {
 	ListRoutes() ([]*gcpcompute.Route, error)
-	DeleteRoute(route string) error
+	DeleteRoute(ctx context.Context, route string) error
 }
 
 type Routes struct {
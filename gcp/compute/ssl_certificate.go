@@ -1,6 +1,9 @@
 package compute
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 type SslCertificate struct {
 	client sslCertificatesClient
@@ -15,7 +18,11 @@ func NewSslCertificate(client sslCertificatesClient, name string) SslCertificate
 }
 
 func (s SslCertificate) Delete() error {
-	err := s.client.DeleteSslCertificate(s.name)
+	return s.DeleteContext(context.Background())
+}
+
+func (s SslCertificate) DeleteContext(ctx context.Context) error {
+	err := s.client.DeleteSslCertificate(ctx, s.name)
 	if err != nil {
 		return fmt.Errorf("Delete: %s", err)
 	}
@@ -1,6 +1,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -10,7 +11,7 @@ import (
 
 type sslCertificatesClient interface {
 	ListSslCertificates() ([]*gcpcompute.SslCertificate, error)
-	DeleteSslCertificate(certificate string) error
+	DeleteSslCertificate(ctx context.Context, certificate string) error
 }
 
 type SslCertificates struct {
@@ -1,6 +1,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"strings"
 )
@@ -29,7 +30,11 @@ func NewSubnetwork(client subnetworksClient, name, region, networkUrl string) Su
 }
 
 func (s Subnetwork) Delete() error {
-	err := s.client.DeleteSubnetwork(s.region, s.name)
+	return s.DeleteContext(context.Background())
+}
+
+func (s Subnetwork) DeleteContext(ctx context.Context) error {
+	err := s.client.DeleteSubnetwork(ctx, s.region, s.name)
 
 	if err != nil {
 		if strings.Contains(err.Error(), "delete auto subnetwork") {
@@ -1,6 +1,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -10,7 +11,7 @@ import (
 
 type subnetworksClient interface {
 	ListSubnetworks(region string) ([]*gcpcompute.Subnetwork, error)
-	DeleteSubnetwork(region, network string) error
+	DeleteSubnetwork(ctx context.Context, region, network string) error
 }
 
 type Subnetworks struct {
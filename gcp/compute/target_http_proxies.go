@@ -1,6 +1,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -10,7 +11,7 @@ import (
 
 type targetHttpProxiesClient interface {
 	ListTargetHttpProxies() (*gcpcompute.TargetHttpProxyList, error)
-	DeleteTargetHttpProxy(targetHttpProxy string) error
+	DeleteTargetHttpProxy(ctx context.Context, targetHttpProxy string) error
 }
 
 type TargetHttpProxies struct {
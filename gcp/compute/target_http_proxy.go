@@ -1,6 +1,9 @@
 package compute
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 type TargetHttpProxy struct {
 	client targetHttpProxiesClient
@@ -15,7 +18,11 @@ func NewTargetHttpProxy(client targetHttpProxiesClient, name string) TargetHttpP
 }
 
 func (t TargetHttpProxy) Delete() error {
-	err := t.client.DeleteTargetHttpProxy(t.name)
+	return t.DeleteContext(context.Background())
+}
+
+func (t TargetHttpProxy) DeleteContext(ctx context.Context) error {
+	err := t.client.DeleteTargetHttpProxy(ctx, t.name)
 
 	if err != nil {
 		return fmt.Errorf("Delete: %s", err)
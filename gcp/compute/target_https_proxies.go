@@ -1,6 +1,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -10,7 +11,7 @@ import (
 
 type targetHttpsProxiesClient interface {
 	ListTargetHttpsProxies() (*gcpcompute.TargetHttpsProxyList, error)
-	DeleteTargetHttpsProxy(targetHttpsProxy string) error
+	DeleteTargetHttpsProxy(ctx context.Context, targetHttpsProxy string) error
 }
 
 type TargetHttpsProxies struct {
@@ -1,6 +1,9 @@
 package compute
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 type TargetHttpsProxy struct {
 	client targetHttpsProxiesClient
@@ -15,7 +18,11 @@ func NewTargetHttpsProxy(client targetHttpsProxiesClient, name string) TargetHtt
 }
 
 func (t TargetHttpsProxy) Delete() error {
-	err := t.client.DeleteTargetHttpsProxy(t.name)
+	return t.DeleteContext(context.Background())
+}
+
+func (t TargetHttpsProxy) DeleteContext(ctx context.Context) error {
+	err := t.client.DeleteTargetHttpsProxy(ctx, t.name)
 
 	if err != nil {
 		return fmt.Errorf("Delete: %s", err)
@@ -1,6 +1,9 @@
 package compute
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 type TargetPool struct {
 	client targetPoolsClient
@@ -17,7 +20,11 @@ func NewTargetPool(client targetPoolsClient, name, region string) TargetPool {
 }
 
 func (t TargetPool) Delete() error {
-	err := t.client.DeleteTargetPool(t.region, t.name)
+	return t.DeleteContext(context.Background())
+}
+
+func (t TargetPool) DeleteContext(ctx context.Context) error {
+	err := t.client.DeleteTargetPool(ctx, t.region, t.name)
 
 	if err != nil {
 		return fmt.Errorf("Delete: %s", err)
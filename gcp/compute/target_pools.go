@@ -1,6 +1,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -10,7 +11,7 @@ import (
 
 type targetPoolsClient interface {
 	ListTargetPools(region string) (*gcpcompute.TargetPoolList, error)
-	DeleteTargetPool(region string, targetPool string) error
+	DeleteTargetPool(ctx context.Context, region string, targetPool string) error
 }
 
 type TargetPools struct {
@@ -1,6 +1,9 @@
 package compute
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 type TargetVpnGateway struct {
 	client targetVpnGatewaysClient
@@ -17,7 +20,11 @@ func NewTargetVpnGateway(client targetVpnGatewaysClient, name, region string) Ta
 }
 
 func (t TargetVpnGateway) Delete() error {
-	err := t.client.DeleteTargetVpnGateway(t.region, t.name)
+	return t.DeleteContext(context.Background())
+}
+
+func (t TargetVpnGateway) DeleteContext(ctx context.Context) error {
+	err := t.client.DeleteTargetVpnGateway(ctx, t.region, t.name)
 
 	if err != nil {
 		return fmt.Errorf("Delete: %s", err)
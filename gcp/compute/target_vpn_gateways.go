@@ -1,6 +1,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -10,7 +11,7 @@ import (
 
 type targetVpnGatewaysClient interface {
 	ListTargetVpnGateways(region string) ([]*gcpcompute.TargetVpnGateway, error)
-	DeleteTargetVpnGateway(region, targetVpnGateway string) error
+	DeleteTargetVpnGateway(ctx context.Context, region, targetVpnGateway string) error
 }
 
 type TargetVpnGateways struct {
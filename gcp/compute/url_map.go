@@ -1,6 +1,9 @@
 package compute
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 type UrlMap struct {
 	client urlMapsClient
@@ -15,7 +18,11 @@ func NewUrlMap(client urlMapsClient, name string) UrlMap {
 }
 
 func (u UrlMap) Delete() error {
-	err := u.client.DeleteUrlMap(u.name)
+	return u.DeleteContext(context.Background())
+}
+
+func (u UrlMap) DeleteContext(ctx context.Context) error {
+	err := u.client.DeleteUrlMap(ctx, u.name)
 
 	if err != nil {
 		return fmt.Errorf("Delete: %s", err)
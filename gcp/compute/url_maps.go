@@ -1,6 +1,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -10,7 +11,7 @@ import (
 
 type urlMapsClient interface {
 	ListUrlMaps() (*gcpcompute.UrlMapList, error)
-	DeleteUrlMap(urlMap string) error
+	DeleteUrlMap(ctx context.Context, urlMap string) error
 }
 
 type UrlMaps struct {
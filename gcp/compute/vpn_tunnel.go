@@ -1,6 +1,9 @@
 package compute
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 type VpnTunnel struct {
 	client vpnTunnelsClient
@@ -17,7 +20,11 @@ func NewVpnTunnel(client vpnTunnelsClient, name, region string) VpnTunnel {
 }
 
 func (v VpnTunnel) Delete() error {
-	err := v.client.DeleteVpnTunnel(v.region, v.name)
+	return v.DeleteContext(context.Background())
+}
+
+func (v VpnTunnel) DeleteContext(ctx context.Context) error {
+	err := v.client.DeleteVpnTunnel(ctx, v.region, v.name)
 
 	if err != nil {
 		return fmt.Errorf("Delete: %s", err)
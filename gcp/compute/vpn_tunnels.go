@@ -1,6 +1,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -10,7 +11,7 @@ import (
 
 type vpnTunnelsClient interface {
 	ListVpnTunnels(region string) ([]*gcpcompute.VpnTunnel, error)
-	DeleteVpnTunnel(region, vpnTunnel string) error
+	DeleteVpnTunnel(ctx context.Context, region, vpnTunnel string) error
 }
 
 type VpnTunnels struct {
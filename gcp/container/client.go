@@ -1,6 +1,7 @@
 package container
 
 import (
+	"context"
 	"fmt"
 
 	gcpcontainer "google.golang.org/api/container/v1"
@@ -29,15 +30,15 @@ func (c client) ListClusters(zone string) (*gcpcontainer.ListClustersResponse, e
 	return c.containers.List(c.project, zone).Do()
 }
 
-func (c client) DeleteCluster(zone string, cluster string) error {
-	return c.wait(c.containers.Delete(c.project, zone, cluster))
+func (c client) DeleteCluster(ctx context.Context, zone string, cluster string) error {
+	return c.wait(ctx, c.containers.Delete(c.project, zone, cluster))
 }
 
 type request interface {
 	Do(...googleapi.CallOption) (*gcpcontainer.Operation, error)
 }
 
-func (c client) wait(request request) error {
+func (c client) wait(ctx context.Context, request request) error {
 	op, err := request.Do()
 	if err != nil {
 		if gerr, ok := err.(*googleapi.Error); ok {
@@ -50,5 +51,5 @@ func (c client) wait(request request) error {
 
 	waiter := NewOperationWaiter(op, c.service, c.project, c.logger)
 
-	return waiter.Wait()
+	return waiter.Wait(ctx)
 }
@@ -1,6 +1,9 @@
 package container
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 type Cluster struct {
 	name   string
@@ -17,7 +20,11 @@ func NewCluster(client clustersClient, zone string, name string) Cluster {
 }
 
 func (c Cluster) Delete() error {
-	err := c.client.DeleteCluster(c.zone, c.name)
+	return c.DeleteContext(context.Background())
+}
+
+func (c Cluster) DeleteContext(ctx context.Context) error {
+	err := c.client.DeleteCluster(ctx, c.zone, c.name)
 	if err != nil {
 		return fmt.Errorf("Delete: %s", err)
 	}
@@ -1,6 +1,7 @@
 package container
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -16,7 +17,7 @@ type Clusters struct {
 
 type clustersClient interface {
 	ListClusters(zone string) (*gcpcontainer.ListClustersResponse, error)
-	DeleteCluster(zone, cluster string) error
+	DeleteCluster(ctx context.Context, zone, cluster string) error
 }
 
 func NewClusters(client clustersClient, zones map[string]string, logger logger) Clusters {
@@ -1,6 +1,7 @@
 package fakes
 
 import (
+	"context"
 	gcpcontainer "google.golang.org/api/container/v1"
 )
 
@@ -34,7 +35,7 @@ func (c *ClustersClient) ListClusters(zone string) (*gcpcontainer.ListClustersRe
 	return c.ListClustersCall.Returns.Output, c.ListClustersCall.Returns.Error
 }
 
-func (c *ClustersClient) DeleteCluster(zone string, cluster string) error {
+func (c *ClustersClient) DeleteCluster(ctx context.Context, zone string, cluster string) error {
 	c.DeleteClusterCall.CallCount++
 	c.DeleteClusterCall.Receives.Zone = zone
 	c.DeleteClusterCall.Receives.Cluster = cluster
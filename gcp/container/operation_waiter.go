@@ -1,9 +1,10 @@
 package container
 
 import (
+	"context"
 	"fmt"
 
-	"github.com/genevieve/leftovers/gcp/common"
+	"github.com/genevieve/leftovers/common"
 
 	gcpcontainer "google.golang.org/api/container/v1"
 )
@@ -24,10 +25,10 @@ func NewOperationWaiter(op *gcpcontainer.Operation, service *gcpcontainer.Servic
 	}
 }
 
-func (w *operationWaiter) Wait() error {
-	state := common.NewState(w.logger, w.refreshFunc())
+func (w *operationWaiter) Wait(ctx context.Context) error {
+	poller := common.NewPoller(w.logger, w.refreshFunc(), []string{"PENDING", "RUNNING", "ABORTING"}, []string{"DONE"})
 
-	raw, err := state.Wait()
+	raw, err := poller.Wait(ctx)
 	if err != nil {
 		return fmt.Errorf("Waiting for operation to complete: %s", err)
 	}
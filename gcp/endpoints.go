@@ -0,0 +1,34 @@
+package gcp
+
+import (
+	"net/url"
+	"strings"
+)
+
+// Endpoints are the URLs that requests are sent to instead of Google, ie.
+// for an emulator or a private endpoint. Services maps the name of an API,
+// like compute, storage or sqladmin, to its base URL. Default replaces the
+// scheme and host of the APIs that are not in Services, keeping their paths.
+type Endpoints struct {
+	Default  string
+	Services map[string]string
+}
+
+// BasePath returns the base URL of the provided API, given the
+// base URL that its client uses by default.
+func (e Endpoints) BasePath(api, basePath string) string {
+	if endpoint, ok := e.Services[api]; ok {
+		return strings.TrimSuffix(endpoint, "/") + "/"
+	}
+
+	if e.Default == "" {
+		return basePath
+	}
+
+	u, err := url.Parse(basePath)
+	if err != nil {
+		return basePath
+	}
+
+	return strings.TrimSuffix(e.Default, "/") + u.Path
+}
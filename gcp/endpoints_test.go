@@ -0,0 +1,39 @@
+package gcp_test
+
+import (
+	"github.com/genevieve/leftovers/gcp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Endpoints", func() {
+	var endpoints gcp.Endpoints
+
+	BeforeEach(func() {
+		endpoints = gcp.Endpoints{
+			Services: map[string]string{"storage": "http://localhost:4443/storage/v1"},
+		}
+	})
+
+	Describe("BasePath", func() {
+		It("returns the base URL of the APIs with endpoints", func() {
+			Expect(endpoints.BasePath("storage", "https://www.googleapis.com/storage/v1/")).To(Equal("http://localhost:4443/storage/v1/"))
+		})
+
+		It("returns the default base URL of the other APIs", func() {
+			Expect(endpoints.BasePath("compute", "https://www.googleapis.com/compute/v1/projects/")).To(Equal("https://www.googleapis.com/compute/v1/projects/"))
+		})
+
+		Context("when there is a default endpoint", func() {
+			BeforeEach(func() {
+				endpoints.Default = "https://private.googleapis.com/"
+			})
+
+			It("replaces the scheme and host of the other APIs", func() {
+				Expect(endpoints.BasePath("compute", "https://www.googleapis.com/compute/v1/projects/")).To(Equal("https://private.googleapis.com/compute/v1/projects/"))
+				Expect(endpoints.BasePath("storage", "https://www.googleapis.com/storage/v1/")).To(Equal("http://localhost:4443/storage/v1/"))
+			})
+		})
+	})
+})
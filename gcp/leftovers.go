@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"net/http"
 
 	homedir "github.com/mitchellh/go-homedir"
 
@@ -18,6 +19,7 @@ import (
 	"github.com/genevieve/leftovers/gcp/iam"
 	"github.com/genevieve/leftovers/gcp/sql"
 	"github.com/genevieve/leftovers/gcp/storage"
+	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/google"
 	gcpcrm "google.golang.org/api/cloudresourcemanager/v1"
 	gcpcompute "google.golang.org/api/compute/v1"
@@ -42,7 +44,11 @@ type Leftovers struct {
 // NewLeftovers returns a new Leftovers for GCP that can be used to list resources,
 // list types, or delete resources for the provided account. It returns an error
 // if the credentials provided are invalid or if a client fails to be created.
-func NewLeftovers(logger logger, keyPath string) (Leftovers, error) {
+// If backup is enabled, stateful resources are snapshotted or archived
+// before they are deleted. APIs are sent requests at the provided endpoints
+// instead of Google, if any. If a transport is provided, it wraps the
+// transport that requests are sent with.
+func NewLeftovers(logger logger, keyPath string, endpoints Endpoints, transport common.WrapTransport, backup common.Backup) (Leftovers, error) {
 	if keyPath == "" {
 		return Leftovers{}, errors.New("Missing service account key path.")
 	}
@@ -72,47 +78,57 @@ func NewLeftovers(logger logger, keyPath string) (Leftovers, error) {
 		return Leftovers{}, fmt.Errorf("Creating jwt config: %s", err)
 	}
 
-	httpClient := config.Client(context.Background())
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, &http.Client{
+		Transport: transport.Wrap(http.DefaultTransport),
+	})
+	httpClient := config.Client(ctx)
 
 	service, err := gcpcompute.New(httpClient)
 	if err != nil {
 		return Leftovers{}, err
 	}
+	service.BasePath = endpoints.BasePath("compute", service.BasePath)
 	client := compute.NewClient(p.ProjectId, service, logger)
 
 	dnsService, err := gcpdns.New(httpClient)
 	if err != nil {
 		return Leftovers{}, err
 	}
+	dnsService.BasePath = endpoints.BasePath("dns", dnsService.BasePath)
 	dnsClient := dns.NewClient(p.ProjectId, dnsService)
 
 	sqlService, err := gcpsql.New(httpClient)
 	if err != nil {
 		return Leftovers{}, err
 	}
+	sqlService.BasePath = endpoints.BasePath("sqladmin", sqlService.BasePath)
 	sqlClient := sql.NewClient(p.ProjectId, sqlService, logger)
 
 	storageService, err := gcpstorage.New(httpClient)
 	if err != nil {
 		return Leftovers{}, err
 	}
+	storageService.BasePath = endpoints.BasePath("storage", storageService.BasePath)
 	storageClient := storage.NewClient(p.ProjectId, storageService)
 
 	crmService, err := gcpcrm.New(httpClient)
 	if err != nil {
 		return Leftovers{}, err
 	}
+	crmService.BasePath = endpoints.BasePath("cloudresourcemanager", crmService.BasePath)
 
 	iamService, err := gcpiam.New(httpClient)
 	if err != nil {
 		return Leftovers{}, err
 	}
+	iamService.BasePath = endpoints.BasePath("iam", iamService.BasePath)
 	iamClient := iam.NewClient(p.ProjectId, iamService, crmService)
 
 	containerService, err := gcpcontainer.New(httpClient)
 	if err != nil {
 		return Leftovers{}, err
 	}
+	containerService.BasePath = endpoints.BasePath("container", containerService.BasePath)
 	containerClient := container.NewClient(p.ProjectId, containerService, logger)
 
 	regions, err := client.ListRegions()
@@ -145,7 +161,7 @@ func NewLeftovers(logger logger, keyPath string) (Leftovers, error) {
 			compute.NewHttpHealthChecks(client, logger),
 			compute.NewHttpsHealthChecks(client, logger),
 			compute.NewImages(client, logger),
-			compute.NewDisks(client, logger, zones),
+			compute.NewDisks(client, logger, zones, backup),
 			compute.NewVpnTunnels(client, logger, regions),
 			compute.NewTargetVpnGateways(client, logger, regions),
 			compute.NewRoutes(client, logger),
@@ -156,8 +172,8 @@ func NewLeftovers(logger logger, keyPath string) (Leftovers, error) {
 			compute.NewSslCertificates(client, logger),
 			iam.NewServiceAccounts(iamClient, logger),
 			dns.NewManagedZones(dnsClient, dns.NewRecordSets(dnsClient), logger),
-			sql.NewInstances(sqlClient, logger),
-			storage.NewBuckets(storageClient, logger),
+			sql.NewInstances(sqlClient, logger, backup),
+			storage.NewBuckets(storageClient, logger, backup),
 			container.NewClusters(containerClient, zones, logger),
 		},
 	}, nil
@@ -172,7 +188,7 @@ func (l Leftovers) List(filter string) {
 	for _, r := range l.resources {
 		list, err := r.List(filter)
 		if err != nil {
-			l.logger.Println(color.YellowString(err.Error()))
+			l.logger.Errorln(color.YellowString(err.Error()))
 		}
 
 		deletables = append(deletables, list...)
@@ -197,38 +213,38 @@ func (l Leftovers) Types() {
 // the provided filter in the resource's identifier, prompt
 // you to confirm deletion (if enabled), and delete those
 // that are selected.
-func (l Leftovers) Delete(filter string) error {
+func (l Leftovers) Delete(ctx context.Context, filter string) error {
 	deletables := [][]common.Deletable{}
 
 	for _, r := range l.resources {
 		list, err := r.List(filter)
 		if err != nil {
-			l.logger.Println(color.YellowString(err.Error()))
+			l.logger.Errorln(color.YellowString(err.Error()))
 		}
 
 		deletables = append(deletables, list)
 	}
 
-	return l.asyncDeleter.Run(deletables)
+	return l.asyncDeleter.Run(ctx, deletables)
 }
 
 // DeleteType will collect all resources of the provided type that contain
 // the provided filter in the resource's identifier, prompt
 // you to confirm deletion (if enabled), and delete those
 // that are selected.
-func (l Leftovers) DeleteType(filter, rType string) error {
+func (l Leftovers) DeleteType(ctx context.Context, filter, rType string) error {
 	deletables := [][]common.Deletable{}
 
 	for _, r := range l.resources {
 		if r.Type() == rType {
 			list, err := r.List(filter)
 			if err != nil {
-				l.logger.Println(color.YellowString(err.Error()))
+				l.logger.Errorln(color.YellowString(err.Error()))
 			}
 
 			deletables = append(deletables, list)
 		}
 	}
 
-	return l.asyncDeleter.Run(deletables)
+	return l.asyncDeleter.Run(ctx, deletables)
 }
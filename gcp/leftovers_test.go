@@ -0,0 +1,64 @@
+package gcp_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"os"
+
+	"github.com/fatih/color"
+	"github.com/genevieve/leftovers/app"
+	"github.com/genevieve/leftovers/common"
+	"github.com/genevieve/leftovers/gcp"
+	"github.com/genevieve/leftovers/recorder"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Leftovers", func() {
+	var (
+		stdout    *bytes.Buffer
+		leftovers gcp.Leftovers
+	)
+
+	BeforeEach(func() {
+		color.NoColor = true
+
+		rec, err := recorder.New("testdata/fakecloud.json", recorder.ModeReplay)
+		Expect(err).NotTo(HaveOccurred())
+
+		// The token request is signed with the key, but the
+		// signature is scrubbed, so any key will do.
+		private, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).NotTo(HaveOccurred())
+
+		key, err := json.Marshal(map[string]string{
+			"type":         "service_account",
+			"project_id":   "leftovers-project",
+			"private_key":  string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(private)})),
+			"client_email": "leftovers@leftovers-project.iam.gserviceaccount.com",
+			"token_uri":    "https://oauth2.googleapis.com/token",
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		stdout = bytes.NewBuffer([]byte{})
+		logger := app.NewLogger(stdout, os.Stdin, true)
+
+		leftovers, err = gcp.NewLeftovers(logger, string(key), gcp.Endpoints{}, rec.Wrap, common.Backup{})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("deletes the recorded resources", func() {
+		err := leftovers.DeleteType(context.Background(), "leftovers", "disk")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(stdout.String()).To(ContainSubstring("[Disk: leftovers-replay] Deleted!"))
+		Expect(stdout.String()).NotTo(ContainSubstring("[Disk: other]"))
+		Expect(stdout.String()).NotTo(ContainSubstring("No recorded response"))
+	})
+})
@@ -3,6 +3,7 @@ package gcp
 type logger interface {
 	Printf(message string, a ...interface{})
 	Println(message string)
+	Errorln(message string)
 	PromptWithDetails(resourceType, resourceName string) bool
 	NoConfirm()
 }
@@ -1,6 +1,7 @@
 package sql
 
 import (
+	"context"
 	"fmt"
 
 	"google.golang.org/api/googleapi"
@@ -28,15 +29,24 @@ func (c client) ListInstances() (*gcpsql.InstancesListResponse, error) {
 	return c.instances.List(c.project).Do()
 }
 
-func (c client) DeleteInstance(instance string) error {
-	return c.wait(c.instances.Delete(c.project, instance))
+func (c client) DeleteInstance(ctx context.Context, instance string) error {
+	return c.wait(ctx, c.instances.Delete(c.project, instance))
+}
+
+func (c client) ExportInstance(ctx context.Context, instance, uri string) error {
+	return c.wait(ctx, c.instances.Export(c.project, instance, &gcpsql.InstancesExportRequest{
+		ExportContext: &gcpsql.ExportContext{
+			FileType: "SQL",
+			Uri:      uri,
+		},
+	}))
 }
 
 type request interface {
 	Do(...googleapi.CallOption) (*gcpsql.Operation, error)
 }
 
-func (c client) wait(request request) error {
+func (c client) wait(ctx context.Context, request request) error {
 	op, err := request.Do()
 	if err != nil {
 		if gerr, ok := err.(*googleapi.Error); ok {
@@ -49,5 +59,5 @@ func (c client) wait(request request) error {
 
 	waiter := NewOperationWaiter(op, c.service, c.project, c.logger)
 
-	return waiter.Wait()
+	return waiter.Wait(ctx)
 }
@@ -1,5 +1,7 @@
 package fakes
 
+import "context"
+
 import gcpsql "google.golang.org/api/sqladmin/v1beta4"
 
 type InstancesClient struct {
@@ -20,6 +22,17 @@ type InstancesClient struct {
 			Error error
 		}
 	}
+
+	ExportInstanceCall struct {
+		CallCount int
+		Receives  struct {
+			Instance string
+			URI      string
+		}
+		Returns struct {
+			Error error
+		}
+	}
 }
 
 func (u *InstancesClient) ListInstances() (*gcpsql.InstancesListResponse, error) {
@@ -28,9 +41,17 @@ func (u *InstancesClient) ListInstances() (*gcpsql.InstancesListResponse, error)
 	return u.ListInstancesCall.Returns.Output, u.ListInstancesCall.Returns.Error
 }
 
-func (u *InstancesClient) DeleteInstance(instance string) error {
+func (u *InstancesClient) DeleteInstance(ctx context.Context, instance string) error {
 	u.DeleteInstanceCall.CallCount++
 	u.DeleteInstanceCall.Receives.Instance = instance
 
 	return u.DeleteInstanceCall.Returns.Error
 }
+
+func (u *InstancesClient) ExportInstance(ctx context.Context, instance, uri string) error {
+	u.ExportInstanceCall.CallCount++
+	u.ExportInstanceCall.Receives.Instance = instance
+	u.ExportInstanceCall.Receives.URI = uri
+
+	return u.ExportInstanceCall.Returns.Error
+}
@@ -1,21 +1,53 @@
 package sql
 
-import "fmt"
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/genevieve/leftovers/common"
+)
 
 type Instance struct {
 	client instancesClient
+	logger logger
 	name   string
+	backup common.Backup
 }
 
-func NewInstance(client instancesClient, name string) Instance {
+func NewInstance(client instancesClient, logger logger, name string, backup common.Backup) Instance {
 	return Instance{
 		client: client,
+		logger: logger,
 		name:   name,
+		backup: backup,
 	}
 }
+
 func (i Instance) Delete() error {
-	err := i.client.DeleteInstance(i.name)
+	return i.DeleteContext(context.Background())
+}
+
+// DeleteContext exports the instance's databases to the backup bucket if
+// backup is enabled, then deletes the instance. An export is used
+// because Cloud SQL backups are deleted along with their instance.
+func (i Instance) DeleteContext(ctx context.Context) error {
+	if i.backup.Enabled {
+		if i.backup.Bucket == "" {
+			return errors.New("Backup: Missing backup bucket.")
+		}
+
+		uri := fmt.Sprintf("gs://%s/%s.sql.gz", i.backup.Bucket, common.BackupName(i.name, 512))
+
+		err := i.client.ExportInstance(ctx, i.name, uri)
+		if err != nil {
+			return fmt.Errorf("Backup: %s", err)
+		}
+
+		i.logger.Printf("[%s: %s] Exported to %s\n", i.Type(), i.name, uri)
+	}
 
+	err := i.client.DeleteInstance(ctx, i.name)
 	if err != nil {
 		return fmt.Errorf("Delete: %s", err)
 	}
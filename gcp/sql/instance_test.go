@@ -2,7 +2,9 @@ package sql_test
 
 import (
 	"errors"
+	"fmt"
 
+	"github.com/genevieve/leftovers/common"
 	"github.com/genevieve/leftovers/gcp/sql"
 	"github.com/genevieve/leftovers/gcp/sql/fakes"
 
@@ -13,6 +15,7 @@ import (
 var _ = Describe("Instance", func() {
 	var (
 		client *fakes.InstancesClient
+		logger *fakes.Logger
 		name   string
 
 		instance sql.Instance
@@ -20,9 +23,10 @@ var _ = Describe("Instance", func() {
 
 	BeforeEach(func() {
 		client = &fakes.InstancesClient{}
+		logger = &fakes.Logger{}
 		name = "banana"
 
-		instance = sql.NewInstance(client, name)
+		instance = sql.NewInstance(client, logger, name, common.Backup{})
 	})
 
 	Describe("Delete", func() {
@@ -32,6 +36,54 @@ var _ = Describe("Instance", func() {
 
 			Expect(client.DeleteInstanceCall.CallCount).To(Equal(1))
 			Expect(client.DeleteInstanceCall.Receives.Instance).To(Equal(name))
+
+			Expect(client.ExportInstanceCall.CallCount).To(Equal(0))
+		})
+
+		Context("when backup is enabled", func() {
+			BeforeEach(func() {
+				instance = sql.NewInstance(client, logger, name, common.Backup{Enabled: true, Bucket: "archive"})
+			})
+
+			It("exports the instance to the backup bucket before deleting it", func() {
+				err := instance.Delete()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(client.ExportInstanceCall.CallCount).To(Equal(1))
+				Expect(client.ExportInstanceCall.Receives.Instance).To(Equal(name))
+				Expect(client.ExportInstanceCall.Receives.URI).To(MatchRegexp(`^gs://archive/banana-leftovers-\d+\.sql\.gz$`))
+
+				Expect(logger.PrintfCall.Messages).To(ConsistOf(
+					fmt.Sprintf("[SQL Instance: banana] Exported to %s\n", client.ExportInstanceCall.Receives.URI),
+				))
+				Expect(client.DeleteInstanceCall.CallCount).To(Equal(1))
+			})
+
+			Context("when there is no backup bucket", func() {
+				BeforeEach(func() {
+					instance = sql.NewInstance(client, logger, name, common.Backup{Enabled: true})
+				})
+
+				It("does not delete the instance", func() {
+					err := instance.Delete()
+					Expect(err).To(MatchError("Backup: Missing backup bucket."))
+
+					Expect(client.DeleteInstanceCall.CallCount).To(Equal(0))
+				})
+			})
+
+			Context("when the client fails to export the instance", func() {
+				BeforeEach(func() {
+					client.ExportInstanceCall.Returns.Error = errors.New("the-error")
+				})
+
+				It("does not delete the instance", func() {
+					err := instance.Delete()
+					Expect(err).To(MatchError("Backup: the-error"))
+
+					Expect(client.DeleteInstanceCall.CallCount).To(Equal(0))
+				})
+			})
 		})
 
 		Context("when the client fails to delete the instance", func() {
@@ -1,6 +1,7 @@
 package sql
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -10,18 +11,21 @@ import (
 
 type instancesClient interface {
 	ListInstances() (*gcpsql.InstancesListResponse, error)
-	DeleteInstance(user string) error
+	DeleteInstance(ctx context.Context, instance string) error
+	ExportInstance(ctx context.Context, instance, uri string) error
 }
 
 type Instances struct {
 	client instancesClient
 	logger logger
+	backup common.Backup
 }
 
-func NewInstances(client instancesClient, logger logger) Instances {
+func NewInstances(client instancesClient, logger logger, backup common.Backup) Instances {
 	return Instances{
 		client: client,
 		logger: logger,
+		backup: backup,
 	}
 }
 
@@ -33,7 +37,7 @@ func (i Instances) List(filter string) ([]common.Deletable, error) {
 
 	var resources []common.Deletable
 	for _, instance := range instances.Items {
-		resource := NewInstance(i.client, instance.Name)
+		resource := NewInstance(i.client, i.logger, instance.Name, i.backup)
 
 		if !strings.Contains(resource.name, filter) {
 			continue
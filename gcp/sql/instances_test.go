@@ -5,6 +5,7 @@ import (
 
 	gcpsql "google.golang.org/api/sqladmin/v1beta4"
 
+	"github.com/genevieve/leftovers/common"
 	"github.com/genevieve/leftovers/gcp/sql"
 	"github.com/genevieve/leftovers/gcp/sql/fakes"
 	. "github.com/onsi/ginkgo"
@@ -25,7 +26,7 @@ var _ = Describe("Instances", func() {
 
 		logger.PromptWithDetailsCall.Returns.Proceed = true
 
-		instances = sql.NewInstances(client, logger)
+		instances = sql.NewInstances(client, logger, common.Backup{})
 	})
 
 	Describe("List", func() {
@@ -1,9 +1,10 @@
 package sql
 
 import (
+	"context"
 	"fmt"
 
-	"github.com/genevieve/leftovers/gcp/common"
+	"github.com/genevieve/leftovers/common"
 
 	gcpsql "google.golang.org/api/sqladmin/v1beta4"
 )
@@ -24,10 +25,10 @@ func NewOperationWaiter(op *gcpsql.Operation, service *gcpsql.Service, project s
 	}
 }
 
-func (w *operationWaiter) Wait() error {
-	state := common.NewState(w.logger, w.refreshFunc())
+func (w *operationWaiter) Wait(ctx context.Context) error {
+	poller := common.NewPoller(w.logger, w.refreshFunc(), []string{"PENDING", "RUNNING"}, []string{"DONE"})
 
-	raw, err := state.Wait()
+	raw, err := poller.Wait(ctx)
 	if err != nil {
 		return fmt.Errorf("Waiting for operation to complete: %s", err)
 	}
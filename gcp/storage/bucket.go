@@ -1,25 +1,58 @@
 package storage
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+
+	"github.com/genevieve/leftovers/common"
+)
 
 type Bucket struct {
 	client bucketsClient
+	logger logger
 	name   string
+	backup common.Backup
 }
 
-func NewBucket(client bucketsClient, name string) Bucket {
+func NewBucket(client bucketsClient, logger logger, name string, backup common.Backup) Bucket {
 	return Bucket{
 		client: client,
+		logger: logger,
 		name:   name,
+		backup: backup,
 	}
 }
 
+// Delete copies the live objects in the bucket to the backup
+// bucket if backup is enabled, then deletes every object
+// generation and the bucket.
 func (b Bucket) Delete() error {
 	objects, err := b.client.ListObjects(b.name)
 	if err != nil {
 		return fmt.Errorf("List Objects: %s", err)
 	}
 
+	if b.backup.Enabled {
+		if b.backup.Bucket == "" {
+			return errors.New("Backup: Missing backup bucket.")
+		}
+
+		prefix := common.BackupName(b.name, 512)
+
+		for _, object := range objects.Items {
+			if object.TimeDeleted != "" {
+				continue
+			}
+
+			err = b.client.CopyObject(b.name, object.Name, b.backup.Bucket, fmt.Sprintf("%s/%s", prefix, object.Name))
+			if err != nil {
+				return fmt.Errorf("Backup: Copy Object %s: %s", object.Name, err)
+			}
+		}
+
+		b.logger.Printf("[%s: %s] Archived to gs://%s/%s/\n", b.Type(), b.name, b.backup.Bucket, prefix)
+	}
+
 	for _, object := range objects.Items {
 		err = b.client.DeleteObject(b.name, object.Name, object.Generation)
 		if err != nil {
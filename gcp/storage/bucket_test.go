@@ -3,6 +3,7 @@ package storage_test
 import (
 	"errors"
 
+	"github.com/genevieve/leftovers/common"
 	"github.com/genevieve/leftovers/gcp/storage"
 	"github.com/genevieve/leftovers/gcp/storage/fakes"
 	gcpstorage "google.golang.org/api/storage/v1"
@@ -14,6 +15,7 @@ import (
 var _ = Describe("Bucket", func() {
 	var (
 		client *fakes.BucketsClient
+		logger *fakes.Logger
 		name   string
 
 		bucket storage.Bucket
@@ -21,9 +23,10 @@ var _ = Describe("Bucket", func() {
 
 	BeforeEach(func() {
 		client = &fakes.BucketsClient{}
+		logger = &fakes.Logger{}
 		name = "banana"
 
-		bucket = storage.NewBucket(client, name)
+		bucket = storage.NewBucket(client, logger, name, common.Backup{})
 	})
 
 	Describe("Delete", func() {
@@ -50,6 +53,56 @@ var _ = Describe("Bucket", func() {
 
 			Expect(client.DeleteBucketCall.CallCount).To(Equal(1))
 			Expect(client.DeleteBucketCall.Receives.Bucket).To(Equal(name))
+
+			Expect(client.CopyObjectCall.CallCount).To(Equal(0))
+		})
+
+		Context("when backup is enabled", func() {
+			BeforeEach(func() {
+				bucket = storage.NewBucket(client, logger, name, common.Backup{Enabled: true, Bucket: "archive"})
+			})
+
+			It("copies the live objects to the backup bucket before deleting them", func() {
+				err := bucket.Delete()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(client.CopyObjectCall.CallCount).To(Equal(1))
+				Expect(client.CopyObjectCall.Receives.Bucket).To(Equal(name))
+				Expect(client.CopyObjectCall.Receives.Object).To(Equal("canteloupe"))
+				Expect(client.CopyObjectCall.Receives.DestinationBucket).To(Equal("archive"))
+				Expect(client.CopyObjectCall.Receives.DestinationObject).To(MatchRegexp(`^banana-leftovers-\d+/canteloupe$`))
+
+				Expect(logger.PrintfCall.Messages[0]).To(HavePrefix("[Storage Bucket: banana] Archived to gs://archive/banana-leftovers-"))
+				Expect(client.DeleteBucketCall.CallCount).To(Equal(1))
+			})
+
+			Context("when there is no backup bucket", func() {
+				BeforeEach(func() {
+					bucket = storage.NewBucket(client, logger, name, common.Backup{Enabled: true})
+				})
+
+				It("does not delete the bucket", func() {
+					err := bucket.Delete()
+					Expect(err).To(MatchError("Backup: Missing backup bucket."))
+
+					Expect(client.DeleteObjectCall.CallCount).To(Equal(0))
+					Expect(client.DeleteBucketCall.CallCount).To(Equal(0))
+				})
+			})
+
+			Context("when the client fails to copy an object", func() {
+				BeforeEach(func() {
+					client.CopyObjectCall.Returns.Error = errors.New("the-error")
+				})
+
+				It("does not delete the bucket", func() {
+					err := bucket.Delete()
+					Expect(err).To(MatchError("Backup: Copy Object canteloupe: the-error"))
+
+					Expect(client.DeleteObjectCall.CallCount).To(Equal(0))
+					Expect(client.DeleteBucketCall.CallCount).To(Equal(0))
+				})
+			})
 		})
 
 		Context("when the are no objects in the bucket", func() {
@@ -14,17 +14,20 @@ type bucketsClient interface {
 
 	ListObjects(bucket string) (*gcpstorage.Objects, error)
 	DeleteObject(bucket, object string, generation int64) error
+	CopyObject(bucket, object, destinationBucket, destinationObject string) error
 }
 
 type Buckets struct {
 	client bucketsClient
 	logger logger
+	backup common.Backup
 }
 
-func NewBuckets(client bucketsClient, logger logger) Buckets {
+func NewBuckets(client bucketsClient, logger logger, backup common.Backup) Buckets {
 	return Buckets{
 		client: client,
 		logger: logger,
+		backup: backup,
 	}
 }
 
@@ -36,12 +39,16 @@ func (i Buckets) List(filter string) ([]common.Deletable, error) {
 
 	var resources []common.Deletable
 	for _, bucket := range buckets.Items {
-		resource := NewBucket(i.client, bucket.Name)
+		resource := NewBucket(i.client, i.logger, bucket.Name, i.backup)
 
 		if !strings.Contains(resource.Name(), filter) {
 			continue
 		}
 
+		if resource.Name() == i.backup.Bucket {
+			continue
+		}
+
 		proceed := i.logger.PromptWithDetails(resource.Type(), resource.Name())
 		if !proceed {
 			continue
@@ -5,6 +5,7 @@ import (
 
 	gcpstorage "google.golang.org/api/storage/v1"
 
+	"github.com/genevieve/leftovers/common"
 	"github.com/genevieve/leftovers/gcp/storage"
 	"github.com/genevieve/leftovers/gcp/storage/fakes"
 	. "github.com/onsi/ginkgo"
@@ -25,7 +26,7 @@ var _ = Describe("Buckets", func() {
 
 		logger.PromptWithDetailsCall.Returns.Proceed = true
 
-		buckets = storage.NewBuckets(client, logger)
+		buckets = storage.NewBuckets(client, logger, common.Backup{})
 	})
 
 	Describe("List", func() {
@@ -74,6 +75,20 @@ var _ = Describe("Buckets", func() {
 			})
 		})
 
+		Context("when the bucket is the backup bucket", func() {
+			BeforeEach(func() {
+				buckets = storage.NewBuckets(client, logger, common.Backup{Enabled: true, Bucket: "banana-bucket"})
+			})
+
+			It("does not add it to the list", func() {
+				list, err := buckets.List(filter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PromptWithDetailsCall.CallCount).To(Equal(0))
+				Expect(list).To(HaveLen(0))
+			})
+		})
+
 		Context("when the bucket says no to the prompt", func() {
 			BeforeEach(func() {
 				logger.PromptWithDetailsCall.Returns.Proceed = false
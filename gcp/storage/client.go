@@ -36,3 +36,22 @@ func (c client) ListObjects(bucket string) (*gcpstorage.Objects, error) {
 func (c client) DeleteObject(bucket, object string, generation int64) error {
 	return c.objects.Delete(bucket, object).Generation(generation).Do()
 }
+
+// CopyObject rewrites the object into the destination bucket, continuing
+// the rewrite until it is done for objects that take more than one call.
+func (c client) CopyObject(bucket, object, destinationBucket, destinationObject string) error {
+	var token string
+
+	for {
+		resp, err := c.objects.Rewrite(bucket, object, destinationBucket, destinationObject, nil).RewriteToken(token).Do()
+		if err != nil {
+			return err
+		}
+
+		if resp.Done {
+			return nil
+		}
+
+		token = resp.RewriteToken
+	}
+}
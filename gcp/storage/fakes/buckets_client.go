@@ -43,6 +43,19 @@ type BucketsClient struct {
 			Error error
 		}
 	}
+
+	CopyObjectCall struct {
+		CallCount int
+		Receives  struct {
+			Bucket            string
+			Object            string
+			DestinationBucket string
+			DestinationObject string
+		}
+		Returns struct {
+			Error error
+		}
+	}
 }
 
 func (u *BucketsClient) ListBuckets() (*gcpstorage.Buckets, error) {
@@ -73,3 +86,13 @@ func (b *BucketsClient) DeleteObject(bucket, object string, generation int64) er
 
 	return b.DeleteObjectCall.Returns.Error
 }
+
+func (b *BucketsClient) CopyObject(bucket, object, destinationBucket, destinationObject string) error {
+	b.CopyObjectCall.CallCount++
+	b.CopyObjectCall.Receives.Bucket = bucket
+	b.CopyObjectCall.Receives.Object = object
+	b.CopyObjectCall.Receives.DestinationBucket = destinationBucket
+	b.CopyObjectCall.Receives.DestinationObject = destinationObject
+
+	return b.CopyObjectCall.Returns.Error
+}
@@ -0,0 +1,13 @@
+package nsxt_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestNSXT(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "nsxt")
+}
@@ -1,8 +1,11 @@
 package nsxt
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"net/url"
+	"strings"
 
 	"github.com/fatih/color"
 	"github.com/genevieve/leftovers/app"
@@ -15,6 +18,7 @@ import (
 type logger interface {
 	Printf(message string, a ...interface{})
 	Println(message string)
+	Errorln(message string)
 	PromptWithDetails(resourceType, resourceName string) bool
 	NoConfirm()
 }
@@ -39,7 +43,7 @@ func (l Leftovers) List(filter string) {
 	for _, r := range l.resources {
 		list, err := r.List(filter)
 		if err != nil {
-			l.logger.Println(color.YellowString(err.Error()))
+			l.logger.Errorln(color.YellowString(err.Error()))
 		}
 
 		deletables = append(deletables, list...)
@@ -62,43 +66,49 @@ func (l Leftovers) Types() {
 // the provided filter in the resource's identifier, prompt
 // you to confirm deletion (if enabled), and delete those
 // that are selected.
-func (l Leftovers) Delete(filter string) error {
+func (l Leftovers) Delete(ctx context.Context, filter string) error {
 	deletables := [][]common.Deletable{}
 
 	for _, r := range l.resources {
 		list, err := r.List(filter)
 		if err != nil {
-			l.logger.Println(color.YellowString(err.Error()))
+			l.logger.Errorln(color.YellowString(err.Error()))
 		}
 
 		deletables = append(deletables, list)
 	}
 
-	return l.asyncDeleter.Run(deletables)
+	return l.asyncDeleter.Run(ctx, deletables)
 }
 
 // DeleteType will collect all resources of the provied type that contain
 // the provided filter in the resource's identifier, prompt
 // you to confirm deletion (if enabled), and delete those
 // that are selected.
-func (l Leftovers) DeleteType(filter, rType string) error {
+func (l Leftovers) DeleteType(ctx context.Context, filter, rType string) error {
 	deletables := [][]common.Deletable{}
 
 	for _, r := range l.resources {
 		if r.Type() == rType {
 			list, err := r.List(filter)
 			if err != nil {
-				l.logger.Println(color.YellowString(err.Error()))
+				l.logger.Errorln(color.YellowString(err.Error()))
 			}
 
 			deletables = append(deletables, list)
 		}
 	}
 
-	return l.asyncDeleter.Run(deletables)
+	return l.asyncDeleter.Run(ctx, deletables)
 }
 
-func NewLeftovers(logger logger, managerHost, user, password string) (Leftovers, error) {
+// NewLeftovers returns a new Leftovers for NSX-T that can be used to list resources,
+// list types, or delete resources for the provided manager. It returns an error
+// if the credentials provided are invalid or a client cannot be created. The
+// manager host may include a scheme and port, and the API is served at the
+// provided base path, or /api/v1. If a transport is provided, it wraps the
+// transport that requests are sent with.
+func NewLeftovers(logger logger, managerHost, user, password, basePath string, transport common.WrapTransport) (Leftovers, error) {
 	if managerHost == "" {
 		return Leftovers{}, errors.New("Missing NSX-T manager host.")
 	}
@@ -111,18 +121,39 @@ func NewLeftovers(logger logger, managerHost, user, password string) (Leftovers,
 		return Leftovers{}, errors.New("Missing NSX-T password.")
 	}
 
-	nsxtClient, err := nsxt.NewAPIClient(&nsxt.Configuration{
-		BasePath: fmt.Sprintf("https://%s/api/v1", managerHost),
+	if !strings.Contains(managerHost, "://") {
+		managerHost = fmt.Sprintf("https://%s", managerHost)
+	}
+
+	manager, err := url.Parse(managerHost)
+	if err != nil {
+		return Leftovers{}, fmt.Errorf("Invalid NSX-T manager host %s: %s", managerHost, err)
+	}
+
+	if basePath == "" {
+		basePath = "/api/v1"
+	}
+
+	config := &nsxt.Configuration{
+		BasePath: fmt.Sprintf("%s://%s/%s", manager.Scheme, manager.Host, strings.Trim(basePath, "/")),
 		UserName: user,
 		Password: password,
-		Host:     managerHost,
+		Host:     manager.Host,
 		Insecure: true,
 		RetriesConfiguration: nsxt.ClientRetriesConfiguration{
 			MaxRetries:    1,
 			RetryMinDelay: 100,
 			RetryMaxDelay: 500,
 		},
-	})
+	}
+
+	err = nsxt.InitHttpClient(config)
+	if err != nil {
+		return Leftovers{}, fmt.Errorf("Error creating NSX-T API client: %s", err)
+	}
+	config.HTTPClient.Transport = transport.Wrap(config.HTTPClient.Transport)
+
+	nsxtClient, err := nsxt.NewAPIClient(config)
 	if err != nil {
 		return Leftovers{}, fmt.Errorf("Error creating NSX-T API client: %s", err)
 	}
@@ -0,0 +1,48 @@
+package nsxt_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+
+	"github.com/fatih/color"
+	"github.com/genevieve/leftovers/app"
+	"github.com/genevieve/leftovers/nsxt"
+	"github.com/genevieve/leftovers/recorder"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Leftovers", func() {
+	var (
+		stdout    *bytes.Buffer
+		leftovers nsxt.Leftovers
+	)
+
+	BeforeEach(func() {
+		color.NoColor = true
+
+		rec, err := recorder.New("testdata/fixture.json", recorder.ModeReplay)
+		Expect(err).NotTo(HaveOccurred())
+
+		stdout = bytes.NewBuffer([]byte{})
+		logger := app.NewLogger(stdout, os.Stdin, true)
+
+		leftovers, err = nsxt.NewLeftovers(logger, "nsx.example.com", "admin", "password", "", rec.Wrap)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("lists and deletes the recorded resources", func() {
+		leftovers.List("leftovers")
+
+		Expect(stdout.String()).To(ContainSubstring("[Tier 1 Router: leftovers-tier1]\n"))
+		Expect(stdout.String()).NotTo(ContainSubstring("other-tier1"))
+
+		err := leftovers.Delete(context.Background(), "leftovers")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(stdout.String()).To(ContainSubstring("[Tier 1 Router: leftovers-tier1] Deleted!"))
+		Expect(stdout.String()).NotTo(ContainSubstring("No recorded response"))
+	})
+})
@@ -1,23 +1,29 @@
 package openstack
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/genevieve/leftovers/bosh"
+)
 
 type ComputeInstance struct {
 	name          string
 	id            string
+	metadata      map[string]string
 	computeClient ComputeClient
 }
 
-func NewComputeInstance(name string, id string, computeClient ComputeClient) ComputeInstance {
+func NewComputeInstance(name string, id string, metadata map[string]string, computeClient ComputeClient) ComputeInstance {
 	return ComputeInstance{
 		name:          name,
 		id:            id,
+		metadata:      metadata,
 		computeClient: computeClient,
 	}
 }
 
 func (ci ComputeInstance) Name() string {
-	return fmt.Sprintf("%s %s", ci.name, ci.id)
+	return bosh.WithMetadata(fmt.Sprintf("%s %s", ci.name, ci.id), ci.metadata)
 }
 
 func (ci ComputeInstance) Type() string {
@@ -13,10 +13,18 @@ import (
 var _ = Describe("Compute Instance", func() {
 	Describe("NewComputeInstance", func() {
 		It("has a name and type", func() {
-			computeInstance := openstack.NewComputeInstance("some-name", "some-id", nil)
+			computeInstance := openstack.NewComputeInstance("some-name", "some-id", nil, nil)
 			Expect(computeInstance.Name()).To(Equal("some-name some-id"))
 			Expect(computeInstance.Type()).To(Equal("Compute Instance"))
 		})
+
+		Context("when the instance has bosh metadata", func() {
+			It("includes the director and deployment in the name", func() {
+				computeInstance := openstack.NewComputeInstance("some-name", "some-id", map[string]string{"director": "bosh-banana", "deployment": "cf"}, nil)
+
+				Expect(computeInstance.Name()).To(Equal("some-name some-id (director:bosh-banana, deployment:cf)"))
+			})
+		})
 	})
 
 	Describe("Delete", func() {
@@ -27,7 +35,7 @@ var _ = Describe("Compute Instance", func() {
 
 		BeforeEach(func() {
 			fakeComputeClient = &fakes.ComputeInstanceClient{}
-			computeInstance = openstack.NewComputeInstance("some-name", "some-id", fakeComputeClient)
+			computeInstance = openstack.NewComputeInstance("some-name", "some-id", nil, fakeComputeClient)
 		})
 
 		It("deletes the compute instance", func() {
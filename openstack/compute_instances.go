@@ -30,7 +30,7 @@ func (ci ComputeInstances) List() ([]common.Deletable, error) {
 
 	var deletables []common.Deletable
 	for _, instance := range computeInstances {
-		deletable := NewComputeInstance(instance.Name, instance.ID, ci.computeClient)
+		deletable := NewComputeInstance(instance.Name, instance.ID, instance.Metadata, ci.computeClient)
 		if ci.logger.PromptWithDetails(deletable.Type(), deletable.Name()) {
 			deletables = append(deletables, deletable)
 		}
@@ -30,4 +30,5 @@ func (l *Logger) PromptWithDetails(resourceType, resourceName string) bool {
 
 func (l *Logger) Printf(message string, a ...interface{}) {}
 func (l *Logger) Println(message string)                  {}
+func (l *Logger) Errorln(message string)                  {}
 func (l *Logger) NoConfirm()                              {}
@@ -1,8 +1,10 @@
 package openstack
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"net/http"
 
 	"github.com/fatih/color"
 	"github.com/genevieve/leftovers/app"
@@ -19,6 +21,7 @@ type listTyper interface {
 type logger interface {
 	Printf(message string, a ...interface{})
 	Println(message string)
+	Errorln(message string)
 	PromptWithDetails(resourceType, resourceName string) bool
 	NoConfirm()
 }
@@ -41,8 +44,15 @@ type AuthArgs struct {
 // NewLeftovers returns a new Leftovers for OpenStack that can be used to list resources,
 // list types, or delete resources for the provided account. It returns an error
 // if the credentials provided are invalid or if a client fails to be created.
-func NewLeftovers(logger logger, authArgs AuthArgs) (Leftovers, error) {
-	provider, err := openstack.AuthenticatedClient(gophercloud.AuthOptions{
+// If a transport is provided, it wraps the transport that requests are sent with.
+func NewLeftovers(logger logger, authArgs AuthArgs, transport common.WrapTransport) (Leftovers, error) {
+	provider, err := openstack.NewClient(authArgs.AuthURL)
+	if err != nil {
+		return Leftovers{}, fmt.Errorf("failed to make authenticated client: %s", err)
+	}
+	provider.HTTPClient = http.Client{Transport: transport.Wrap(http.DefaultTransport)}
+
+	err = openstack.Authenticate(provider, gophercloud.AuthOptions{
 		IdentityEndpoint: authArgs.AuthURL,
 		Username:         authArgs.Username,
 		Password:         authArgs.Password,
@@ -98,7 +108,7 @@ func (l Leftovers) List(filter string) {
 	for _, r := range l.resources {
 		list, err := r.List()
 		if err != nil {
-			l.logger.Println(color.YellowString(err.Error()))
+			l.logger.Errorln(color.YellowString(err.Error()))
 		}
 
 		deletables = append(deletables, list...)
@@ -123,7 +133,7 @@ func (l Leftovers) Types() {
 // the provided filter in the resource's identifier, prompt
 // you to confirm deletion (if enabled), and delete thoseu
 // that are selected.
-func (l Leftovers) Delete(filter string) error {
+func (l Leftovers) Delete(ctx context.Context, filter string) error {
 	if filter != "" {
 		l.logger.Println(color.RedString("Error: Filters are not supported for OpenStack. Aborting deletion!"))
 		return errors.New("cannot delete openstack resources using a filter")
@@ -134,20 +144,20 @@ func (l Leftovers) Delete(filter string) error {
 	for _, r := range l.resources {
 		list, err := r.List()
 		if err != nil {
-			l.logger.Println(color.YellowString(err.Error()))
+			l.logger.Errorln(color.YellowString(err.Error()))
 		}
 
 		deletables = append(deletables, list)
 	}
 
-	return l.asyncDeleter.Run(deletables)
+	return l.asyncDeleter.Run(ctx, deletables)
 }
 
 // DeleteType will collect all resources of the provied type that contain
 // the provided filter in the resource's identifier, prompt
 // you to confirm deletion (if enabled), and delete those
 // that are selected.
-func (l Leftovers) DeleteType(filter, rType string) error {
+func (l Leftovers) DeleteType(ctx context.Context, filter, rType string) error {
 	if filter != "" {
 		l.logger.Println(color.RedString("Error: Filters are not supported for OpenStack. Aborting deletion!"))
 		return errors.New("cannot delete openstack resources using a filter")
@@ -159,12 +169,12 @@ func (l Leftovers) DeleteType(filter, rType string) error {
 		if r.Type() == rType {
 			list, err := r.List()
 			if err != nil {
-				l.logger.Println(color.YellowString(err.Error()))
+				l.logger.Errorln(color.YellowString(err.Error()))
 			}
 
 			deletables = append(deletables, list)
 		}
 	}
 
-	return l.asyncDeleter.Run(deletables)
+	return l.asyncDeleter.Run(ctx, deletables)
 }
@@ -0,0 +1,56 @@
+package openstack
+
+import (
+	"bytes"
+	"context"
+	"os"
+
+	"github.com/fatih/color"
+	"github.com/genevieve/leftovers/app"
+	"github.com/genevieve/leftovers/recorder"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Leftovers", func() {
+	var (
+		stdout    *bytes.Buffer
+		leftovers Leftovers
+	)
+
+	BeforeEach(func() {
+		color.NoColor = true
+
+		rec, err := recorder.New("testdata/fakecloud.json", recorder.ModeReplay)
+		Expect(err).NotTo(HaveOccurred())
+
+		stdout = bytes.NewBuffer([]byte{})
+		logger := app.NewLogger(stdout, os.Stdin, true)
+
+		leftovers, err = NewLeftovers(logger, AuthArgs{
+			AuthURL:    "https://openstack.example.com/v3/",
+			Username:   "leftovers",
+			Password:   "password",
+			Domain:     "default",
+			TenantName: "leftovers",
+			Region:     "RegionOne",
+		}, rec.Wrap)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("lists and deletes the recorded resources", func() {
+		leftovers.List("")
+
+		Expect(stdout.String()).To(ContainSubstring("[Volume: leftovers-volume volume-1]\n"))
+		Expect(stdout.String()).To(ContainSubstring("[Compute Instance: leftovers-server server-1]\n"))
+		Expect(stdout.String()).To(ContainSubstring("[Image: leftovers-image image-1]\n"))
+
+		err := leftovers.Delete(context.Background(), "")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(stdout.String()).To(ContainSubstring("[Volume: leftovers-volume volume-1] Deleted!"))
+		Expect(stdout.String()).To(ContainSubstring("[Compute Instance: leftovers-server server-1] Deleted!"))
+		Expect(stdout.String()).To(ContainSubstring("[Image: leftovers-image image-1] Deleted!"))
+	})
+})
@@ -1,23 +1,29 @@
 package openstack
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/genevieve/leftovers/bosh"
+)
 
 type Volume struct {
 	name          string
 	id            string
+	metadata      map[string]string
 	volumesClient VolumesClient
 }
 
-func NewVolume(name string, id string, volumesClient VolumesClient) Volume {
+func NewVolume(name string, id string, metadata map[string]string, volumesClient VolumesClient) Volume {
 	return Volume{
 		name:          name,
 		id:            id,
+		metadata:      metadata,
 		volumesClient: volumesClient,
 	}
 }
 
 func (volume Volume) Name() string {
-	return fmt.Sprintf("%s %s", volume.name, volume.id)
+	return bosh.WithMetadata(fmt.Sprintf("%s %s", volume.name, volume.id), volume.metadata)
 }
 func (volume Volume) Type() string {
 	return "Volume"
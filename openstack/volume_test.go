@@ -13,11 +13,19 @@ import (
 var _ = Describe("Volume", func() {
 	Describe("NewVolume", func() {
 		It("has a name and type", func() {
-			volume := openstack.NewVolume("some-name", "some-id", nil)
+			volume := openstack.NewVolume("some-name", "some-id", nil, nil)
 
 			Expect(volume.Name()).To(Equal("some-name some-id"))
 			Expect(volume.Type()).To(Equal("Volume"))
 		})
+
+		Context("when the volume has bosh metadata", func() {
+			It("includes the director and deployment in the name", func() {
+				volume := openstack.NewVolume("some-name", "some-id", map[string]string{"deployment": "cf", "director": "bosh-banana"}, nil)
+
+				Expect(volume.Name()).To(Equal("some-name some-id (director:bosh-banana, deployment:cf)"))
+			})
+		})
 	})
 
 	Describe("Delete", func() {
@@ -28,7 +36,7 @@ var _ = Describe("Volume", func() {
 
 		BeforeEach(func() {
 			fakeVolumesClient = &fakes.VolumesClient{}
-			volume = openstack.NewVolume("some-name", "some-id", fakeVolumesClient)
+			volume = openstack.NewVolume("some-name", "some-id", nil, fakeVolumesClient)
 		})
 
 		It("deletes the correct volume", func() {
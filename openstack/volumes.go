@@ -35,7 +35,7 @@ func (volumes Volumes) List() ([]common.Deletable, error) {
 
 	var deletables []common.Deletable
 	for _, volume := range result {
-		deletable := NewVolume(volume.Name, volume.ID, volumes.volumesClient)
+		deletable := NewVolume(volume.Name, volume.ID, volume.Metadata, volumes.volumesClient)
 		confirm := volumes.logger.PromptWithDetails(deletable.Type(), deletable.Name())
 
 		if confirm {
@@ -0,0 +1,69 @@
+package recorder
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+// Cassette is the recorded traffic of a run, in the order that
+// the responses were received.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a request and the response that it received.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+type Request struct {
+	Method  string      `json:"method"`
+	URL     string      `json:"url"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"`
+}
+
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// Load reads the cassette at the provided path.
+func Load(path string) (Cassette, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Cassette{}, fmt.Errorf("Reading cassette: %s", err)
+	}
+
+	var c Cassette
+	if err := json.Unmarshal(contents, &c); err != nil {
+		return Cassette{}, fmt.Errorf("Unmarshalling cassette %s: %s", path, err)
+	}
+
+	return c, nil
+}
+
+// Save writes the cassette to the provided path,
+// creating its directory if it does not exist.
+func (c Cassette) Save(path string) error {
+	contents, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("Marshalling cassette: %s", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("Creating cassette directory: %s", err)
+	}
+
+	if err := ioutil.WriteFile(path, append(contents, '\n'), 0644); err != nil {
+		return fmt.Errorf("Writing cassette: %s", err)
+	}
+
+	return nil
+}
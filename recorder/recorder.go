@@ -0,0 +1,206 @@
+package recorder
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+)
+
+type Mode int
+
+const (
+	// ModeReplay answers each request with a recorded response
+	// without sending it.
+	ModeReplay Mode = iota
+	// ModeRecord sends each request and records it with its response.
+	ModeRecord
+)
+
+// matchHeaders are the request headers, besides the method, URL and body,
+// that tell requests apart. AWS JSON APIs only name the action in a header.
+var matchHeaders = []string{"X-Amz-Target"}
+
+// Recorder records the traffic of the transports it wraps to a
+// cassette, or replays the traffic of a cassette recorded earlier.
+type Recorder struct {
+	mode     Mode
+	path     string
+	mutex    *sync.Mutex
+	cassette Cassette
+	played   []bool
+	scrubber scrubber
+}
+
+// New returns a new Recorder for the cassette at the provided path.
+// When replaying, it returns an error if the cassette cannot be loaded.
+func New(path string, mode Mode) (*Recorder, error) {
+	r := &Recorder{
+		mode:  mode,
+		path:  path,
+		mutex: &sync.Mutex{},
+	}
+
+	if mode == ModeReplay {
+		cassette, err := Load(path)
+		if err != nil {
+			return nil, err
+		}
+
+		r.cassette = cassette
+		r.played = make([]bool, len(cassette.Interactions))
+	}
+
+	return r, nil
+}
+
+// Scrub replaces every occurrence of the provided secrets, like
+// credentials or account ids, in the traffic that is recorded.
+func (r *Recorder) Scrub(secrets ...string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.scrubber.secrets = append(r.scrubber.secrets, secrets...)
+}
+
+// Wrap returns a transport that records or replays requests through
+// the Recorder. When recording, requests are sent with the base
+// transport, or http.DefaultTransport if it is nil.
+func (r *Recorder) Wrap(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return transport{recorder: r, base: base}
+}
+
+// Stop saves the cassette when recording.
+func (r *Recorder) Stop() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.cassette.Save(r.path)
+}
+
+func (r *Recorder) currentScrubber() scrubber {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.scrubber
+}
+
+func (r *Recorder) record(req Request, resp *http.Response, body []byte) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: req,
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Headers:    r.scrubber.headers(resp.Header),
+			Body:       r.scrubber.body(string(body)),
+		},
+	})
+}
+
+// replay returns the first recorded response that has not been replayed
+// yet for a request with the same method, URL, body and matched headers.
+func (r *Recorder) replay(req Request) (Response, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for i, interaction := range r.cassette.Interactions {
+		if r.played[i] || !matches(interaction.Request, req) {
+			continue
+		}
+
+		r.played[i] = true
+		return interaction.Response, nil
+	}
+
+	return Response{}, fmt.Errorf("No recorded response in %s for %s %s.", r.path, req.Method, req.URL)
+}
+
+func matches(recorded, req Request) bool {
+	if recorded.Method != req.Method || recorded.URL != req.URL || recorded.Body != req.Body {
+		return false
+	}
+
+	for _, h := range matchHeaders {
+		if recorded.Headers.Get(h) != req.Headers.Get(h) {
+			return false
+		}
+	}
+
+	return true
+}
+
+type transport struct {
+	recorder *Recorder
+	base     http.RoundTripper
+}
+
+func (t transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	scrubber := t.recorder.currentScrubber()
+	recorded := Request{
+		Method:  req.Method,
+		URL:     scrubber.url(*req.URL),
+		Headers: scrubber.headers(req.Header),
+		Body:    scrubber.body(string(body)),
+	}
+
+	if t.recorder.mode == ModeReplay {
+		r, err := t.recorder.replay(recorded)
+		if err != nil {
+			return nil, err
+		}
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", r.StatusCode, http.StatusText(r.StatusCode)),
+			StatusCode:    r.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        cloneHeader(r.Headers),
+			Body:          ioutil.NopCloser(bytes.NewReader([]byte(r.Body))),
+			ContentLength: int64(len(r.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	t.recorder.record(recorded, resp, respBody)
+
+	return resp, nil
+}
+
+func cloneHeader(h http.Header) http.Header {
+	clone := http.Header{}
+	for k, values := range h {
+		clone[k] = append([]string{}, values...)
+	}
+	return clone
+}
@@ -0,0 +1,165 @@
+package recorder_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/genevieve/leftovers/recorder"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Recorder", func() {
+	var (
+		dir    string
+		path   string
+		server *httptest.Server
+		calls  int
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "recorder")
+		Expect(err).NotTo(HaveOccurred())
+
+		path = filepath.Join(dir, "cassettes", "test.json")
+
+		calls = 0
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.Header().Set("Set-Cookie", "session=banana")
+			w.Header().Set("X-Subject-Token", "banana")
+			fmt.Fprintf(w, `{"access_token": "banana", "account": "123456789012", "call": %d}`, calls)
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+		os.RemoveAll(dir)
+	})
+
+	send := func(rec *recorder.Recorder, body string) (*http.Response, string, error) {
+		client := &http.Client{Transport: rec.Wrap(nil)}
+
+		req, err := http.NewRequest("POST", server.URL+"/path?Action=List&X-Amz-Signature=banana", strings.NewReader(body))
+		Expect(err).NotTo(HaveOccurred())
+		req.Header.Set("Authorization", "Bearer banana")
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, "", err
+		}
+		defer resp.Body.Close()
+
+		contents, err := ioutil.ReadAll(resp.Body)
+		Expect(err).NotTo(HaveOccurred())
+
+		return resp, string(contents), nil
+	}
+
+	record := func(bodies ...string) {
+		rec, err := recorder.New(path, recorder.ModeRecord)
+		Expect(err).NotTo(HaveOccurred())
+		rec.Scrub("123456789012")
+
+		for _, body := range bodies {
+			_, _, err := send(rec, body)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		Expect(rec.Stop()).To(Succeed())
+	}
+
+	Describe("ModeRecord", func() {
+		It("sends the requests and saves them with their responses", func() {
+			record("name=kiwi&password=hunter2")
+
+			Expect(calls).To(Equal(1))
+
+			cassette, err := recorder.Load(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cassette.Interactions).To(HaveLen(1))
+
+			req := cassette.Interactions[0].Request
+			Expect(req.Method).To(Equal("POST"))
+			Expect(req.URL).To(Equal(server.URL + "/path?Action=List&X-Amz-Signature=REDACTED"))
+			Expect(req.Body).To(Equal("name=kiwi&password=REDACTED"))
+			Expect(req.Headers.Get("Authorization")).To(Equal("REDACTED"))
+			Expect(req.Headers.Get("Content-Type")).To(Equal("application/x-www-form-urlencoded"))
+
+			resp := cassette.Interactions[0].Response
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(resp.Body).To(Equal(`{"access_token": "REDACTED", "account": "REDACTED", "call": 1}`))
+			Expect(resp.Headers.Get("Set-Cookie")).To(Equal("REDACTED"))
+			Expect(resp.Headers.Get("X-Subject-Token")).To(Equal("REDACTED"))
+			Expect(resp.Headers.Get("Content-Length")).To(Equal(""))
+		})
+	})
+
+	Describe("ModeReplay", func() {
+		BeforeEach(func() {
+			record("name=kiwi&password=hunter2", "name=kiwi&password=hunter2", "name=lime")
+			server.Close()
+		})
+
+		It("replays the recorded responses in order without sending the requests", func() {
+			rec, err := recorder.New(path, recorder.ModeReplay)
+			Expect(err).NotTo(HaveOccurred())
+
+			resp, body, err := send(rec, "name=lime")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(body).To(ContainSubstring(`"call": 3`))
+
+			_, body, err = send(rec, "name=kiwi&password=other")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(body).To(ContainSubstring(`"call": 1`))
+
+			resp, body, err = send(rec, "name=kiwi&password=other")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(body).To(ContainSubstring(`"call": 2`))
+			Expect(resp.Header.Get("X-Subject-Token")).To(Equal("REDACTED"))
+
+			Expect(calls).To(Equal(3))
+		})
+
+		Context("when every matching response has been replayed", func() {
+			It("returns an error", func() {
+				rec, err := recorder.New(path, recorder.ModeReplay)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, _, err = send(rec, "name=lime")
+				Expect(err).NotTo(HaveOccurred())
+
+				_, _, err = send(rec, "name=lime")
+				Expect(err).To(MatchError(ContainSubstring("No recorded response in %s for POST %s/path", path, server.URL)))
+			})
+		})
+
+		Context("when no recorded request matches", func() {
+			It("returns an error instead of sending the request", func() {
+				rec, err := recorder.New(path, recorder.ModeReplay)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, _, err = send(rec, "name=banana")
+				Expect(err).To(MatchError(ContainSubstring("No recorded response in %s for POST %s/path", path, server.URL)))
+
+				Expect(calls).To(Equal(3))
+			})
+		})
+
+		Context("when the cassette does not exist", func() {
+			It("returns an error", func() {
+				_, err := recorder.New(filepath.Join(dir, "missing.json"), recorder.ModeReplay)
+				Expect(err).To(MatchError(ContainSubstring("Reading cassette")))
+			})
+		})
+	})
+})
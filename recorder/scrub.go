@@ -0,0 +1,94 @@
+package recorder
+
+import (
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+const redacted = "REDACTED"
+
+var (
+	sensitiveHeaders = []string{
+		"Authorization",
+		"Proxy-Authorization",
+		"Cookie",
+		"Set-Cookie",
+		"X-Amz-Security-Token",
+		"X-Auth-Token",
+		"X-Subject-Token",
+		"X-Xsrf-Token",
+	}
+
+	// volatileHeaders change between runs, or with scrubbing,
+	// and are left out of the recorded traffic.
+	volatileHeaders = []string{
+		"Content-Length",
+		"Date",
+		"User-Agent",
+		"X-Amz-Date",
+	}
+
+	sensitiveFields = "password|secret|client_secret|access_token|refresh_token|id_token|private_key|assertion|" +
+		"AccessKeyId|SecretAccessKey|SessionToken|X-Amz-Signature|X-Amz-Credential|X-Amz-Security-Token"
+
+	jsonField = regexp.MustCompile(`("(?i:` + sensitiveFields + `)"\s*:\s*)"[^"]*"`)
+	formField = regexp.MustCompile(`((?:^|&)(?i:` + sensitiveFields + `)=)[^&]*`)
+	xmlField  = regexp.MustCompile(`(<(?:\w+:)?(?i:` + sensitiveFields + `)>)[^<]*(</)`)
+)
+
+// scrubber removes credentials from recorded traffic: the values of
+// headers, JSON fields, form fields and XML elements that carry them,
+// and every occurrence of the secrets it is given.
+type scrubber struct {
+	secrets []string
+}
+
+func (s scrubber) url(u url.URL) string {
+	u.User = nil
+	u.RawQuery = formField.ReplaceAllString(u.RawQuery, "${1}"+redacted)
+	return s.text(u.String())
+}
+
+func (s scrubber) headers(h http.Header) http.Header {
+	scrubbed := http.Header{}
+	for k, values := range h {
+		for _, v := range values {
+			scrubbed.Add(k, s.text(v))
+		}
+	}
+
+	for _, k := range volatileHeaders {
+		scrubbed.Del(k)
+	}
+
+	for _, k := range sensitiveHeaders {
+		if scrubbed.Get(k) != "" {
+			scrubbed.Set(k, redacted)
+		}
+	}
+
+	if len(scrubbed) == 0 {
+		return nil
+	}
+	return scrubbed
+}
+
+func (s scrubber) body(body string) string {
+	body = jsonField.ReplaceAllString(body, "${1}\""+redacted+"\"")
+	body = xmlField.ReplaceAllString(body, "${1}"+redacted+"${2}")
+	if !strings.ContainsAny(body, "{<") {
+		body = formField.ReplaceAllString(body, "${1}"+redacted)
+	}
+	return s.text(body)
+}
+
+func (s scrubber) text(text string) string {
+	for _, secret := range s.secrets {
+		if secret != "" {
+			text = strings.Replace(text, secret, redacted, -1)
+		}
+	}
+	return text
+}
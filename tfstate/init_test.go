@@ -0,0 +1,13 @@
+package tfstate_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestTFState(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "tfstate")
+}
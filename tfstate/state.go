@@ -0,0 +1,180 @@
+package tfstate
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+)
+
+// State is the set of resources managed by a Terraform state file,
+// keyed by the leftovers resource type that each one maps to.
+type State struct {
+	resources map[string]map[string]bool
+	outputs   []string
+}
+
+type output struct {
+	Value interface{} `json:"value"`
+}
+
+type stateFile struct {
+	Version int `json:"version"`
+
+	// Version 3 state files, written by Terraform 0.11 and earlier.
+	Modules []struct {
+		Outputs   map[string]output `json:"outputs"`
+		Resources map[string]struct {
+			Type    string `json:"type"`
+			Primary struct {
+				ID         string            `json:"id"`
+				Attributes map[string]string `json:"attributes"`
+			} `json:"primary"`
+		} `json:"resources"`
+	} `json:"modules"`
+
+	// Version 4 state files, written by Terraform 0.12 and later.
+	Outputs   map[string]output `json:"outputs"`
+	Resources []struct {
+		Mode      string `json:"mode"`
+		Type      string `json:"type"`
+		Instances []struct {
+			Attributes map[string]interface{} `json:"attributes"`
+		} `json:"instances"`
+	} `json:"resources"`
+}
+
+// Load reads the Terraform state file at the provided path and
+// returns the managed resources in it that leftovers can delete.
+func Load(path string) (State, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return State{}, fmt.Errorf("Reading terraform state: %s", err)
+	}
+
+	return Parse(contents)
+}
+
+// Parse returns the managed resources in the provided
+// contents of a version 3 or version 4 state file.
+func Parse(contents []byte) (State, error) {
+	var file stateFile
+	err := json.Unmarshal(contents, &file)
+	if err != nil {
+		return State{}, fmt.Errorf("Parsing terraform state: %s", err)
+	}
+
+	state := State{resources: map[string]map[string]bool{}}
+
+	switch file.Version {
+	case 3:
+		for _, module := range file.Modules {
+			for _, o := range module.Outputs {
+				state.addOutput(o.Value)
+			}
+
+			for key, r := range module.Resources {
+				if strings.HasPrefix(key, "data.") {
+					continue
+				}
+
+				attributes := map[string]string{"id": r.Primary.ID}
+				for k, v := range r.Primary.Attributes {
+					attributes[k] = v
+				}
+
+				state.add(r.Type, attributes)
+			}
+		}
+	case 4:
+		for _, o := range file.Outputs {
+			state.addOutput(o.Value)
+		}
+
+		for _, r := range file.Resources {
+			if r.Mode != "managed" {
+				continue
+			}
+
+			for _, instance := range r.Instances {
+				attributes := map[string]string{}
+				for k, v := range instance.Attributes {
+					if s, ok := v.(string); ok {
+						attributes[k] = s
+					}
+				}
+
+				state.add(r.Type, attributes)
+			}
+		}
+	default:
+		return State{}, fmt.Errorf("Unsupported terraform state version: %d", file.Version)
+	}
+
+	return state, nil
+}
+
+func (s State) add(tfType string, attributes map[string]string) {
+	rType, ok := resourceTypes[tfType]
+	if !ok {
+		return
+	}
+
+	if _, ok := s.resources[rType.leftovers]; !ok {
+		s.resources[rType.leftovers] = map[string]bool{}
+	}
+
+	for _, a := range rType.attributes {
+		if v := strings.TrimSuffix(attributes[a], "."); v != "" {
+			s.resources[rType.leftovers][v] = true
+		}
+	}
+}
+
+// addOutput adds the value of an output, or the values of a list output,
+// that are strings without whitespace, which leaves out keys and certificates.
+func (s *State) addOutput(value interface{}) {
+	switch v := value.(type) {
+	case string:
+		if v != "" && !strings.ContainsAny(v, " \t\n") {
+			s.outputs = append(s.outputs, v)
+		}
+	case []interface{}:
+		for _, e := range v {
+			s.addOutput(e)
+		}
+	}
+}
+
+// Outputs returns the string values of the outputs in the state,
+// like the names and ids of the resources that a module created.
+func (s State) Outputs() []string {
+	outputs := append([]string{}, s.outputs...)
+	sort.Strings(outputs)
+	return outputs
+}
+
+// Contains returns true if a resource of the provided type is in the
+// state and its identifier is in the provided resource name. Names that
+// leftovers prints with extra details, like tags, contain the identifier
+// separated from those details by a space.
+func (s State) Contains(resourceType, resourceName string) bool {
+	ids, ok := s.resources[resourceType]
+	if !ok {
+		return false
+	}
+
+	name := strings.TrimSuffix(resourceName, ".")
+	if ids[name] {
+		return true
+	}
+
+	for id := range ids {
+		if strings.Contains(" "+name+" ", " "+id+" ") {
+			return true
+		}
+	}
+
+	return false
+}
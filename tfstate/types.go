@@ -0,0 +1,79 @@
+package tfstate
+
+// resourceTypes maps Terraform resource types to the type of the leftovers
+// resource they create and the attributes that leftovers identifies that
+// resource by. Only resources in this map are read from the state file.
+var resourceTypes = map[string]resourceType{
+	"aws_instance":               {"EC2 Instance", []string{"id"}},
+	"aws_ebs_volume":             {"EC2 Volume", []string{"id"}},
+	"aws_ebs_snapshot":           {"EC2 Snapshot", []string{"id"}},
+	"aws_ami":                    {"EC2 Image", []string{"id"}},
+	"aws_key_pair":               {"EC2 Key Pair", []string{"key_name"}},
+	"aws_eip":                    {"EC2 Address", []string{"public_ip"}},
+	"aws_nat_gateway":            {"EC2 Nat Gateway", []string{"id"}},
+	"aws_network_interface":      {"EC2 Network Interface", []string{"id"}},
+	"aws_security_group":         {"EC2 Security Group", []string{"name"}},
+	"aws_vpc":                    {"EC2 VPC", []string{"id"}},
+	"aws_elb":                    {"ELB Load Balancer", []string{"name"}},
+	"aws_lb":                     {"ELBV2 Load Balancer", []string{"name"}},
+	"aws_alb":                    {"ELBV2 Load Balancer", []string{"name"}},
+	"aws_lb_target_group":        {"ELBV2 Target Group", []string{"name"}},
+	"aws_alb_target_group":       {"ELBV2 Target Group", []string{"name"}},
+	"aws_iam_instance_profile":   {"IAM Instance Profile", []string{"name"}},
+	"aws_iam_role":               {"IAM Role", []string{"name"}},
+	"aws_iam_user":               {"IAM User", []string{"name"}},
+	"aws_iam_policy":             {"IAM Policy", []string{"name"}},
+	"aws_iam_server_certificate": {"IAM Server Certificate", []string{"name"}},
+	"aws_eks_cluster":            {"EKS Cluster", []string{"name"}},
+	"aws_db_instance":            {"RDS DB Instance", []string{"identifier", "id"}},
+	"aws_rds_cluster":            {"RDS DB Cluster", []string{"cluster_identifier", "id"}},
+	"aws_db_subnet_group":        {"RDS DB Subnet Group", []string{"name"}},
+	"aws_s3_bucket":              {"S3 Bucket", []string{"bucket", "id"}},
+	"aws_kms_key":                {"KMS Key", []string{"key_id", "id"}},
+	"aws_kms_alias":              {"KMS Alias", []string{"name"}},
+	"aws_route53_zone":           {"Route53 Hosted Zone", []string{"name"}},
+	"aws_route53_health_check":   {"Route53 Health Check", []string{"id"}},
+
+	"google_compute_instance":               {"Compute Instance", []string{"name"}},
+	"google_compute_disk":                   {"Disk", []string{"name"}},
+	"google_compute_image":                  {"Image", []string{"name"}},
+	"google_compute_network":                {"Network", []string{"name"}},
+	"google_compute_subnetwork":             {"Subnetwork", []string{"name"}},
+	"google_compute_firewall":               {"Firewall", []string{"name"}},
+	"google_compute_route":                  {"Route", []string{"name"}},
+	"google_compute_address":                {"Address", []string{"name"}},
+	"google_compute_global_address":         {"Global Address", []string{"name"}},
+	"google_compute_forwarding_rule":        {"Forwarding Rule", []string{"name"}},
+	"google_compute_global_forwarding_rule": {"Global Forwarding Rule", []string{"name"}},
+	"google_compute_target_http_proxy":      {"Target Http Proxy", []string{"name"}},
+	"google_compute_target_https_proxy":     {"Target Https Proxy", []string{"name"}},
+	"google_compute_url_map":                {"Url Map", []string{"name"}},
+	"google_compute_target_pool":            {"Target Pool", []string{"name"}},
+	"google_compute_backend_service":        {"Backend Service", []string{"name"}},
+	"google_compute_instance_template":      {"Instance Template", []string{"name"}},
+	"google_compute_instance_group":         {"Instance Group", []string{"name"}},
+	"google_compute_instance_group_manager": {"Instance Group Manager", []string{"name"}},
+	"google_compute_health_check":           {"Global Health Check", []string{"name"}},
+	"google_compute_http_health_check":      {"Http Health Check", []string{"name"}},
+	"google_compute_https_health_check":     {"Https Health Check", []string{"name"}},
+	"google_compute_vpn_gateway":            {"Target Vpn Gateway", []string{"name"}},
+	"google_compute_vpn_tunnel":             {"Vpn Tunnel", []string{"name"}},
+	"google_compute_ssl_certificate":        {"Compute Ssl Certificate", []string{"name"}},
+	"google_service_account":                {"IAM Service Account", []string{"name"}},
+	"google_dns_managed_zone":               {"DNS Managed Zone", []string{"name"}},
+	"google_sql_database_instance":          {"SQL Instance", []string{"name"}},
+	"google_storage_bucket":                 {"Storage Bucket", []string{"name"}},
+	"google_container_cluster":              {"Container Cluster", []string{"name"}},
+
+	"azurerm_resource_group": {"Resource Group", []string{"name"}},
+
+	"openstack_compute_instance_v2":    {"Compute Instance", []string{"id"}},
+	"openstack_blockstorage_volume_v2": {"Volume", []string{"id"}},
+	"openstack_blockstorage_volume_v3": {"Volume", []string{"id"}},
+	"openstack_images_image_v2":        {"Image", []string{"id"}},
+}
+
+type resourceType struct {
+	leftovers  string
+	attributes []string
+}
@@ -12,17 +12,23 @@ import (
 type Folder struct {
 	folder *object.Folder
 	name   string
+	logger logger
 }
 
-func NewFolder(folder *object.Folder, name string) Folder {
+func NewFolder(folder *object.Folder, name string, logger logger) Folder {
 	return Folder{
 		folder: folder,
 		name:   name,
+		logger: logger,
 	}
 }
 
 func (f Folder) Delete() error {
-	tctx, cancel := context.WithTimeout(context.Background(), time.Minute*5)
+	return f.DeleteContext(context.Background())
+}
+
+func (f Folder) DeleteContext(ctx context.Context) error {
+	tctx, cancel := context.WithTimeout(ctx, time.Minute*5)
 	defer cancel()
 
 	destroy, err := f.folder.Common.Destroy(tctx)
@@ -30,7 +36,7 @@ func (f Folder) Delete() error {
 		return fmt.Errorf("Destroy folder %s: %s", f.name, err)
 	}
 
-	err = destroy.Wait(tctx)
+	err = waitForTask(tctx, f.logger, destroy)
 	if err != nil {
 		return fmt.Errorf("Waiting for folder %s to destroy: %s", f.name, err)
 	}
@@ -7,6 +7,9 @@ import (
 
 	"github.com/genevieve/leftovers/common"
 	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
 )
 
 type client interface {
@@ -45,10 +48,15 @@ func (f Folders) listChildren(parent *object.Folder, rType string) ([]common.Del
 		return nil, fmt.Errorf("listing children: %s", err)
 	}
 
+	properties, err := virtualMachineProperties(ctx, parent, children)
+	if err != nil {
+		return nil, fmt.Errorf("Getting virtual machine properties: %s", err)
+	}
+
 	for _, child := range children {
 		g, ok := child.(*object.VirtualMachine)
 		if ok {
-			vm := NewVirtualMachine(g)
+			vm := NewVirtualMachine(g, properties[g.Reference()], f.logger)
 
 			if strings.Contains(strings.ToLower(vm.Type()), strings.ToLower(rType)) {
 				proceed := f.logger.PromptWithDetails(vm.Type(), vm.Name())
@@ -76,7 +84,7 @@ func (f Folders) listChildren(parent *object.Folder, rType string) ([]common.Del
 				return nil, fmt.Errorf("Folder name: %s", err)
 			}
 
-			childFolderToDelete := NewFolder(childFolder, childFolderName)
+			childFolderToDelete := NewFolder(childFolder, childFolderName, f.logger)
 
 			if strings.Contains(strings.ToLower(childFolderToDelete.Type()), strings.ToLower(rType)) {
 				proceed := f.logger.PromptWithDetails(childFolderToDelete.Type(), childFolderToDelete.Name())
@@ -95,6 +103,35 @@ func (f Folders) listChildren(parent *object.Folder, rType string) ([]common.Del
 	return deletable, nil
 }
 
+// virtualMachineProperties returns the name and the custom attributes of
+// the virtual machines among the children of the folder, which are
+// retrieved with one property collector call.
+func virtualMachineProperties(ctx context.Context, parent *object.Folder, children []object.Reference) (map[types.ManagedObjectReference]mo.VirtualMachine, error) {
+	var refs []types.ManagedObjectReference
+	for _, child := range children {
+		if vm, ok := child.(*object.VirtualMachine); ok {
+			refs = append(refs, vm.Reference())
+		}
+	}
+
+	properties := map[types.ManagedObjectReference]mo.VirtualMachine{}
+	if len(refs) == 0 {
+		return properties, nil
+	}
+
+	var vms []mo.VirtualMachine
+	err := property.DefaultCollector(parent.Client()).Retrieve(ctx, refs, []string{"name", "availableField", "customValue"}, &vms)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, vm := range vms {
+		properties[vm.Reference()] = vm
+	}
+
+	return properties, nil
+}
+
 func (f Folders) Type() string {
 	return "folder"
 }
@@ -11,6 +11,9 @@ import (
 	"github.com/genevieve/leftovers/common"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/session"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/soap"
 )
 
 type resource interface {
@@ -31,7 +34,7 @@ func (l Leftovers) List(filter string) {
 	for _, r := range l.resources {
 		list, err := r.List(filter, "")
 		if err != nil {
-			l.logger.Println(color.YellowString(err.Error()))
+			l.logger.Errorln(color.YellowString(err.Error()))
 		}
 
 		all = append(all, list...)
@@ -54,15 +57,15 @@ func (l Leftovers) Types() {
 // the provided filter in the resource's identifier, prompt
 // you to confirm deletion (if enabled), and delete those
 // that are selected.
-func (l Leftovers) Delete(filter string) error {
-	return l.DeleteType(filter, "")
+func (l Leftovers) Delete(ctx context.Context, filter string) error {
+	return l.DeleteType(ctx, filter, "")
 }
 
 // DeleteType will collect all resources of the provied type that contain
 // the provided filter in the resource's identifier, prompt
 // you to confirm deletion, and delete those
 // that are selected.
-func (l Leftovers) DeleteType(filter, rType string) error {
+func (l Leftovers) DeleteType(ctx context.Context, filter, rType string) error {
 	var (
 		deletables []common.Deletable
 		result     *multierror.Error
@@ -78,14 +81,19 @@ func (l Leftovers) DeleteType(filter, rType string) error {
 	}
 
 	for _, d := range deletables {
+		if ctx.Err() != nil {
+			result = multierror.Append(result, ctx.Err())
+			break
+		}
+
 		l.logger.Println(fmt.Sprintf("[%s: %s] Deleting...", d.Type(), d.Name()))
 
-		err := d.Delete()
+		err := common.Delete(ctx, d)
 		if err != nil {
 			err = fmt.Errorf("[%s: %s] %s", d.Type(), d.Name(), color.YellowString(err.Error()))
 			result = multierror.Append(result, err)
 
-			l.logger.Println(err.Error())
+			l.logger.Errorln(err.Error())
 		} else {
 			l.logger.Println(fmt.Sprintf("[%s: %s] %s", d.Type(), d.Name(), color.GreenString("Deleted!")))
 		}
@@ -97,7 +105,8 @@ func (l Leftovers) DeleteType(filter, rType string) error {
 // NewLeftovers returns a new Leftovers for vSphere that can be used to list resources,
 // list types, or delete resources for the provided account. It returns an error
 // if the credentials provided are invalid or a client cannot be created.
-func NewLeftovers(logger logger, vCenterIP, vCenterUser, vCenterPassword, vCenterDC string) (Leftovers, error) {
+// If a transport is provided, it wraps the transport that requests are sent with.
+func NewLeftovers(logger logger, vCenterIP, vCenterUser, vCenterPassword, vCenterDC string, transport common.WrapTransport) (Leftovers, error) {
 	if vCenterIP == "" {
 		return Leftovers{}, errors.New("Missing vCenter IP.")
 	}
@@ -124,7 +133,20 @@ func NewLeftovers(logger logger, vCenterIP, vCenterUser, vCenterPassword, vCente
 	ctx, cancel := context.WithTimeout(context.Background(), time.Minute*5)
 	defer cancel()
 
-	vmomi, err := govmomi.NewClient(ctx, vCenterUrl, true)
+	soapClient := soap.NewClient(vCenterUrl, true)
+	soapClient.Client.Transport = transport.Wrap(soapClient.Client.Transport)
+
+	vimClient, err := vim25.NewClient(ctx, soapClient)
+	if err != nil {
+		return Leftovers{}, fmt.Errorf("Error setting up client: %s", err)
+	}
+
+	vmomi := &govmomi.Client{
+		Client:         vimClient,
+		SessionManager: session.NewManager(vimClient),
+	}
+
+	err = vmomi.Login(ctx, vCenterUrl.User)
 	if err != nil {
 		return Leftovers{}, fmt.Errorf("Error setting up client: %s", err)
 	}
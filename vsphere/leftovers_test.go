@@ -0,0 +1,40 @@
+package vsphere_test
+
+import (
+	"bytes"
+	"os"
+
+	"github.com/fatih/color"
+	"github.com/genevieve/leftovers/app"
+	"github.com/genevieve/leftovers/recorder"
+	"github.com/genevieve/leftovers/vsphere"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Leftovers", func() {
+	var (
+		stdout    *bytes.Buffer
+		leftovers vsphere.Leftovers
+	)
+
+	BeforeEach(func() {
+		color.NoColor = true
+
+		rec, err := recorder.New("testdata/fixture.json", recorder.ModeReplay)
+		Expect(err).NotTo(HaveOccurred())
+
+		stdout = bytes.NewBuffer([]byte{})
+		logger := app.NewLogger(stdout, os.Stdin, true)
+
+		leftovers, err = vsphere.NewLeftovers(logger, "vcenter.example.com", "user", "password", "DC0", rec.Wrap)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("lists the recorded resources in the folder and its child folders", func() {
+		leftovers.List("leftovers")
+
+		Expect(stdout.String()).To(Equal("[Virtual Machine: DC0_H0_VM1]\n[Folder: leftovers-nested]\n[Virtual Machine: DC0_H0_VM0 (director:bosh-banana, deployment:cf)]\n"))
+	})
+})
@@ -3,5 +3,6 @@ package vsphere
 type logger interface {
 	Printf(message string, a ...interface{})
 	Println(message string)
+	Errorln(message string)
 	PromptWithDetails(resourceType, resourceName string) bool
 }
@@ -0,0 +1,39 @@
+package vsphere
+
+import (
+	"context"
+	"errors"
+
+	"github.com/genevieve/leftovers/common"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// waitForTask polls the task until it succeeds, fails,
+// or the context is done.
+func waitForTask(ctx context.Context, logger logger, task *object.Task) error {
+	poller := common.NewPoller(logger, taskRefresh(ctx, task), []string{"queued", "running"}, []string{"success"})
+
+	_, err := poller.Wait(ctx)
+	return err
+}
+
+func taskRefresh(ctx context.Context, task *object.Task) common.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		var t mo.Task
+		err := task.Properties(ctx, task.Reference(), []string{"info"}, &t)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if t.Info.State == types.TaskInfoStateError {
+			if t.Info.Error != nil {
+				return nil, "", errors.New(t.Info.Error.LocalizedMessage)
+			}
+			return nil, "", errors.New("Task failed.")
+		}
+
+		return t.Info, string(t.Info.State), nil
+	}
+}
@@ -5,52 +5,63 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/genevieve/leftovers/bosh"
 	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
 )
 
 // VirtualMachine represents a vm or template in vSphere.
 type VirtualMachine struct {
-	name string
-	vm   *object.VirtualMachine
+	name   string
+	vm     *object.VirtualMachine
+	logger logger
 }
 
-func NewVirtualMachine(vm *object.VirtualMachine) VirtualMachine {
-	name, _ := vm.Common.ObjectName(context.Background())
+// NewVirtualMachine includes the director and deployment custom attributes
+// that BOSH sets on the vm in its name. The properties have to include
+// the name, availableField and customValue of the vm.
+func NewVirtualMachine(vm *object.VirtualMachine, props mo.VirtualMachine, logger logger) VirtualMachine {
 	return VirtualMachine{
-		name: name,
-		vm:   vm,
+		name:   withCustomAttributes(props.Name, props),
+		vm:     vm,
+		logger: logger,
 	}
 }
 
-// Delete will shut off a VM, if it is powered on or suspended,
-// and will delete a VM or template from inventory.
 func (v VirtualMachine) Delete() error {
-	tctx, tcancel := context.WithTimeout(context.Background(), time.Minute*5)
+	return v.DeleteContext(context.Background())
+}
+
+// DeleteContext will shut off a VM, if it is powered on or suspended,
+// and will delete a VM or template from inventory.
+func (v VirtualMachine) DeleteContext(ctx context.Context) error {
+	tctx, tcancel := context.WithTimeout(ctx, time.Minute*5)
 	defer tcancel()
 
-	powerState, err := v.vm.PowerState(context.Background())
+	powerState, err := v.vm.PowerState(tctx)
 	if err != nil {
 		return fmt.Errorf("Getting power state: %s", powerState)
 	}
 
 	if powerState == "poweredOn" || powerState == "suspended" {
-		powerOff, err := v.vm.PowerOff(context.Background())
+		powerOff, err := v.vm.PowerOff(tctx)
 		if err != nil {
 			return fmt.Errorf("Shutting down virtual machine: %s", err)
 		}
 
-		err = powerOff.Wait(tctx)
+		err = waitForTask(tctx, v.logger, powerOff)
 		if err != nil {
 			return fmt.Errorf("Waiting for machine to shut down: %s", err)
 		}
 	}
 
-	destroy, err := v.vm.Destroy(context.Background())
+	destroy, err := v.vm.Destroy(tctx)
 	if err != nil {
 		return fmt.Errorf("Destroying virtual machine: %s", err)
 	}
 
-	err = destroy.Wait(tctx)
+	err = waitForTask(tctx, v.logger, destroy)
 	if err != nil {
 		return fmt.Errorf("Waiting for machine to destroy: %s", err)
 	}
@@ -65,3 +76,19 @@ func (v VirtualMachine) Name() string {
 func (v VirtualMachine) Type() string {
 	return "Virtual Machine"
 }
+
+func withCustomAttributes(name string, props mo.VirtualMachine) string {
+	fields := map[int32]string{}
+	for _, f := range props.AvailableField {
+		fields[f.Key] = f.Name
+	}
+
+	values := map[string]string{}
+	for _, v := range props.CustomValue {
+		if s, ok := v.(*types.CustomFieldStringValue); ok {
+			values[fields[s.Key]] = s.Value
+		}
+	}
+
+	return bosh.WithMetadata(name, values)
+}